@@ -7,6 +7,7 @@ import (
 	acltokenfactorymapping "github.com/kiichain/kiichain/aclmapping/tokenfactory"
 	aclwasmmapping "github.com/kiichain/kiichain/aclmapping/wasm"
 	evmkeeper "github.com/kiichain/kiichain/x/evm/keeper"
+	tokenfactorykeeper "github.com/kiichain/kiichain/x/tokenfactory/keeper"
 )
 
 type CustomDependencyGenerator struct{}
@@ -15,12 +16,12 @@ func NewCustomDependencyGenerator() CustomDependencyGenerator {
 	return CustomDependencyGenerator{}
 }
 
-func (customDepGen CustomDependencyGenerator) GetCustomDependencyGenerators(evmKeeper evmkeeper.Keeper) aclkeeper.DependencyGeneratorMap {
+func (customDepGen CustomDependencyGenerator) GetCustomDependencyGenerators(evmKeeper evmkeeper.Keeper, tokenFactoryKeeper tokenfactorykeeper.Keeper) aclkeeper.DependencyGeneratorMap {
 	dependencyGeneratorMap := make(aclkeeper.DependencyGeneratorMap)
 	wasmDependencyGenerators := aclwasmmapping.NewWasmDependencyGenerator()
 
 	dependencyGeneratorMap = dependencyGeneratorMap.Merge(aclbankmapping.GetBankDepedencyGenerator())
-	dependencyGeneratorMap = dependencyGeneratorMap.Merge(acltokenfactorymapping.GetTokenFactoryDependencyGenerators())
+	dependencyGeneratorMap = dependencyGeneratorMap.Merge(acltokenfactorymapping.GetTokenFactoryDependencyGenerators(tokenFactoryKeeper))
 	dependencyGeneratorMap = dependencyGeneratorMap.Merge(wasmDependencyGenerators.GetWasmDependencyGenerators())
 	dependencyGeneratorMap = dependencyGeneratorMap.Merge(aclevmmapping.GetEVMDependencyGenerators(evmKeeper))
 
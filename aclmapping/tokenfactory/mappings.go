@@ -10,22 +10,54 @@ import (
 	acltypes "github.com/cosmos/cosmos-sdk/x/accesscontrol/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	aclutils "github.com/kiichain/kiichain/aclmapping/utils"
+	tfkkeeper "github.com/kiichain/kiichain/x/tokenfactory/keeper"
 	tfktypes "github.com/kiichain/kiichain/x/tokenfactory/types"
 )
 
 var ErrInvalidMessageType = fmt.Errorf("invalid message received for TokenFactory Module")
 
-func GetTokenFactoryDependencyGenerators() aclkeeper.DependencyGeneratorMap {
+func GetTokenFactoryDependencyGenerators(tokenFactoryKeeper tfkkeeper.Keeper) aclkeeper.DependencyGeneratorMap {
 	dependencyGeneratorMap := make(aclkeeper.DependencyGeneratorMap)
 	MintMsgKey := acltypes.GenerateMessageKey(&tfktypes.MsgMint{})
 	dependencyGeneratorMap[MintMsgKey] = TokenFactoryMintDependencyGenerator
 
 	BurnMsgKey := acltypes.GenerateMessageKey(&tfktypes.MsgBurn{})
-	dependencyGeneratorMap[BurnMsgKey] = TokenFactoryBurnDependencyGenerator
+	dependencyGeneratorMap[BurnMsgKey] = func(k aclkeeper.Keeper, ctx sdk.Context, msg sdk.Msg) ([]sdkacltypes.AccessOperation, error) {
+		return TokenFactoryBurnDependencyGenerator(k, tokenFactoryKeeper, ctx, msg)
+	}
+
+	ForceTransferMsgKey := acltypes.GenerateMessageKey(&tfktypes.MsgForceTransfer{})
+	dependencyGeneratorMap[ForceTransferMsgKey] = func(k aclkeeper.Keeper, ctx sdk.Context, msg sdk.Msg) ([]sdkacltypes.AccessOperation, error) {
+		return TokenFactoryForceTransferDependencyGenerator(k, tokenFactoryKeeper, ctx, msg)
+	}
 
 	return dependencyGeneratorMap
 }
 
+// pausedDenomAccessOps returns the access operations of the admin actions on a
+// paused denom, which restore the bank allow list of the denom while they run.
+// The bank store has no resource type for the allow lists, so the whole bank
+// store is declared, only while the denom is paused.
+func pausedDenomAccessOps(tokenFactoryKeeper tfkkeeper.Keeper, ctx sdk.Context, denom string) []sdkacltypes.AccessOperation {
+	if !tokenFactoryKeeper.IsPaused(ctx, denom) {
+		return []sdkacltypes.AccessOperation{}
+	}
+
+	return []sdkacltypes.AccessOperation{
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_BANK,
+			IdentifierTemplate: aclutils.DefaultIDTemplate,
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_WRITE,
+			ResourceType:       sdkacltypes.ResourceType_KV_BANK,
+			IdentifierTemplate: aclutils.DefaultIDTemplate,
+		},
+	}
+}
+
 func TokenFactoryMintDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context, msg sdk.Msg) ([]sdkacltypes.AccessOperation, error) {
 	mintMsg, ok := msg.(*tfktypes.MsgMint)
 	if !ok {
@@ -34,6 +66,12 @@ func TokenFactoryMintDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context,
 	moduleAdr := keeper.AccountKeeper.GetModuleAddress(tfktypes.ModuleName)
 	denom := mintMsg.GetAmount().Denom
 
+	// the tokens are minted to the sender unless a recipient is given
+	mintToAddress := mintMsg.GetSender()
+	if mintMsg.GetMintToAddress() != "" {
+		mintToAddress = mintMsg.GetMintToAddress()
+	}
+
 	denomMetaDataKey := append([]byte(tfktypes.DenomAuthorityMetadataKey), []byte(denom)...)
 	tokenfactoryDenomKey := tfktypes.GetDenomPrefixStore(denom)
 	bankDenomMetaDataKey := banktypes.DenomMetadataKey(denom)
@@ -60,6 +98,12 @@ func TokenFactoryMintDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context,
 			ResourceType:       sdkacltypes.ResourceType_KV_TOKENFACTORY_DENOM,
 			IdentifierTemplate: hex.EncodeToString(tokenfactoryDenomKey),
 		},
+		// Spends the minter allowance
+		{
+			AccessType:         sdkacltypes.AccessType_WRITE,
+			ResourceType:       sdkacltypes.ResourceType_KV_TOKENFACTORY_DENOM,
+			IdentifierTemplate: hex.EncodeToString(tokenfactoryDenomKey),
+		},
 
 		// Gets Module Account information
 		{
@@ -78,16 +122,16 @@ func TokenFactoryMintDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context,
 			IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefix(moduleAdr)),
 		},
 
-		// Deposit into Sender's Bank Balance
+		// Deposit into the recipient's Bank Balance
 		{
 			AccessType:         sdkacltypes.AccessType_READ,
 			ResourceType:       sdkacltypes.ResourceType_KV_BANK_BALANCES,
-			IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefixFromBech32(mintMsg.GetSender())),
+			IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefixFromBech32(mintToAddress)),
 		},
 		{
 			AccessType:         sdkacltypes.AccessType_WRITE,
 			ResourceType:       sdkacltypes.ResourceType_KV_BANK_BALANCES,
-			IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefixFromBech32(mintMsg.GetSender())),
+			IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefixFromBech32(mintToAddress)),
 		},
 
 		// Read and update supply after burn
@@ -105,19 +149,19 @@ func TokenFactoryMintDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context,
 		{
 			AccessType:         sdkacltypes.AccessType_READ,
 			ResourceType:       sdkacltypes.ResourceType_KV_AUTH_ADDRESS_STORE,
-			IdentifierTemplate: hex.EncodeToString(authtypes.CreateAddressStoreKeyFromBech32(mintMsg.GetSender())),
+			IdentifierTemplate: hex.EncodeToString(authtypes.CreateAddressStoreKeyFromBech32(mintToAddress)),
 		},
 		{
 			AccessType:         sdkacltypes.AccessType_WRITE,
 			ResourceType:       sdkacltypes.ResourceType_KV_AUTH_ADDRESS_STORE,
-			IdentifierTemplate: hex.EncodeToString(authtypes.CreateAddressStoreKeyFromBech32(mintMsg.GetSender())),
+			IdentifierTemplate: hex.EncodeToString(authtypes.CreateAddressStoreKeyFromBech32(mintToAddress)),
 		},
 		// Last Operation should always be a commit
 		*acltypes.CommitAccessOp(),
 	}, nil
 }
 
-func TokenFactoryBurnDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context, msg sdk.Msg) ([]sdkacltypes.AccessOperation, error) {
+func TokenFactoryBurnDependencyGenerator(keeper aclkeeper.Keeper, tokenFactoryKeeper tfkkeeper.Keeper, ctx sdk.Context, msg sdk.Msg) ([]sdkacltypes.AccessOperation, error) {
 	burnMsg, ok := msg.(*tfktypes.MsgBurn)
 	if !ok {
 		return []sdkacltypes.AccessOperation{}, ErrInvalidMessageType
@@ -126,11 +170,17 @@ func TokenFactoryBurnDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context,
 	moduleAdr := keeper.AccountKeeper.GetModuleAddress(tfktypes.ModuleName)
 	denom := burnMsg.GetAmount().Denom
 
+	// the tokens are burned from the sender unless another account is given
+	burnFromAddress := burnMsg.GetSender()
+	if burnMsg.GetBurnFromAddress() != "" {
+		burnFromAddress = burnMsg.GetBurnFromAddress()
+	}
+
 	denomMetaDataKey := append([]byte(tfktypes.DenomAuthorityMetadataKey), []byte(denom)...)
 	tokenfactoryDenomKey := tfktypes.GetDenomPrefixStore(denom)
 	bankDenomMetaDataKey := banktypes.DenomMetadataKey(denom)
 	supplyKey := hex.EncodeToString(append(banktypes.SupplyKey, []byte(denom)...))
-	return []sdkacltypes.AccessOperation{
+	accessOperations := []sdkacltypes.AccessOperation{
 		// Reads denom data From BankKeeper
 		{
 			AccessType:         sdkacltypes.AccessType_READ,
@@ -169,16 +219,16 @@ func TokenFactoryBurnDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context,
 			IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefix(moduleAdr)),
 		},
 
-		// Checks balance for receiver
+		// Checks balance of the burned account
 		{
 			AccessType:         sdkacltypes.AccessType_READ,
 			ResourceType:       sdkacltypes.ResourceType_KV_BANK_BALANCES,
-			IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefixFromBech32(burnMsg.GetSender())),
+			IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefixFromBech32(burnFromAddress)),
 		},
 		{
 			AccessType:         sdkacltypes.AccessType_WRITE,
 			ResourceType:       sdkacltypes.ResourceType_KV_BANK_BALANCES,
-			IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefixFromBech32(burnMsg.GetSender())),
+			IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefixFromBech32(burnFromAddress)),
 		},
 
 		// Read and update supply after burn
@@ -196,15 +246,97 @@ func TokenFactoryBurnDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context,
 		{
 			AccessType:         sdkacltypes.AccessType_READ,
 			ResourceType:       sdkacltypes.ResourceType_KV_AUTH_ADDRESS_STORE,
-			IdentifierTemplate: hex.EncodeToString(authtypes.CreateAddressStoreKeyFromBech32(burnMsg.GetSender())),
+			IdentifierTemplate: hex.EncodeToString(authtypes.CreateAddressStoreKeyFromBech32(burnFromAddress)),
 		},
 		{
 			AccessType:         sdkacltypes.AccessType_WRITE,
 			ResourceType:       sdkacltypes.ResourceType_KV_AUTH_ADDRESS_STORE,
-			IdentifierTemplate: hex.EncodeToString(authtypes.CreateAddressStoreKeyFromBech32(burnMsg.GetSender())),
+			IdentifierTemplate: hex.EncodeToString(authtypes.CreateAddressStoreKeyFromBech32(burnFromAddress)),
 		},
+	}
+	accessOperations = append(accessOperations, pausedDenomAccessOps(tokenFactoryKeeper, ctx, denom)...)
 
-		// Last Operation should always be a commit
-		*acltypes.CommitAccessOp(),
-	}, nil
+	// Last Operation should always be a commit
+	return append(accessOperations, *acltypes.CommitAccessOp()), nil
+}
+
+func TokenFactoryForceTransferDependencyGenerator(keeper aclkeeper.Keeper, tokenFactoryKeeper tfkkeeper.Keeper, ctx sdk.Context, msg sdk.Msg) ([]sdkacltypes.AccessOperation, error) {
+	forceTransferMsg, ok := msg.(*tfktypes.MsgForceTransfer)
+	if !ok {
+		return []sdkacltypes.AccessOperation{}, ErrInvalidMessageType
+	}
+
+	denom := forceTransferMsg.GetAmount().Denom
+	fromAddress := forceTransferMsg.GetTransferFromAddress()
+	toAddress := forceTransferMsg.GetTransferToAddress()
+
+	denomMetaDataKey := append([]byte(tfktypes.DenomAuthorityMetadataKey), []byte(denom)...)
+	tokenfactoryDenomKey := tfktypes.GetDenomPrefixStore(denom)
+	fromAddrIdentifier := hex.EncodeToString(banktypes.CreateAccountBalancesPrefixFromBech32(fromAddress))
+	toAddrIdentifier := hex.EncodeToString(banktypes.CreateAccountBalancesPrefixFromBech32(toAddress))
+	accessOperations := []sdkacltypes.AccessOperation{
+		// Gets Authoritity data related to the denom
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_TOKENFACTORY_METADATA,
+			IdentifierTemplate: hex.EncodeToString(denomMetaDataKey),
+		},
+
+		// Gets Authoritity data related to the denom
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_TOKENFACTORY_DENOM,
+			IdentifierTemplate: hex.EncodeToString(tokenfactoryDenomKey),
+		},
+
+		// Reduce the amount from the source account balance
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_BANK_BALANCES,
+			IdentifierTemplate: fromAddrIdentifier,
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_WRITE,
+			ResourceType:       sdkacltypes.ResourceType_KV_BANK_BALANCES,
+			IdentifierTemplate: fromAddrIdentifier,
+		},
+
+		// Deposit into the recipient's Bank Balance
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_BANK_BALANCES,
+			IdentifierTemplate: toAddrIdentifier,
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_WRITE,
+			ResourceType:       sdkacltypes.ResourceType_KV_BANK_BALANCES,
+			IdentifierTemplate: toAddrIdentifier,
+		},
+
+		// Tries to create the reciever's account if it doesn't exist
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_AUTH_ADDRESS_STORE,
+			IdentifierTemplate: hex.EncodeToString(authtypes.CreateAddressStoreKeyFromBech32(toAddress)),
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_WRITE,
+			ResourceType:       sdkacltypes.ResourceType_KV_AUTH_ADDRESS_STORE,
+			IdentifierTemplate: hex.EncodeToString(authtypes.CreateAddressStoreKeyFromBech32(toAddress)),
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_AUTH_GLOBAL_ACCOUNT_NUMBER,
+			IdentifierTemplate: hex.EncodeToString(authtypes.GlobalAccountNumberKey),
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_WRITE,
+			ResourceType:       sdkacltypes.ResourceType_KV_AUTH_GLOBAL_ACCOUNT_NUMBER,
+			IdentifierTemplate: hex.EncodeToString(authtypes.GlobalAccountNumberKey),
+		},
+	}
+	accessOperations = append(accessOperations, pausedDenomAccessOps(tokenFactoryKeeper, ctx, denom)...)
+
+	// Last Operation should always be a commit
+	return append(accessOperations, *acltypes.CommitAccessOp()), nil
 }
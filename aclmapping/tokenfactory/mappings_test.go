@@ -5,11 +5,9 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
-	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkacltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
 	acltypes "github.com/cosmos/cosmos-sdk/x/accesscontrol/types"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	tkfactory "github.com/kiichain/kiichain/aclmapping/tokenfactory"
 	aclutils "github.com/kiichain/kiichain/aclmapping/utils"
@@ -18,10 +16,9 @@ import (
 	tokenfactorykeeper "github.com/kiichain/kiichain/x/tokenfactory/keeper"
 	"github.com/kiichain/kiichain/x/tokenfactory/types"
 	tokenfactorytypes "github.com/kiichain/kiichain/x/tokenfactory/types"
-	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
-	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 type KeeperTestSuite struct {
@@ -74,10 +71,57 @@ func (suite *KeeperTestSuite) PrepareTest() {
 		panic(err)
 	}
 
+	_, err = suite.msgServer.Mint(
+		sdk.WrapSDKContext(suite.Ctx),
+		types.NewMsgMintTo(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.testDenom, 1000000), suite.TestAccs[1].String()),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// the third account mints with an allowance
+	_, err = suite.msgServer.GrantRole(
+		sdk.WrapSDKContext(suite.Ctx),
+		types.NewMsgGrantRole(suite.TestAccs[0].String(), suite.testDenom, types.RoleMinter, suite.TestAccs[2].String()),
+	)
+	if err != nil {
+		panic(err)
+	}
+	_, err = suite.msgServer.SetMinterAllowance(
+		sdk.WrapSDKContext(suite.Ctx),
+		types.NewMsgSetMinterAllowance(suite.TestAccs[0].String(), suite.testDenom, suite.TestAccs[2].String(), sdk.NewInt(1000000)),
+	)
+	if err != nil {
+		panic(err)
+	}
+
 	msgValidator := sdkacltypes.NewMsgValidator(aclutils.StoreKeyToResourceTypePrefixMap)
 	suite.Ctx = suite.Ctx.WithMsgValidator(msgValidator)
 }
 
+// pauseTestDenom pauses the test denom until the end of the test case
+func (suite *KeeperTestSuite) pauseTestDenom() {
+	_, err := suite.msgServer.SetDenomPause(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetDenomPause(suite.TestAccs[0].String(), suite.testDenom, true))
+	suite.Require().NoError(err)
+	suite.T().Cleanup(func() {
+		_, err := suite.msgServer.SetDenomPause(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetDenomPause(suite.TestAccs[0].String(), suite.testDenom, false))
+		suite.Require().NoError(err)
+	})
+}
+
+// denomAllowListAccesses returns the bank store accesses to the allow list of
+// the test denom done by the admin actions on a paused denom, the cache store
+// of the tests doesn't record the resource accesses
+func (suite *KeeperTestSuite) denomAllowListAccesses() []abci.Event {
+	key := append(banktypes.DenomAllowListKey(suite.testDenom), []byte(suite.testDenom)...)
+	storeKey := suite.App.GetKey(banktypes.StoreKey)
+
+	eventManager := sdk.NewEventManager()
+	eventManager.EmitResourceAccessReadEvent("get", storeKey, key, nil)
+	eventManager.EmitResourceAccessWriteEvent("set", storeKey, key, nil)
+	return eventManager.ABCIEvents()
+}
+
 func cacheTxContext(ctx sdk.Context) (sdk.Context, sdk.CacheMultiStore) {
 	ms := ctx.MultiStore()
 	msCache := ms.CacheMultiStore()
@@ -94,6 +138,7 @@ func (suite *KeeperTestSuite) TestMsgBurnDependencies() {
 		expectedError error
 		msg           *tokenfactorytypes.MsgBurn
 		dynamicDep    bool
+		paused        bool
 	}{
 		{
 			name:          "default burn",
@@ -107,9 +152,26 @@ func (suite *KeeperTestSuite) TestMsgBurnDependencies() {
 			expectedError: nil,
 			dynamicDep:    false,
 		},
+		{
+			name:          "burn from another account",
+			msg:           tokenfactorytypes.NewMsgBurnFrom(addr1, burnAmount, suite.TestAccs[1].String()),
+			expectedError: nil,
+			dynamicDep:    true,
+		},
+		{
+			name:          "burn from another account of a paused denom",
+			msg:           tokenfactorytypes.NewMsgBurnFrom(addr1, burnAmount, suite.TestAccs[1].String()),
+			expectedError: nil,
+			dynamicDep:    true,
+			paused:        true,
+		},
 	}
 	for _, tc := range tests {
 		suite.Run(fmt.Sprintf("Test Case: %s", tc.name), func() {
+			if tc.paused {
+				suite.pauseTestDenom()
+			}
+
 			handlerCtx, cms := cacheTxContext(suite.Ctx)
 			_, err := suite.msgServer.Burn(
 				sdk.WrapSDKContext(handlerCtx),
@@ -118,6 +180,7 @@ func (suite *KeeperTestSuite) TestMsgBurnDependencies() {
 
 			depdenencies, _ := tkfactory.TokenFactoryBurnDependencyGenerator(
 				suite.App.AccessControlKeeper,
+				suite.App.TokenFactoryKeeper,
 				handlerCtx,
 				tc.msg,
 			)
@@ -134,6 +197,12 @@ func (suite *KeeperTestSuite) TestMsgBurnDependencies() {
 
 			missing := handlerCtx.MsgValidator().ValidateAccessOperations(depdenencies, cms.GetEvents())
 			suite.Require().Empty(missing)
+
+			// the allow list accesses of the paused denom must be declared as well
+			if tc.paused {
+				missing = handlerCtx.MsgValidator().ValidateAccessOperations(depdenencies, suite.denomAllowListAccesses())
+				suite.Require().Empty(missing)
+			}
 		})
 	}
 }
@@ -161,6 +230,24 @@ func (suite *KeeperTestSuite) TestMsgMintDependencies() {
 			expectedError: nil,
 			dynamicDep:    false,
 		},
+		{
+			name:          "mint to another account",
+			msg:           tokenfactorytypes.NewMsgMintTo(addr1, burnAmount, suite.TestAccs[1].String()),
+			expectedError: nil,
+			dynamicDep:    true,
+		},
+		{
+			name:          "mint to a new account",
+			msg:           tokenfactorytypes.NewMsgMintTo(addr1, burnAmount, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()),
+			expectedError: nil,
+			dynamicDep:    true,
+		},
+		{
+			name:          "mint with a minter allowance",
+			msg:           tokenfactorytypes.NewMsgMint(suite.TestAccs[2].String(), burnAmount),
+			expectedError: nil,
+			dynamicDep:    true,
+		},
 	}
 	for _, tc := range tests {
 		suite.Run(fmt.Sprintf("Test Case: %s", tc.name), func() {
@@ -192,6 +279,86 @@ func (suite *KeeperTestSuite) TestMsgMintDependencies() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgForceTransferDependencies() {
+	suite.PrepareTest()
+
+	transferAmount := sdk.NewInt64Coin(suite.testDenom, 10)
+	addr1 := suite.TestAccs[0].String()
+	addr2 := suite.TestAccs[1].String()
+	tests := []struct {
+		name          string
+		expectedError error
+		msg           *tokenfactorytypes.MsgForceTransfer
+		dynamicDep    bool
+		paused        bool
+	}{
+		{
+			name:          "default force transfer",
+			msg:           tokenfactorytypes.NewMsgForceTransfer(addr1, transferAmount, addr2, addr1),
+			expectedError: nil,
+			dynamicDep:    true,
+		},
+		{
+			name:          "force transfer to a new account",
+			msg:           tokenfactorytypes.NewMsgForceTransfer(addr1, transferAmount, addr2, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()),
+			expectedError: nil,
+			dynamicDep:    true,
+		},
+		{
+			name:          "dont check synchronous",
+			msg:           tokenfactorytypes.NewMsgForceTransfer(addr1, transferAmount, addr2, addr1),
+			expectedError: nil,
+			dynamicDep:    false,
+		},
+		{
+			name:          "force transfer of a paused denom",
+			msg:           tokenfactorytypes.NewMsgForceTransfer(addr1, transferAmount, addr2, addr1),
+			expectedError: nil,
+			dynamicDep:    true,
+			paused:        true,
+		},
+	}
+	for _, tc := range tests {
+		suite.Run(fmt.Sprintf("Test Case: %s", tc.name), func() {
+			if tc.paused {
+				suite.pauseTestDenom()
+			}
+
+			handlerCtx, cms := cacheTxContext(suite.Ctx)
+			_, err := suite.msgServer.ForceTransfer(
+				sdk.WrapSDKContext(handlerCtx),
+				tc.msg,
+			)
+
+			depdenencies, _ := tkfactory.TokenFactoryForceTransferDependencyGenerator(
+				suite.App.AccessControlKeeper,
+				suite.App.TokenFactoryKeeper,
+				handlerCtx,
+				tc.msg,
+			)
+
+			if !tc.dynamicDep {
+				depdenencies = sdkacltypes.SynchronousAccessOps()
+			}
+
+			if tc.expectedError != nil {
+				suite.Require().EqualError(err, tc.expectedError.Error())
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			missing := handlerCtx.MsgValidator().ValidateAccessOperations(depdenencies, cms.GetEvents())
+			suite.Require().Empty(missing)
+
+			// the allow list accesses of the paused denom must be declared as well
+			if tc.paused {
+				missing = handlerCtx.MsgValidator().ValidateAccessOperations(depdenencies, suite.denomAllowListAccesses())
+				suite.Require().Empty(missing)
+			}
+		})
+	}
+}
+
 // func TestGeneratorInvalidMessageTypes(t *testing.T) {
 // 	accs := authtypes.GenesisAccounts{}
 // 	balances := []banktypes.Balance{}
@@ -212,23 +379,17 @@ func (suite *KeeperTestSuite) TestMsgMintDependencies() {
 // 	require.Error(t, err)
 // }
 
-func TestMsgBeginBurnDepedencyGenerator(t *testing.T) {
-	priv1 := secp256k1.GenPrivKey()
-	addr1 := sdk.AccAddress(priv1.PubKey().Address())
-
-	accs := authtypes.GenesisAccounts{}
-	balances := []banktypes.Balance{}
-
-	app := simapp.SetupWithGenesisAccounts(accs, balances...)
-	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+func (suite *KeeperTestSuite) TestMsgBeginBurnDepedencyGenerator() {
+	suite.PrepareTest()
 
-	sendMsg := tokenfactorytypes.MsgBurn{
-		Sender: addr1.String(),
-		Amount: sdk.NewInt64Coin("ukii", 10),
-	}
+	burnMsg := tokenfactorytypes.NewMsgBurnFrom(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.testDenom, 10), suite.TestAccs[1].String())
+	accessOps, err := tkfactory.TokenFactoryBurnDependencyGenerator(suite.App.AccessControlKeeper, suite.App.TokenFactoryKeeper, suite.Ctx, burnMsg)
+	suite.Require().NoError(err)
+	suite.Require().NoError(acltypes.ValidateAccessOps(accessOps))
 
-	accessOps, err := tkfactory.TokenFactoryBurnDependencyGenerator(app.AccessControlKeeper, ctx, &sendMsg)
-	require.NoError(t, err)
-	err = acltypes.ValidateAccessOps(accessOps)
-	require.NoError(t, err)
+	// the access operations of a paused denom are valid as well
+	suite.pauseTestDenom()
+	accessOps, err = tkfactory.TokenFactoryBurnDependencyGenerator(suite.App.AccessControlKeeper, suite.App.TokenFactoryKeeper, suite.Ctx, burnMsg)
+	suite.Require().NoError(err)
+	suite.Require().NoError(acltypes.ValidateAccessOps(accessOps))
 }
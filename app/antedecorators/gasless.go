@@ -95,6 +95,11 @@ func IsTxGasless(tx sdk.Tx, ctx sdk.Context, evmKeeper *evmkeeper.Keeper, oracle
 			}
 			// ddos prevention
 			return len(tx.GetMsgs()) == 1, nil
+		case *oracletypes.MsgAggregateExchangeRatePrevote:
+			isGassLess, err := oraclePrevoteIsGassLess(m, ctx, oracleKeeper)
+			if err != nil || !isGassLess {
+				return false, err
+			}
 		case *oracletypes.MsgAggregateExchangeRateVote:
 			isGassLess, err := oracleVoteIsGassLess(m, ctx, oracleKeeper)
 			if err != nil || !isGassLess {
@@ -149,3 +154,42 @@ func oracleVoteIsGassLess(msg *oracletypes.MsgAggregateExchangeRateVote, ctx sdk
 
 	return false, err
 }
+
+// oraclePrevoteIsGassLess checks the prevote msg's info is valid
+func oraclePrevoteIsGassLess(msg *oracletypes.MsgAggregateExchangeRatePrevote, ctx sdk.Context, keeper oraclekeeper.Keeper) (bool, error) {
+	// validate feeder address
+	feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return false, err
+	}
+
+	// validate validator address
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return false, err
+	}
+
+	// validate the feeder is allowed to send tx
+	err = keeper.ValidateFeeder(ctx, feederAddr, valAddr)
+	if err != nil {
+		return false, err
+	}
+
+	// if there are no prevotes by valAddress means the first prevote, allow them
+	prevote, err := keeper.GetAggregateExchangeRatePrevote(ctx, valAddr)
+	if errors.Is(err, oracletypes.ErrNoAggregatePrevote) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	// a prevote from a previous vote period is going to be revealed or replaced, allow them
+	votePeriod := keeper.GetParams(ctx).VotePeriod
+	if prevote.SubmitBlock/votePeriod < uint64(ctx.BlockHeight())/votePeriod {
+		return true, nil
+	}
+
+	// there is a prevote present on the current vote period, so we don't allow gasless tx (spamming protection)
+	return false, sdkerrors.Wrap(oracletypes.ErrAggregatePrevoteExist, valAddr.String())
+}
@@ -130,6 +130,7 @@ import (
 	mintkeeper "github.com/kiichain/kiichain/x/mint/keeper"
 	minttypes "github.com/kiichain/kiichain/x/mint/types"
 	oraclemodule "github.com/kiichain/kiichain/x/oracle"
+	oracleclient "github.com/kiichain/kiichain/x/oracle/client/cli"
 
 	oraclekeeper "github.com/kiichain/kiichain/x/oracle/keeper"
 	oracletypes "github.com/kiichain/kiichain/x/oracle/types"
@@ -171,6 +172,10 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		ibcclientclient.UpgradeProposalHandler,
 		aclclient.ResourceDependencyProposalHandler,
 		mintclient.UpdateMinterHandler,
+		oracleclient.AddOracleDenomProposalHandler,
+		oracleclient.RemoveOracleDenomProposalHandler,
+		oracleclient.AddPricePublisherProposalHandler,
+		oracleclient.RemovePricePublisherProposalHandler,
 		// this line is used by starport scaffolding # stargate/app/govProposalHandler
 	)
 
@@ -551,6 +556,8 @@ func New(
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.StakingKeeper,
+		app.SlashingKeeper,
+		app.DistrKeeper,
 		distrtypes.ModuleName,
 	)
 
@@ -656,7 +663,7 @@ func New(
 
 	customDependencyGenerators := aclmapping.NewCustomDependencyGenerator()
 	aclOpts = append(aclOpts, aclkeeper.WithResourceTypeToStoreKeyMap(aclutils.ResourceTypeToStoreKeyMap))
-	aclOpts = append(aclOpts, aclkeeper.WithDependencyGeneratorMappings(customDependencyGenerators.GetCustomDependencyGenerators(app.EvmKeeper)))
+	aclOpts = append(aclOpts, aclkeeper.WithDependencyGeneratorMappings(customDependencyGenerators.GetCustomDependencyGenerators(app.EvmKeeper, app.TokenFactoryKeeper)))
 	app.AccessControlKeeper = aclkeeper.NewKeeper(
 		appCodec,
 		app.keys[acltypes.StoreKey],
@@ -678,8 +685,8 @@ func New(
 		AddRoute(minttypes.RouterKey, mint.NewProposalHandler(app.MintKeeper)).
 		AddRoute(tokenfactorytypes.RouterKey, tokenfactorymodule.NewProposalHandler(app.TokenFactoryKeeper)).
 		AddRoute(acltypes.ModuleName, aclmodule.NewProposalHandler(app.AccessControlKeeper)).
-		AddRoute(evmtypes.RouterKey, evm.NewProposalHandler(app.EvmKeeper))
-		// FIXME: Add proposal handler for oracle Module
+		AddRoute(evmtypes.RouterKey, evm.NewProposalHandler(app.EvmKeeper)).
+		AddRoute(oracletypes.RouterKey, oraclemodule.NewProposalHandler(app.OracleKeeper))
 	if len(enabledProposals) != 0 {
 		govRouter.AddRoute(wasm.RouterKey, wasm.NewWasmProposalHandler(app.WasmKeeper, enabledProposals))
 	}
@@ -715,6 +722,8 @@ func New(
 			app.IBCKeeper.ChannelKeeper,
 			app.AccountKeeper,
 			app.OracleKeeper,
+			tokenfactorykeeper.NewMsgServerImpl(app.TokenFactoryKeeper),
+			app.TokenFactoryKeeper,
 		); err != nil {
 			panic(err)
 		}
@@ -769,6 +778,7 @@ func New(
 		upgradetypes.ModuleName,
 		capabilitytypes.ModuleName,
 		minttypes.ModuleName,
+		oracletypes.ModuleName, // oracle reward pool takes its share before distribution drains the fee collector
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName,
 		evidencetypes.ModuleName,
@@ -784,7 +794,6 @@ func New(
 		vestingtypes.ModuleName,
 		ibchost.ModuleName,
 		ibctransfertypes.ModuleName,
-		oracletypes.ModuleName, // register oracle begin blocker
 		evmtypes.ModuleName,
 		wasm.ModuleName,
 		tokenfactorytypes.ModuleName,
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kiichain/kiichain/oracle/price_feeder/config"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle"
+)
+
+const flagReference = "reference"
+
+// CmdBacktest is the command executed when users will type "backtest subcommand"
+func CmdBacktest() *cobra.Command {
+	backtestCmd := &cobra.Command{
+		Use:   "backtest [config-file] [record-file]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Replay recorded market data and print the prices that would have been voted",
+		Long: `Replay the market data recorded by the price-feeder --record flag through the price
+conversion, deviation filtering and aggregation of the given configuration. The prices that
+would have been voted are printed as CSV, nothing is broadcasted. A reference series can be
+given as CSV rows with the timestamp (RFC3339), the base and the price to compare against.`,
+		RunE: backtestCmdHandler,
+	}
+
+	backtestCmd.Flags().String(flagReference, "", "CSV file with the reference prices (timestamp,base,price)")
+
+	return backtestCmd
+}
+
+// backtestCmdHandler replays the recorded market data with the config file settings
+func backtestCmdHandler(cmd *cobra.Command, args []string) error {
+	logger, err := newLogger(cmd)
+	if err != nil {
+		return err
+	}
+
+	// pase configurations from the config file to Config struct
+	cfg, err := config.ParseConfig(args[0])
+	if err != nil {
+		return err
+	}
+
+	deviations, err := getDeviations(cfg)
+	if err != nil {
+		return err
+	}
+
+	// read the recorded market data
+	recordFile, err := os.Open(args[1])
+	if err != nil {
+		return fmt.Errorf("failed to open record file: %w", err)
+	}
+	defer recordFile.Close()
+
+	records, err := oracle.ReadMarketDataRecords(recordFile)
+	if err != nil {
+		return err
+	}
+
+	// read the optional reference series
+	reference := make(oracle.ReferenceSeries)
+	referencePath, err := cmd.Flags().GetString(flagReference)
+	if err != nil {
+		return err
+	}
+	if len(referencePath) > 0 {
+		referenceFile, err := os.Open(referencePath)
+		if err != nil {
+			return fmt.Errorf("failed to open reference file: %w", err)
+		}
+		defer referenceFile.Close()
+
+		reference, err = oracle.ReadReferenceSeries(referenceFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	results, err := oracle.Backtest(logger, cfg.CurrencyPairs, deviations, records, reference)
+	if err != nil {
+		return err
+	}
+
+	return writeBacktestResults(csv.NewWriter(cmd.OutOrStdout()), results)
+}
+
+// writeBacktestResults prints a CSV row per backtest result
+func writeBacktestResults(w *csv.Writer, results []oracle.BacktestResult) error {
+	err := w.Write([]string{
+		"timestamp", "base", "chain_denom", "price", "reference", "deviation_pct",
+		"source", "aggregation", "providers", "filtered_providers",
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		report := result.Report
+
+		var price, reference, deviation string
+		if !report.Price.IsNil() {
+			price = report.Price.String()
+		}
+		if !result.Reference.IsNil() {
+			reference = result.Reference.String()
+		}
+		if d, ok := result.Deviation(); ok {
+			deviation = d.String()
+		}
+
+		var providers, filtered []string
+		for _, providerPrice := range report.Providers {
+			if providerPrice.Filtered {
+				filtered = append(filtered, providerPrice.Provider)
+				continue
+			}
+			providers = append(providers, providerPrice.Provider)
+		}
+
+		err := w.Write([]string{
+			result.Timestamp.UTC().Format(time.RFC3339),
+			report.Base,
+			report.ChainDenom,
+			price,
+			reference,
+			deviation,
+			report.Source,
+			report.Aggregation,
+			strings.Join(providers, ";"),
+			strings.Join(filtered, ";"),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
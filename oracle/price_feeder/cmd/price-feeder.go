@@ -3,8 +3,11 @@ package cmd
 import (
 	"bufio"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"os/signal"
 	"strings"
@@ -12,7 +15,10 @@ import (
 	"time"
 
 	input "github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 
+	"github.com/gorilla/mux"
+	"github.com/rs/cors"
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
 	"golang.org/x/sync/errgroup"
@@ -20,6 +26,7 @@ import (
 	"github.com/kiichain/kiichain/oracle/price_feeder/config"
 	"github.com/kiichain/kiichain/oracle/price_feeder/oracle"
 	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/client"
+	"github.com/kiichain/kiichain/oracle/price_feeder/router"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -30,8 +37,12 @@ const (
 
 	FLAG_LOG_LEVEL  = "log-level"
 	FLAG_LOG_FORMAT = "log-format"
+	FLAG_RECORD     = "record"
 
 	envVariablePass = "PRICE_FEEDER_PASS"
+
+	// time given to the status server to finish the ongoing requests
+	serverShutdownTimeout = 15 * time.Second
 )
 
 var rootCmd = &cobra.Command{
@@ -49,9 +60,11 @@ sources, e.g. exchanges, then, submits vote messages following the oracle voting
 func init() {
 	rootCmd.PersistentFlags().String(FLAG_LOG_LEVEL, zerolog.InfoLevel.String(), "logging level")
 	rootCmd.PersistentFlags().String(FLAG_LOG_FORMAT, LOG_LEVEL_TEXT, "logging format; must be either json or text")
+	rootCmd.Flags().String(FLAG_RECORD, "", "append the market data fetched from the providers to the given JSONL file, used by the backtest")
 
 	// add subcomands
 	rootCmd.AddCommand(CmdgetVersion())
+	rootCmd.AddCommand(CmdBacktest())
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -64,23 +77,23 @@ func Execute() {
 	}
 }
 
-// priceFeederCmdHandler init the price feeder
-func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
+// newLogger creates the logger configured by the log cmd flags
+func newLogger(cmd *cobra.Command) (zerolog.Logger, error) {
 	// get value from the log level cmd flag
 	logLvlStr, err := cmd.Flags().GetString(FLAG_LOG_LEVEL)
 	if err != nil {
-		return err
+		return zerolog.Logger{}, err
 	}
 
 	// get value from the log format cmd flag
 	logFormatStr, err := cmd.Flags().GetString(FLAG_LOG_FORMAT)
 	if err != nil {
-		return err
+		return zerolog.Logger{}, err
 	}
 
 	logLvl, err := zerolog.ParseLevel(logLvlStr)
 	if err != nil {
-		return err
+		return zerolog.Logger{}, err
 	}
 
 	// set the log format based on the flags
@@ -94,11 +107,41 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 		logWriter = zerolog.ConsoleWriter{Out: os.Stderr}
 
 	default:
-		return fmt.Errorf("invalid logging format: %s", logFormatStr)
+		return zerolog.Logger{}, fmt.Errorf("invalid logging format: %s", logFormatStr)
 	}
 
 	// create looger
-	logger := zerolog.New(logWriter).Level(logLvl).With().Timestamp().Logger()
+	return zerolog.New(logWriter).Level(logLvl).With().Timestamp().Logger(), nil
+}
+
+// getDeviations creates a map with the deviation by denom from config file
+func getDeviations(cfg config.Config) (map[string]sdk.Dec, error) {
+	deviations := make(map[string]sdk.Dec, len(cfg.Deviations))
+	for _, deviation := range cfg.Deviations {
+		threshold, err := sdk.NewDecFromStr(deviation.Threshold)
+		if err != nil {
+			return nil, err
+		}
+		deviations[deviation.Base] = threshold
+	}
+	return deviations, nil
+}
+
+// getEndpoints creates a map with the provider endpoints by provider name
+func getEndpoints(cfg config.Config) map[string]config.ProviderEndpoint {
+	endpoints := make(map[string]config.ProviderEndpoint, len(cfg.ProviderEndpoints))
+	for _, endpoint := range cfg.ProviderEndpoints {
+		endpoints[endpoint.Name] = endpoint
+	}
+	return endpoints
+}
+
+// priceFeederCmdHandler init the price feeder
+func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
+	logger, err := newLogger(cmd)
+	if err != nil {
+		return err
+	}
 
 	// pase configurations from the config file to Config struct
 	cfg, err := config.ParseConfig(args[0])
@@ -106,16 +149,20 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// get the feeder accounts, they all share the same chain id and prefix
+	accounts := cfg.GetAccounts()
+	chainAccount := accounts[0]
+
 	// Set prefixes (cosmos will analize accounts with these prefixes)
-	accountPubKeyPrefix := cfg.Account.Prefix + "pub"
-	validatorAddressPrefix := cfg.Account.Prefix + "valoper"
-	validatorPubKeyPrefix := cfg.Account.Prefix + "valoperpub"
-	consNodeAddressPrefix := cfg.Account.Prefix + "valcons"
-	consNodePubKeyPrefix := cfg.Account.Prefix + "valconspub"
+	accountPubKeyPrefix := chainAccount.Prefix + "pub"
+	validatorAddressPrefix := chainAccount.Prefix + "valoper"
+	validatorPubKeyPrefix := chainAccount.Prefix + "valoperpub"
+	consNodeAddressPrefix := chainAccount.Prefix + "valcons"
+	consNodePubKeyPrefix := chainAccount.Prefix + "valconspub"
 
 	// Set and seal config
 	sdkConfig := sdk.GetConfig()
-	sdkConfig.SetBech32PrefixForAccount(cfg.Account.Prefix, accountPubKeyPrefix)           // accounts must have this prefix
+	sdkConfig.SetBech32PrefixForAccount(chainAccount.Prefix, accountPubKeyPrefix)          // accounts must have this prefix
 	sdkConfig.SetBech32PrefixForValidator(validatorAddressPrefix, validatorPubKeyPrefix)   // validators' address must have this prefix
 	sdkConfig.SetBech32PrefixForConsensusNode(consNodeAddressPrefix, consNodePubKeyPrefix) // node address must have this prefix
 	sdkConfig.Seal()                                                                       // the previous configuration cannont be changed further
@@ -133,28 +180,46 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to parse RPC timeout: %w", err)
 	}
 
-	// Gather password via env variable or std input
-	keyringPass, err := getKeyringPassword()
+	// get the time without new blocks after which the node is considered stalled
+	stallTimeout, err := time.ParseDuration(cfg.RPC.StallTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to parse RPC stall timeout: %w", err)
+	}
+
+	// the client fails over to the fallback nodes when the main node fails
+	nodeEndpoints := make([]client.Endpoint, 0, len(cfg.RPC.FallbackEndpoints)+1)
+	for _, endpoint := range cfg.RPC.GetEndpoints() {
+		nodeEndpoints = append(nodeEndpoints, client.Endpoint{TMRPC: endpoint.TMRPCEndpoint, GRPC: endpoint.GRPCEndpoint})
+	}
+
+	// create the signer which holds the keys of the feeder accounts
+	signer, err := newSigner(cfg)
 	if err != nil {
 		return err
 	}
 
+	// create a feeder account per validator
+	feederAccounts := make([]client.FeederAccount, 0, len(accounts))
+	for _, account := range accounts {
+		feederAccount, err := client.NewFeederAccount(account.Address, account.Validator, account.FeeGranter)
+		if err != nil {
+			return fmt.Errorf("invalid account %s: %w", account.Address, err)
+		}
+		feederAccounts = append(feederAccounts, feederAccount)
+	}
+
 	// Retry creating oracle client for 5 seconds
 	var oracleClient client.OracleClient
 	for i := 0; i < 5; i++ {
 		oracleClient, err = client.NewOracleClient(
 			ctx,
 			logger,
-			cfg.Account.ChainID,
-			cfg.Keyring.Backend,
-			cfg.Keyring.Dir,
-			keyringPass,
-			cfg.RPC.TMRPCEndpoint,
+			chainAccount.ChainID,
+			signer,
+			nodeEndpoints,
 			rpcTimeout,
-			cfg.Account.Address,
-			cfg.Account.Validator,
-			cfg.Account.FeeGranter,
-			cfg.RPC.GRPCEndpoint,
+			stallTimeout,
+			feederAccounts,
 			cfg.GasAdjustment,
 			cfg.GasPrices,
 		)
@@ -177,19 +242,28 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 	}
 
 	// create a map with the deviation by denom from config file
-	deviations := make(map[string]sdk.Dec, len(cfg.Deviations))
-	for _, deviation := range cfg.Deviations {
-		threshold, err := sdk.NewDecFromStr(deviation.Threshold)
-		if err != nil {
-			return err
-		}
-		deviations[deviation.Base] = threshold
+	deviations, err := getDeviations(cfg)
+	if err != nil {
+		return err
 	}
 
 	// create a map with the endpoitns listed on the config file
-	endpoints := make(map[string]config.ProviderEndpoint, len(cfg.ProviderEndpoints))
-	for _, endpoint := range cfg.ProviderEndpoints {
-		endpoints[endpoint.Name] = endpoint
+	endpoints := getEndpoints(cfg)
+
+	// open the file which records the market data to be replayed by the backtest
+	recordPath, err := cmd.Flags().GetString(FLAG_RECORD)
+	if err != nil {
+		return err
+	}
+	var recorder *oracle.Recorder
+	if len(recordPath) > 0 {
+		recordFile, err := os.OpenFile(recordPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			return fmt.Errorf("failed to open record file: %w", err)
+		}
+		defer recordFile.Close()
+
+		recorder = oracle.NewRecorder(recordFile)
 	}
 
 	// create new oracle instance
@@ -203,16 +277,107 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 		cfg.Healthchecks,
 	)
 
+	// record the market data to be replayed by the backtest
+	if recorder != nil {
+		oracle.SetRecorder(recorder)
+	}
+
+	// initialize the telemetry, the metrics are exposed by the status server
+	var metrics router.Metrics
+	if cfg.Telemetry.Enabled {
+		metrics, err = telemetry.New(telemetry.Config{
+			ServiceName:             cfg.Telemetry.ServiceName,
+			Enabled:                 cfg.Telemetry.Enabled,
+			EnableHostname:          cfg.Telemetry.EnableHostname,
+			EnableHostnameLabel:     cfg.Telemetry.EnableHostnameLabel,
+			EnableServiceLabel:      cfg.Telemetry.EnableServiceLabel,
+			PrometheusRetentionTime: cfg.Telemetry.PrometheusRetentionTime,
+			GlobalLabels:            cfg.Telemetry.GlobalLabels,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to initialize telemetry: %w", err)
+		}
+	}
+
 	// start the process that calculates oracle prices and votes
 	group.Go(func() error {
 		return startPriceOracle(ctx, logger, oracle)
 	})
 
+	// reload the configuration when a SIGHUP signal is received
+	trapReloadSignal(ctx, logger, args[0], cfg, oracle)
+
+	// start the status server, it is disabled when no listen address is set
+	if len(cfg.Server.ListenAddr) > 0 {
+		group.Go(func() error {
+			return startStatusServer(ctx, logger, cfg.Server, oracle, metrics)
+		})
+	}
+
 	// Block main process until all spawned goroutines have gracefully exited and
 	// signal has been captured in the main process or if an error occurs.
 	return group.Wait()
 }
 
+// newSigner creates the signer selected on the config, the keyring password
+// is only requested when the keyring is used
+func newSigner(cfg config.Config) (client.Signer, error) {
+	switch cfg.Signer.Type {
+	case config.SignerRemote:
+		timeout, err := time.ParseDuration(cfg.Signer.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse signer timeout: %w", err)
+		}
+
+		tlsConfig, err := remoteSignerTLSConfig(cfg.Signer)
+		if err != nil {
+			return nil, err
+		}
+
+		return client.NewRemoteSigner(cfg.Signer.Endpoint, timeout, tlsConfig)
+
+	case config.SignerFile:
+		return client.NewFileSigner(cfg.Signer.KeyFile)
+
+	default:
+		// Gather password via env variable or std input
+		keyringPass, err := getKeyringPassword()
+		if err != nil {
+			return nil, err
+		}
+
+		return client.NewKeyringSigner(cfg.Keyring.Backend, cfg.Keyring.Dir, keyringPass)
+	}
+}
+
+// remoteSignerTLSConfig loads the CA and client certificates of the remote signer,
+// the system roots are used when no CA is set
+func remoteSignerTLSConfig(signerCfg config.Signer) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if len(signerCfg.CACert) > 0 {
+		caCert, err := os.ReadFile(signerCfg.CACert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read signer CA certificate: %w", err)
+		}
+
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("invalid signer CA certificate: %s", signerCfg.CACert)
+		}
+	}
+
+	if len(signerCfg.ClientCert) > 0 {
+		clientCert, err := tls.LoadX509KeyPair(signerCfg.ClientCert, signerCfg.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load signer client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	return tlsConfig, nil
+}
+
 // getKeyringPassword obtains the keyring password from the env var or stdin
 func getKeyringPassword() (string, error) {
 	reader := bufio.NewReader(os.Stdin)
@@ -242,6 +407,52 @@ func trapSignal(cancel context.CancelFunc, logger zerolog.Logger) {
 	}()
 }
 
+// trapReloadSignal listens for the SIGHUP signal and reloads the currency pairs,
+// deviation thresholds and provider endpoints of the config file. The config is
+// validated before being applied, the invalid configs are discarded.
+func trapReloadSignal(
+	ctx context.Context,
+	logger zerolog.Logger,
+	configPath string,
+	cfg config.Config,
+	oracle *oracle.Oracle,
+) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP)
+
+	go func() {
+		defer signal.Stop(sigCh)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case <-sigCh:
+				logger.Info().Str("config", configPath).Msg("caught SIGHUP; reloading configuration...")
+
+				newCfg, err := config.ParseConfig(configPath)
+				if err != nil {
+					logger.Err(err).Msg("invalid configuration, keeping the running one")
+					continue
+				}
+
+				deviations, err := getDeviations(newCfg)
+				if err != nil {
+					logger.Err(err).Msg("invalid configuration, keeping the running one")
+					continue
+				}
+
+				if cfg.RequiresRestart(newCfg) {
+					logger.Warn().Msg("only currency_pairs, deviation_thresholds and provider_endpoints are reloaded, other changes require a restart")
+				}
+
+				oracle.Reload(newCfg.CurrencyPairs, deviations, getEndpoints(newCfg))
+			}
+		}
+	}()
+}
+
 // startPriceOracle initialize a goroutine with the price-feeder
 func startPriceOracle(ctx context.Context, logger zerolog.Logger, oracle *oracle.Oracle) error {
 	// channel to receive errors from the price-feeder
@@ -267,3 +478,67 @@ func startPriceOracle(ctx context.Context, logger zerolog.Logger, oracle *oracle
 		}
 	}
 }
+
+// startStatusServer serves the status API until the context is canceled
+func startStatusServer(
+	ctx context.Context,
+	logger zerolog.Logger,
+	srvCfg config.Server,
+	oracle *oracle.Oracle,
+	metrics router.Metrics,
+) error {
+	// get the server timeouts from config
+	readTimeout, err := time.ParseDuration(srvCfg.ReadTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to parse server read timeout: %w", err)
+	}
+	writeTimeout, err := time.ParseDuration(srvCfg.WriteTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to parse server write timeout: %w", err)
+	}
+
+	// register the status routes
+	rtr := mux.NewRouter()
+	router.New(logger, oracle, metrics).RegisterRoutes(rtr)
+
+	// every origin is allowed when no origin is listed
+	corsHandler := cors.New(cors.Options{
+		AllowedOrigins: srvCfg.AllowedOrigins,
+		Debug:          srvCfg.VerboseCORS,
+	})
+
+	srv := &http.Server{
+		Handler:           corsHandler.Handler(rtr),
+		Addr:              srvCfg.ListenAddr,
+		WriteTimeout:      writeTimeout,
+		ReadTimeout:       readTimeout,
+		ReadHeaderTimeout: readTimeout,
+	}
+
+	// channel to receive errors from the server
+	srvErrCh := make(chan error, 1)
+
+	// launch the server as goroutine
+	go func() {
+		logger.Info().Str("listen_addr", srvCfg.ListenAddr).Msg("starting price-feeder status server...")
+		srvErrCh <- srv.ListenAndServe()
+	}()
+
+	// stay tuned for errors on the context or server
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), serverShutdownTimeout)
+		defer cancel()
+
+		logger.Info().Msg("shutting down price-feeder status server...")
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			logger.Err(err).Msg("failed to gracefully shutdown price-feeder status server")
+			return err
+		}
+		return nil
+
+	case err := <-srvErrCh:
+		logger.Err(err).Msg("failed to start price-feeder status server")
+		return err
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"reflect"
 	"strings"
 	"time"
 
@@ -16,6 +17,10 @@ const (
 	DenomUSD = "USD"
 
 	defaultProviderTimeout = 100 * time.Millisecond
+	defaultSrvWriteTimeout = 15 * time.Second
+	defaultSrvReadTimeout  = 15 * time.Second
+	defaultSignerTimeout   = 5 * time.Second
+	defaultStallTimeout    = 30 * time.Second
 
 	// API sources for oracle price feed - examples include price of BTC, ETH
 	ProviderKraken   = "kraken"
@@ -26,7 +31,35 @@ const (
 	ProviderOkx      = "okx"
 	ProviderGate     = "gate"
 	ProviderCoinbase = "coinbase"
+	ProviderDex      = "dex"
 	ProviderMock     = "mock"
+
+	// fiat exchange rate sources, their pairs are priced from the USD rates
+	ProviderFrankfurter  = "frankfurter"
+	ProviderExchangeRate = "exchangerate"
+	ProviderCurrencyAPI  = "currencyapi"
+	ProviderFloatRates   = "floatrates"
+
+	// ProviderTypeGeneric is the type of the providers defined entirely by their
+	// endpoint settings, their name is chosen by the operator
+	ProviderTypeGeneric = "generic"
+
+	// on-chain AMM pool types supported by the dex provider
+	DexPoolUniswapV2 = "uniswap_v2"
+	DexPoolUniswapV3 = "uniswap_v3"
+	DexPoolCosmWasm  = "cosmwasm"
+
+	// strategies used to aggregate the prices of the providers of a currency pair
+	AggregationVWAP        = "vwap"
+	AggregationMedian      = "median"
+	AggregationTrimmedMean = "trimmed_mean"
+	AggregationVWMedian    = "vw_median"
+	AggregationMAD         = "mad"
+
+	// signers holding the keys of the feeder accounts
+	SignerKeyring = "keyring"
+	SignerRemote  = "remote"
+	SignerFile    = "file"
 )
 
 var (
@@ -43,7 +76,22 @@ var (
 		ProviderHuobi:    {},
 		ProviderGate:     {},
 		ProviderCoinbase: {},
+		ProviderDex:      {},
 		ProviderMock:     {},
+
+		ProviderFrankfurter:  {},
+		ProviderExchangeRate: {},
+		ProviderCurrencyAPI:  {},
+		ProviderFloatRates:   {},
+	}
+
+	// FXProviders defines the providers of fiat exchange rates, they only have a
+	// rest endpoint
+	FXProviders = map[string]struct{}{
+		ProviderFrankfurter:  {},
+		ProviderExchangeRate: {},
+		ProviderCurrencyAPI:  {},
+		ProviderFloatRates:   {},
 	}
 
 	// maxDeviationThreshold is the maxmimum allowed amount of standard
@@ -61,6 +109,20 @@ var (
 		"BTC":     {},
 		"ETH":     {},
 		"ATOM":    {},
+
+		// fiat currencies, converted to USD through the FX providers
+		"EUR": {},
+		"GBP": {},
+		"JPY": {},
+		"CHF": {},
+		"CAD": {},
+		"AUD": {},
+		"COP": {},
+		"MXN": {},
+		"BRL": {},
+		"ARS": {},
+		"CLP": {},
+		"PEN": {},
 	}
 )
 
@@ -69,9 +131,12 @@ type (
 	Config struct {
 		CurrencyPairs     []CurrencyPair     `toml:"currency_pairs" validate:"required,gt=0,dive,required"`
 		Deviations        []Deviation        `toml:"deviation_thresholds"`
-		Account           Account            `toml:"account" validate:"required,gt=0,dive,required"`
-		Keyring           Keyring            `toml:"keyring" validate:"required,gt=0,dive,required"`
+		Account           Account            `toml:"account" validate:"-"`
+		Accounts          []Account          `toml:"accounts" validate:"-"`
+		Keyring           Keyring            `toml:"keyring" validate:"-"`
+		Signer            Signer             `toml:"signer"`
 		RPC               RPC                `toml:"rpc" validate:"required,gt=0,dive,required"`
+		Server            Server             `toml:"server"`
 		Telemetry         Telemetry          `toml:"telemetry"`
 		GasAdjustment     float64            `toml:"gas_adjustment" validate:"required"`
 		GasPrices         string             `toml:"gas_prices" validate:"required"`
@@ -87,6 +152,10 @@ type (
 		ChainDenom string   `toml:"chain_denom" validate:"required"`
 		Quote      string   `toml:"quote" validate:"required"`
 		Providers  []string `toml:"providers" validate:"required,gt=0,dive,required"`
+
+		// Aggregation is the strategy used to aggregate the providers prices,
+		// the (T)VWAP of the prices filtered by standard deviation is the default
+		Aggregation string `toml:"aggregation" validate:"omitempty,oneof=vwap median trimmed_mean vw_median mad"`
 	}
 
 	// Deviation defines a maximum amount of standard deviations that a given asset can
@@ -97,7 +166,8 @@ type (
 	}
 
 	// Account defines account related configuration that is related to the
-	// network and transaction signing functionality.
+	// network and transaction signing functionality. A single price-feeder can
+	// vote for several validators by listing one account per validator
 	Account struct {
 		ChainID    string `toml:"chain_id" validate:"required"`
 		Address    string `toml:"address" validate:"required"`
@@ -112,11 +182,55 @@ type (
 		Dir     string `toml:"dir" validate:"required"`
 	}
 
+	// Signer defines the signer of the feeder accounts transactions, the keyring
+	// is used by default. The remote signer requests the signatures to an external
+	// signing service so no key is kept on the price-feeder machine, the file
+	// signer is only meant for testing.
+	Signer struct {
+		Type string `toml:"type" validate:"omitempty,oneof=keyring remote file"`
+
+		// Endpoint of the remote signing service, ex. "https://signer:8080"
+		Endpoint string `toml:"endpoint"`
+		Timeout  string `toml:"timeout"`
+
+		// optional TLS settings of the remote signer, the client certificate
+		// authenticates the price-feeder to the signing service
+		CACert     string `toml:"ca_cert"`
+		ClientCert string `toml:"client_cert"`
+		ClientKey  string `toml:"client_key"`
+
+		// KeyFile lists the hex encoded private keys used by the file signer
+		KeyFile string `toml:"key_file"`
+	}
+
 	// RPC defines RPC configuration of both the gRPC and Tendermint nodes.
 	RPC struct {
 		TMRPCEndpoint string `toml:"tmrpc_endpoint" validate:"required"`
 		GRPCEndpoint  string `toml:"grpc_endpoint" validate:"required"`
 		RPCTimeout    string `toml:"rpc_timeout" validate:"required"`
+
+		// StallTimeout is the time without new blocks after which the node is
+		// considered stalled and the price-feeder fails over to another node
+		StallTimeout string `toml:"stall_timeout"`
+
+		// FallbackEndpoints lists the nodes used when the main node fails
+		FallbackEndpoints []RPCEndpoint `toml:"fallback_endpoints" validate:"dive"`
+	}
+
+	// RPCEndpoint defines the gRPC and Tendermint endpoints of a fallback node.
+	RPCEndpoint struct {
+		TMRPCEndpoint string `toml:"tmrpc_endpoint" validate:"required"`
+		GRPCEndpoint  string `toml:"grpc_endpoint" validate:"required"`
+	}
+
+	// Server defines the status API server configuration, the server is
+	// disabled when the listen address is empty.
+	Server struct {
+		ListenAddr     string   `toml:"listen_addr"`
+		WriteTimeout   string   `toml:"write_timeout"`
+		ReadTimeout    string   `toml:"read_timeout"`
+		VerboseCORS    bool     `toml:"verbose_cors"`
+		AllowedOrigins []string `toml:"allowed_origins"`
 	}
 
 	// Telemetry defines the configuration options for application telemetry.
@@ -161,11 +275,71 @@ type (
 
 		// Websocket endpoint for the provider, ex. "stream.binance.com:9443"
 		Websocket string `toml:"websocket"`
+
+		// GRPC endpoint for the providers reading on-chain data, ex. "localhost:9090"
+		GRPC string `toml:"grpc"`
+
+		// Pools read by the dex provider, the rest endpoint is the EVM JSON-RPC
+		Pools []DexPool `toml:"pools" validate:"dive"`
+
+		// Type of the provider, the "generic" providers aren't built in and are
+		// defined by the Generic settings
+		Type    string          `toml:"type" validate:"omitempty,oneof=generic"`
+		Generic GenericProvider `toml:"generic" validate:"-"`
+	}
+
+	// GenericProvider defines the REST request and the JSONPath selectors a generic
+	// provider polls the ticker of every pair with.
+	GenericProvider struct {
+		// TickerPath is requested on the rest endpoint for every pair, {symbol} is
+		// replaced by the pair symbol, ex. "/v3/ticker?book={symbol}"
+		TickerPath string `toml:"ticker_path"`
+
+		// JSONPath selectors of the ticker response values, ex. "$.payload.last".
+		// The volume is 1 when no selector is set and the timestamp, in unix
+		// seconds, milliseconds or RFC3339, is the polling time.
+		PricePath     string `toml:"price_path"`
+		VolumePath    string `toml:"volume_path"`
+		TimestampPath string `toml:"timestamp_path"`
+
+		// PollInterval is the time between the ticker requests, ex. "10s"
+		PollInterval string `toml:"poll_interval"`
+
+		// Symbols maps the pairs, ex. "USDCMXN", to the provider symbols, the
+		// base and the quote are concatenated by default
+		Symbols map[string]string `toml:"symbols"`
+
+		// Headers are sent on every request, ex. an API key
+		Headers map[string]string `toml:"headers"`
 	}
 
+	// DexPool defines an on-chain AMM pool the dex provider reads the price
+	// and liquidity of a currency pair from.
+	DexPool struct {
+		Base  string `toml:"base" validate:"required"`
+		Quote string `toml:"quote" validate:"required"`
+
+		// Type of the pool, ex. "uniswap_v2", "uniswap_v3" or "cosmwasm"
+		Type string `toml:"type" validate:"required,oneof=uniswap_v2 uniswap_v3 cosmwasm"`
+
+		// Address of the pool contract
+		Address string `toml:"address" validate:"required"`
+
+		// BaseToken identifies the base asset on the pool, the ERC20 address
+		// for EVM pools or the denom / CW20 address for CosmWasm pools
+		BaseToken string `toml:"base_token" validate:"required"`
+
+		// decimals of the pool assets, used to scale the pool amounts
+		BaseDecimals  uint8 `toml:"base_decimals"`
+		QuoteDecimals uint8 `toml:"quote_decimals"`
+	}
+
+	// Healthchecks defines an endpoint pinged on successful oracle votes, it only
+	// follows the votes of the validator when one is set
 	Healthchecks struct {
-		URL     string `toml:"url" validate:"required"`
-		Timeout string `toml:"timeout" validate:"required"`
+		URL       string `toml:"url" validate:"required"`
+		Timeout   string `toml:"timeout" validate:"required"`
+		Validator string `toml:"validator"`
 	}
 )
 
@@ -184,7 +358,33 @@ func endpointValidation(sl validator.StructLevel) {
 	endpoint := sl.Current().Interface().(ProviderEndpoint)
 
 	// must have at least one endpoint data
-	if len(endpoint.Name) < 1 || len(endpoint.Rest) < 1 || len(endpoint.Websocket) < 1 {
+	switch {
+	case endpoint.Type == ProviderTypeGeneric:
+		// the generic providers can't replace a built in provider
+		if _, ok := SupportedProviders[endpoint.Name]; ok || len(endpoint.Name) < 1 {
+			sl.ReportError(endpoint.Name, "name", "Name", "unsupportedEndpointProvider", "")
+		}
+		if len(endpoint.Rest) < 1 || len(endpoint.Generic.TickerPath) < 1 || len(endpoint.Generic.PricePath) < 1 {
+			sl.ReportError(endpoint, "endpoint", "Endpoint", "unsupportedEndpointType", "")
+		}
+		if len(endpoint.Generic.PollInterval) > 0 {
+			if _, err := time.ParseDuration(endpoint.Generic.PollInterval); err != nil {
+				sl.ReportError(endpoint.Generic.PollInterval, "poll_interval", "PollInterval", "invalidPollInterval", "")
+			}
+		}
+		return
+
+	case endpoint.Name == ProviderDex:
+		// the dex provider reads EVM pools from the rest endpoint and CosmWasm pools from the grpc one
+		if len(endpoint.Pools) < 1 || (len(endpoint.Rest) < 1 && len(endpoint.GRPC) < 1) {
+			sl.ReportError(endpoint, "endpoint", "Endpoint", "unsupportedEndpointType", "")
+		}
+	case isFXProvider(endpoint.Name):
+		// the FX providers poll their rates from the rest endpoint
+		if len(endpoint.Rest) < 1 {
+			sl.ReportError(endpoint, "endpoint", "Endpoint", "unsupportedEndpointType", "")
+		}
+	case len(endpoint.Name) < 1 || len(endpoint.Rest) < 1 || len(endpoint.Websocket) < 1:
 		sl.ReportError(endpoint, "endpoint", "Endpoint", "unsupportedEndpointType", "")
 	}
 
@@ -195,10 +395,181 @@ func endpointValidation(sl validator.StructLevel) {
 	}
 }
 
+// isFXProvider returns true if the provider is a fiat exchange rate provider
+func isFXProvider(name string) bool {
+	_, ok := FXProviders[name]
+	return ok
+}
+
+// isSupportedProvider returns true if the provider is built in or defined as a
+// generic provider
+func (c Config) isSupportedProvider(name string) bool {
+	if _, ok := SupportedProviders[name]; ok {
+		return true
+	}
+	for _, endpoint := range c.ProviderEndpoints {
+		if endpoint.Type == ProviderTypeGeneric && endpoint.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// hasConversionPath returns true if the asset can be converted to USD through
+// the currency pairs, the pairs convert their base to their quote and back
+func (c Config) hasConversionPath(asset string) bool {
+	neighbors := make(map[string][]string)
+	for _, pair := range c.CurrencyPairs {
+		base, quote := strings.ToUpper(pair.Base), strings.ToUpper(pair.Quote)
+		neighbors[base] = append(neighbors[base], quote)
+		neighbors[quote] = append(neighbors[quote], base)
+	}
+
+	visited := map[string]struct{}{strings.ToUpper(asset): {}}
+	queue := []string{strings.ToUpper(asset)}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == DenomUSD {
+			return true
+		}
+
+		for _, next := range neighbors[current] {
+			if _, ok := visited[next]; !ok {
+				visited[next] = struct{}{}
+				queue = append(queue, next)
+			}
+		}
+	}
+	return false
+}
+
+// hasDexPool returns true if the dex provider endpoint has a pool for the pair
+func (c Config) hasDexPool(base, quote string) bool {
+	for _, endpoint := range c.ProviderEndpoints {
+		if endpoint.Name != ProviderDex {
+			continue
+		}
+		for _, pool := range endpoint.Pools {
+			if strings.EqualFold(pool.Base, base) && strings.EqualFold(pool.Quote, quote) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetAccounts returns the feeder accounts, the accounts list or the single account
+// when the list is not defined
+func (c Config) GetAccounts() []Account {
+	if len(c.Accounts) > 0 {
+		return c.Accounts
+	}
+	return []Account{c.Account}
+}
+
+// GetEndpoints returns the main node followed by the fallback nodes
+func (r RPC) GetEndpoints() []RPCEndpoint {
+	endpoints := []RPCEndpoint{{TMRPCEndpoint: r.TMRPCEndpoint, GRPCEndpoint: r.GRPCEndpoint}}
+	return append(endpoints, r.FallbackEndpoints...)
+}
+
+// RequiresRestart returns true if the settings which can't be reloaded differ from
+// the other config, only the currency pairs, deviation thresholds and provider
+// endpoints are reloaded by the running price-feeder
+func (c Config) RequiresRestart(other Config) bool {
+	c.CurrencyPairs, other.CurrencyPairs = nil, nil
+	c.Deviations, other.Deviations = nil, nil
+	c.ProviderEndpoints, other.ProviderEndpoints = nil, nil
+
+	return !reflect.DeepEqual(c, other)
+}
+
+// validateAccounts validates the feeder accounts, all of them must be on the same
+// network and each validator can only be listed once
+func (c Config) validateAccounts() error {
+	if len(c.Accounts) > 0 && len(c.Account.Address) > 0 {
+		return errors.New("account and accounts can not be defined together")
+	}
+
+	accounts := c.GetAccounts()
+	validators := make(map[string]struct{}, len(accounts))
+	for _, account := range accounts {
+		if err := validate.Struct(account); err != nil {
+			return err
+		}
+
+		// the oracle client connects to a single chain
+		if account.ChainID != accounts[0].ChainID || account.Prefix != accounts[0].Prefix {
+			return errors.New("all accounts must have the same chain_id and prefix")
+		}
+
+		if _, ok := validators[account.Validator]; ok {
+			return fmt.Errorf("duplicated validator: %s", account.Validator)
+		}
+		validators[account.Validator] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateHealthchecks validates the healthchecks of a validator follow the
+// votes of one of the feeder accounts
+func (c Config) validateHealthchecks() error {
+	validators := make(map[string]struct{})
+	for _, account := range c.GetAccounts() {
+		validators[account.Validator] = struct{}{}
+	}
+
+	for _, healthcheck := range c.Healthchecks {
+		if len(healthcheck.Validator) == 0 {
+			continue
+		}
+		if _, ok := validators[healthcheck.Validator]; !ok {
+			return fmt.Errorf("healthcheck validator %s has no feeder account", healthcheck.Validator)
+		}
+	}
+
+	return nil
+}
+
+// validateSigner validates the settings of the selected signer, the keyring
+// is only required when the transactions are signed with it
+func (c Config) validateSigner() error {
+	switch c.Signer.Type {
+	case "", SignerKeyring:
+		return validate.Struct(c.Keyring)
+
+	case SignerRemote:
+		if len(c.Signer.Endpoint) == 0 {
+			return errors.New("the remote signer requires an endpoint")
+		}
+		if (len(c.Signer.ClientCert) == 0) != (len(c.Signer.ClientKey) == 0) {
+			return errors.New("the remote signer client_cert and client_key must be defined together")
+		}
+
+	case SignerFile:
+		if len(c.Signer.KeyFile) == 0 {
+			return errors.New("the file signer requires a key_file")
+		}
+	}
+
+	return nil
+}
+
 // Validate returns an error if the Config object is invalid.
 func (c Config) Validate() error {
 	validate.RegisterStructValidation(telemetryValidation, Telemetry{})
 	validate.RegisterStructValidation(endpointValidation, ProviderEndpoint{})
+	if err := c.validateAccounts(); err != nil {
+		return err
+	}
+	if err := c.validateHealthchecks(); err != nil {
+		return err
+	}
+	if err := c.validateSigner(); err != nil {
+		return err
+	}
 	return validate.Struct(c)
 }
 
@@ -228,9 +599,34 @@ func ParseConfig(configPath string) (Config, error) {
 	if len(cfg.ProviderTimeout) == 0 {
 		cfg.ProviderTimeout = defaultProviderTimeout.String()
 	}
+	if len(cfg.Server.ReadTimeout) == 0 {
+		cfg.Server.ReadTimeout = defaultSrvReadTimeout.String()
+	}
+	if len(cfg.Server.WriteTimeout) == 0 {
+		cfg.Server.WriteTimeout = defaultSrvWriteTimeout.String()
+	}
+	if len(cfg.RPC.StallTimeout) == 0 {
+		cfg.RPC.StallTimeout = defaultStallTimeout.String()
+	}
+	if len(cfg.Signer.Timeout) == 0 {
+		cfg.Signer.Timeout = defaultSignerTimeout.String()
+	}
+	if _, err := time.ParseDuration(cfg.Server.ReadTimeout); err != nil {
+		return cfg, fmt.Errorf("failed to parse server read timeout: %w", err)
+	}
+	if _, err := time.ParseDuration(cfg.Server.WriteTimeout); err != nil {
+		return cfg, fmt.Errorf("failed to parse server write timeout: %w", err)
+	}
+	if _, err := time.ParseDuration(cfg.RPC.StallTimeout); err != nil {
+		return cfg, fmt.Errorf("failed to parse RPC stall timeout: %w", err)
+	}
+	if _, err := time.ParseDuration(cfg.Signer.Timeout); err != nil {
+		return cfg, fmt.Errorf("failed to parse signer timeout: %w", err)
+	}
 
 	pairs := make(map[string]map[string]struct{})
 	coinQuotes := make(map[string]struct{})
+	providerBases := make(map[string]map[string]struct{})
 
 	// iterate over the currency pairs from the config
 	for _, currencyPair := range cfg.CurrencyPairs {
@@ -255,28 +651,34 @@ func ParseConfig(configPath string) (Config, error) {
 		// iterate over the providers by currency
 		for _, provider := range currencyPair.Providers {
 			// validate the provider is supported
-			_, ok = SupportedProviders[provider]
-			if !ok {
+			if !cfg.isSupportedProvider(provider) {
 				return cfg, fmt.Errorf("unsupported provider: %s", provider)
 			}
 
+			// the dex provider requires a pool to read the pair price from
+			if provider == ProviderDex && !cfg.hasDexPool(currencyPair.Base, currencyPair.Quote) {
+				return cfg, fmt.Errorf("missing dex pool for %s/%s", currencyPair.Base, currencyPair.Quote)
+			}
+
+			// the prices of a provider are stored by base, a base has a single quote per provider
+			if _, ok := providerBases[provider]; !ok {
+				providerBases[provider] = make(map[string]struct{})
+			}
+			if _, ok := providerBases[provider][currencyPair.Base]; ok {
+				return cfg, fmt.Errorf("provider %s has several quotes for %s", provider, currencyPair.Base)
+			}
+			providerBases[provider][currencyPair.Base] = struct{}{}
+
 			// save the providers by base denom
 			pairs[currencyPair.Base][provider] = struct{}{}
 		}
 	}
 
-	// Use coinQuotes to ensure that any quotes can be converted to USD.
+	// Use coinQuotes to ensure that any quotes can be converted to USD, through
+	// one or several pairs.
 	for quote := range coinQuotes {
-		for index, pair := range cfg.CurrencyPairs {
-
-			// validate I have the way to convert the quote to USD
-			if pair.Base == quote && pair.Quote == DenomUSD {
-				break
-			}
-
-			if index == len(cfg.CurrencyPairs)-1 {
-				return cfg, fmt.Errorf("all non-usd quotes require a conversion rate feed")
-			}
+		if !cfg.hasConversionPath(quote) {
+			return cfg, fmt.Errorf("all non-usd quotes require a conversion rate feed: %s", quote)
 		}
 	}
 
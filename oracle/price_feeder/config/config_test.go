@@ -81,6 +81,155 @@ func TestValidate(t *testing.T) {
 		},
 	}
 
+	dexPool := config.DexPool{
+		Base:      "KII",
+		Quote:     "USDT",
+		Type:      config.DexPoolUniswapV2,
+		Address:   "0x1000000000000000000000000000000000000001",
+		BaseToken: "0x2000000000000000000000000000000000000001",
+	}
+
+	dexEndpoint := validConfig()
+	dexEndpoint.ProviderEndpoints = []config.ProviderEndpoint{
+		{
+			Name:  config.ProviderDex,
+			Rest:  "http://localhost:8545",
+			Pools: []config.DexPool{dexPool},
+		},
+	}
+
+	dexEndpointNoPools := validConfig()
+	dexEndpointNoPools.ProviderEndpoints = []config.ProviderEndpoint{
+		{
+			Name: config.ProviderDex,
+			Rest: "http://localhost:8545",
+		},
+	}
+
+	invalidDexPool := validConfig()
+	invalidDexPool.ProviderEndpoints = []config.ProviderEndpoint{
+		{
+			Name:  config.ProviderDex,
+			GRPC:  "localhost:9090",
+			Pools: []config.DexPool{dexPool},
+		},
+	}
+	invalidDexPool.ProviderEndpoints[0].Pools[0].Type = "balancer"
+
+	genericProvider := config.ProviderEndpoint{
+		Name: "bitso",
+		Type: config.ProviderTypeGeneric,
+		Rest: "https://api.bitso.com",
+		Generic: config.GenericProvider{
+			TickerPath:   "/v3/ticker?book={symbol}",
+			PricePath:    "$.payload.last",
+			PollInterval: "10s",
+		},
+	}
+
+	genericEndpoint := validConfig()
+	genericEndpoint.ProviderEndpoints = []config.ProviderEndpoint{genericProvider}
+
+	genericEndpointBuiltInName := validConfig()
+	genericEndpointBuiltInName.ProviderEndpoints = []config.ProviderEndpoint{genericProvider}
+	genericEndpointBuiltInName.ProviderEndpoints[0].Name = config.ProviderKraken
+
+	genericEndpointNoPricePath := validConfig()
+	genericEndpointNoPricePath.ProviderEndpoints = []config.ProviderEndpoint{genericProvider}
+	genericEndpointNoPricePath.ProviderEndpoints[0].Generic.PricePath = ""
+
+	genericEndpointInvalidInterval := validConfig()
+	genericEndpointInvalidInterval.ProviderEndpoints = []config.ProviderEndpoint{genericProvider}
+	genericEndpointInvalidInterval.ProviderEndpoints[0].Generic.PollInterval = "often"
+
+	fxEndpoint := validConfig()
+	fxEndpoint.ProviderEndpoints = []config.ProviderEndpoint{
+		{Name: config.ProviderFrankfurter, Rest: "https://frankfurter.internal"},
+	}
+
+	fxEndpointNoRest := validConfig()
+	fxEndpointNoRest.ProviderEndpoints = []config.ProviderEndpoint{
+		{Name: config.ProviderExchangeRate, Websocket: "fx.internal"},
+	}
+
+	medianAggregation := validConfig()
+	medianAggregation.CurrencyPairs[0].Aggregation = "median"
+
+	invalidAggregation := validConfig()
+	invalidAggregation.CurrencyPairs[0].Aggregation = "mode"
+
+	emptyAccount := validConfig()
+	emptyAccount.Account = config.Account{}
+
+	multipleAccounts := validConfig()
+	multipleAccounts.Account = config.Account{}
+	multipleAccounts.Accounts = []config.Account{
+		{Address: "fromaddr1", Validator: "valaddr1", ChainID: "chain-id", Prefix: "chain"},
+		{Address: "fromaddr2", Validator: "valaddr2", ChainID: "chain-id", Prefix: "chain", FeeGranter: "granter"},
+	}
+
+	accountAndAccounts := validConfig()
+	accountAndAccounts.Accounts = multipleAccounts.Accounts
+
+	duplicatedValidator := validConfig()
+	duplicatedValidator.Account = config.Account{}
+	duplicatedValidator.Accounts = []config.Account{
+		{Address: "fromaddr1", Validator: "valaddr", ChainID: "chain-id", Prefix: "chain"},
+		{Address: "fromaddr2", Validator: "valaddr", ChainID: "chain-id", Prefix: "chain"},
+	}
+
+	differentChains := validConfig()
+	differentChains.Account = config.Account{}
+	differentChains.Accounts = []config.Account{
+		{Address: "fromaddr1", Validator: "valaddr1", ChainID: "chain-id", Prefix: "chain"},
+		{Address: "fromaddr2", Validator: "valaddr2", ChainID: "other-chain-id", Prefix: "chain"},
+	}
+
+	invalidAccounts := validConfig()
+	invalidAccounts.Account = config.Account{}
+	invalidAccounts.Accounts = []config.Account{
+		{Address: "fromaddr1", ChainID: "chain-id", Prefix: "chain"},
+	}
+
+	emptyKeyring := validConfig()
+	emptyKeyring.Keyring = config.Keyring{}
+
+	remoteSigner := validConfig()
+	remoteSigner.Keyring = config.Keyring{}
+	remoteSigner.Signer = config.Signer{Type: config.SignerRemote, Endpoint: "https://signer:8080"}
+
+	remoteSignerNoEndpoint := validConfig()
+	remoteSignerNoEndpoint.Signer = config.Signer{Type: config.SignerRemote}
+
+	remoteSignerNoClientKey := validConfig()
+	remoteSignerNoClientKey.Signer = config.Signer{Type: config.SignerRemote, Endpoint: "https://signer:8080", ClientCert: "client.crt"}
+
+	fileSignerNoKeyFile := validConfig()
+	fileSignerNoKeyFile.Signer = config.Signer{Type: config.SignerFile}
+
+	fallbackEndpoints := validConfig()
+	fallbackEndpoints.RPC.FallbackEndpoints = []config.RPCEndpoint{
+		{TMRPCEndpoint: "http://node2:26657", GRPCEndpoint: "node2:9090"},
+	}
+
+	invalidFallbackEndpoints := validConfig()
+	invalidFallbackEndpoints.RPC.FallbackEndpoints = []config.RPCEndpoint{
+		{TMRPCEndpoint: "http://node2:26657"},
+	}
+
+	invalidSigner := validConfig()
+	invalidSigner.Signer = config.Signer{Type: "ledger"}
+
+	validatorHealthcheck := validConfig()
+	validatorHealthcheck.Healthchecks = []config.Healthchecks{
+		{URL: "https://hc-ping.com/HEALTHCHECK-UUID", Timeout: "200ms", Validator: "valaddr"},
+	}
+
+	unknownValidatorHealthcheck := validConfig()
+	unknownValidatorHealthcheck.Healthchecks = []config.Healthchecks{
+		{URL: "https://hc-ping.com/HEALTHCHECK-UUID", Timeout: "200ms", Validator: "othervaladdr"},
+	}
+
 	testCases := []struct {
 		name      string
 		cfg       config.Config
@@ -121,6 +270,141 @@ func TestValidate(t *testing.T) {
 			invalidEndpointsProvider,
 			true,
 		},
+		{
+			"dex endpoint",
+			dexEndpoint,
+			false,
+		},
+		{
+			"dex endpoint without pools",
+			dexEndpointNoPools,
+			true,
+		},
+		{
+			"invalid dex pool type",
+			invalidDexPool,
+			true,
+		},
+		{
+			"generic endpoint",
+			genericEndpoint,
+			false,
+		},
+		{
+			"generic endpoint with a built in provider name",
+			genericEndpointBuiltInName,
+			true,
+		},
+		{
+			"generic endpoint without price path",
+			genericEndpointNoPricePath,
+			true,
+		},
+		{
+			"generic endpoint with invalid poll interval",
+			genericEndpointInvalidInterval,
+			true,
+		},
+		{
+			"fx endpoint",
+			fxEndpoint,
+			false,
+		},
+		{
+			"fx endpoint without rest",
+			fxEndpointNoRest,
+			true,
+		},
+		{
+			"median aggregation",
+			medianAggregation,
+			false,
+		},
+		{
+			"invalid aggregation",
+			invalidAggregation,
+			true,
+		},
+		{
+			"empty account",
+			emptyAccount,
+			true,
+		},
+		{
+			"multiple accounts",
+			multipleAccounts,
+			false,
+		},
+		{
+			"account and accounts",
+			accountAndAccounts,
+			true,
+		},
+		{
+			"duplicated validator",
+			duplicatedValidator,
+			true,
+		},
+		{
+			"accounts on different chains",
+			differentChains,
+			true,
+		},
+		{
+			"invalid accounts",
+			invalidAccounts,
+			true,
+		},
+		{
+			"empty keyring",
+			emptyKeyring,
+			true,
+		},
+		{
+			"remote signer",
+			remoteSigner,
+			false,
+		},
+		{
+			"remote signer without endpoint",
+			remoteSignerNoEndpoint,
+			true,
+		},
+		{
+			"remote signer without client key",
+			remoteSignerNoClientKey,
+			true,
+		},
+		{
+			"file signer without key file",
+			fileSignerNoKeyFile,
+			true,
+		},
+		{
+			"fallback endpoints",
+			fallbackEndpoints,
+			false,
+		},
+		{
+			"invalid fallback endpoints",
+			invalidFallbackEndpoints,
+			true,
+		},
+		{
+			"invalid signer",
+			invalidSigner,
+			true,
+		},
+		{
+			"validator healthcheck",
+			validatorHealthcheck,
+			false,
+		},
+		{
+			"healthcheck of an unknown validator",
+			unknownValidatorHealthcheck,
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -191,6 +475,10 @@ tmrpc_endpoint = "http://localhost:26657"
 grpc_endpoint = "localhost:9090"
 rpc_timeout = "100ms"
 
+[[rpc.fallback_endpoints]]
+tmrpc_endpoint = "http://node2:26657"
+grpc_endpoint = "node2:9090"
+
 [telemetry]
 service_name = "price-feeder"
 enabled = true
@@ -216,6 +504,17 @@ timeout = "200ms"
 	require.Len(t, cfg.CurrencyPairs[0].Providers, 3)
 	require.Equal(t, "kraken", cfg.CurrencyPairs[0].Providers[0])
 	require.Equal(t, "binance", cfg.CurrencyPairs[0].Providers[1])
+	require.Equal(t, "0.0.0.0:99999", cfg.Server.ListenAddr)
+	require.Equal(t, "20s", cfg.Server.ReadTimeout)
+	require.Equal(t, "20s", cfg.Server.WriteTimeout)
+	require.True(t, cfg.Server.VerboseCORS)
+	require.Empty(t, cfg.Signer.Type)
+	require.Equal(t, "5s", cfg.Signer.Timeout)
+	require.Equal(t, "30s", cfg.RPC.StallTimeout)
+	require.Equal(t, []config.RPCEndpoint{
+		{TMRPCEndpoint: "http://localhost:26657", GRPCEndpoint: "localhost:9090"},
+		{TMRPCEndpoint: "http://node2:26657", GRPCEndpoint: "node2:9090"},
+	}, cfg.RPC.GetEndpoints())
 }
 
 func TestParseConfig_Valid_NoTelemetry(t *testing.T) {
@@ -328,6 +627,102 @@ providers = [
 	require.Error(t, err)
 }
 
+func TestParseConfig_GenericProvider(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	content := []byte(`
+gas_adjustment = 1.5
+gas_prices = "0.00125usei"
+
+[account]
+address = "sei15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "seivalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
+chain_id = "sei-local-testnet"
+prefix = "sei"
+
+[keyring]
+backend = "test"
+dir = "/var/sei"
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+
+[telemetry]
+service_name = "price-feeder"
+enabled = false
+
+[[currency_pairs]]
+base = "USDC"
+chain_denom = "uusdc"
+quote = "USD"
+providers = [
+	"bitso",
+	"mock"
+]
+
+[[provider_endpoints]]
+name = "bitso"
+type = "generic"
+rest = "https://api.bitso.com"
+
+[provider_endpoints.generic]
+ticker_path = "/v3/ticker?book={symbol}"
+price_path = "$.payload.last"
+volume_path = "$.payload.volume"
+poll_interval = "15s"
+
+[provider_endpoints.generic.symbols]
+USDCUSD = "usdc_usd"
+`)
+	_, err = tmpFile.Write(content)
+	require.NoError(t, err)
+
+	cfg, err := config.ParseConfig(tmpFile.Name())
+	require.NoError(t, err)
+	require.Equal(t, config.ProviderTypeGeneric, cfg.ProviderEndpoints[0].Type)
+	require.Equal(t, "$.payload.last", cfg.ProviderEndpoints[0].Generic.PricePath)
+	require.Equal(t, map[string]string{"USDCUSD": "usdc_usd"}, cfg.ProviderEndpoints[0].Generic.Symbols)
+}
+
+func TestParseConfig_MissingDexPool(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	content := []byte(`
+listen_addr = ""
+
+[[currency_pairs]]
+base = "KII"
+chain_denom = "ukii"
+quote = "USDT"
+providers = [
+	"dex",
+	"mock"
+]
+
+[[provider_endpoints]]
+name = "dex"
+rest = "http://localhost:8545"
+
+[[provider_endpoints.pools]]
+base = "ETH"
+quote = "USDT"
+type = "uniswap_v2"
+address = "0x1000000000000000000000000000000000000001"
+base_token = "0x2000000000000000000000000000000000000001"
+`)
+	_, err = tmpFile.Write(content)
+	require.NoError(t, err)
+
+	_, err = config.ParseConfig(tmpFile.Name())
+	require.ErrorContains(t, err, "missing dex pool for KII/USDT")
+}
+
 func TestParseConfig_NonUSDQuote(t *testing.T) {
 	tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
 	require.NoError(t, err)
@@ -361,6 +756,117 @@ providers = [
 	require.Error(t, err)
 }
 
+func TestParseConfig_FXConversion(t *testing.T) {
+	content := `
+gas_adjustment = 1.5
+gas_prices = "0.00125usei"
+
+[account]
+address = "sei15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "seivalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
+chain_id = "sei-local-testnet"
+prefix = "sei"
+
+[keyring]
+backend = "test"
+dir = "/var/sei"
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+
+[telemetry]
+service_name = "price-feeder"
+enabled = false
+
+[[currency_pairs]]
+base = "BTC"
+chain_denom = "ubtc"
+quote = "COP"
+providers = [
+	"buda",
+	"mock"
+]
+
+[[currency_pairs]]
+base = "USDT"
+chain_denom = "uusdt"
+quote = "COP"
+providers = [
+	"buda",
+	"mock"
+]
+
+[[provider_endpoints]]
+name = "buda"
+type = "generic"
+rest = "https://www.buda.com"
+
+[provider_endpoints.generic]
+ticker_path = "/api/v2/markets/{symbol}/ticker"
+price_path = "$.ticker.last_price[0]"
+`
+
+	// COP can't be converted to USD
+	_, err := parseConfigContent(t, content)
+	require.ErrorContains(t, err, "all non-usd quotes require a conversion rate feed: COP")
+
+	// COP is converted through USDT/COP and USDT/USD
+	_, err = parseConfigContent(t, content+`
+[[currency_pairs]]
+base = "USDT"
+chain_denom = "uusdt"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"okx"
+]
+`)
+	require.NoError(t, err)
+
+	// COP is converted by the FX providers
+	_, err = parseConfigContent(t, content+`
+[[currency_pairs]]
+base = "COP"
+chain_denom = "ucop"
+quote = "USD"
+providers = [
+	"exchangerate",
+	"currencyapi",
+	"floatrates"
+]
+`)
+	require.NoError(t, err)
+
+	// the prices of a provider are stored by base
+	_, err = parseConfigContent(t, content+`
+[[currency_pairs]]
+base = "USDT"
+chain_denom = "uusdt"
+quote = "USD"
+providers = [
+	"buda",
+	"binance",
+	"okx"
+]
+`)
+	require.ErrorContains(t, err, "provider buda has several quotes for USDT")
+}
+
+// parseConfigContent parses the config content from a temporary file
+func parseConfigContent(t *testing.T, content string) (config.Config, error) {
+	tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	_, err = tmpFile.Write([]byte(content))
+	require.NoError(t, err)
+
+	return config.ParseConfig(tmpFile.Name())
+}
+
 func TestParseConfig_Valid_Deviations(t *testing.T) {
 	tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
 	require.NoError(t, err)
@@ -539,3 +1045,116 @@ global_labels = [["chain-id", "umee-local-testnet"]]
 	_, err = config.ParseConfig(tmpFile.Name())
 	require.Error(t, err)
 }
+
+func TestParseConfig_Valid_Accounts(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	content := []byte(`
+gas_adjustment = 1.5
+gas_prices = "0.00125usei"
+
+[server]
+listen_addr = "0.0.0.0:99999"
+read_timeout = "20s"
+verbose_cors = true
+write_timeout = "20s"
+
+[[currency_pairs]]
+base = "ATOM"
+chain_denom = "uatom"
+quote = "USDT"
+providers = [
+	"kraken",
+	"binance",
+	"huobi"
+]
+
+[[currency_pairs]]
+base = "USDT"
+chain_denom = "uusdt"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"huobi"
+]
+
+[[accounts]]
+address = "sei15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "seivaloper1first"
+chain_id = "sei-local-testnet"
+prefix = "sei"
+
+[[accounts]]
+address = "sei1hhh4f2ej4ahhflq3uvk7kqfyrtldjvhkgw6gca"
+validator = "seivaloper1second"
+fee_granter = "sei15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+chain_id = "sei-local-testnet"
+prefix = "sei"
+
+[keyring]
+backend = "test"
+dir = "/Users/username/.sei"
+pass = "keyringPassword"
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+
+[telemetry]
+enabled = false
+`)
+	_, err = tmpFile.Write(content)
+	require.NoError(t, err)
+
+	cfg, err := config.ParseConfig(tmpFile.Name())
+	require.NoError(t, err)
+
+	accounts := cfg.GetAccounts()
+	require.Len(t, accounts, 2)
+	require.Equal(t, "seivaloper1first", accounts[0].Validator)
+	require.Empty(t, accounts[0].FeeGranter)
+	require.Equal(t, "seivaloper1second", accounts[1].Validator)
+	require.Equal(t, "sei15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4", accounts[1].FeeGranter)
+}
+
+func TestGetAccounts(t *testing.T) {
+	account := config.Account{Address: "fromaddr", Validator: "valaddr", ChainID: "chain-id", Prefix: "chain"}
+
+	// the single account is used when the list is not defined
+	cfg := config.Config{Account: account}
+	require.Equal(t, []config.Account{account}, cfg.GetAccounts())
+
+	accounts := []config.Account{
+		{Address: "fromaddr1", Validator: "valaddr1", ChainID: "chain-id", Prefix: "chain"},
+		{Address: "fromaddr2", Validator: "valaddr2", ChainID: "chain-id", Prefix: "chain"},
+	}
+	cfg = config.Config{Accounts: accounts}
+	require.Equal(t, accounts, cfg.GetAccounts())
+}
+
+func TestRequiresRestart(t *testing.T) {
+	cfg := config.Config{
+		CurrencyPairs: []config.CurrencyPair{
+			{Base: "ATOM", ChainDenom: "uatom", Quote: "USDT", Providers: []string{"kraken"}},
+		},
+		Account:   config.Account{Address: "fromaddr", Validator: "valaddr", ChainID: "chain-id", Prefix: "chain"},
+		GasPrices: "0.00125ukii",
+	}
+
+	// the currency pairs, deviations and endpoints are reloaded
+	reloaded := cfg
+	reloaded.CurrencyPairs = []config.CurrencyPair{
+		{Base: "ETH", ChainDenom: "ueth", Quote: "USDT", Providers: []string{"kraken", "binance"}},
+	}
+	reloaded.Deviations = []config.Deviation{{Base: "ETH", Threshold: "2"}}
+	reloaded.ProviderEndpoints = []config.ProviderEndpoint{{Name: "kraken", Rest: "https://kraken.example"}}
+	require.False(t, cfg.RequiresRestart(reloaded))
+
+	// the other settings require a restart
+	reloaded.GasPrices = "0.1ukii"
+	require.True(t, cfg.RequiresRestart(reloaded))
+}
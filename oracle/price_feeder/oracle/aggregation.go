@@ -0,0 +1,316 @@
+package oracle
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/oracle/price_feeder/config"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/provider"
+)
+
+var (
+	// trimmedMeanFraction is the fraction of the prices discarded from each end
+	// by the trimmed mean
+	trimmedMeanFraction = sdk.MustNewDecFromStr("0.2")
+
+	// defaultMADThreshold defines how many scaled MADs a provider can be away
+	// from the median without being considered faulty. This can be overridden
+	// by the deviation threshold in the config.
+	defaultMADThreshold = sdk.MustNewDecFromStr("3.0")
+
+	// madScaleFactor scales the MAD to be comparable with the standard deviation
+	// of normally distributed prices
+	madScaleFactor = sdk.MustNewDecFromStr("1.4826")
+)
+
+// AggregationStrategies maps the strategy names accepted on the currency pairs config
+// to their implementation. The assets without a strategy use the (T)VWAP of the prices
+// filtered by standard deviation.
+var AggregationStrategies = map[string]AggregationStrategy{
+	config.AggregationMedian:      ComputeMedian,
+	config.AggregationTrimmedMean: ComputeTrimmedMean,
+	config.AggregationVWMedian:    ComputeVolumeWeightedMedian,
+	config.AggregationMAD:         ComputeMADFilteredVWAP,
+}
+
+// PricePoint defines the price and volume reported by a provider for an asset.
+type PricePoint struct {
+	Provider string
+	Price    sdk.Dec
+	Volume   sdk.Dec
+}
+
+// AggregationStrategy computes the price of an asset from the price points of its
+// providers, the threshold is the deviation threshold set for the asset on the config.
+type AggregationStrategy func(points []PricePoint, threshold sdk.Dec) (sdk.Dec, error)
+
+// ComputeMedian returns the median of the prices.
+func ComputeMedian(points []PricePoint, _ sdk.Dec) (sdk.Dec, error) {
+	if len(points) == 0 {
+		return sdk.Dec{}, fmt.Errorf("no prices to aggregate")
+	}
+
+	return median(sortedPrices(points)), nil
+}
+
+// ComputeTrimmedMean returns the mean of the prices after discarding 20% of the
+// prices from each end. The lowest and highest prices are always discarded when
+// there are more than two prices, so a single outlier can't skew thin markets.
+func ComputeTrimmedMean(points []PricePoint, _ sdk.Dec) (sdk.Dec, error) {
+	if len(points) == 0 {
+		return sdk.Dec{}, fmt.Errorf("no prices to aggregate")
+	}
+
+	prices := sortedPrices(points)
+	trim := int(trimmedMeanFraction.MulInt64(int64(len(prices))).TruncateInt64())
+	if trim == 0 && len(prices) > 2 {
+		trim = 1
+	}
+
+	return mean(prices[trim : len(prices)-trim]), nil
+}
+
+// ComputeVolumeWeightedMedian returns the price at which the cumulative volume of
+// the prices sorted from the lowest reaches half of the total volume. It returns
+// the median when there is no volume.
+func ComputeVolumeWeightedMedian(points []PricePoint, threshold sdk.Dec) (sdk.Dec, error) {
+	if len(points) == 0 {
+		return sdk.Dec{}, fmt.Errorf("no prices to aggregate")
+	}
+
+	sorted := sortPricePoints(points)
+	volumeSum := sdk.ZeroDec()
+	for _, point := range sorted {
+		volumeSum = volumeSum.Add(point.Volume)
+	}
+	if !volumeSum.IsPositive() {
+		return ComputeMedian(points, threshold)
+	}
+
+	half := volumeSum.QuoInt64(2)
+	cumulativeVolume := sdk.ZeroDec()
+	for _, point := range sorted {
+		cumulativeVolume = cumulativeVolume.Add(point.Volume)
+		if cumulativeVolume.GTE(half) {
+			return point.Price, nil
+		}
+	}
+
+	return sorted[len(sorted)-1].Price, nil
+}
+
+// ComputeMADFilteredVWAP discards the prices further than the threshold (3 by default)
+// times the scaled median absolute deviation (MAD) from the median and returns the
+// VWAP of the remaining prices, or their mean when there is no volume.
+//
+// Ref: https://en.wikipedia.org/wiki/Median_absolute_deviation
+func ComputeMADFilteredVWAP(points []PricePoint, threshold sdk.Dec) (sdk.Dec, error) {
+	if len(points) == 0 {
+		return sdk.Dec{}, fmt.Errorf("no prices to aggregate")
+	}
+	if threshold.IsNil() {
+		threshold = defaultMADThreshold
+	}
+
+	// get the median and the median of the absolute deviations from it
+	prices := sortedPrices(points)
+	priceMedian := median(prices)
+	deviations := make([]sdk.Dec, len(prices))
+	for i, price := range prices {
+		deviations[i] = price.Sub(priceMedian).Abs()
+	}
+	sort.Slice(deviations, func(i, j int) bool { return deviations[i].LT(deviations[j]) })
+	margin := median(deviations).Mul(madScaleFactor).Mul(threshold)
+
+	// compute the VWAP of the prices within the margin
+	weightedPrices, volumeSum := sdk.ZeroDec(), sdk.ZeroDec()
+	kept := []sdk.Dec{}
+	for _, point := range sortPricePoints(points) {
+		if !isBetween(point.Price, priceMedian, margin) {
+			continue
+		}
+		kept = append(kept, point.Price)
+		weightedPrices = weightedPrices.Add(point.Price.Mul(point.Volume))
+		volumeSum = volumeSum.Add(point.Volume)
+	}
+
+	// with an even amount of prices the median may not be one of them
+	if len(kept) == 0 {
+		return priceMedian, nil
+	}
+	if !volumeSum.IsPositive() {
+		return mean(kept), nil
+	}
+	return weightedPrices.Quo(volumeSum), nil
+}
+
+// ComputeAggregatedPrices computes the price of the assets with an aggregation
+// strategy from the price points of their providers.
+func ComputeAggregatedPrices(
+	points map[string][]PricePoint,
+	aggregations map[string]string,
+	deviationThresholds map[string]sdk.Dec,
+) (map[string]sdk.Dec, error) {
+	prices := make(map[string]sdk.Dec, len(points))
+	for base, basePoints := range points {
+		strategy, ok := AggregationStrategies[aggregations[base]]
+		if !ok || len(basePoints) == 0 {
+			continue
+		}
+
+		price, err := strategy(basePoints, deviationThresholds[base])
+		if err != nil {
+			return nil, fmt.Errorf("failed to aggregate %s prices: %w", base, err)
+		}
+		prices[base] = price
+	}
+	return prices, nil
+}
+
+// hasAggregationStrategy returns true if the asset prices are aggregated by a strategy
+func hasAggregationStrategy(aggregations map[string]string, base string) bool {
+	_, ok := AggregationStrategies[aggregations[base]]
+	return ok
+}
+
+// aggregatedBases returns a function which tells whether the asset prices are
+// aggregated by a strategy
+func aggregatedBases(aggregations map[string]string) func(base string) bool {
+	return func(base string) bool {
+		return hasAggregationStrategy(aggregations, base)
+	}
+}
+
+// allBases includes every asset on the price points
+func allBases(string) bool {
+	return true
+}
+
+// tickerPricePoints returns the ticker price points of the included assets
+func tickerPricePoints(
+	prices provider.AggregatedProviderPrices,
+	include func(base string) bool,
+) map[string][]PricePoint {
+	points := make(map[string][]PricePoint)
+	for providerName, tickers := range prices {
+		for base, tp := range tickers {
+			if !include(base) {
+				continue
+			}
+			points[base] = append(points[base], PricePoint{Provider: providerName, Price: tp.Price, Volume: tp.Volume})
+		}
+	}
+	return points
+}
+
+// candlePricePoints returns the candle price points of the included assets, the
+// price of each provider is the TVWAP of its candles and the volume is the sum of
+// the candles volume
+func candlePricePoints(
+	candles provider.AggregatedProviderCandles,
+	include func(base string) bool,
+) (map[string][]PricePoint, error) {
+	points := make(map[string][]PricePoint)
+	for providerName, providerCandles := range candles {
+		for base, cp := range providerCandles {
+			if !include(base) {
+				continue
+			}
+
+			tvwap, err := ComputeTVWAP(provider.AggregatedProviderCandles{providerName: {base: cp}})
+			if err != nil {
+				return nil, err
+			}
+
+			// skip providers without recent candles
+			price, ok := tvwap[base]
+			if !ok {
+				continue
+			}
+
+			volume := sdk.ZeroDec()
+			for _, candle := range cp {
+				volume = volume.Add(candle.Volume)
+			}
+			points[base] = append(points[base], PricePoint{Provider: providerName, Price: price, Volume: volume})
+		}
+	}
+	return points, nil
+}
+
+// withoutAggregatedTickers returns the tickers of the assets without an aggregation strategy
+func withoutAggregatedTickers(
+	prices provider.AggregatedProviderPrices,
+	aggregations map[string]string,
+) provider.AggregatedProviderPrices {
+	filtered := make(provider.AggregatedProviderPrices, len(prices))
+	for providerName, tickers := range prices {
+		filtered[providerName] = make(map[string]provider.TickerPrice, len(tickers))
+		for base, tp := range tickers {
+			if !hasAggregationStrategy(aggregations, base) {
+				filtered[providerName][base] = tp
+			}
+		}
+	}
+	return filtered
+}
+
+// withoutAggregatedCandles returns the candles of the assets without an aggregation strategy
+func withoutAggregatedCandles(
+	candles provider.AggregatedProviderCandles,
+	aggregations map[string]string,
+) provider.AggregatedProviderCandles {
+	filtered := make(provider.AggregatedProviderCandles, len(candles))
+	for providerName, providerCandles := range candles {
+		filtered[providerName] = make(map[string][]provider.CandlePrice, len(providerCandles))
+		for base, cp := range providerCandles {
+			if !hasAggregationStrategy(aggregations, base) {
+				filtered[providerName][base] = cp
+			}
+		}
+	}
+	return filtered
+}
+
+// sortPricePoints returns a copy of the price points sorted by price, the ties are
+// sorted by provider so the result doesn't depend on the providers map order
+func sortPricePoints(points []PricePoint) []PricePoint {
+	sorted := make([]PricePoint, len(points))
+	copy(sorted, points)
+	sort.Slice(sorted, func(i, j int) bool {
+		if !sorted[i].Price.Equal(sorted[j].Price) {
+			return sorted[i].Price.LT(sorted[j].Price)
+		}
+		return sorted[i].Provider < sorted[j].Provider
+	})
+	return sorted
+}
+
+// sortedPrices returns the prices of the price points sorted from the lowest
+func sortedPrices(points []PricePoint) []sdk.Dec {
+	prices := make([]sdk.Dec, len(points))
+	for i, point := range sortPricePoints(points) {
+		prices[i] = point.Price
+	}
+	return prices
+}
+
+// median returns the median of the sorted values
+func median(sorted []sdk.Dec) sdk.Dec {
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return sorted[middle-1].Add(sorted[middle]).QuoInt64(2)
+	}
+	return sorted[middle]
+}
+
+// mean returns the mean of the values
+func mean(values []sdk.Dec) sdk.Dec {
+	sum := sdk.ZeroDec()
+	for _, value := range values {
+		sum = sum.Add(value)
+	}
+	return sum.QuoInt64(int64(len(values)))
+}
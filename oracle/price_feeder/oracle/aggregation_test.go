@@ -0,0 +1,278 @@
+package oracle
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiichain/kiichain/oracle/price_feeder/config"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/provider"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/types"
+)
+
+// pricePoints creates a price point per price with the given volumes
+func pricePoints(prices []string, volumes []string) []PricePoint {
+	points := make([]PricePoint, len(prices))
+	for i, price := range prices {
+		points[i] = PricePoint{
+			Provider: string(rune('a' + i)),
+			Price:    sdk.MustNewDecFromStr(price),
+			Volume:   sdk.MustNewDecFromStr(volumes[i]),
+		}
+	}
+	return points
+}
+
+func TestAggregationStrategies(t *testing.T) {
+	// a thin market where a single exchange reports an outlier price
+	outlierPoints := pricePoints(
+		[]string{"2002", "2600", "2000", "2001"},
+		[]string{"30", "1", "10", "20"},
+	)
+	noVolumePoints := pricePoints(
+		[]string{"2002", "2600", "2000", "2001"},
+		[]string{"0", "0", "0", "0"},
+	)
+
+	tests := []struct {
+		name      string
+		strategy  string
+		points    []PricePoint
+		threshold sdk.Dec
+		expected  sdk.Dec
+		expectErr bool
+	}{
+		{
+			name:      "median, no prices",
+			strategy:  config.AggregationMedian,
+			expectErr: true,
+		},
+		{
+			name:     "median, single price",
+			strategy: config.AggregationMedian,
+			points:   pricePoints([]string{"1.5"}, []string{"1"}),
+			expected: sdk.MustNewDecFromStr("1.5"),
+		},
+		{
+			name:     "median, odd amount of prices",
+			strategy: config.AggregationMedian,
+			points:   pricePoints([]string{"3", "100", "1"}, []string{"1", "1", "1"}),
+			expected: sdk.MustNewDecFromStr("3"),
+		},
+		{
+			name:     "median, even amount of prices",
+			strategy: config.AggregationMedian,
+			points:   outlierPoints,
+			expected: sdk.MustNewDecFromStr("2001.5"),
+		},
+		{
+			name:      "trimmed mean, no prices",
+			strategy:  config.AggregationTrimmedMean,
+			expectErr: true,
+		},
+		{
+			name:     "trimmed mean, two prices are not trimmed",
+			strategy: config.AggregationTrimmedMean,
+			points:   pricePoints([]string{"1", "2"}, []string{"1", "1"}),
+			expected: sdk.MustNewDecFromStr("1.5"),
+		},
+		{
+			name:     "trimmed mean, thin market discards the extremes",
+			strategy: config.AggregationTrimmedMean,
+			points:   outlierPoints,
+			expected: sdk.MustNewDecFromStr("2001.5"),
+		},
+		{
+			name:     "trimmed mean, discards 20% from each end",
+			strategy: config.AggregationTrimmedMean,
+			points: pricePoints(
+				[]string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "100"},
+				[]string{"1", "1", "1", "1", "1", "1", "1", "1", "1", "1"},
+			),
+			expected: sdk.MustNewDecFromStr("5.5"),
+		},
+		{
+			name:      "volume weighted median, no prices",
+			strategy:  config.AggregationVWMedian,
+			expectErr: true,
+		},
+		{
+			name:     "volume weighted median, price reaching half of the volume",
+			strategy: config.AggregationVWMedian,
+			points:   outlierPoints,
+			expected: sdk.MustNewDecFromStr("2002"),
+		},
+		{
+			name:     "volume weighted median, high volume outlier",
+			strategy: config.AggregationVWMedian,
+			points:   pricePoints([]string{"1", "2", "10"}, []string{"1", "1", "5"}),
+			expected: sdk.MustNewDecFromStr("10"),
+		},
+		{
+			name:     "volume weighted median, no volume uses the median",
+			strategy: config.AggregationVWMedian,
+			points:   noVolumePoints,
+			expected: sdk.MustNewDecFromStr("2001.5"),
+		},
+		{
+			name:      "mad, no prices",
+			strategy:  config.AggregationMAD,
+			expectErr: true,
+		},
+		{
+			name:     "mad, discards the outlier and computes the vwap",
+			strategy: config.AggregationMAD,
+			points:   outlierPoints,
+			expected: sdk.MustNewDecFromStr("120080").QuoInt64(60),
+		},
+		{
+			name:     "mad, no volume uses the mean",
+			strategy: config.AggregationMAD,
+			points:   noVolumePoints,
+			expected: sdk.MustNewDecFromStr("2001"),
+		},
+		{
+			name:      "mad, configured threshold",
+			strategy:  config.AggregationMAD,
+			points:    outlierPoints,
+			threshold: sdk.MustNewDecFromStr("1000"),
+			expected:  sdk.MustNewDecFromStr("122680").QuoInt64(61),
+		},
+		{
+			name:      "mad, every price discarded uses the median",
+			strategy:  config.AggregationMAD,
+			points:    outlierPoints,
+			threshold: sdk.MustNewDecFromStr("0.1"),
+			expected:  sdk.MustNewDecFromStr("2001.5"),
+		},
+		{
+			name:     "mad, equal prices",
+			strategy: config.AggregationMAD,
+			points:   pricePoints([]string{"2", "2", "2", "3"}, []string{"1", "1", "1", "1"}),
+			expected: sdk.MustNewDecFromStr("2"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			strategy, ok := AggregationStrategies[tc.strategy]
+			require.True(t, ok)
+
+			price, err := strategy(tc.points, tc.threshold)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, price)
+		})
+	}
+}
+
+func TestGetComputedPricesAggregations(t *testing.T) {
+	pair := types.CurrencyPair{Base: "XAUT", Quote: "USD"}
+	providerPairs := map[string][]types.CurrencyPair{
+		config.ProviderHuobi: {pair},
+		config.ProviderOkx:   {pair},
+		config.ProviderGate:  {pair},
+	}
+
+	tickerPrice := func(price string) map[string]provider.TickerPrice {
+		return map[string]provider.TickerPrice{
+			pair.Base: {Price: sdk.MustNewDecFromStr(price), Volume: sdk.MustNewDecFromStr("1")},
+		}
+	}
+	providerPrices := provider.AggregatedProviderPrices{
+		config.ProviderHuobi: tickerPrice("2000"),
+		config.ProviderOkx:   tickerPrice("2002"),
+		config.ProviderGate:  tickerPrice("2600"),
+	}
+
+	tests := []struct {
+		name        string
+		aggregation string
+		expected    sdk.Dec
+	}{
+		{
+			// the outlier is discarded by the standard deviation filter
+			name:        "default vwap",
+			aggregation: "",
+			expected:    sdk.MustNewDecFromStr("2001"),
+		},
+		{
+			name:        "median",
+			aggregation: config.AggregationMedian,
+			expected:    sdk.MustNewDecFromStr("2002"),
+		},
+		{
+			name:        "trimmed mean",
+			aggregation: config.AggregationTrimmedMean,
+			expected:    sdk.MustNewDecFromStr("2002"),
+		},
+		{
+			name:        "mad",
+			aggregation: config.AggregationMAD,
+			expected:    sdk.MustNewDecFromStr("2001"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			prices, err := GetComputedPrices(
+				zerolog.Nop(),
+				make(provider.AggregatedProviderCandles),
+				providerPrices,
+				providerPairs,
+				make(map[string]sdk.Dec),
+				createAggregationsFromPairs([]config.CurrencyPair{
+					{Base: pair.Base, Quote: pair.Quote, Aggregation: tc.aggregation},
+				}),
+				map[string]struct{}{pair.Base: {}},
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, prices[pair.Base])
+		})
+	}
+}
+
+func TestGetComputedPricesAggregationsCandles(t *testing.T) {
+	pair := types.CurrencyPair{Base: "XAUT", Quote: "USD"}
+	providerPairs := map[string][]types.CurrencyPair{
+		config.ProviderHuobi: {pair},
+		config.ProviderOkx:   {pair},
+		config.ProviderGate:  {pair},
+	}
+
+	candlePrice := func(price string) map[string][]provider.CandlePrice {
+		return map[string][]provider.CandlePrice{
+			pair.Base: {
+				{
+					Price:     sdk.MustNewDecFromStr(price),
+					Volume:    sdk.MustNewDecFromStr("1"),
+					TimeStamp: provider.PastUnixTime(1 * time.Minute),
+				},
+			},
+		}
+	}
+	providerCandles := provider.AggregatedProviderCandles{
+		config.ProviderHuobi: candlePrice("2000"),
+		config.ProviderOkx:   candlePrice("2002"),
+		config.ProviderGate:  candlePrice("2600"),
+	}
+
+	// the price of each provider is the TVWAP of its candles
+	prices, err := GetComputedPrices(
+		zerolog.Nop(),
+		providerCandles,
+		make(provider.AggregatedProviderPrices),
+		providerPairs,
+		make(map[string]sdk.Dec),
+		map[string]string{pair.Base: config.AggregationMedian},
+		map[string]struct{}{pair.Base: {}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("2002"), prices[pair.Base])
+}
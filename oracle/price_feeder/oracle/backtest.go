@@ -0,0 +1,194 @@
+package oracle
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+
+	"github.com/kiichain/kiichain/oracle/price_feeder/config"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/provider"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/types"
+)
+
+type (
+	// ReferencePrice defines the price of an asset on the reference series
+	ReferencePrice struct {
+		Timestamp time.Time
+		Price     sdk.Dec
+	}
+
+	// ReferenceSeries defines the reference prices by base sorted by timestamp,
+	// the backtest prices are compared against them
+	ReferenceSeries map[string][]ReferencePrice
+
+	// BacktestResult defines the price that would have been voted for an asset
+	// on a recorded tick, the price is nil when it couldn't be computed
+	BacktestResult struct {
+		Timestamp time.Time
+		Report    PriceReport
+		Reference sdk.Dec
+	}
+)
+
+// ReadReferenceSeries reads a reference series from CSV rows with the timestamp
+// (RFC3339), the base and the price, ex. "2024-01-02T15:04:05Z,ATOM,9.87"
+func ReadReferenceSeries(r io.Reader) (ReferenceSeries, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	series := make(ReferenceSeries)
+	for line := 1; ; line++ {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// the header is optional
+		if line == 1 && strings.EqualFold(row[0], "timestamp") {
+			continue
+		}
+
+		timestamp, err := time.Parse(time.RFC3339, row[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid reference timestamp on line %d: %w", line, err)
+		}
+		price, err := sdk.NewDecFromStr(row[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid reference price on line %d: %w", line, err)
+		}
+
+		base := strings.ToUpper(row[1])
+		series[base] = append(series[base], ReferencePrice{Timestamp: timestamp, Price: price})
+	}
+
+	for base := range series {
+		prices := series[base]
+		sort.SliceStable(prices, func(i, j int) bool { return prices[i].Timestamp.Before(prices[j].Timestamp) })
+	}
+	return series, nil
+}
+
+// Price returns the latest reference price of the base at the given time
+func (rs ReferenceSeries) Price(base string, timestamp time.Time) (sdk.Dec, bool) {
+	prices := rs[strings.ToUpper(base)]
+
+	// get the first price after the timestamp, the previous one is the latest
+	i := sort.Search(len(prices), func(i int) bool { return prices[i].Timestamp.After(timestamp) })
+	if i == 0 {
+		return sdk.Dec{}, false
+	}
+	return prices[i-1].Price, true
+}
+
+// Deviation returns the deviation of the price from the reference price, as a
+// percentage of the reference price
+func (r BacktestResult) Deviation() (sdk.Dec, bool) {
+	if r.Report.Price.IsNil() || r.Reference.IsNil() || r.Reference.IsZero() {
+		return sdk.Dec{}, false
+	}
+	return r.Report.Price.Sub(r.Reference).Quo(r.Reference).MulInt64(100), true
+}
+
+// Backtest replays the recorded market data and returns the prices that would
+// have been voted for the currency pairs, nothing is broadcasted. The records of
+// the providers which aren't listed on the currency pairs are ignored, so the
+// provider sets and deviation thresholds can be tuned on the same records.
+func Backtest(
+	logger zerolog.Logger,
+	currencyPairs []config.CurrencyPair,
+	deviations map[string]sdk.Dec,
+	records []MarketDataRecord,
+	reference ReferenceSeries,
+) ([]BacktestResult, error) {
+	chainDenomMapping, providerPairs := createMappingsFromPairs(currencyPairs)
+	aggregations := createAggregationsFromPairs(currencyPairs)
+
+	// every currency is reported, even if it has no price
+	bases := make([]string, 0, len(chainDenomMapping))
+	requiredRates := make(map[string]struct{}, len(chainDenomMapping))
+	for base := range chainDenomMapping {
+		bases = append(bases, base)
+		requiredRates[base] = struct{}{}
+	}
+	sort.Strings(bases)
+
+	results := []BacktestResult{}
+	for _, record := range records {
+		providerPrices, providerCandles := replayMarketDataRecord(record, providerPairs)
+
+		_, reports, err := computePrices(
+			logger,
+			providerCandles,
+			providerPrices,
+			providerPairs,
+			deviations,
+			aggregations,
+			requiredRates,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute the prices recorded at %s: %w", record.Timestamp, err)
+		}
+
+		for _, base := range bases {
+			report, ok := reports[base]
+			if !ok {
+				report = PriceReport{Base: base}
+			}
+			report.ChainDenom = chainDenomMapping[base]
+
+			result := BacktestResult{Timestamp: record.Timestamp, Report: report}
+			if price, ok := reference.Price(base, record.Timestamp); ok {
+				result.Reference = price
+			}
+			results = append(results, result)
+		}
+	}
+
+	return results, nil
+}
+
+// replayMarketDataRecord flattens the recorded market data of the providers into the
+// tickers and candles by base. The candles are shifted to the current time, so the
+// TVWAP considers them as recent as they were when recorded.
+func replayMarketDataRecord(
+	record MarketDataRecord,
+	providerPairs map[string][]types.CurrencyPair,
+) (provider.AggregatedProviderPrices, provider.AggregatedProviderCandles) {
+	providerPrices := make(provider.AggregatedProviderPrices)
+	providerCandles := make(provider.AggregatedProviderCandles)
+	offset := provider.PastUnixTime(0) - record.Timestamp.Unix()*int64(time.Second/time.Millisecond)
+
+	for providerName, data := range record.Providers {
+		pairs, ok := providerPairs[providerName]
+		if !ok {
+			continue
+		}
+
+		candles := make(map[string][]provider.CandlePrice, len(data.Candles))
+		for symbol, symbolCandles := range data.Candles {
+			shifted := make([]provider.CandlePrice, len(symbolCandles))
+			for i, candle := range symbolCandles {
+				candle.TimeStamp += offset
+				shifted[i] = candle
+			}
+			candles[symbol] = shifted
+		}
+
+		for _, pair := range pairs {
+			SetProviderTickerPricesAndCandles(providerName, providerPrices, providerCandles, data.Tickers, candles, pair)
+		}
+	}
+
+	return providerPrices, providerCandles
+}
@@ -0,0 +1,149 @@
+package oracle
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiichain/kiichain/oracle/price_feeder/config"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/provider"
+)
+
+// recordedMarketData records the ATOM candles reported by the providers two hours ago
+func recordedMarketData(t *testing.T) []MarketDataRecord {
+	recordedAt := time.Now().Add(-2 * time.Hour).UTC().Truncate(time.Second)
+	candle := func(price string) map[string][]provider.CandlePrice {
+		return map[string][]provider.CandlePrice{
+			"ATOMUSD": {
+				{
+					Price:     sdk.MustNewDecFromStr(price),
+					Volume:    sdk.OneDec(),
+					TimeStamp: recordedAt.Add(-time.Minute).UnixMilli(),
+				},
+			},
+		}
+	}
+
+	// the records are written and read back as JSON lines
+	buf := new(bytes.Buffer)
+	recorder := NewRecorder(buf)
+	require.NoError(t, recorder.Record(MarketDataRecord{
+		Timestamp: recordedAt,
+		Providers: map[string]ProviderMarketData{
+			config.ProviderBinance: {Candles: candle("10")},
+			config.ProviderKraken:  {Candles: candle("10.2")},
+			config.ProviderHuobi:   {Candles: candle("15")},
+		},
+	}))
+
+	records, err := ReadMarketDataRecords(buf)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, recordedAt, records[0].Timestamp)
+
+	return records
+}
+
+// requireDecApprox asserts the values are equal up to the TVWAP weights rounding
+func requireDecApprox(t *testing.T, expected, actual sdk.Dec) {
+	require.True(t, expected.Sub(actual).Abs().LT(sdk.NewDecWithPrec(1, 9)), "expected %s, got %s", expected, actual)
+}
+
+func TestBacktest(t *testing.T) {
+	records := recordedMarketData(t)
+	recordedAt := records[0].Timestamp
+
+	reference, err := ReadReferenceSeries(strings.NewReader(
+		"timestamp,base,price\n" +
+			recordedAt.Add(-time.Hour).Format(time.RFC3339) + ",atom,10\n" +
+			recordedAt.Add(time.Hour).Format(time.RFC3339) + ",atom,20\n",
+	))
+	require.NoError(t, err)
+
+	t.Run("deviation filter", func(t *testing.T) {
+		results, err := Backtest(
+			zerolog.Nop(),
+			[]config.CurrencyPair{
+				{
+					Base:       "ATOM",
+					ChainDenom: "uatom",
+					Quote:      "USD",
+					Providers:  []string{config.ProviderBinance, config.ProviderKraken, config.ProviderHuobi},
+				},
+			},
+			make(map[string]sdk.Dec),
+			records,
+			reference,
+		)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+
+		// the candles are still recent when replayed and the outlier is filtered
+		result := results[0]
+		require.Equal(t, recordedAt, result.Timestamp)
+		require.Equal(t, "uatom", result.Report.ChainDenom)
+		require.Equal(t, PriceSourceCandle, result.Report.Source)
+		requireDecApprox(t, sdk.MustNewDecFromStr("10.1"), result.Report.Price)
+		require.Len(t, result.Report.Providers, 3)
+		require.Equal(t, config.ProviderHuobi, result.Report.Providers[1].Provider)
+		require.True(t, result.Report.Providers[1].Filtered)
+
+		// the reference price is the latest before the record
+		require.Equal(t, sdk.MustNewDecFromStr("10"), result.Reference)
+		deviation, ok := result.Deviation()
+		require.True(t, ok)
+		requireDecApprox(t, sdk.MustNewDecFromStr("1"), deviation)
+	})
+
+	t.Run("provider set", func(t *testing.T) {
+		results, err := Backtest(
+			zerolog.Nop(),
+			[]config.CurrencyPair{
+				{Base: "ATOM", ChainDenom: "uatom", Quote: "USD", Providers: []string{config.ProviderBinance}},
+				{Base: "ETH", ChainDenom: "ueth", Quote: "USD", Providers: []string{config.ProviderBinance}},
+			},
+			make(map[string]sdk.Dec),
+			records,
+			make(ReferenceSeries),
+		)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+
+		// only the listed providers are replayed
+		require.Equal(t, sdk.MustNewDecFromStr("10"), results[0].Report.Price)
+		require.Len(t, results[0].Report.Providers, 1)
+
+		// the currencies without prices are reported too
+		require.Equal(t, "ETH", results[1].Report.Base)
+		require.True(t, results[1].Report.Price.IsNil())
+		_, ok := results[1].Deviation()
+		require.False(t, ok)
+	})
+}
+
+func TestReadReferenceSeries(t *testing.T) {
+	_, err := ReadReferenceSeries(strings.NewReader("2024-01-02,ATOM,10\n"))
+	require.ErrorContains(t, err, "invalid reference timestamp")
+
+	_, err = ReadReferenceSeries(strings.NewReader("2024-01-02T15:04:05Z,ATOM,price\n"))
+	require.ErrorContains(t, err, "invalid reference price")
+
+	series, err := ReadReferenceSeries(strings.NewReader("2024-01-02T15:04:05Z,ATOM,10\n"))
+	require.NoError(t, err)
+
+	_, ok := series.Price("ATOM", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.False(t, ok)
+	price, ok := series.Price("ATOM", time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	require.Equal(t, sdk.MustNewDecFromStr("10"), price)
+}
+
+func TestReadMarketDataRecords(t *testing.T) {
+	_, err := ReadMarketDataRecords(strings.NewReader("{}\n\nnot json\n"))
+	require.ErrorContains(t, err, "line 3")
+}
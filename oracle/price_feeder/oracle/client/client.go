@@ -12,40 +12,31 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/client/tx"
-	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/rs/zerolog"
-	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
-	tmjsonclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
 )
 
 type (
 	// OracleClient defines a structure that interact with the kiichain node.
 	OracleClient struct {
-		Logger              zerolog.Logger
-		ChainID             string
-		KeyringBackend      string
-		KeyringDir          string
-		KeyringPass         string
-		TMRPC               string
-		RPCTimeout          time.Duration
-		OracleAddr          sdk.AccAddress
-		OracleAddrString    string
-		ValidatorAddr       sdk.ValAddress
-		ValidatorAddrString string
-		FeeGranterAddr      sdk.AccAddress
-		Encoding            simappparams.EncodingConfig
-		GasPrices           string
-		GasAdjustment       float64
-		GRPCEndpoint        string
-		KeyringPassphrase   string
-		BlockHeightEvents   chan int64
+		Logger            zerolog.Logger
+		ChainID           string
+		Signer            Signer
+		Endpoints         *EndpointPool
+		RPCTimeout        time.Duration
+		Accounts          []FeederAccount
+		Encoding          simappparams.EncodingConfig
+		GasPrices         string
+		GasAdjustment     float64
+		KeyringPassphrase string
+		BlockHeightEvents chan int64
 
 		// MockBroadcastTx allows for a basic mock without refactoring this to an interface
 		MockBroadcastTx func(clientCtx client.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error)
@@ -62,56 +53,57 @@ func NewOracleClient(
 	ctx context.Context,
 	logger zerolog.Logger,
 	chainID string,
-	keyringBackend string,
-	keyringDir string,
-	keyringPass string,
-	tmRPC string,
+	signer Signer,
+	endpoints []Endpoint,
 	rpcTimeout time.Duration,
-	oracleAddrString string,
-	validatorAddrString string,
-	feeGranterAddrString string,
-	grpcEndpoint string,
+	stallTimeout time.Duration,
+	accounts []FeederAccount,
 	gasAdjustment float64,
 	gasPrices string,
 ) (OracleClient, error) {
-	// get the account which performs the transaction
-	oracleAddr, err := sdk.AccAddressFromBech32(oracleAddrString)
+	if len(accounts) == 0 {
+		return OracleClient{}, fmt.Errorf("at least one feeder account is required")
+	}
+
+	// create the pool of nodes, the client fails over to another node when the current one fails
+	endpointPool, err := NewEndpointPool(logger, endpoints, rpcTimeout)
 	if err != nil {
 		return OracleClient{}, err
 	}
 
-	// get the account who will pay the gas
-	feegrantAddr, _ := sdk.AccAddressFromBech32(feeGranterAddrString)
-
 	// create client
 	oracleClient := OracleClient{
-		Logger:              logger.With().Str("module", "oracle_client").Logger(),
-		ChainID:             chainID,
-		KeyringBackend:      keyringBackend,
-		KeyringDir:          keyringDir,
-		KeyringPass:         keyringPass,
-		TMRPC:               tmRPC, // tendermint endpoint
-		RPCTimeout:          rpcTimeout,
-		OracleAddr:          oracleAddr,
-		OracleAddrString:    oracleAddrString,
-		ValidatorAddr:       sdk.ValAddress(validatorAddrString),
-		ValidatorAddrString: validatorAddrString,
-		FeeGranterAddr:      feegrantAddr,
-		Encoding:            simapp.MakeTestEncodingConfig(),
-		GasAdjustment:       gasAdjustment,
-		GRPCEndpoint:        grpcEndpoint,
-		GasPrices:           gasPrices,
-		BlockHeightEvents:   make(chan int64, 1),
+		Logger:            logger.With().Str("module", "oracle_client").Logger(),
+		ChainID:           chainID,
+		Signer:            signer,
+		Endpoints:         endpointPool,
+		RPCTimeout:        rpcTimeout,
+		Accounts:          accounts,
+		Encoding:          simapp.MakeTestEncodingConfig(),
+		GasAdjustment:     gasAdjustment,
+		GasPrices:         gasPrices,
+		BlockHeightEvents: make(chan int64, 1),
 	}
 
-	// creates the cosmos client context based on the oracle client
-	clientCtx, err := oracleClient.CreateClientContext()
-	if err != nil {
-		return OracleClient{}, err
+	// the signer must hold the key of every feeder account
+	for _, account := range oracleClient.Accounts {
+		if _, err := signer.PubKey(account.OracleAddr); err != nil {
+			return OracleClient{}, fmt.Errorf("failed to get the key of %s: %w", account.OracleAddrString, err)
+		}
 	}
 
-	// get block height from the rpc connection
-	blockHeight, err := rpc.GetChainHeight(clientCtx)
+	// get block height from the rpc connection, trying the other nodes if it fails
+	var blockHeight int64
+	for range endpoints {
+		clientCtx := oracleClient.CreateClientContext()
+		index, _, _ := endpointPool.Current()
+
+		blockHeight, err = rpc.GetChainHeight(clientCtx)
+		if err == nil {
+			break
+		}
+		endpointPool.ReportFailure(index, err)
+	}
 	if err != nil {
 		return OracleClient{}, err
 	}
@@ -121,17 +113,22 @@ func NewOracleClient(
 		Logger:        logger,
 		LastHeight:    blockHeight,
 		ChBlockHeight: oracleClient.BlockHeightEvents,
+		Endpoints:     endpointPool,
+		StallTimeout:  stallTimeout,
 	}
 
 	// start tracking the chain for new block events and update the height
-	err = chainHeightUpdater.Start(ctx, clientCtx.Client, oracleClient.Logger)
-	if err != nil {
-		return OracleClient{}, err
-	}
+	chainHeightUpdater.Start(ctx, oracleClient.Logger)
 
 	return oracleClient, nil
 }
 
+// GRPCEndpoint returns the gRPC endpoint of the current node
+func (oc OracleClient) GRPCEndpoint() string {
+	_, endpoint, _ := oc.Endpoints.Current()
+	return endpoint.GRPC
+}
+
 // newPassReader returns a reader obj with the password from env
 func newPassReader(pass string) io.Reader {
 	return &passReader{
@@ -174,15 +171,19 @@ func (oc OracleClient) BroadcastTx(
 	defer telemetry.MeasureSince(startTime, "latency", "broadcast")
 
 	// create transaction factory
-	txf, err := oc.CreateTxFactory()
-	if err != nil {
-		return nil, err
-	}
+	txf := oc.CreateTxFactory()
 
-	// get account number and next sequence
-	txAccountInfo := NewAccountInfo()
-	txf, err = txAccountInfo.ObtainAccountInfo(clientCtx, txf, oc.Logger)
+	// broadcast to the current node, the account sequence is obtained again from
+	// the node when the client failed over since the last transaction
+	endpointIndex, endpoint, rpcClient := oc.Endpoints.Current()
+	clientCtx = clientCtx.WithClient(rpcClient).WithNodeURI(endpoint.TMRPC)
+	txAccountInfo := oc.accountInfo(clientCtx.GetFromAddress())
+	txAccountInfo.UseEndpoint(endpoint.TMRPC)
+
+	// get account number and next sequence of the signing feeder account
+	txf, err := txAccountInfo.ObtainAccountInfo(clientCtx, txf, oc.Logger)
 	if err != nil {
+		oc.Endpoints.ReportFailure(endpointIndex, err)
 		return nil, err
 	}
 
@@ -193,7 +194,7 @@ func (oc OracleClient) BroadcastTx(
 	}
 
 	// Sign the transaction
-	err = tx.Sign(txf, clientCtx.GetFromName(), transaction, true)
+	err = oc.signTx(txf, clientCtx.GetFromAddress(), transaction)
 	if err != nil {
 		return nil, err
 	}
@@ -208,8 +209,19 @@ func (oc OracleClient) BroadcastTx(
 
 	// broadcast transaction
 	resp, err := clientCtx.BroadcastTx(txBytes)
+
+	// the node is healthy if it responded, even if the transaction was rejected,
+	// the next transactions are sent to another node when it doesn't respond
+	if resp != nil {
+		oc.Endpoints.ReportSuccess(endpointIndex)
+	} else if err != nil {
+		oc.Endpoints.ReportFailure(endpointIndex, err)
+	}
+
 	if resp != nil && resp.Code != 0 && resp.Code != sdkerrors.ErrAlreadyExists.ABCICode() {
 		err = fmt.Errorf("received error response code %d from broadcast tx: %s", resp.Code, resp.Logs.String())
+		// the transaction was rejected, so the local sequence may be out of sync with the chain
+		txAccountInfo.ShouldResetSequence = true
 		return resp, err
 	}
 	if err != nil {
@@ -224,43 +236,52 @@ func (oc OracleClient) BroadcastTx(
 
 }
 
-// CreateClientContext creates an SDK client Context instance used for transaction
-// generation, signing and broadcasting.
-func (oc OracleClient) CreateClientContext() (client.Context, error) {
-	// get keyring password from selected input
-	var keyringInput io.Reader
-	if len(oc.KeyringPass) > 0 {
-		keyringInput = newPassReader(oc.KeyringPass)
-	} else {
-		keyringInput = os.Stdin
-	}
-
-	// create a new keyring
-	kr, err := keyring.New("kiichain3", oc.KeyringBackend, oc.KeyringDir, keyringInput)
+// signTx signs the transaction with the key of the feeder account through the
+// signer, the sign bytes are built the same way as the cosmos-sdk tx.Sign
+func (oc OracleClient) signTx(txf tx.Factory, addr sdk.AccAddress, txBuilder client.TxBuilder) error {
+	pubKey, err := oc.Signer.PubKey(addr)
 	if err != nil {
-		return client.Context{}, err
+		return err
 	}
 
-	// create a tendermint HTTP client
-	httpClient, err := tmjsonclient.DefaultHTTPClient(oc.TMRPC)
-	if err != nil {
-		return client.Context{}, err
+	signMode := txf.SignMode()
+	signerData := authsigning.SignerData{
+		ChainID:       txf.ChainID(),
+		AccountNumber: txf.AccountNumber(),
+		Sequence:      txf.Sequence(),
 	}
 
-	httpClient.Timeout = oc.RPCTimeout
+	// the signer infos are part of the sign bytes, so an empty signature is set first
+	sig := signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: txf.Sequence(),
+	}
+	if err := txBuilder.SetSignatures(sig); err != nil {
+		return err
+	}
 
-	// create a tendermint RPC client
-	tmRPC, err := rpchttp.NewWithClient(oc.TMRPC, httpClient)
+	bytesToSign, err := oc.Encoding.TxConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
 	if err != nil {
-		return client.Context{}, err
+		return err
 	}
 
-	// get keyring from the info from the oracle addr
-	keyInfo, err := kr.KeyByAddress(oc.OracleAddr)
+	sigBytes, err := oc.Signer.Sign(addr, bytesToSign)
 	if err != nil {
-		return client.Context{}, err
+		return err
 	}
 
+	sig.Data = &signing.SingleSignatureData{SignMode: signMode, Signature: sigBytes}
+	return txBuilder.SetSignatures(sig)
+}
+
+// CreateClientContext creates an SDK client Context instance used for transaction
+// generation, signing and broadcasting. The context signs with the first feeder
+// account, use AccountClientContext to sign with the other ones. The context
+// connects to the current node of the endpoint pool.
+func (oc OracleClient) CreateClientContext() client.Context {
+	_, endpoint, tmRPC := oc.Endpoints.Current()
+
 	// create a cosmos client context
 	clientCtx := client.Context{
 		ChainID:           oc.ChainID,
@@ -273,43 +294,34 @@ func (oc OracleClient) CreateClientContext() (client.Context, error) {
 		Codec:             oc.Encoding.Marshaler,
 		LegacyAmino:       oc.Encoding.Amino,
 		Input:             os.Stdin,
-		NodeURI:           oc.TMRPC,
+		NodeURI:           endpoint.TMRPC,
 		Client:            tmRPC,
-		Keyring:           kr,
-		FromAddress:       oc.OracleAddr,
-		FromName:          keyInfo.GetName(),
-		From:              keyInfo.GetName(),
 		OutputFormat:      "json",
 		UseLedger:         false,
 		Simulate:          false,
 		GenerateOnly:      false,
 		Offline:           false,
 		SkipConfirm:       true,
-		FeeGranter:        oc.FeeGranterAddr,
 	}
 
-	return clientCtx, nil
+	// sign with the first feeder account by default
+	if len(oc.Accounts) > 0 {
+		clientCtx = oc.AccountClientContext(clientCtx, oc.Accounts[0])
+	}
+
+	return clientCtx
 }
 
 // CreateTxFactory creates an SDK Factory instance used for transaction
 // generation, signing and broadcasting.
-func (oc OracleClient) CreateTxFactory() (tx.Factory, error) {
-	// create cosmos context
-	clientCtx, err := oc.CreateClientContext()
-	if err != nil {
-		return tx.Factory{}, err
-	}
-
+func (oc OracleClient) CreateTxFactory() tx.Factory {
 	// craete a transaction
-	txFactory := tx.Factory{}.
-		WithAccountRetriever(clientCtx.AccountRetriever).
+	return tx.Factory{}.
+		WithAccountRetriever(authtypes.AccountRetriever{}).
 		WithChainID(oc.ChainID).
-		WithTxConfig(clientCtx.TxConfig).
+		WithTxConfig(oc.Encoding.TxConfig).
 		WithGasAdjustment(oc.GasAdjustment).
 		WithGasPrices(oc.GasPrices).
-		WithKeybase(clientCtx.Keyring).
 		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT).
 		WithSimulateAndExecute(true)
-
-	return txFactory, nil
 }
@@ -0,0 +1,194 @@
+package client
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	tmjsonclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
+)
+
+const (
+	// maxEndpointScore is the health score of the nodes which didn't fail recently
+	maxEndpointScore = 10
+
+	// endpointFailurePenalty is removed from the node score on every failure
+	endpointFailurePenalty = 5
+
+	// endpointRecoveryInterval is the time it takes a failed node to recover a point
+	endpointRecoveryInterval = 30 * time.Second
+)
+
+type (
+	// Endpoint defines the Tendermint RPC and gRPC endpoints of a node
+	Endpoint struct {
+		TMRPC string
+		GRPC  string
+	}
+
+	// EndpointStatus defines the health of a node
+	EndpointStatus struct {
+		TMRPC   string `json:"tmrpc"`
+		GRPC    string `json:"grpc"`
+		Score   int    `json:"score"`
+		Current bool   `json:"current"`
+	}
+
+	// EndpointPool keeps the health score of the nodes the oracle client connects
+	// to. The score of a node is lowered when it fails and recovered over time and
+	// on success, the client fails over to the healthiest node when the current
+	// one fails.
+	EndpointPool struct {
+		logger zerolog.Logger
+
+		mtx       sync.Mutex
+		endpoints []*nodeEndpoint
+		current   int
+
+		// now is overridden by unit tests
+		now func() time.Time
+	}
+
+	// nodeEndpoint defines a node of the pool and its RPC client
+	nodeEndpoint struct {
+		Endpoint
+
+		client    tmrpcclient.Client
+		score     int
+		updatedAt time.Time
+	}
+)
+
+// NewEndpointPool creates a pool with the nodes, the first node is used until it fails
+func NewEndpointPool(logger zerolog.Logger, endpoints []Endpoint, rpcTimeout time.Duration) (*EndpointPool, error) {
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("at least one endpoint is required")
+	}
+
+	pool := &EndpointPool{
+		logger:    logger.With().Str("module", "endpoint_pool").Logger(),
+		endpoints: make([]*nodeEndpoint, 0, len(endpoints)),
+		now:       time.Now,
+	}
+	for _, endpoint := range endpoints {
+		// create a tendermint HTTP client
+		httpClient, err := tmjsonclient.DefaultHTTPClient(endpoint.TMRPC)
+		if err != nil {
+			return nil, err
+		}
+		httpClient.Timeout = rpcTimeout
+
+		// create a tendermint RPC client
+		tmRPC, err := rpchttp.NewWithClient(endpoint.TMRPC, httpClient)
+		if err != nil {
+			return nil, err
+		}
+
+		pool.endpoints = append(pool.endpoints, &nodeEndpoint{
+			Endpoint:  endpoint,
+			client:    tmRPC,
+			score:     maxEndpointScore,
+			updatedAt: pool.now(),
+		})
+	}
+
+	return pool, nil
+}
+
+// Current returns the index, the endpoints and the RPC client of the current node
+func (p *EndpointPool) Current() (int, Endpoint, tmrpcclient.Client) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	endpoint := p.endpoints[p.current]
+	return p.current, endpoint.Endpoint, endpoint.client
+}
+
+// ReportSuccess raises the score of the node
+func (p *EndpointPool) ReportSuccess(index int) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.updateScore(index, 1)
+}
+
+// ReportFailure lowers the score of the node, the pool fails over to the healthiest
+// node when the current one fails. It returns true if the current node changed.
+func (p *EndpointPool) ReportFailure(index int, err error) bool {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.updateScore(index, -endpointFailurePenalty)
+	if index != p.current {
+		return false
+	}
+
+	// the current node is kept when no other node is healthier
+	now := p.now()
+	best := p.current
+	for i, endpoint := range p.endpoints {
+		if endpoint.currentScore(now) > p.endpoints[best].currentScore(now) {
+			best = i
+		}
+	}
+	if best == p.current {
+		p.logger.Warn().Err(err).Str("endpoint", p.endpoints[index].TMRPC).Msg("node failed, no healthier node to fail over to")
+		return false
+	}
+
+	p.logger.Warn().
+		Err(err).
+		Str("endpoint", p.endpoints[index].TMRPC).
+		Str("new_endpoint", p.endpoints[best].TMRPC).
+		Msg("node failed, failing over to another node")
+	p.current = best
+	return true
+}
+
+// Status returns the health of the nodes
+func (p *EndpointPool) Status() []EndpointStatus {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	now := p.now()
+	statuses := make([]EndpointStatus, 0, len(p.endpoints))
+	for i, endpoint := range p.endpoints {
+		statuses = append(statuses, EndpointStatus{
+			TMRPC:   endpoint.TMRPC,
+			GRPC:    endpoint.GRPC,
+			Score:   endpoint.currentScore(now),
+			Current: i == p.current,
+		})
+	}
+	return statuses
+}
+
+// updateScore adds the delta to the score of the node
+func (p *EndpointPool) updateScore(index int, delta int) {
+	if index < 0 || index >= len(p.endpoints) {
+		return
+	}
+
+	now := p.now()
+	endpoint := p.endpoints[index]
+	endpoint.score = endpoint.currentScore(now) + delta
+	if endpoint.score > maxEndpointScore {
+		endpoint.score = maxEndpointScore
+	}
+	if endpoint.score < 0 {
+		endpoint.score = 0
+	}
+	endpoint.updatedAt = now
+}
+
+// currentScore returns the score of the node with the points recovered since its last update
+func (e *nodeEndpoint) currentScore(now time.Time) int {
+	score := e.score + int(now.Sub(e.updatedAt)/endpointRecoveryInterval)
+	if score > maxEndpointScore {
+		return maxEndpointScore
+	}
+	return score
+}
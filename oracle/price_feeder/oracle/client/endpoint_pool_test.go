@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// eventsClient reports the new block header events of a node, the height
+// increases on every query unless the node is stalled
+type eventsClient struct {
+	tmrpcclient.Client
+	height  int64
+	stalled bool
+}
+
+func (c *eventsClient) Events(_ context.Context, _ *coretypes.RequestEvents) (*coretypes.ResultEvents, error) {
+	height := atomic.LoadInt64(&c.height)
+	if !c.stalled {
+		height = atomic.AddInt64(&c.height, 1)
+	}
+
+	data := tmtypes.EventDataNewBlockHeader{Header: tmtypes.Header{Height: height}}
+	bz, err := json.Marshal(struct {
+		Type  string      `json:"type"`
+		Value interface{} `json:"value"`
+	}{data.TypeTag(), data})
+	if err != nil {
+		return nil, err
+	}
+
+	return &coretypes.ResultEvents{Items: []*coretypes.EventItem{{Data: bz}}}, nil
+}
+
+// newTestEndpointPool creates a pool with the nodes and a controllable clock
+func newTestEndpointPool(t *testing.T, nodes int) (*EndpointPool, *time.Time) {
+	endpoints := make([]Endpoint, nodes)
+	for i := range endpoints {
+		endpoints[i] = Endpoint{TMRPC: fmt.Sprintf("http://node%d:26657", i), GRPC: fmt.Sprintf("node%d:9090", i)}
+	}
+
+	pool, err := NewEndpointPool(zerolog.Nop(), endpoints, time.Second)
+	require.NoError(t, err)
+
+	now := time.Now()
+	pool.now = func() time.Time { return now }
+	for _, endpoint := range pool.endpoints {
+		endpoint.updatedAt = now
+	}
+	return pool, &now
+}
+
+func TestEndpointPoolFailover(t *testing.T) {
+	pool, now := newTestEndpointPool(t, 3)
+
+	index, endpoint, _ := pool.Current()
+	require.Equal(t, 0, index)
+	require.Equal(t, "node0:9090", endpoint.GRPC)
+
+	// the failures of the other nodes don't change the current node
+	require.False(t, pool.ReportFailure(1, fmt.Errorf("timeout")))
+	require.Equal(t, 0, pool.current)
+
+	// the current node fails over to the healthiest node
+	require.True(t, pool.ReportFailure(0, fmt.Errorf("timeout")))
+	index, endpoint, _ = pool.Current()
+	require.Equal(t, 2, index)
+	require.Equal(t, "http://node2:26657", endpoint.TMRPC)
+
+	// the node is kept when no other node is healthier
+	require.False(t, pool.ReportFailure(2, fmt.Errorf("timeout")))
+	require.Equal(t, 2, pool.current)
+
+	// the failed nodes recover over time and on success, up to the maximum score
+	*now = now.Add(3 * endpointRecoveryInterval)
+	pool.ReportSuccess(0)
+	pool.ReportSuccess(0)
+	pool.ReportSuccess(0)
+	require.Equal(t, []EndpointStatus{
+		{TMRPC: "http://node0:26657", GRPC: "node0:9090", Score: maxEndpointScore},
+		{TMRPC: "http://node1:26657", GRPC: "node1:9090", Score: 8},
+		{TMRPC: "http://node2:26657", GRPC: "node2:9090", Score: 8, Current: true},
+	}, pool.Status())
+
+	require.True(t, pool.ReportFailure(2, fmt.Errorf("timeout")))
+	require.Equal(t, 0, pool.current)
+
+	_, err := NewEndpointPool(zerolog.Nop(), nil, time.Second)
+	require.Error(t, err)
+}
+
+func TestHeightUpdaterFailover(t *testing.T) {
+	pool, _ := newTestEndpointPool(t, 2)
+	pool.now = time.Now
+	pool.endpoints[0].client = &eventsClient{height: 10, stalled: true}
+	pool.endpoints[1].client = &eventsClient{height: 10}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	heightUpdater := HeightUpdater{
+		Logger:        zerolog.Nop(),
+		LastHeight:    10,
+		ChBlockHeight: make(chan int64, 1),
+		Endpoints:     pool,
+		StallTimeout:  100 * time.Millisecond,
+	}
+	go heightUpdater.subscribe(ctx, zerolog.Nop())
+
+	// the heights are received from the fallback node once the main node stalls
+	select {
+	case height := <-heightUpdater.ChBlockHeight:
+		require.Greater(t, height, int64(10))
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "no block height received")
+	}
+
+	index, _, _ := pool.Current()
+	require.Equal(t, 1, index)
+}
+
+func TestAccountInfoUseEndpoint(t *testing.T) {
+	accountInfo := &AccountInfo{AccountSequence: 5, Endpoint: "http://node0:26657"}
+
+	accountInfo.UseEndpoint("http://node0:26657")
+	require.False(t, accountInfo.ShouldResetSequence)
+
+	// the sequence is obtained again from the new node
+	accountInfo.UseEndpoint("http://node1:26657")
+	require.True(t, accountInfo.ShouldResetSequence)
+	require.Equal(t, "http://node1:26657", accountInfo.Endpoint)
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeederAccount defines an account which broadcasts the oracle votes on
+// behalf of a validator
+type FeederAccount struct {
+	OracleAddr          sdk.AccAddress
+	OracleAddrString    string
+	ValidatorAddrString string
+	FeeGranterAddr      sdk.AccAddress
+
+	// AccountInfo keeps the local account sequence of the feeder
+	AccountInfo *AccountInfo
+}
+
+// NewFeederAccount creates a new instance of the FeederAccount
+func NewFeederAccount(oracleAddrString, validatorAddrString, feeGranterAddrString string) (FeederAccount, error) {
+	// get the account which performs the transaction
+	oracleAddr, err := sdk.AccAddressFromBech32(oracleAddrString)
+	if err != nil {
+		return FeederAccount{}, err
+	}
+
+	// validate the validator the account votes for
+	if _, err := sdk.ValAddressFromBech32(validatorAddrString); err != nil {
+		return FeederAccount{}, err
+	}
+
+	// get the account who will pay the gas
+	feegrantAddr, _ := sdk.AccAddressFromBech32(feeGranterAddrString)
+
+	return FeederAccount{
+		OracleAddr:          oracleAddr,
+		OracleAddrString:    oracleAddrString,
+		ValidatorAddrString: validatorAddrString,
+		FeeGranterAddr:      feegrantAddr,
+		AccountInfo:         NewAccountInfo(),
+	}, nil
+}
+
+// AccountClientContext returns a copy of the client context which signs and
+// pays the transactions with the feeder account
+func (oc OracleClient) AccountClientContext(clientCtx client.Context, account FeederAccount) client.Context {
+	return clientCtx.
+		WithFromAddress(account.OracleAddr).
+		WithFeeGranterAddress(account.FeeGranterAddr)
+}
+
+// accountInfo returns the local account sequence of the feeder account with
+// the given address
+func (oc OracleClient) accountInfo(addr sdk.AccAddress) *AccountInfo {
+	for _, account := range oc.Accounts {
+		if account.OracleAddr.Equals(addr) && account.AccountInfo != nil {
+			return account.AccountInfo
+		}
+	}
+
+	// the sequence of unknown accounts is always queried from the chain
+	return NewAccountInfo()
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -14,60 +15,72 @@ var (
 	started                  = false
 	queryEventNewBlockHeader = tmtypes.QueryForEvent(tmtypes.EventNewBlockHeaderValue) // event to be queried
 	queryInterval            = 20 * time.Millisecond                                   // time between query the latest new block event
+
+	errNodeStalled = errors.New("no new block received")
 )
 
 // HeightUpdater is used to provide the updates of the latest chain
-// It starts a goroutine to subscribe to new block event and send the latest block height to the channel
+// It starts a goroutine to subscribe to new block event and send the latest block height to the channel.
+// The events are queried from the current node of the endpoint pool, the node is reported as failed
+// when it doesn't report a new block within the stall timeout so the subscription fails over.
 type HeightUpdater struct {
 	Logger        zerolog.Logger
 	LastHeight    int64 // store the last processed block height
 	ChBlockHeight chan int64
+	Endpoints     *EndpointPool
+	StallTimeout  time.Duration
 }
 
-// Start starts the rpc client and subscribes to EventNewBlockHeader.
-func (heightUpdater HeightUpdater) Start(
-	ctx context.Context,
-	rpcClient tmrpcclient.Client,
-	logger zerolog.Logger,
-) error {
+// Start subscribes to EventNewBlockHeader.
+func (heightUpdater HeightUpdater) Start(ctx context.Context, logger zerolog.Logger) {
 	if !started {
-		// start rpc connection
-		err := rpcClient.Start(ctx)
-		if err != nil {
-			return err
-		}
-
 		// track the new block events generated and update the chain height
-		go heightUpdater.subscribe(ctx, rpcClient, logger)
+		go heightUpdater.subscribe(ctx, logger)
 		started = true
 	}
-	return nil
 }
 
 // subscribe listens to new blocks being made
 // and updates the chain height.
-func (heightUpdater HeightUpdater) subscribe(
-	ctx context.Context,
-	eventsClient tmrpcclient.EventsClient,
-	logger zerolog.Logger,
-) {
-	for {
+func (heightUpdater HeightUpdater) subscribe(ctx context.Context, logger zerolog.Logger) {
+	lastUpdate := time.Now()
+
+	for ctx.Err() == nil {
+		// the events are queried from the current node
+		index, endpoint, eventsClient := heightUpdater.Endpoints.Current()
+
+		// the node is considered stalled when it doesn't report new blocks
+		if time.Since(lastUpdate) > heightUpdater.StallTimeout {
+			logger.Warn().Str("endpoint", endpoint.TMRPC).Msg(fmt.Sprintf("No new block since height %d", heightUpdater.LastHeight))
+			heightUpdater.Endpoints.ReportFailure(index, errNodeStalled)
+			lastUpdate = time.Now()
+			continue
+		}
+
 		// wait until a EventNewBlockHeader event
-		eventData, err := tmrpcclient.WaitForOneEvent(ctx, eventsClient, queryEventNewBlockHeader.String())
+		eventCtx, cancel := context.WithTimeout(ctx, heightUpdater.StallTimeout)
+		eventData, err := tmrpcclient.WaitForOneEvent(eventCtx, eventsClient, queryEventNewBlockHeader.String())
+		cancel()
 		if err != nil {
 			logger.Debug().Err(err).Msg("Failed to query EventNewBlockHeader")
+			time.Sleep(queryInterval)
+			continue
 		}
 
 		// check if the event received is type EventDataNewBlockHeader
 		eventDataNewBlockHeader, ok := eventData.(tmtypes.EventDataNewBlockHeader)
 		if !ok {
-			logger.Err(err).Msg("Failed to parse event from eventDataNewBlockHeader")
+			logger.Error().Msg("Failed to parse event from eventDataNewBlockHeader")
+			time.Sleep(queryInterval)
 			continue
 		}
 
 		// extract the block height from the event
 		eventHeight := eventDataNewBlockHeader.Header.Height
 		if eventHeight > heightUpdater.LastHeight {
+			lastUpdate = time.Now()
+			heightUpdater.Endpoints.ReportSuccess(index)
+
 			logger.Info().Msg(fmt.Sprintf("Received new Chain Height: %d", eventHeight))
 			heightUpdater.LastHeight = eventHeight // update the height with the latest
 
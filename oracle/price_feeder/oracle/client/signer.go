@@ -0,0 +1,280 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// remote signer API paths
+	remoteSignerPubKeyPath = "/pubkey"
+	remoteSignerSignPath   = "/sign"
+
+	// maxRemoteSignerResponseSize limits the size of the remote signer responses
+	maxRemoteSignerResponseSize = 1 << 20
+)
+
+type (
+	// Signer defines the interface which signs the transactions of the feeder
+	// accounts, the keys can be held by the local keyring or by an external
+	// signing service so no key is kept on the price-feeder machine.
+	Signer interface {
+		// PubKey returns the public key of the feeder account
+		PubKey(addr sdk.AccAddress) (cryptotypes.PubKey, error)
+
+		// Sign signs the bytes with the key of the feeder account
+		Sign(addr sdk.AccAddress, signBytes []byte) ([]byte, error)
+	}
+
+	// KeyringSigner signs with the keys of a cosmos keyring
+	KeyringSigner struct {
+		keyring keyring.Keyring
+	}
+
+	// RemoteSigner signs with an external signing service through its HTTP API
+	RemoteSigner struct {
+		endpoint   string
+		httpClient *http.Client
+
+		mtx     sync.Mutex
+		pubKeys map[string]cryptotypes.PubKey
+	}
+
+	// FileSigner signs with the secp256k1 private keys read from a file, it is
+	// meant for testing and must not be used with funded accounts
+	FileSigner struct {
+		keys map[string]*secp256k1.PrivKey
+	}
+
+	// RemotePubKeyResponse defines the response of the remote signer public key endpoint
+	RemotePubKeyResponse struct {
+		PubKey []byte `json:"pub_key"`
+	}
+
+	// RemoteSignRequest defines the request of the remote signer sign endpoint
+	RemoteSignRequest struct {
+		Address   string `json:"address"`
+		SignBytes []byte `json:"sign_bytes"`
+	}
+
+	// RemoteSignResponse defines the response of the remote signer sign endpoint
+	RemoteSignResponse struct {
+		Signature []byte `json:"signature"`
+	}
+)
+
+var (
+	_ Signer = (*KeyringSigner)(nil)
+	_ Signer = (*RemoteSigner)(nil)
+	_ Signer = (*FileSigner)(nil)
+)
+
+// NewKeyringSigner creates a signer with the keys of the keyring, the password
+// is read from stdin when it is empty
+func NewKeyringSigner(backend, dir, pass string) (*KeyringSigner, error) {
+	// get keyring password from selected input
+	var keyringInput io.Reader
+	if len(pass) > 0 {
+		keyringInput = newPassReader(pass)
+	} else {
+		keyringInput = os.Stdin
+	}
+
+	kr, err := keyring.New("kiichain3", backend, dir, keyringInput)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyringSigner{keyring: kr}, nil
+}
+
+// PubKey implements the Signer interface
+func (s *KeyringSigner) PubKey(addr sdk.AccAddress) (cryptotypes.PubKey, error) {
+	keyInfo, err := s.keyring.KeyByAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	return keyInfo.GetPubKey(), nil
+}
+
+// Sign implements the Signer interface
+func (s *KeyringSigner) Sign(addr sdk.AccAddress, signBytes []byte) ([]byte, error) {
+	signature, _, err := s.keyring.SignByAddress(addr, signBytes)
+	return signature, err
+}
+
+// NewRemoteSigner creates a signer which requests the signatures to the signing
+// service on the endpoint, the TLS config is optional and allows to authenticate
+// the price-feeder with a client certificate
+func NewRemoteSigner(endpoint string, timeout time.Duration, tlsConfig *tls.Config) (*RemoteSigner, error) {
+	endpointURL, err := url.ParseRequestURI(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote signer endpoint: %w", err)
+	}
+	if endpointURL.Scheme != "http" && endpointURL.Scheme != "https" {
+		return nil, fmt.Errorf("invalid remote signer endpoint scheme: %s", endpointURL.Scheme)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &RemoteSigner{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		httpClient: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+		},
+		pubKeys: make(map[string]cryptotypes.PubKey),
+	}, nil
+}
+
+// PubKey implements the Signer interface, the public keys are cached once they
+// are returned by the signing service
+func (s *RemoteSigner) PubKey(addr sdk.AccAddress) (cryptotypes.PubKey, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if pubKey, ok := s.pubKeys[addr.String()]; ok {
+		return pubKey, nil
+	}
+
+	resp, err := s.httpClient.Get(s.endpoint + remoteSignerPubKeyPath + "?address=" + url.QueryEscape(addr.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to request the public key of %s: %w", addr, err)
+	}
+	defer resp.Body.Close()
+
+	var pubKeyResp RemotePubKeyResponse
+	if err := decodeRemoteSignerResponse(resp, &pubKeyResp); err != nil {
+		return nil, fmt.Errorf("failed to get the public key of %s: %w", addr, err)
+	}
+	if len(pubKeyResp.PubKey) != secp256k1.PubKeySize {
+		return nil, fmt.Errorf("invalid public key size of %s: %d", addr, len(pubKeyResp.PubKey))
+	}
+
+	// the signing service must hold the key of the feeder account
+	pubKey := &secp256k1.PubKey{Key: pubKeyResp.PubKey}
+	if !sdk.AccAddress(pubKey.Address()).Equals(addr) {
+		return nil, fmt.Errorf("the remote signer public key doesn't match the address %s", addr)
+	}
+
+	s.pubKeys[addr.String()] = pubKey
+	return pubKey, nil
+}
+
+// Sign implements the Signer interface, the signature returned by the signing
+// service is verified before being used
+func (s *RemoteSigner) Sign(addr sdk.AccAddress, signBytes []byte) ([]byte, error) {
+	pubKey, err := s.PubKey(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(RemoteSignRequest{Address: addr.String(), SignBytes: signBytes})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.httpClient.Post(s.endpoint+remoteSignerSignPath, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to request the signature of %s: %w", addr, err)
+	}
+	defer resp.Body.Close()
+
+	var signResp RemoteSignResponse
+	if err := decodeRemoteSignerResponse(resp, &signResp); err != nil {
+		return nil, fmt.Errorf("failed to sign with %s: %w", addr, err)
+	}
+	if !pubKey.VerifySignature(signBytes, signResp.Signature) {
+		return nil, fmt.Errorf("invalid remote signer signature for %s", addr)
+	}
+
+	return signResp.Signature, nil
+}
+
+// decodeRemoteSignerResponse decodes the JSON response of the signing service
+func decodeRemoteSignerResponse(resp *http.Response, v interface{}) error {
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxRemoteSignerResponseSize))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("remote signer returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return json.Unmarshal(body, v)
+}
+
+// NewFileSigner creates a signer with the hex encoded secp256k1 private keys
+// listed on the file, one per line. Empty lines and lines starting with # are
+// ignored.
+func NewFileSigner(path string) (*FileSigner, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open key file: %w", err)
+	}
+	defer file.Close()
+
+	signer := &FileSigner{keys: make(map[string]*secp256k1.PrivKey)}
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if len(text) == 0 || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		key, err := hex.DecodeString(text)
+		if err != nil || len(key) != secp256k1.PrivKeySize {
+			return nil, fmt.Errorf("invalid private key on line %d", line)
+		}
+		privKey := &secp256k1.PrivKey{Key: key}
+		signer.keys[sdk.AccAddress(privKey.PubKey().Address()).String()] = privKey
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return signer, nil
+}
+
+// PubKey implements the Signer interface
+func (s *FileSigner) PubKey(addr sdk.AccAddress) (cryptotypes.PubKey, error) {
+	privKey, err := s.privKey(addr)
+	if err != nil {
+		return nil, err
+	}
+	return privKey.PubKey(), nil
+}
+
+// Sign implements the Signer interface
+func (s *FileSigner) Sign(addr sdk.AccAddress, signBytes []byte) ([]byte, error) {
+	privKey, err := s.privKey(addr)
+	if err != nil {
+		return nil, err
+	}
+	return privKey.Sign(signBytes)
+}
+
+// privKey returns the private key of the address
+func (s *FileSigner) privKey(addr sdk.AccAddress) (*secp256k1.PrivKey, error) {
+	privKey, ok := s.keys[addr.String()]
+	if !ok {
+		return nil, fmt.Errorf("key not found for address %s", addr)
+	}
+	return privKey, nil
+}
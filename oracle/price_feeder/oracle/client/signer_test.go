@@ -0,0 +1,174 @@
+package client
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+// writeKeyFile writes the private keys to a file signer key file
+func writeKeyFile(t *testing.T, keys ...*secp256k1.PrivKey) string {
+	content := "# feeder keys\n\n"
+	for _, key := range keys {
+		content += hex.EncodeToString(key.Bytes()) + "\n"
+	}
+
+	path := filepath.Join(t.TempDir(), "keys")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+// newRemoteSignerServer serves the remote signer API with the keys of the file signer
+func newRemoteSignerServer(t *testing.T, signer *FileSigner) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc(remoteSignerPubKeyPath, func(w http.ResponseWriter, r *http.Request) {
+		addr, err := sdk.AccAddressFromBech32(r.URL.Query().Get("address"))
+		require.NoError(t, err)
+
+		pubKey, err := signer.PubKey(addr)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(RemotePubKeyResponse{PubKey: pubKey.Bytes()}))
+	})
+	mux.HandleFunc(remoteSignerSignPath, func(w http.ResponseWriter, r *http.Request) {
+		var req RemoteSignRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		addr, err := sdk.AccAddressFromBech32(req.Address)
+		require.NoError(t, err)
+
+		signature, err := signer.Sign(addr, req.SignBytes)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(RemoteSignResponse{Signature: signature}))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestFileSigner(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+
+	signer, err := NewFileSigner(writeKeyFile(t, privKey))
+	require.NoError(t, err)
+
+	pubKey, err := signer.PubKey(addr)
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().Equals(pubKey))
+
+	signature, err := signer.Sign(addr, []byte("sign bytes"))
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature([]byte("sign bytes"), signature))
+
+	// unknown accounts can't sign
+	_, err = signer.Sign(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), []byte("sign bytes"))
+	require.ErrorContains(t, err, "key not found")
+
+	// the keys must be hex encoded secp256k1 keys
+	path := filepath.Join(t.TempDir(), "keys")
+	require.NoError(t, os.WriteFile(path, []byte("\nnot a key\n"), 0o600))
+	_, err = NewFileSigner(path)
+	require.ErrorContains(t, err, "line 2")
+}
+
+func TestRemoteSigner(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+
+	fileSigner, err := NewFileSigner(writeKeyFile(t, privKey))
+	require.NoError(t, err)
+	server := newRemoteSignerServer(t, fileSigner)
+
+	signer, err := NewRemoteSigner(server.URL+"/", time.Second, nil)
+	require.NoError(t, err)
+
+	pubKey, err := signer.PubKey(addr)
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().Equals(pubKey))
+
+	signature, err := signer.Sign(addr, []byte("sign bytes"))
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature([]byte("sign bytes"), signature))
+
+	// the errors of the signing service are reported
+	_, err = signer.Sign(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), []byte("sign bytes"))
+	require.ErrorContains(t, err, "remote signer returned status 404")
+
+	_, err = NewRemoteSigner("signer:8080", time.Second, nil)
+	require.ErrorContains(t, err, "invalid remote signer endpoint scheme")
+}
+
+func TestRemoteSignerWrongKey(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	otherKey := secp256k1.GenPrivKey()
+
+	// the signing service returns the key of another account
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewEncoder(w).Encode(RemotePubKeyResponse{PubKey: otherKey.PubKey().Bytes()}))
+	}))
+	defer server.Close()
+
+	signer, err := NewRemoteSigner(server.URL, time.Second, nil)
+	require.NoError(t, err)
+
+	_, err = signer.Sign(addr, []byte("sign bytes"))
+	require.ErrorContains(t, err, "doesn't match the address")
+}
+
+func TestSignTx(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+
+	signer, err := NewFileSigner(writeKeyFile(t, privKey))
+	require.NoError(t, err)
+
+	oc := OracleClient{
+		ChainID:  "kiichain3",
+		Signer:   signer,
+		Encoding: simapp.MakeTestEncodingConfig(),
+	}
+	txf := tx.Factory{}.
+		WithChainID(oc.ChainID).
+		WithTxConfig(oc.Encoding.TxConfig).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT).
+		WithAccountNumber(7).
+		WithSequence(3)
+
+	txBuilder := oc.Encoding.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("ukii", 1)))))
+	require.NoError(t, oc.signTx(txf, addr, txBuilder))
+
+	// the signature is valid for the account number and sequence of the factory
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.Equal(t, uint64(3), sigs[0].Sequence)
+
+	signerData := authsigning.SignerData{ChainID: oc.ChainID, AccountNumber: 7, Sequence: 3}
+	handler := oc.Encoding.TxConfig.SignModeHandler()
+	require.NoError(t, authsigning.VerifySignature(privKey.PubKey(), signerData, sigs[0].Data, handler, txBuilder.GetTx()))
+
+	signerData.AccountNumber = 8
+	require.Error(t, authsigning.VerifySignature(privKey.PubKey(), signerData, sigs[0].Data, handler, txBuilder.GetTx()))
+}
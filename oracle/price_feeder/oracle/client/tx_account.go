@@ -13,6 +13,9 @@ type AccountInfo struct {
 	AccountNumber       uint64
 	AccountSequence     uint64
 	ShouldResetSequence bool
+
+	// Endpoint is the node the account sequence was obtained from
+	Endpoint string
 }
 
 // NewAccountInfo creates a new instance of AccountInfo
@@ -20,6 +23,15 @@ func NewAccountInfo() *AccountInfo {
 	return &AccountInfo{}
 }
 
+// UseEndpoint sets the node the account transactions are broadcasted to, the
+// account sequence is reset from the node when it differs from the previous one
+func (accountInfo *AccountInfo) UseEndpoint(endpoint string) {
+	if accountInfo.Endpoint != endpoint {
+		accountInfo.Endpoint = endpoint
+		accountInfo.ShouldResetSequence = true
+	}
+}
+
 // ObtainAccountInfo ensures the account defined by ctx.GetFromAddress() exists.
 // We keep a local copy of account sequence number and manually increment it.
 // If the local sequence number is 0, we will initialize it with the latest value getting from the chain.
@@ -2,6 +2,7 @@ package oracle
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/kiichain/kiichain/oracle/price_feeder/config"
@@ -12,28 +13,239 @@ import (
 	"github.com/rs/zerolog"
 )
 
-// getUSDBasedProviders retrieves which providers for an asset have a USD-based pair,
-// given the asset and the map of providers to currency pairs.
-func getUSDBasedProviders(asset string, providerPairs map[string][]types.CurrencyPair) (map[string]struct{}, error) {
-	conversionProviders := make(map[string]struct{})
+type (
+	// conversionPair defines the price of a pair aggregated over its providers,
+	// the pairs are the edges of the conversion graph
+	conversionPair struct {
+		types.CurrencyPair
 
-	for provider, pairs := range providerPairs {
+		price  sdk.Dec // price of the base in the quote
+		volume sdk.Dec // volume of the pair in base units
+	}
+
+	// conversionRate defines the USD rate of an asset converted through a path of pairs
+	conversionRate struct {
+		rate      sdk.Dec  // price of the asset in USD
+		liquidity sdk.Dec  // lowest USD volume of the pairs of the path
+		path      []string // assets of the path, from the asset to USD
+	}
+)
+
+// conversionsToUSD returns the pairs, with upper case assets, which the providers
+// have a price for and which aren't quoted in USD
+func conversionsToUSD(
+	providerPairs map[string][]types.CurrencyPair,
+	hasPrice func(providerName, base string) bool,
+) map[types.CurrencyPair]struct{} {
+	conversions := make(map[types.CurrencyPair]struct{})
+	for providerName, pairs := range providerPairs {
 		for _, pair := range pairs {
-			if strings.ToUpper(pair.Quote) == config.DenomUSD && strings.ToUpper(pair.Base) == asset {
-				conversionProviders[provider] = struct{}{}
+			conversion := types.CurrencyPair{Base: strings.ToUpper(pair.Base), Quote: strings.ToUpper(pair.Quote)}
+			if conversion.Quote != config.DenomUSD && hasPrice(providerName, pair.Base) {
+				conversions[conversion] = struct{}{}
 			}
 		}
 	}
-	if len(conversionProviders) == 0 {
-		return nil, fmt.Errorf("no providers have a usd conversion for this asset")
+	return conversions
+}
+
+// conversionGraphPairs returns the providers of the pairs which may convert the
+// quotes of the conversions to USD. The assets connected to a single other asset
+// can't be an intermediate step of a conversion, their pairs are pruned unless
+// the asset is one of the quotes.
+func conversionGraphPairs(
+	providerPairs map[string][]types.CurrencyPair,
+	conversions map[types.CurrencyPair]struct{},
+) map[types.CurrencyPair][]string {
+	quotes := make(map[string]struct{}, len(conversions))
+	for conversion := range conversions {
+		quotes[conversion.Quote] = struct{}{}
+	}
+
+	pairProviders := make(map[types.CurrencyPair][]string)
+	neighbors := make(map[string]map[string]struct{})
+	for providerName, pairs := range providerPairs {
+		for _, pair := range pairs {
+			base, quote := strings.ToUpper(pair.Base), strings.ToUpper(pair.Quote)
+			if base == quote {
+				continue
+			}
+			pairProviders[pair] = append(pairProviders[pair], providerName)
+
+			for _, edge := range [][2]string{{base, quote}, {quote, base}} {
+				if _, ok := neighbors[edge[0]]; !ok {
+					neighbors[edge[0]] = make(map[string]struct{})
+				}
+				neighbors[edge[0]][edge[1]] = struct{}{}
+			}
+		}
+	}
+
+	// prune the leaves until every remaining asset may be on a path
+	for pruned := true; pruned; {
+		pruned = false
+		for asset, assetNeighbors := range neighbors {
+			if _, ok := quotes[asset]; ok || asset == config.DenomUSD || len(assetNeighbors) > 1 {
+				continue
+			}
+			for neighbor := range assetNeighbors {
+				delete(neighbors[neighbor], asset)
+			}
+			delete(neighbors, asset)
+			pruned = true
+		}
+	}
+
+	for pair := range pairProviders {
+		_, baseOk := neighbors[strings.ToUpper(pair.Base)]
+		_, quoteOk := neighbors[strings.ToUpper(pair.Quote)]
+		if !baseOk || !quoteOk {
+			delete(pairProviders, pair)
+		}
+	}
+	return pairProviders
+}
+
+// findConversionRates finds the USD rate of every asset connected to USD by the
+// pairs, without going through the excluded asset. A pair converts its base to
+// its quote by its price and its quote to its base by the inverse price, the
+// path of an asset is the one with the highest liquidity, that is, the highest
+// lowest USD volume of its pairs. The paths are expanded from USD to the assets
+// in order of liquidity, the shortest path is preferred on equal liquidity.
+func findConversionRates(pairs []conversionPair, excluded string) map[string]conversionRate {
+	rates := map[string]conversionRate{
+		config.DenomUSD: {rate: sdk.OneDec(), path: []string{config.DenomUSD}},
+	}
+
+	for {
+		var (
+			bestAsset string
+			best      *conversionRate
+		)
+		for _, pair := range pairs {
+			if !pair.price.IsPositive() {
+				continue
+			}
+
+			base, quote := strings.ToUpper(pair.Base), strings.ToUpper(pair.Quote)
+			if base == excluded || quote == excluded {
+				continue
+			}
+			baseRate, baseOk := rates[base]
+			quoteRate, quoteOk := rates[quote]
+
+			var (
+				asset     string
+				candidate conversionRate
+			)
+			switch {
+			case quoteOk && !baseOk:
+				// the base is converted to the quote by the pair price
+				rate := pair.price.Mul(quoteRate.rate)
+				asset, candidate = base, quoteRate.extend(base, rate, pair.volume.Mul(rate))
+
+			case baseOk && !quoteOk:
+				// the quote is converted to the base by the inverse pair price
+				rate := baseRate.rate.Quo(pair.price)
+				asset, candidate = quote, baseRate.extend(quote, rate, pair.volume.Mul(baseRate.rate))
+
+			default:
+				continue
+			}
+
+			if best == nil || candidate.isBetter(asset, *best, bestAsset) {
+				bestAsset, best = asset, &candidate
+			}
+		}
+
+		if best == nil {
+			return rates
+		}
+		rates[bestAsset] = *best
+	}
+}
+
+// extend returns the rate of the asset converted to the asset of the rate by a
+// pair with the given USD volume
+func (r conversionRate) extend(asset string, rate, liquidity sdk.Dec) conversionRate {
+	// the USD rate has no pair to bound the liquidity
+	if len(r.path) > 1 && r.liquidity.LT(liquidity) {
+		liquidity = r.liquidity
+	}
+
+	return conversionRate{
+		rate:      rate,
+		liquidity: liquidity,
+		path:      append([]string{asset}, r.path...),
+	}
+}
+
+// isBetter returns true if the rate has a higher liquidity than the other rate,
+// the ties are broken by the path length and the assets for determinism
+func (r conversionRate) isBetter(asset string, other conversionRate, otherAsset string) bool {
+	switch {
+	case !r.liquidity.Equal(other.liquidity):
+		return r.liquidity.GT(other.liquidity)
+	case len(r.path) != len(other.path):
+		return len(r.path) < len(other.path)
+	case asset != otherAsset:
+		return asset < otherAsset
+	default:
+		return strings.Join(r.path, "/") < strings.Join(other.path, "/")
+	}
+}
+
+// conversionRatesToUSD returns the USD rates of the quotes of the conversions.
+// The quote of a pair is converted without going through its base, the price of
+// the base would only reflect the prices of the path otherwise, the pairs whose
+// quote is only converted through their base have no rate. It fails if a quote
+// has no path to USD.
+func conversionRatesToUSD(
+	logger zerolog.Logger,
+	pairs []conversionPair,
+	conversions map[types.CurrencyPair]struct{},
+) (map[types.CurrencyPair]sdk.Dec, error) {
+	sortedConversions := make([]types.CurrencyPair, 0, len(conversions))
+	for conversion := range conversions {
+		sortedConversions = append(sortedConversions, conversion)
 	}
+	sort.Slice(sortedConversions, func(i, j int) bool {
+		return sortedConversions[i].String() < sortedConversions[j].String()
+	})
+
+	allRates := findConversionRates(pairs, "")
+	ratesByBase := make(map[string]map[string]conversionRate)
+	conversionRates := make(map[types.CurrencyPair]sdk.Dec, len(conversions))
+	for _, conversion := range sortedConversions {
+		if _, ok := allRates[conversion.Quote]; !ok {
+			return nil, fmt.Errorf("there are no valid conversion rates for %s", conversion.Quote)
+		}
+		if _, ok := ratesByBase[conversion.Base]; !ok {
+			ratesByBase[conversion.Base] = findConversionRates(pairs, conversion.Base)
+		}
+
+		rate, ok := ratesByBase[conversion.Base][conversion.Quote]
+		if !ok {
+			logger.Debug().
+				Str("pair", conversion.String()).
+				Msg("the quote is only converted to USD through the base, skipping the pair prices")
+			continue
+		}
 
-	return conversionProviders, nil
+		logger.Debug().
+			Str("pair", conversion.String()).
+			Str("path", strings.Join(rate.path, "/")).
+			Str("rate", rate.rate.String()).
+			Msg("converting quote to USD")
+		conversionRates[conversion] = rate.rate
+	}
+	return conversionRates, nil
 }
 
 // ConvertCandlesToUSD converts any candles which are not quoted in USD
 // to USD by other price feeds. It will also filter out any candles not
-// within the deviation threshold set by the config.
+// within the deviation threshold set by the config. The quotes may be
+// converted through several pairs, see findConversionRates.
 //
 // Ref: https://github.com/umee-network/umee/blob/4348c3e433df8c37dd98a690e96fc275de609bc1/price-feeder/oracle/filter.go#L41
 func convertCandlesToUSD(
@@ -46,68 +258,74 @@ func convertCandlesToUSD(
 		return candles, nil
 	}
 
-	conversionRates := make(map[string]sdk.Dec)
-	requiredConversions := make(map[string]types.CurrencyPair)
-
-	for pairProviderName, pairs := range providerPairs {
-		for _, pair := range pairs {
-			if strings.ToUpper(pair.Quote) != config.DenomUSD {
-				// Get valid providers and use them to generate a USD-based price for this asset.
-				validProviders, err := getUSDBasedProviders(pair.Quote, providerPairs)
-				if err != nil {
-					return nil, err
-				}
-
-				// Find candles which we can use for conversion, and calculate the tvwap
-				// to find the conversion rate.
-				validCandleList := provider.AggregatedProviderCandles{}
-				for providerName, candleSet := range candles {
-					if _, ok := validProviders[providerName]; ok {
-						for base, candle := range candleSet {
-							if base == pair.Quote {
-								if _, ok := validCandleList[providerName]; !ok {
-									validCandleList[providerName] = make(map[string][]provider.CandlePrice)
-								}
-
-								validCandleList[providerName][base] = candle
-							}
-						}
-					}
-				}
+	conversions := conversionsToUSD(providerPairs, func(providerName, base string) bool {
+		_, ok := candles[providerName][base]
+		return ok
+	})
+	if len(conversions) == 0 {
+		return candles, nil
+	}
 
-				if len(validCandleList) == 0 {
-					return nil, fmt.Errorf("there are no valid conversion rates for %s", pair.Quote)
-				}
+	// compute the tvwap of the pairs of the conversion graph
+	pairs := []conversionPair{}
+	for pair, pairProviders := range conversionGraphPairs(providerPairs, conversions) {
+		validCandleList := provider.AggregatedProviderCandles{}
+		for _, providerName := range pairProviders {
+			if candle, ok := candles[providerName][pair.Base]; ok {
+				validCandleList[providerName] = map[string][]provider.CandlePrice{pair.Base: candle}
+			}
+		}
+		if len(validCandleList) == 0 {
+			continue
+		}
 
-				filteredCandles, err := FilterCandleDeviations(
-					logger,
-					validCandleList,
-					deviationThresholds,
-				)
-				if err != nil {
-					return nil, err
-				}
+		filteredCandles, err := FilterCandleDeviations(
+			logger,
+			validCandleList,
+			deviationThresholds,
+		)
+		if err != nil {
+			return nil, err
+		}
 
-				tvwap, err := ComputeTVWAP(filteredCandles)
-				if err != nil {
-					return nil, err
-				}
+		tvwap, err := ComputeTVWAP(filteredCandles)
+		if err != nil {
+			return nil, err
+		}
+		price, ok := tvwap[pair.Base]
+		if !ok {
+			continue
+		}
 
-				conversionRates[pair.Quote] = tvwap[pair.Quote]
-				requiredConversions[pairProviderName] = pair
+		volume := sdk.ZeroDec()
+		for _, candleSet := range filteredCandles {
+			for _, candle := range candleSet[pair.Base] {
+				volume = volume.Add(candle.Volume)
 			}
 		}
+		pairs = append(pairs, conversionPair{CurrencyPair: pair, price: price, volume: volume})
+	}
+
+	conversionRates, err := conversionRatesToUSD(logger, pairs, conversions)
+	if err != nil {
+		return nil, err
 	}
 
 	// Convert assets to USD.
-	for provider, assetMap := range candles {
-		for asset, assetCandles := range assetMap {
-			if requiredConversions[provider].Base == asset {
-				for i := range assetCandles {
-					assetCandles[i].Price = assetCandles[i].Price.Mul(
-						conversionRates[requiredConversions[provider].Quote],
-					)
-				}
+	for providerName, currencyPairs := range providerPairs {
+		for _, pair := range currencyPairs {
+			assetCandles, ok := candles[providerName][pair.Base]
+			if !ok || strings.ToUpper(pair.Quote) == config.DenomUSD {
+				continue
+			}
+
+			rate, ok := conversionRates[types.CurrencyPair{Base: strings.ToUpper(pair.Base), Quote: strings.ToUpper(pair.Quote)}]
+			if !ok {
+				delete(candles[providerName], pair.Base)
+				continue
+			}
+			for i := range assetCandles {
+				assetCandles[i].Price = assetCandles[i].Price.Mul(rate)
 			}
 		}
 	}
@@ -117,7 +335,8 @@ func convertCandlesToUSD(
 
 // convertTickersToUSD converts any tickers which are not quoted in USD to USD,
 // using the conversion rates of other tickers. It will also filter out any tickers
-// not within the deviation threshold set by the config.
+// not within the deviation threshold set by the config. The quotes may be
+// converted through several pairs, see findConversionRates.
 //
 // Ref: https://github.com/umee-network/umee/blob/4348c3e433df8c37dd98a690e96fc275de609bc1/price-feeder/oracle/filter.go#L41
 func convertTickersToUSD(
@@ -130,70 +349,73 @@ func convertTickersToUSD(
 		return tickers, nil
 	}
 
-	conversionRates := make(map[string]sdk.Dec)
-	requiredConversions := make(map[string]types.CurrencyPair)
-
-	for pairProviderName, pairs := range providerPairs {
-		for _, pair := range pairs {
-			if strings.ToUpper(pair.Quote) != config.DenomUSD {
-				// Get valid providers and use them to generate a USD-based price for this asset.
-				validProviders, err := getUSDBasedProviders(pair.Quote, providerPairs)
-				if err != nil {
-					return nil, err
-				}
-
-				// Find valid candles, and then let's re-compute the tvwap.
-				validTickerList := provider.AggregatedProviderPrices{}
-				for providerName, candleSet := range tickers {
-					// Find tickers which we can use for conversion, and calculate the vwap
-					// to find the conversion rate.
-					if _, ok := validProviders[providerName]; ok {
-						for base, ticker := range candleSet {
-							if base == pair.Quote {
-								if _, ok := validTickerList[providerName]; !ok {
-									validTickerList[providerName] = make(map[string]provider.TickerPrice)
-								}
-
-								validTickerList[providerName][base] = ticker
-							}
-						}
-					}
-				}
+	conversions := conversionsToUSD(providerPairs, func(providerName, base string) bool {
+		_, ok := tickers[providerName][base]
+		return ok
+	})
+	if len(conversions) == 0 {
+		return tickers, nil
+	}
 
-				if len(validTickerList) == 0 {
-					return nil, fmt.Errorf("there are no valid conversion rates for %s", pair.Quote)
-				}
+	// compute the vwap of the pairs of the conversion graph
+	pairs := []conversionPair{}
+	for pair, pairProviders := range conversionGraphPairs(providerPairs, conversions) {
+		validTickerList := provider.AggregatedProviderPrices{}
+		for _, providerName := range pairProviders {
+			if ticker, ok := tickers[providerName][pair.Base]; ok {
+				validTickerList[providerName] = map[string]provider.TickerPrice{pair.Base: ticker}
+			}
+		}
+		if len(validTickerList) == 0 {
+			continue
+		}
 
-				filteredTickers, err := FilterTickerDeviations(
-					logger,
-					validTickerList,
-					deviationThresholds,
-				)
-				if err != nil {
-					return nil, err
-				}
+		filteredTickers, err := FilterTickerDeviations(
+			logger,
+			validTickerList,
+			deviationThresholds,
+		)
+		if err != nil {
+			return nil, err
+		}
 
-				vwap, err := ComputeVWAP(filteredTickers)
-				if err != nil {
-					return nil, err
-				}
+		vwap, err := ComputeVWAP(filteredTickers)
+		if err != nil {
+			return nil, err
+		}
+		price, ok := vwap[pair.Base]
+		if !ok {
+			continue
+		}
 
-				conversionRates[pair.Quote] = vwap[pair.Quote]
-				requiredConversions[pairProviderName] = pair
-			}
+		volume := sdk.ZeroDec()
+		for _, tickerSet := range filteredTickers {
+			volume = volume.Add(tickerSet[pair.Base].Volume)
 		}
+		pairs = append(pairs, conversionPair{CurrencyPair: pair, price: price, volume: volume})
+	}
+
+	conversionRates, err := conversionRatesToUSD(logger, pairs, conversions)
+	if err != nil {
+		return nil, err
 	}
 
 	// Convert assets to USD.
-	for providerName, assetMap := range tickers {
-		for asset := range assetMap {
-			if requiredConversions[providerName].Base == asset {
-				assetMap[asset] = provider.TickerPrice{
-					Price: assetMap[asset].Price.Mul(
-						conversionRates[requiredConversions[providerName].Quote],
-					),
-					Volume: assetMap[asset].Volume,
-				}
+	for providerName, currencyPairs := range providerPairs {
+		for _, pair := range currencyPairs {
+			ticker, ok := tickers[providerName][pair.Base]
+			if !ok || strings.ToUpper(pair.Quote) == config.DenomUSD {
+				continue
+			}
+
+			rate, ok := conversionRates[types.CurrencyPair{Base: strings.ToUpper(pair.Base), Quote: strings.ToUpper(pair.Quote)}]
+			if !ok {
+				delete(tickers[providerName], pair.Base)
+				continue
+			}
+			tickers[providerName][pair.Base] = provider.TickerPrice{
+				Price:  ticker.Price.Mul(rate),
+				Volume: ticker.Volume,
 			}
 		}
 	}
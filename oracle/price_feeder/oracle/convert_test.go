@@ -29,52 +29,6 @@ var (
 	}
 )
 
-func TestGetUSDBasedProviders(t *testing.T) {
-	providerPairs := make(map[string][]types.CurrencyPair, 3)
-	providerPairs["coinbase"] = []types.CurrencyPair{
-		{
-			Base:  "FOO",
-			Quote: "USD",
-		},
-	}
-	providerPairs["huobi"] = []types.CurrencyPair{
-		{
-			Base:  "FOO",
-			Quote: "USD",
-		},
-	}
-	providerPairs["kraken"] = []types.CurrencyPair{
-		{
-			Base:  "FOO",
-			Quote: "USDT",
-		},
-	}
-	providerPairs["binance"] = []types.CurrencyPair{
-		{
-			Base:  "USDT",
-			Quote: "USD",
-		},
-	}
-
-	pairs, err := getUSDBasedProviders("FOO", providerPairs)
-	require.NoError(t, err)
-	expectedPairs := map[string]struct{}{
-		"coinbase": {},
-		"huobi":    {},
-	}
-	require.Equal(t, pairs, expectedPairs)
-
-	pairs, err = getUSDBasedProviders("USDT", providerPairs)
-	require.NoError(t, err)
-	expectedPairs = map[string]struct{}{
-		"binance": {},
-	}
-	require.Equal(t, pairs, expectedPairs)
-
-	pairs, err = getUSDBasedProviders("BAR", providerPairs)
-	require.Error(t, err)
-}
-
 func TestConvertCandlesToUSD(t *testing.T) {
 	providerCandles := make(provider.AggregatedProviderCandles, 2)
 
@@ -269,3 +223,113 @@ func TestConvertTickersToUSDFiltering(t *testing.T) {
 		covertedDeviation["binance"]["ATOM"].Price,
 	)
 }
+
+func TestFindConversionRates(t *testing.T) {
+	newPair := func(base, quote, price, volume string) conversionPair {
+		return conversionPair{
+			CurrencyPair: types.CurrencyPair{Base: base, Quote: quote},
+			price:        sdk.MustNewDecFromStr(price),
+			volume:       sdk.MustNewDecFromStr(volume),
+		}
+	}
+
+	// USDT/COP and USDT/USD convert COP through USDT, MXN is converted through EUR
+	pairs := []conversionPair{
+		newPair("USDT", "COP", "4000", "1000000"),
+		newPair("USDT", "USD", "1", "10000000"),
+		newPair("MXN", "EUR", "0.05", "20000000000"),
+		newPair("EUR", "USD", "1.1", "1000000000"),
+	}
+	rates := findConversionRates(pairs, "")
+	require.Equal(t, sdk.MustNewDecFromStr("0.00025"), rates["COP"].rate)
+	require.Equal(t, []string{"COP", "USDT", "USD"}, rates["COP"].path)
+	require.Equal(t, sdk.MustNewDecFromStr("0.055"), rates["MXN"].rate)
+	require.Equal(t, []string{"MXN", "EUR", "USD"}, rates["MXN"].path)
+
+	// the liquid COP/USD FX rate is preferred over the USDT market
+	pairs = append(pairs, newPair("COP", "USD", "0.00026", "4000000000000"))
+	rates = findConversionRates(pairs, "")
+	require.Equal(t, sdk.MustNewDecFromStr("0.00026"), rates["COP"].rate)
+	require.Equal(t, []string{"COP", "USD"}, rates["COP"].path)
+
+	// a thin direct pair loses to a liquid path of several pairs
+	pairs = append(pairs, newPair("MXN", "USD", "0.06", "100"))
+	rates = findConversionRates(pairs, "")
+	require.Equal(t, []string{"MXN", "EUR", "USD"}, rates["MXN"].path)
+
+	// the paths don't go through the excluded asset
+	rates = findConversionRates(pairs, "EUR")
+	require.Equal(t, sdk.MustNewDecFromStr("0.06"), rates["MXN"].rate)
+	require.NotContains(t, rates, "EUR")
+}
+
+func TestConvertTickersToUSDMultiHop(t *testing.T) {
+	btcCOPPair := types.CurrencyPair{Base: "BTC", Quote: "COP"}
+	usdtCOPPair := types.CurrencyPair{Base: "USDT", Quote: "COP"}
+
+	providerPrices := provider.AggregatedProviderPrices{
+		"buda": {
+			"BTC":  {Price: sdk.MustNewDecFromStr("200000000"), Volume: sdk.MustNewDecFromStr("10")},
+			"USDT": {Price: sdk.MustNewDecFromStr("4000"), Volume: usdtVolume},
+		},
+		config.ProviderKraken: {
+			"USDT": {Price: usdtPrice, Volume: usdtVolume},
+		},
+	}
+	providerPairs := map[string][]types.CurrencyPair{
+		"buda":                {btcCOPPair, usdtCOPPair},
+		config.ProviderKraken: {usdtPair},
+	}
+
+	// BTC/COP is converted through USDT/COP and USDT/USD, USDT/COP can't be
+	// converted through USDT itself and is skipped
+	convertedTickers, err := convertTickersToUSD(
+		zerolog.Nop(),
+		providerPrices,
+		providerPairs,
+		make(map[string]sdk.Dec),
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		sdk.MustNewDecFromStr("200000000").Quo(sdk.MustNewDecFromStr("4000")).Mul(usdtPrice),
+		convertedTickers["buda"]["BTC"].Price,
+	)
+	require.NotContains(t, convertedTickers["buda"], "USDT")
+	require.Equal(t, usdtPrice, convertedTickers[config.ProviderKraken]["USDT"].Price)
+
+	// the quotes without path fail the conversion
+	delete(providerPrices, config.ProviderKraken)
+	_, err = convertTickersToUSD(
+		zerolog.Nop(),
+		providerPrices,
+		providerPairs,
+		make(map[string]sdk.Dec),
+	)
+	require.ErrorContains(t, err, "there are no valid conversion rates for COP")
+}
+
+func TestConversionGraphPairs(t *testing.T) {
+	atomUSDPair := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	copUSDPair := types.CurrencyPair{Base: "COP", Quote: "USD"}
+	btcCOPPair := types.CurrencyPair{Base: "BTC", Quote: "COP"}
+
+	providerPairs := map[string][]types.CurrencyPair{
+		config.ProviderBinance:     {atomPair, atomUSDPair},
+		config.ProviderKraken:      {usdtPair},
+		config.ProviderFrankfurter: {copUSDPair},
+		"buda":                     {btcCOPPair},
+	}
+
+	// BTC is only connected to COP, it can't be on the path of COP
+	pairs := conversionGraphPairs(providerPairs, map[types.CurrencyPair]struct{}{
+		{Base: "BTC", Quote: "COP"}:   {},
+		{Base: "ATOM", Quote: "USDT"}: {},
+	})
+	require.Equal(t, map[types.CurrencyPair][]string{
+		atomPair:    {config.ProviderBinance},
+		atomUSDPair: {config.ProviderBinance},
+		usdtPair:    {config.ProviderKraken},
+		copUSDPair:  {config.ProviderFrankfurter},
+	}, pairs)
+}
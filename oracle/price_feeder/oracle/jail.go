@@ -34,29 +34,30 @@ func (jailCache *JailCache) IsOutdated(currentBlockHeight int64) bool {
 	return (currentBlockHeight - jailCache.lastUpdatedBlock) > jailCacheIntervalBlocks
 }
 
-// GetCachedJailedState
-func (o *Oracle) GetCachedJailedState(ctx context.Context, currentBlockHeight int64) (bool, error) {
+// GetCachedJailedState returns the jailing state of the voter's validator, refreshing
+// the voter's cache when it is outdated
+func (o *Oracle) GetCachedJailedState(ctx context.Context, v *voter, currentBlockHeight int64) (bool, error) {
 	// check if the cached info is outdated (if no, return the cached data)
-	if !o.jailCache.IsOutdated(currentBlockHeight) {
-		return o.jailCache.isJailed, nil
+	if !v.jailCache.IsOutdated(currentBlockHeight) {
+		return v.jailCache.isJailed, nil
 	}
 
 	// if the cached data is outdated fetch the validator's info
-	isJailed, err := o.GetJailedState(ctx)
+	isJailed, err := o.GetJailedState(ctx, v.account.ValidatorAddrString)
 	if err != nil {
 		return false, err
 	}
 
 	// update the cached info
-	o.jailCache.Update(currentBlockHeight, isJailed)
+	v.jailCache.Update(currentBlockHeight, isJailed)
 	return isJailed, nil
 }
 
 // GetJailedState returns the current on-chain jailing state of the validator
-func (o *Oracle) GetJailedState(ctx context.Context) (bool, error) {
+func (o *Oracle) GetJailedState(ctx context.Context, validatorAddr string) (bool, error) {
 	// create grpc connection with the blockchain
 	grpcConn, err := grpc.Dial(
-		o.oracleClient.GRPCEndpoint,
+		o.oracleClient.GRPCEndpoint(),
 		// the Cosmos SDK doesn't support any transport security mechanism
 		grpc.WithInsecure(),
 		grpc.WithContextDialer(dialerFunc),
@@ -76,7 +77,7 @@ func (o *Oracle) GetJailedState(ctx context.Context) (bool, error) {
 	defer cancel() // cancel context when the function ends
 
 	// query the validator information
-	queryResponse, err := queryClient.Validator(ctx, &stakingtypes.QueryValidatorRequest{ValidatorAddr: o.oracleClient.ValidatorAddrString})
+	queryResponse, err := queryClient.Validator(ctx, &stakingtypes.QueryValidatorRequest{ValidatorAddr: validatorAddr})
 	if err != nil {
 		return false, fmt.Errorf("failed to get staking validator: %w", err)
 	}
@@ -2,7 +2,10 @@ package oracle
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"math"
 	"net/http"
@@ -32,26 +35,66 @@ type Oracle struct {
 	logger zerolog.Logger
 	closer *closer.Closer
 
-	providerTimeout    time.Duration
-	providerPairs      map[string][]types.CurrencyPair
-	chainDenomMapping  map[string]string // map with the chain-denom by base name
-	previousVotePeriod float64
-	priceProviders     map[string]provider.Provider
-	failedProviders    map[string]error
-	oracleClient       client.OracleClient
-	deviations         map[string]sdk.Dec
-	endpoints          map[string]config.ProviderEndpoint
+	providerTimeout   time.Duration
+	providerPairs     map[string][]types.CurrencyPair
+	chainDenomMapping map[string]string // map with the chain-denom by base name
+	aggregations      map[string]string // map with the aggregation strategy by base name
+	priceProviders    map[string]provider.Provider
+	providerCancels   map[string]context.CancelFunc // stop the running providers
+	failedProviders   map[string]error
+	oracleClient      client.OracleClient
+	voters            []*voter // the validators the feeder votes for
+	deviations        map[string]sdk.Dec
+	endpoints         map[string]config.ProviderEndpoint
+
+	// tickMtx prevents reloading the configuration during a tick
+	tickMtx sync.Mutex
 
 	// variables store and handle the prices
 	mtx             sync.RWMutex
 	lastPriceSyncTS time.Time
-	prices          map[string]sdk.Dec // map with the prices to be requested
+	prices          map[string]sdk.Dec     // map with the prices to be requested
+	priceReports    map[string]PriceReport // map with the providers prices by base
 	paramCache      ParamCache
-	jailCache       JailCache
-	healthchecks    map[string]http.Client
+	healthchecks    map[string]healthcheckClient
+	recorder        *Recorder                       // records the market data when set
 	mockSetPrices   func(ctx context.Context) error // used for testing
 }
 
+// PreviousPrevote stores the data used to build the last prevote hash,
+// it is revealed with the vote submitted on the next vote period
+type PreviousPrevote struct {
+	ExchangeRates    string
+	Salt             string
+	SubmitVotePeriod float64
+}
+
+// voter keeps the voting state of a validator whose votes are broadcasted
+// by one of the feeder accounts
+type voter struct {
+	account            client.FeederAccount
+	previousVotePeriod float64
+	previousPrevote    *PreviousPrevote
+	jailCache          JailCache
+	lastVote           *VoteStatus
+}
+
+// healthcheckClient pings an endpoint on successful votes, it only follows the votes
+// of the validator when one is set
+type healthcheckClient struct {
+	httpClient http.Client
+	validator  string
+}
+
+// newVoters creates a voter for every feeder account
+func newVoters(accounts []client.FeederAccount) []*voter {
+	voters := make([]*voter, 0, len(accounts))
+	for _, account := range accounts {
+		voters = append(voters, &voter{account: account})
+	}
+	return voters
+}
+
 // createMappingsFromPairs is a helper function to initialize maps from currencyPairs
 // this is used to by test cases to initialize the oracle client
 func createMappingsFromPairs(currencyPairs []config.CurrencyPair) (map[string]string, map[string][]types.CurrencyPair) {
@@ -78,6 +121,18 @@ func createMappingsFromPairs(currencyPairs []config.CurrencyPair) (map[string]st
 	return chainDenomMapping, providerPairs
 }
 
+// createAggregationsFromPairs returns the aggregation strategy of the currencies
+// which don't use the default (T)VWAP
+func createAggregationsFromPairs(currencyPairs []config.CurrencyPair) map[string]string {
+	aggregations := make(map[string]string)
+	for _, pair := range currencyPairs {
+		if _, ok := AggregationStrategies[pair.Aggregation]; ok {
+			aggregations[pair.Base] = pair.Aggregation
+		}
+	}
+	return aggregations
+}
+
 // New creates a new instance of the Oracle struct and
 // extract the currencie pairs per denom
 func New(
@@ -93,7 +148,7 @@ func New(
 	chainDenomMapping, providerPairs := createMappingsFromPairs(currencyPairs)
 
 	// iterate over the health list and check their health
-	healthchecks := make(map[string]http.Client)
+	healthchecks := make(map[string]healthcheckClient)
 	for _, healthcheck := range healthchecksConfig {
 		// get the timeout per provider
 		timeout, err := time.ParseDuration(healthcheck.Timeout)
@@ -102,8 +157,9 @@ func New(
 		if err != nil {
 			logger.Warn().Str("timeout", healthcheck.Timeout).Msg("failed to parse healthcheck timeout, skipping configuration")
 		} else {
-			healthchecks[healthcheck.URL] = http.Client{
-				Timeout: timeout,
+			healthchecks[healthcheck.URL] = healthcheckClient{
+				httpClient: http.Client{Timeout: timeout},
+				validator:  healthcheck.Validator,
 			}
 		}
 	}
@@ -112,13 +168,15 @@ func New(
 		logger:            logger.With().Str("module", "oracle").Logger(),
 		closer:            closer.NewCloser(), // create closer flag
 		oracleClient:      oc,
+		voters:            newVoters(oc.Accounts),
 		providerPairs:     providerPairs,
 		chainDenomMapping: chainDenomMapping,
+		aggregations:      createAggregationsFromPairs(currencyPairs),
 		priceProviders:    make(map[string]provider.Provider),
+		providerCancels:   make(map[string]context.CancelFunc),
 		providerTimeout:   providerTimeout,
 		deviations:        deviations,
 		paramCache:        ParamCache{},
-		jailCache:         JailCache{},
 		failedProviders:   make(map[string]error),
 		endpoints:         endpoints,
 		healthchecks:      healthchecks,
@@ -128,10 +186,7 @@ func New(
 // Start starts the oracle process in a blocking fashion.
 func (o *Oracle) Start(ctx context.Context) error {
 	// create cosmos client context
-	clientCtx, err := o.oracleClient.CreateClientContext()
-	if err != nil {
-		return err
-	}
+	clientCtx := o.oracleClient.CreateClientContext()
 
 	var previousBlockHeight int64
 
@@ -149,7 +204,7 @@ func (o *Oracle) Start(ctx context.Context) error {
 
 			startTime := time.Now()
 
-			err = o.tick(ctx, clientCtx, currBlockHeight)
+			err := o.tick(ctx, clientCtx, currBlockHeight)
 			if err != nil {
 				telemetry.IncrCounter(1, "failure", "tick")
 				o.logger.Warn().Msg(fmt.Sprintf("Oracle tick failed for height %d, err: %s", currBlockHeight, err.Error()))
@@ -241,6 +296,10 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 	providerPrices := make(provider.AggregatedProviderPrices)
 	providerCandles := make(provider.AggregatedProviderCandles)
 	requiredRates := make(map[string]struct{})
+	record := MarketDataRecord{
+		Timestamp: time.Now().UTC(),
+		Providers: make(map[string]ProviderMarketData),
+	}
 
 	//iterate over the pairs by provider
 	for providerName, currencyPairs := range o.providerPairs {
@@ -302,6 +361,7 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 			//
 			// e.g.: {ProviderKraken: {"ATOM": <price, volume>, ...}}
 			mtx.Lock()
+			record.Providers[providerName] = ProviderMarketData{Tickers: prices, Candles: candles}
 			for _, pair := range currencyPairs {
 				success := SetProviderTickerPricesAndCandles(providerName, providerPrices, providerCandles, prices, candles, pair)
 				if !success {
@@ -327,12 +387,20 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 		o.logger.Error().Err(err).Msg("set-prices errgroup returned an error")
 	}
 
-	computedPrices, err := GetComputedPrices(
+	// the recording failures don't stop the votes
+	if o.recorder != nil {
+		if err := o.recorder.Record(record); err != nil {
+			o.logger.Warn().Err(err).Msg("failed to record market data")
+		}
+	}
+
+	computedPrices, reports, err := computePrices(
 		o.logger,
 		providerCandles,
 		providerPrices,
 		o.providerPairs,
 		o.deviations,
+		o.aggregations,
 		requiredRates,
 	)
 	if err != nil {
@@ -345,22 +413,56 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 		}
 	}
 
+	for base, report := range reports {
+		report.ChainDenom = o.chainDenomMapping[base]
+		reports[base] = report
+	}
+
+	o.mtx.Lock()
 	o.prices = computedPrices
+	o.priceReports = reports
+	o.mtx.Unlock()
+
 	return nil
 }
 
 // GetComputedPrices gets the candle and ticker prices and computes it.
 // It returns candles' TVWAP if possible, if not possible (not available
 // or due to some staleness) it will use the most recent ticker prices
-// and the VWAP formula instead.
+// and the VWAP formula instead. The assets with an aggregation strategy
+// are computed by the strategy instead of the (T)VWAP.
 func GetComputedPrices(
 	logger zerolog.Logger,
 	providerCandles provider.AggregatedProviderCandles,
 	providerPrices provider.AggregatedProviderPrices,
 	providerPairs map[string][]types.CurrencyPair,
 	deviations map[string]sdk.Dec,
+	aggregations map[string]string,
 	requiredRates map[string]struct{},
 ) (prices map[string]sdk.Dec, err error) {
+	prices, _, err = computePrices(
+		logger,
+		providerCandles,
+		providerPrices,
+		providerPairs,
+		deviations,
+		aggregations,
+		requiredRates,
+	)
+	return prices, err
+}
+
+// computePrices computes the prices as described by GetComputedPrices, along
+// with the report of each price containing the prices of its providers.
+func computePrices(
+	logger zerolog.Logger,
+	providerCandles provider.AggregatedProviderCandles,
+	providerPrices provider.AggregatedProviderPrices,
+	providerPairs map[string][]types.CurrencyPair,
+	deviations map[string]sdk.Dec,
+	aggregations map[string]string,
+	requiredRates map[string]struct{},
+) (map[string]sdk.Dec, map[string]PriceReport, error) {
 	// only do asset provider map logic is log level is debug
 	if logger.GetLevel() == zerolog.DebugLevel {
 		assetProviderMap := make(map[string][]string)
@@ -371,7 +473,7 @@ func GetComputedPrices(
 		}
 		assetProviderJSON, err := json.Marshal(assetProviderMap)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		logger.Debug().Msg(fmt.Sprintf("Asset Provider Coverage Map: %s", string(assetProviderJSON)))
 
@@ -383,7 +485,7 @@ func GetComputedPrices(
 		}
 		candleProviderJSON, err := json.Marshal(candleProviderMap)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		logger.Debug().Msg(fmt.Sprintf("Candle Provider Coverage Map: %s", string(candleProviderJSON)))
 	}
@@ -395,23 +497,50 @@ func GetComputedPrices(
 		deviations,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// filter out any erroneous candles
 	filteredCandles, err := FilterCandleDeviations(
 		logger,
-		convertedCandles,
+		withoutAggregatedCandles(convertedCandles, aggregations),
 		deviations,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// attempt to use candles for TVWAP calculations
 	computedPrices, err := ComputeTVWAP(filteredCandles)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	// the assets with an aggregation strategy use the candles of every provider
+	candlePoints, err := candlePricePoints(convertedCandles, aggregatedBases(aggregations))
+	if err != nil {
+		return nil, nil, err
+	}
+	aggregatedPrices, err := ComputeAggregatedPrices(candlePoints, aggregations, deviations)
+	if err != nil {
+		return nil, nil, err
+	}
+	for base, price := range aggregatedPrices {
+		computedPrices[base] = price
+	}
+
+	// report the candle prices of every provider
+	allCandlePoints, err := candlePricePoints(convertedCandles, allBases)
+	if err != nil {
+		return nil, nil, err
+	}
+	candleBreakdown := providerPriceBreakdown(allCandlePoints, func(providerName, base string) bool {
+		_, ok := filteredCandles[providerName][base]
+		return !ok && !hasAggregationStrategy(aggregations, base)
+	})
+	reports := make(map[string]PriceReport, len(computedPrices))
+	for base, price := range computedPrices {
+		reports[base] = newPriceReport(base, price, PriceSourceCandle, aggregations, candleBreakdown)
 	}
 
 	candleAssets := []string{}
@@ -436,32 +565,51 @@ func GetComputedPrices(
 			deviations,
 		)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		filteredProviderPrices, err := FilterTickerDeviations(
 			logger,
-			convertedTickers,
+			withoutAggregatedTickers(convertedTickers, aggregations),
 			deviations,
 		)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		vwapPrices, err := ComputeVWAP(filteredProviderPrices)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+
+		aggregatedPrices, err := ComputeAggregatedPrices(
+			tickerPricePoints(convertedTickers, aggregatedBases(aggregations)),
+			aggregations,
+			deviations,
+		)
+		if err != nil {
+			return nil, nil, err
 		}
+		for base, price := range aggregatedPrices {
+			vwapPrices[base] = price
+		}
+
+		// report the ticker prices of every provider
+		tickerBreakdown := providerPriceBreakdown(tickerPricePoints(convertedTickers, allBases), func(providerName, base string) bool {
+			_, ok := filteredProviderPrices[providerName][base]
+			return !ok && !hasAggregationStrategy(aggregations, base)
+		})
 
 		for asset, price := range vwapPrices {
 			if _, ok := computedPrices[asset]; !ok {
 				tickerAssets = append(tickerAssets, asset)
 				computedPrices[asset] = price
+				reports[asset] = newPriceReport(asset, price, PriceSourceTicker, aggregations, tickerBreakdown)
 			}
 		}
 	}
 	logger.Debug().Msg(fmt.Sprint("Assets using Candle TVWAP: ", candleAssets, " Assets using Ticker VWAP: ", tickerAssets))
-	return computedPrices, nil
+	return computedPrices, reports, nil
 }
 
 // SetProviderTickerPricesAndCandles flattens and collects prices for
@@ -508,20 +656,29 @@ func (o *Oracle) getOrSetProvider(ctx context.Context, providerName string) (pro
 
 	priceProvider, ok = o.priceProviders[providerName]
 	if !ok {
+		// the provider is stopped by canceling its context
+		providerCtx, cancel := context.WithCancel(ctx)
 		newProvider, err := NewProvider(
-			ctx,
+			providerCtx,
 			providerName,
 			o.logger,
 			o.endpoints[providerName],
 			o.providerPairs[providerName]...,
 		)
+
+		// the providers are read by the status endpoints
+		o.mtx.Lock()
+		defer o.mtx.Unlock()
+
 		if err != nil {
+			cancel()
 			o.failedProviders[providerName] = err
 			return nil, err
 		}
 		priceProvider = newProvider
 
 		o.priceProviders[providerName] = priceProvider
+		o.providerCancels[providerName] = cancel
 	}
 
 	return priceProvider, nil
@@ -535,6 +692,11 @@ func NewProvider(
 	endpoint config.ProviderEndpoint,
 	providerPairs ...types.CurrencyPair,
 ) (provider.Provider, error) {
+	// the generic providers are named by the operator
+	if endpoint.Type == config.ProviderTypeGeneric {
+		return provider.NewGenericProvider(ctx, logger, endpoint, providerPairs...)
+	}
+
 	switch providerName {
 	case config.ProviderBinance:
 		return provider.NewBinanceProvider(ctx, logger, endpoint, providerPairs...)
@@ -560,8 +722,14 @@ func NewProvider(
 	case config.ProviderGate:
 		return provider.NewGateProvider(ctx, logger, endpoint, providerPairs...)
 
+	case config.ProviderDex:
+		return provider.NewDexProvider(ctx, logger, endpoint, providerPairs...)
+
 	case config.ProviderMock:
 		return provider.NewMockProvider(), nil
+
+	case config.ProviderFrankfurter, config.ProviderExchangeRate, config.ProviderCurrencyAPI, config.ProviderFloatRates:
+		return provider.NewFXProvider(ctx, providerName, logger, endpoint, providerPairs...)
 	}
 
 	return nil, fmt.Errorf("provider %s not found", providerName)
@@ -587,6 +755,10 @@ func (o *Oracle) tick(
 	clientCtx sdkclient.Context,
 	blockHeight int64) error {
 
+	// the configuration is reloaded between ticks
+	o.tickMtx.Lock()
+	defer o.tickMtx.Unlock()
+
 	startTime := time.Now().UTC()
 
 	o.logger.Debug().Msg(fmt.Sprintf("executing oracle tick for height %d", blockHeight))
@@ -596,15 +768,27 @@ func (o *Oracle) tick(
 		return fmt.Errorf("expected positive block height")
 	}
 
-	// get the cached data regarding validator's jail status (updated within a period of 50 blocks)
-	isJailed, err := o.GetCachedJailedState(ctx, blockHeight)
-	if err != nil {
-		return err
+	// select the validators able to vote, the jailing state is cached within a period of 50 blocks
+	var errs []error
+	activeVoters := make([]*voter, 0, len(o.voters))
+	for _, v := range o.voters {
+		isJailed, err := o.GetCachedJailedState(ctx, v, blockHeight)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		// if validator is jailed, don't vote
+		if isJailed {
+			errs = append(errs, fmt.Errorf("validator %s is jailed", v.account.ValidatorAddrString))
+			continue
+		}
+		activeVoters = append(activeVoters, v)
 	}
 
-	// if validator is jailed, don't vote
-	if isJailed {
-		return fmt.Errorf("validator %s is jailed", o.oracleClient.ValidatorAddrString)
+	// avoid fetching the prices when no validator can vote
+	if len(activeVoters) == 0 {
+		return joinErrors(errs)
 	}
 
 	// get the cached oracle module's params
@@ -613,13 +797,15 @@ func (o *Oracle) tick(
 		return err
 	}
 
-	// get exchange rates //TODO: Check
+	// get exchange rates, they are fetched once and shared by all the validators
 	err = o.SetPrices(ctx)
 	if err != nil {
 		return err
 	}
 
+	o.mtx.Lock()
 	o.lastPriceSyncTS = time.Now() // update the date when the prices was updated
+	o.mtx.Unlock()
 
 	// Get oracle vote period, next block height, current vote period, and index
 	// in the vote period.
@@ -627,75 +813,148 @@ func (o *Oracle) tick(
 	nextBlockHeight := blockHeight + 1
 	currentVotePeriod := math.Floor(float64(nextBlockHeight) / float64(oracleVotePeriod))
 
+	// get prices
+	prices := o.GetPrices()
+
+	// filter for whitelisted denominations so that extra oracle prices are not penalized
+	filteredPrices := filterPricesByDenomList(prices, oracleParams.Whitelist)
+
+	// convert rates to string (sorted string)
+	exchangeRatesStr := GenerateExchangeRatesString(filteredPrices)
+
+	o.logger.Debug().
+		Str("exchange_rates", GenerateExchangeRatesString(prices)).
+		Msg("pre-filtered prices")
+
+	// broadcast the votes of every validator
+	broadcasted := make(map[string]bool)
+	for _, v := range activeVoters {
+		voted, err := o.vote(clientCtx, v, oracleVotePeriod, currentVotePeriod, exchangeRatesStr, startTime, blockHeight)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if voted {
+			broadcasted[v.account.ValidatorAddrString] = true
+		}
+	}
+
+	// validate the health endpoints
+	o.healthchecksPing(broadcasted, len(errs) == 0)
+
+	return joinErrors(errs)
+}
+
+// vote broadcasts the prevote of the current vote period for the voter's validator,
+// the prevote of the previous vote period is revealed on the same transaction.
+// It returns false when the validator has already voted on the current vote period
+func (o *Oracle) vote(
+	clientCtx sdkclient.Context,
+	v *voter,
+	oracleVotePeriod int64,
+	currentVotePeriod float64,
+	exchangeRatesStr string,
+	startTime time.Time,
+	blockHeight int64) (bool, error) {
+
 	// Skip until new voting period. Specifically, skip when:
 	// index [0, oracleVotePeriod - 1] > oracleVotePeriod - 2 OR index is 0
-	if currentVotePeriod == o.previousVotePeriod {
+	if currentVotePeriod == v.previousVotePeriod {
 		o.logger.Info().
+			Str("validator", v.account.ValidatorAddrString).
 			Int64("vote_period", oracleVotePeriod).
-			Float64("previous", o.previousVotePeriod).
+			Float64("previous", v.previousVotePeriod).
 			Float64("current", currentVotePeriod).
 			Int64("tick_duration", time.Since(startTime).Milliseconds()).
 			Msg("skipping until next voting period")
-		return nil
+		return false, nil
 	}
 
 	// get validator address
-	valAddr, err := sdk.ValAddressFromBech32(o.oracleClient.ValidatorAddrString)
+	valAddr, err := sdk.ValAddressFromBech32(v.account.ValidatorAddrString)
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	// get prices
-	prices := o.GetPrices()
+	// generate the salt to hide the exchange rates on the prevote
+	salt, err := GenerateSalt(32)
+	if err != nil {
+		return false, err
+	}
 
-	// filter for whitelisted denominations so that extra oracle prices are not penalized
-	filteredPrices := filterPricesByDenomList(prices, oracleParams.Whitelist)
+	// prepare the prevote message for the current vote period
+	voteHash := oracletypes.GetAggregateVoteHash(salt, exchangeRatesStr, valAddr)
+	prevoteMsg := &oracletypes.MsgAggregateExchangeRatePrevote{
+		Hash:      voteHash.String(),
+		Feeder:    v.account.OracleAddrString,
+		Validator: valAddr.String(),
+	}
 
-	// convert rates to string (sorted string)
-	exchangeRatesStr := GenerateExchangeRatesString(filteredPrices)
+	// the prevote from the previous vote period is revealed on the same transaction
+	msgs := []sdk.Msg{}
+	if v.previousPrevote != nil && v.previousPrevote.SubmitVotePeriod == currentVotePeriod-1 {
+		// prepate voting message
+		voteMsg := &oracletypes.MsgAggregateExchangeRateVote{
+			Salt:          v.previousPrevote.Salt,
+			ExchangeRates: v.previousPrevote.ExchangeRates,
+			Feeder:        v.account.OracleAddrString,
+			Validator:     valAddr.String(),
+		}
+		msgs = append(msgs, voteMsg)
 
-	// prepate voting message
-	voteMsg := &oracletypes.MsgAggregateExchangeRateVote{
-		ExchangeRates: exchangeRatesStr,
-		Feeder:        o.oracleClient.OracleAddrString,
-		Validator:     valAddr.String(),
+		o.logger.Info().
+			Str("exchange_rates", voteMsg.ExchangeRates).
+			Str("validator", voteMsg.Validator).
+			Str("feeder", voteMsg.Feeder).
+			Float64("vote_period", currentVotePeriod).
+			Int64("tick_duration", time.Since(startTime).Milliseconds()).
+			Msg("Going to broadcast vote")
 	}
-
-	o.logger.Debug().
-		Str("exchange_rates", GenerateExchangeRatesString(prices)).
-		Msg("pre-filtered prices")
+	msgs = append(msgs, prevoteMsg)
 
 	o.logger.Info().
-		Str("exchange_rates", voteMsg.ExchangeRates).
-		Str("validator", voteMsg.Validator).
-		Str("feeder", voteMsg.Feeder).
+		Str("hash", prevoteMsg.Hash).
+		Str("validator", prevoteMsg.Validator).
+		Str("feeder", prevoteMsg.Feeder).
 		Float64("vote_period", currentVotePeriod).
 		Int64("tick_duration", time.Since(startTime).Milliseconds()).
-		Msg("Going to broadcast vote")
+		Msg("Going to broadcast prevote")
 
-	// broadcast transaction
-	resp, err := o.oracleClient.BroadcastTx(clientCtx, voteMsg)
+	// broadcast transaction signed by the validator's feeder account
+	resp, err := o.oracleClient.BroadcastTx(o.oracleClient.AccountClientContext(clientCtx, v.account), msgs...)
+	o.setLastVote(v, newVoteStatus(v, currentVotePeriod, blockHeight, resp, err))
 	if err != nil {
 		o.logResponseError(err, resp, startTime, blockHeight)
 		telemetry.IncrCounter(1, "failure", "broadcast")
-		return err
+		return false, err
 	}
 
 	o.logger.Info().
 		Str("status", "success").
+		Str("validator", prevoteMsg.Validator).
 		Uint32("response_code", resp.Code).
 		Str("tx_hash", resp.TxHash).
 		Int64("tick_duration", time.Since(startTime).Milliseconds()).
 		Msg(fmt.Sprintf("broadcasted for height %d", blockHeight))
 	telemetry.IncrCounter(1, "success", "broadcast")
 
-	// update the vote period voted
-	o.previousVotePeriod = currentVotePeriod
+	// update the vote period voted and keep the prevote data to be revealed on the next vote period
+	v.previousVotePeriod = currentVotePeriod
+	v.previousPrevote = &PreviousPrevote{
+		ExchangeRates:    exchangeRatesStr,
+		Salt:             salt,
+		SubmitVotePeriod: currentVotePeriod,
+	}
 
-	// validate the health endpoints
-	o.healthchecksPing()
+	return true, nil
+}
 
-	return nil
+// joinErrors returns the single error as it is or the errors joined together
+func joinErrors(errs []error) error {
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return stderrors.Join(errs...)
 }
 
 // logResponseError print a log message when the an error has occurred
@@ -718,16 +977,26 @@ func (o *Oracle) logResponseError(err error, resp *sdk.TxResponse, startTime tim
 		Msg(fmt.Sprintf("broadcasted for height %d", blockHeight))
 }
 
-// healthchecksPing validates the health endpoints work
-func (o *Oracle) healthchecksPing() {
+// healthchecksPing pings the health endpoints of the validators whose votes were
+// broadcasted, the endpoints without validator are pinged when a vote was broadcasted
+// and every validator succeeded
+func (o *Oracle) healthchecksPing(broadcasted map[string]bool, succeeded bool) {
 	// iterate over the health check endpoints listed
 	for url, client := range o.healthchecks {
-		o.logger.Info().Msg("updating healthcheck status")
+		if len(client.validator) > 0 && !broadcasted[client.validator] {
+			continue
+		}
+		if len(client.validator) == 0 && (len(broadcasted) == 0 || !succeeded) {
+			continue
+		}
+
+		o.logger.Info().Str("validator", client.validator).Msg("updating healthcheck status")
 
 		// check health endpoint
-		response, err := client.Get(url)
+		response, err := client.httpClient.Get(url)
 		if err != nil {
 			o.logger.Warn().Msg("healthcheck ping failed")
+			continue
 		}
 
 		// close http response
@@ -735,6 +1004,21 @@ func (o *Oracle) healthchecksPing() {
 	}
 }
 
+// GenerateSalt generates a random hex salt of the given length in bytes,
+// used to hide the exchange rates on the prevote hash
+func GenerateSalt(length int) (string, error) {
+	if length == 0 {
+		return "", fmt.Errorf("failed to generate salt: zero length")
+	}
+
+	bytes := make([]byte, length)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bytes), nil
+}
+
 // GenerateExchangeRatesString generates a canonical string representation of
 // the aggregated exchange rates.
 func GenerateExchangeRatesString(prices sdk.DecCoins) string {
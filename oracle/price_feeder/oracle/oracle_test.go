@@ -3,6 +3,8 @@ package oracle
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"sync"
 	"testing"
 	"time"
@@ -560,12 +562,14 @@ func TestTickScenarios(t *testing.T) {
 		whitelist          oracletypes.DenomList
 		blockHeight        int64
 		previousVotePeriod float64
+		previousPrevote    *PreviousPrevote
 		votePeriod         uint64
 		mockBroadcastErr   error
 
 		// expectations
-		expectedVoteMsg *oracletypes.MsgAggregateExchangeRateVote
-		expectedErr     error
+		expectedPrevoteRates string
+		expectedVoteMsg      *oracletypes.MsgAggregateExchangeRateVote
+		expectedErr          error
 	}{
 		{
 			name:               "Filtered prices, should broadcast all entries, none filtered",
@@ -583,13 +587,63 @@ func TestTickScenarios(t *testing.T) {
 				"BTC":  sdk.MustNewDecFromStr("2.2"),
 				"ETH":  sdk.MustNewDecFromStr("3.3"),
 			},
-			whitelist: denomList("uusdt", "ubtc", "ueth"),
+			whitelist:            denomList("uusdt", "ubtc", "ueth"),
+			expectedPrevoteRates: "2.200000000000000000ubtc,3.300000000000000000ueth,1.100000000000000000uusdt",
+		},
+		{
+			name:               "Previous prevote, should reveal the vote and broadcast a new prevote",
+			isJailed:           false,
+			blockHeight:        1,
+			previousVotePeriod: 1,
+			previousPrevote: &PreviousPrevote{
+				ExchangeRates:    "2.000000000000000000ubtc,3.000000000000000000ueth,1.000000000000000000uusdt",
+				Salt:             "abcd",
+				SubmitVotePeriod: 1,
+			},
+			votePeriod: 1,
+			pairs: []config.CurrencyPair{
+				{Base: "USDT", ChainDenom: "uusdt", Quote: "USD"},
+				{Base: "BTC", ChainDenom: "ubtc", Quote: "USD"},
+				{Base: "ETH", ChainDenom: "ueth", Quote: "USD"},
+			},
+			prices: map[string]sdk.Dec{
+				"USDT": sdk.MustNewDecFromStr("1.1"),
+				"BTC":  sdk.MustNewDecFromStr("2.2"),
+				"ETH":  sdk.MustNewDecFromStr("3.3"),
+			},
+			whitelist:            denomList("uusdt", "ubtc", "ueth"),
+			expectedPrevoteRates: "2.200000000000000000ubtc,3.300000000000000000ueth,1.100000000000000000uusdt",
 			expectedVoteMsg: &oracletypes.MsgAggregateExchangeRateVote{
-				ExchangeRates: "2.200000000000000000ubtc,3.300000000000000000ueth,1.100000000000000000uusdt",
+				Salt:          "abcd",
+				ExchangeRates: "2.000000000000000000ubtc,3.000000000000000000ueth,1.000000000000000000uusdt",
 				Feeder:        feederAddr,
 				Validator:     validatorAddr,
 			},
 		},
+		{
+			name:               "Expired previous prevote, should only broadcast a new prevote",
+			isJailed:           false,
+			blockHeight:        1,
+			previousVotePeriod: 0,
+			previousPrevote: &PreviousPrevote{
+				ExchangeRates:    "2.000000000000000000ubtc,3.000000000000000000ueth,1.000000000000000000uusdt",
+				Salt:             "abcd",
+				SubmitVotePeriod: 0,
+			},
+			votePeriod: 1,
+			pairs: []config.CurrencyPair{
+				{Base: "USDT", ChainDenom: "uusdt", Quote: "USD"},
+				{Base: "BTC", ChainDenom: "ubtc", Quote: "USD"},
+				{Base: "ETH", ChainDenom: "ueth", Quote: "USD"},
+			},
+			prices: map[string]sdk.Dec{
+				"USDT": sdk.MustNewDecFromStr("1.1"),
+				"BTC":  sdk.MustNewDecFromStr("2.2"),
+				"ETH":  sdk.MustNewDecFromStr("3.3"),
+			},
+			whitelist:            denomList("uusdt", "ubtc", "ueth"),
+			expectedPrevoteRates: "2.200000000000000000ubtc,3.300000000000000000ueth,1.100000000000000000uusdt",
+		},
 		{
 			name:               "Filtered prices, should broadcast only whitelisted entries",
 			isJailed:           false,
@@ -606,12 +660,8 @@ func TestTickScenarios(t *testing.T) {
 				"BTC":   sdk.MustNewDecFromStr("2.2"),
 				"OTHER": sdk.MustNewDecFromStr("3.3"),
 			},
-			whitelist: denomList("uusdt", "ubtc"),
-			expectedVoteMsg: &oracletypes.MsgAggregateExchangeRateVote{
-				ExchangeRates: "2.200000000000000000ubtc,1.100000000000000000uusdt", // does not include uother
-				Feeder:        feederAddr,
-				Validator:     validatorAddr,
-			},
+			whitelist:            denomList("uusdt", "ubtc"),
+			expectedPrevoteRates: "2.200000000000000000ubtc,1.100000000000000000uusdt", // does not include uother
 		},
 		{
 			name:               "Should not crash if broadcast returns nil response with error",
@@ -629,14 +679,10 @@ func TestTickScenarios(t *testing.T) {
 				"BTC":  sdk.MustNewDecFromStr("2.2"),
 				"ETH":  sdk.MustNewDecFromStr("3.3"),
 			},
-			whitelist: denomList("uusdt", "ubtc", "ueth"),
-			expectedVoteMsg: &oracletypes.MsgAggregateExchangeRateVote{
-				ExchangeRates: "2.200000000000000000ubtc,3.300000000000000000ueth,1.100000000000000000uusdt",
-				Feeder:        feederAddr,
-				Validator:     validatorAddr,
-			},
-			mockBroadcastErr: fmt.Errorf("test error"),
-			expectedErr:      fmt.Errorf("test error"),
+			whitelist:            denomList("uusdt", "ubtc", "ueth"),
+			expectedPrevoteRates: "2.200000000000000000ubtc,3.300000000000000000ueth,1.100000000000000000uusdt",
+			mockBroadcastErr:     fmt.Errorf("test error"),
+			expectedErr:          fmt.Errorf("test error"),
 		},
 		{
 			name:               "Same voting period should avoid broadcasting without error",
@@ -645,7 +691,6 @@ func TestTickScenarios(t *testing.T) {
 			previousVotePeriod: 1,
 			votePeriod:         2,
 			expectedErr:        nil,
-			expectedVoteMsg:    nil,
 		},
 		{
 			name:        "Jailed should return error",
@@ -668,18 +713,28 @@ func TestTickScenarios(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			var setPriceCount int
 			var broadcastCount int
+			var prevoteHash string
 			// Create the oracle instance
 			oracle := &Oracle{
-				jailCache: JailCache{
-					isJailed: test.isJailed,
+				voters: []*voter{
+					{
+						account: client.FeederAccount{
+							OracleAddrString:    feederAddr,
+							ValidatorAddrString: validatorAddr,
+						},
+						jailCache: JailCache{
+							isJailed: test.isJailed,
+						},
+						previousVotePeriod: test.previousVotePeriod,
+						previousPrevote:    test.previousPrevote,
+					},
 				},
 				mockSetPrices: func(ctx context.Context) error {
 					setPriceCount++
 					return nil
 				},
-				previousVotePeriod: test.previousVotePeriod,
-				chainDenomMapping:  cdm,
-				prices:             test.prices,
+				chainDenomMapping: cdm,
+				prices:            test.prices,
 				paramCache: ParamCache{
 					params: &oracletypes.Params{
 						Whitelist:  test.whitelist,
@@ -687,20 +742,32 @@ func TestTickScenarios(t *testing.T) {
 					},
 				},
 				oracleClient: client.OracleClient{
-					OracleAddrString:    feederAddr,
-					ValidatorAddrString: validatorAddr,
 					MockBroadcastTx: func(ctx sdkclient.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
-						// Assert that there's only one message
-						require.Equal(t, 1, len(msgs))
-
-						// Extract the message of type MsgAggregateExchangeRateVote
-						voteMsg, ok := msgs[0].(*oracletypes.MsgAggregateExchangeRateVote)
-						require.True(t, ok, "Expected message type *oracletypes.MsgAggregateExchangeRateVote")
+						// Assert the vote is only revealed when expected
+						expectedMsgs := 1
+						if test.expectedVoteMsg != nil {
+							expectedMsgs = 2
+						}
+						require.Equal(t, expectedMsgs, len(msgs))
+
+						if test.expectedVoteMsg != nil {
+							// Extract the message of type MsgAggregateExchangeRateVote
+							voteMsg, ok := msgs[0].(*oracletypes.MsgAggregateExchangeRateVote)
+							require.True(t, ok, "Expected message type *oracletypes.MsgAggregateExchangeRateVote")
+
+							// Assert the expected values in the voteMsg
+							require.Equal(t, test.expectedVoteMsg.Salt, voteMsg.Salt, test.name)
+							require.Equal(t, test.expectedVoteMsg.ExchangeRates, voteMsg.ExchangeRates, test.name)
+							require.Equal(t, test.expectedVoteMsg.Feeder, voteMsg.Feeder, test.name)
+							require.Equal(t, test.expectedVoteMsg.Validator, voteMsg.Validator, test.name)
+						}
 
-						// Assert the expected values in the voteMsg
-						require.Equal(t, test.expectedVoteMsg.ExchangeRates, voteMsg.ExchangeRates, test.name)
-						require.Equal(t, test.expectedVoteMsg.Feeder, voteMsg.Feeder, test.name)
-						require.Equal(t, test.expectedVoteMsg.Validator, voteMsg.Validator, test.name)
+						// Extract the message of type MsgAggregateExchangeRatePrevote
+						prevoteMsg, ok := msgs[len(msgs)-1].(*oracletypes.MsgAggregateExchangeRatePrevote)
+						require.True(t, ok, "Expected message type *oracletypes.MsgAggregateExchangeRatePrevote")
+						require.Equal(t, feederAddr, prevoteMsg.Feeder, test.name)
+						require.Equal(t, validatorAddr, prevoteMsg.Validator, test.name)
+						prevoteHash = prevoteMsg.Hash
 
 						broadcastCount++
 
@@ -721,19 +788,134 @@ func TestTickScenarios(t *testing.T) {
 			} else {
 				require.NoError(t, err, test.name)
 			}
-			if test.expectedVoteMsg != nil {
+			if test.expectedPrevoteRates != "" {
 				// ensure functions were actually called
 				require.Equal(t, 1, broadcastCount, test.name)
 				require.Equal(t, 1, setPriceCount, test.name)
 			}
-			if test.expectedVoteMsg == nil {
+			if test.expectedPrevoteRates == "" {
 				// should not call broadcast
 				require.Equal(t, 0, broadcastCount, test.name)
 			}
+			if test.expectedPrevoteRates != "" && test.expectedErr == nil {
+				// the prevote data is kept to be revealed on the next vote period
+				previousPrevote := oracle.voters[0].previousPrevote
+				require.Equal(t, test.expectedPrevoteRates, previousPrevote.ExchangeRates, test.name)
+				valAddr, err := sdk.ValAddressFromBech32(validatorAddr)
+				require.NoError(t, err)
+				expectedHash := oracletypes.GetAggregateVoteHash(previousPrevote.Salt, test.expectedPrevoteRates, valAddr)
+				require.Equal(t, expectedHash.String(), prevoteHash, test.name)
+			}
 		})
 	}
 }
 
+func TestTickMultipleValidators(t *testing.T) {
+	// generate a feeder account per validator
+	accounts := make([]client.FeederAccount, 3)
+	for i := range accounts {
+		accounts[i] = client.FeederAccount{
+			OracleAddrString:    generateAcctAddr(),
+			ValidatorAddrString: generateValidatorAddr(),
+		}
+	}
+
+	pairs := []config.CurrencyPair{
+		{Base: "BTC", ChainDenom: "ubtc", Quote: "USD"},
+		{Base: "ETH", ChainDenom: "ueth", Quote: "USD"},
+	}
+	cdm, _ := createMappingsFromPairs(pairs)
+	expectedRates := "2.200000000000000000ubtc,3.300000000000000000ueth"
+
+	// a healthcheck follows each validator, the last one follows all of them
+	var pingsMtx sync.Mutex
+	pings := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pingsMtx.Lock()
+		defer pingsMtx.Unlock()
+		pings[r.URL.Path]++
+	}))
+	defer server.Close()
+	healthchecks := map[string]healthcheckClient{server.URL + "/all": {}}
+	for i, account := range accounts {
+		healthchecks[fmt.Sprintf("%s/%d", server.URL, i)] = healthcheckClient{validator: account.ValidatorAddrString}
+	}
+
+	var setPriceCount int
+	broadcasts := make(map[string]string) // validator by feeder
+	oracle := &Oracle{
+		voters:       newVoters(accounts),
+		healthchecks: healthchecks,
+		mockSetPrices: func(ctx context.Context) error {
+			setPriceCount++
+			return nil
+		},
+		chainDenomMapping: cdm,
+		prices: map[string]sdk.Dec{
+			"BTC": sdk.MustNewDecFromStr("2.2"),
+			"ETH": sdk.MustNewDecFromStr("3.3"),
+		},
+		paramCache: ParamCache{
+			params: &oracletypes.Params{
+				Whitelist:  denomList("ubtc", "ueth"),
+				VotePeriod: 1,
+			},
+		},
+		oracleClient: client.OracleClient{
+			MockBroadcastTx: func(ctx sdkclient.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+				require.Len(t, msgs, 1)
+				prevoteMsg, ok := msgs[0].(*oracletypes.MsgAggregateExchangeRatePrevote)
+				require.True(t, ok)
+
+				// the last validator fails to broadcast
+				if prevoteMsg.Validator == accounts[2].ValidatorAddrString {
+					return nil, fmt.Errorf("test error")
+				}
+
+				broadcasts[prevoteMsg.Feeder] = prevoteMsg.Validator
+				return &sdk.TxResponse{TxHash: "0xhash"}, nil
+			},
+		},
+	}
+	oracle.voters[1].jailCache.isJailed = true
+
+	// the jailed and failing validators are reported, the other one votes
+	err := oracle.tick(context.Background(), sdkclient.Context{}, 1)
+	require.ErrorContains(t, err, fmt.Sprintf("validator %s is jailed", accounts[1].ValidatorAddrString))
+	require.ErrorContains(t, err, "test error")
+
+	// the prices are fetched once for all the validators
+	require.Equal(t, 1, setPriceCount)
+	require.Equal(t, map[string]string{accounts[0].OracleAddrString: accounts[0].ValidatorAddrString}, broadcasts)
+
+	// only the healthcheck of the broadcasted vote is pinged
+	require.Equal(t, map[string]int{"/0": 1}, pings)
+
+	// only the broadcasted vote is kept to be revealed
+	require.Equal(t, expectedRates, oracle.voters[0].previousPrevote.ExchangeRates)
+	require.Equal(t, float64(2), oracle.voters[0].previousVotePeriod)
+	require.Nil(t, oracle.voters[1].previousPrevote)
+	require.Nil(t, oracle.voters[2].previousPrevote)
+
+	// the broadcasted and failed votes are reported by the status API
+	votes := oracle.GetVoteStatuses()
+	require.Len(t, votes, 2)
+	require.Equal(t, accounts[0].ValidatorAddrString, votes[0].Validator)
+	require.Equal(t, "0xhash", votes[0].TxHash)
+	require.Equal(t, uint64(2), votes[0].VotePeriod)
+	require.Empty(t, votes[0].Error)
+	require.Equal(t, accounts[2].ValidatorAddrString, votes[1].Validator)
+	require.Equal(t, "test error", votes[1].Error)
+
+	// when every validator is jailed the prices are not fetched
+	for _, v := range oracle.voters {
+		v.jailCache.isJailed = true
+	}
+	err = oracle.tick(context.Background(), sdkclient.Context{}, 2)
+	require.Error(t, err)
+	require.Equal(t, 1, setPriceCount)
+}
+
 func TestFilterPricesWithDenomList(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -798,6 +980,21 @@ func TestFilterPricesWithDenomList(t *testing.T) {
 		})
 	}
 }
+func TestGenerateSalt(t *testing.T) {
+	salt, err := GenerateSalt(0)
+	require.Error(t, err)
+	require.Empty(t, salt)
+
+	salt, err = GenerateSalt(32)
+	require.NoError(t, err)
+	require.Len(t, salt, 64)
+
+	// two generated salts must be different
+	otherSalt, err := GenerateSalt(32)
+	require.NoError(t, err)
+	require.NotEqual(t, salt, otherSalt)
+}
+
 func TestGenerateExchangeRatesString(t *testing.T) {
 	testCases := map[string]struct {
 		input    sdk.DecCoins
@@ -916,6 +1113,7 @@ func TestSuccessGetComputedPricesCandles(t *testing.T) {
 		make(provider.AggregatedProviderPrices, 1),
 		providerPair,
 		make(map[string]sdk.Dec),
+		make(map[string]string),
 		map[string]struct{}{
 			"ATOM": {},
 		},
@@ -952,6 +1150,7 @@ func TestSuccessGetComputedPricesTickers(t *testing.T) {
 		providerPrices,
 		providerPair,
 		make(map[string]sdk.Dec),
+		make(map[string]string),
 		map[string]struct{}{
 			"ATOM": {},
 		},
@@ -1051,6 +1250,7 @@ func TestGetComputedPricesCandlesConversion(t *testing.T) {
 		make(provider.AggregatedProviderPrices, 1),
 		providerPair,
 		make(map[string]sdk.Dec),
+		make(map[string]string),
 		map[string]struct{}{
 			"BTC": {},
 		},
@@ -1138,6 +1338,7 @@ func TestGetComputedPricesTickersConversion(t *testing.T) {
 		providerPrices,
 		providerPair,
 		make(map[string]sdk.Dec),
+		make(map[string]string),
 		map[string]struct{}{
 			"BTC": {},
 		},
@@ -78,7 +78,7 @@ func (o *Oracle) GetParamCache(ctx context.Context, currentBlockHeight int64) (o
 func (o *Oracle) GetParams(ctx context.Context) (oracletypes.Params, error) {
 	// create the connection with the blockchain
 	grpcConn, err := grpc.Dial(
-		o.oracleClient.GRPCEndpoint,
+		o.oracleClient.GRPCEndpoint(),
 		// the Cosmos SDK doesn't support any transport security mechanism
 		grpc.WithInsecure(),
 		grpc.WithContextDialer(dialerFunc),
@@ -383,6 +383,11 @@ func (p *CryptoProvider) setSubscribedPairs(cps ...types.CurrencyPair) {
 	}
 }
 
+// WebsocketStatus returns the connection state of the provider websocket.
+func (p *CryptoProvider) WebsocketStatus() WebsocketStatus {
+	return p.wsc.Status()
+}
+
 // GetAvailablePairs returns all pairs to which the provider can subscribe.
 // ex.: map["ATOMUSDT" => {}, "UMEEUSDC" => {}].
 func (p *CryptoProvider) GetAvailablePairs() (map[string]struct{}, error) {
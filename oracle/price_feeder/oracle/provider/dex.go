@@ -0,0 +1,428 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+
+	"github.com/kiichain/kiichain/oracle/price_feeder/config"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/types"
+)
+
+const (
+	// dexCosmWasmPoolQuery is the smart query answered by the terraswap / astroport
+	// style pair contracts with the pool assets
+	dexCosmWasmPoolQuery = `{"pool":{}}`
+
+	// dexUniswapPoolABI defines the Uniswap V2 and V3 pool methods read by the provider
+	dexUniswapPoolABI = `[
+		{"type":"function","name":"token0","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+		{"type":"function","name":"token1","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+		{"type":"function","name":"getReserves","stateMutability":"view","inputs":[],"outputs":[{"name":"reserve0","type":"uint112"},{"name":"reserve1","type":"uint112"},{"name":"blockTimestampLast","type":"uint32"}]},
+		{"type":"function","name":"slot0","stateMutability":"view","inputs":[],"outputs":[{"name":"sqrtPriceX96","type":"uint160"},{"name":"tick","type":"int24"},{"name":"observationIndex","type":"uint16"},{"name":"observationCardinality","type":"uint16"},{"name":"observationCardinalityNext","type":"uint16"},{"name":"feeProtocol","type":"uint8"},{"name":"unlocked","type":"bool"}]},
+		{"type":"function","name":"liquidity","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint128"}]}
+	]`
+)
+
+var (
+	_ Provider = (*DexProvider)(nil)
+
+	// q96 is the fixed point resolution of the Uniswap V3 sqrt price
+	q96 = new(big.Int).Lsh(big.NewInt(1), 96)
+)
+
+type (
+	// DexProvider defines an Oracle provider which reads the spot prices and the
+	// liquidity of on-chain AMM pools. The EVM pools (Uniswap V2 and V3 style) are
+	// read through the EVM JSON-RPC and the CosmWasm pools through gRPC smart queries.
+	// The pool liquidity, in base asset amount, is used as the ticker volume.
+	DexProvider struct {
+		logger       zerolog.Logger
+		mtx          sync.RWMutex
+		endpoint     config.ProviderEndpoint
+		pools        map[string]config.DexPool // Symbol => config.DexPool
+		poolABI      abi.ABI
+		tokens       map[string][2]common.Address // EVM pool address => token0 and token1 addresses
+		httpClient   *http.Client
+		grpcDialOpts []grpc.DialOption
+	}
+
+	// DexCosmWasmPoolResponse defines the response of the CosmWasm pair contracts
+	// to the pool query.
+	DexCosmWasmPoolResponse struct {
+		Assets []DexCosmWasmAsset `json:"assets"`
+	}
+	DexCosmWasmAsset struct {
+		Info   DexCosmWasmAssetInfo `json:"info"`
+		Amount string               `json:"amount"`
+	}
+	DexCosmWasmAssetInfo struct {
+		NativeToken *DexCosmWasmNativeToken `json:"native_token,omitempty"`
+		Token       *DexCosmWasmToken       `json:"token,omitempty"`
+	}
+	DexCosmWasmNativeToken struct {
+		Denom string `json:"denom"`
+	}
+	DexCosmWasmToken struct {
+		ContractAddr string `json:"contract_addr"`
+	}
+)
+
+// NewDexProvider creates a new instance of the DexProvider, every pair must have
+// a pool defined on the dex provider endpoint.
+func NewDexProvider(
+	_ context.Context,
+	logger zerolog.Logger,
+	endpoint config.ProviderEndpoint,
+	pairs ...types.CurrencyPair,
+) (*DexProvider, error) {
+	if endpoint.Name != config.ProviderDex {
+		return nil, fmt.Errorf("the dex provider requires a %s provider endpoint", config.ProviderDex)
+	}
+
+	poolABI, err := abi.JSON(strings.NewReader(dexUniswapPoolABI))
+	if err != nil {
+		return nil, err
+	}
+
+	// index the pools by the pair symbol
+	pools := make(map[string]config.DexPool, len(endpoint.Pools))
+	for _, pool := range endpoint.Pools {
+		if pool.Type != config.DexPoolCosmWasm && !common.IsHexAddress(pool.Address) {
+			return nil, fmt.Errorf("invalid dex pool address: %s", pool.Address)
+		}
+
+		cp := types.CurrencyPair{Base: strings.ToUpper(pool.Base), Quote: strings.ToUpper(pool.Quote)}
+		pools[cp.String()] = pool
+	}
+
+	provider := &DexProvider{
+		logger:       logger.With().Str("provider", config.ProviderDex).Logger(),
+		endpoint:     endpoint,
+		pools:        pools,
+		poolABI:      poolABI,
+		tokens:       map[string][2]common.Address{},
+		httpClient:   newDefaultHTTPClient(),
+		grpcDialOpts: []grpc.DialOption{grpc.WithInsecure()},
+	}
+
+	if err := provider.SubscribeCurrencyPairs(pairs...); err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+// GetTickerPrices returns the spot price and liquidity of the pools of the given pairs.
+func (p *DexProvider) GetTickerPrices(pairs ...types.CurrencyPair) (map[string]TickerPrice, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	var (
+		evmClient *ethclient.Client
+		grpcConn  *grpc.ClientConn
+		err       error
+	)
+
+	tickerPrices := make(map[string]TickerPrice, len(pairs))
+	for _, cp := range pairs {
+		pool, ok := p.pools[strings.ToUpper(cp.String())]
+		if !ok {
+			return nil, fmt.Errorf("dex pool not found for %s", cp.String())
+		}
+
+		var ticker TickerPrice
+		switch pool.Type {
+		case config.DexPoolUniswapV2, config.DexPoolUniswapV3:
+			// the JSON-RPC client is only created when an EVM pool is read
+			if evmClient == nil {
+				evmClient, err = p.newEVMClient()
+				if err != nil {
+					return nil, err
+				}
+				defer evmClient.Close()
+			}
+
+			if pool.Type == config.DexPoolUniswapV2 {
+				ticker, err = p.getUniswapV2Ticker(ctx, evmClient, pool)
+			} else {
+				ticker, err = p.getUniswapV3Ticker(ctx, evmClient, pool)
+			}
+
+		case config.DexPoolCosmWasm:
+			// the gRPC connection is only created when a CosmWasm pool is read
+			if grpcConn == nil {
+				grpcConn, err = grpc.DialContext(ctx, p.endpoint.GRPC, p.grpcDialOpts...)
+				if err != nil {
+					return nil, fmt.Errorf("failed to dial Cosmos gRPC service: %w", err)
+				}
+				defer grpcConn.Close()
+			}
+
+			ticker, err = p.getCosmWasmTicker(ctx, grpcConn, pool)
+
+		default:
+			err = fmt.Errorf("unsupported pool type %s", pool.Type)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read dex pool %s for %s: %w", pool.Address, cp.String(), err)
+		}
+
+		tickerPrices[cp.String()] = ticker
+	}
+
+	return tickerPrices, nil
+}
+
+// GetCandlePrices returns a single candle per pair with the current pool price,
+// pools have no trade history to build candles from.
+func (p *DexProvider) GetCandlePrices(pairs ...types.CurrencyPair) (map[string][]CandlePrice, error) {
+	tickerPrices, err := p.GetTickerPrices(pairs...)
+	if err != nil {
+		return nil, err
+	}
+
+	candles := make(map[string][]CandlePrice, len(tickerPrices))
+	for pair, ticker := range tickerPrices {
+		candles[pair] = []CandlePrice{
+			{
+				Price:     ticker.Price,
+				Volume:    ticker.Volume,
+				TimeStamp: PastUnixTime(0),
+			},
+		}
+	}
+	return candles, nil
+}
+
+// SubscribeCurrencyPairs performs no subscription since the pools are read on demand,
+// it only ensures there is a pool for every pair.
+func (p *DexProvider) SubscribeCurrencyPairs(pairs ...types.CurrencyPair) error {
+	for _, cp := range pairs {
+		if _, ok := p.pools[strings.ToUpper(cp.String())]; !ok {
+			return fmt.Errorf("dex pool not found for %s", cp.String())
+		}
+	}
+	return nil
+}
+
+// GetAvailablePairs returns the pairs of the configured pools.
+func (p *DexProvider) GetAvailablePairs() (map[string]struct{}, error) {
+	availablePairs := make(map[string]struct{}, len(p.pools))
+	for symbol := range p.pools {
+		availablePairs[symbol] = struct{}{}
+	}
+	return availablePairs, nil
+}
+
+// newEVMClient creates a client to the EVM JSON-RPC endpoint
+func (p *DexProvider) newEVMClient() (*ethclient.Client, error) {
+	rpcClient, err := rpc.DialHTTPWithClient(p.endpoint.Rest, p.httpClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial EVM JSON-RPC: %w", err)
+	}
+	return ethclient.NewClient(rpcClient), nil
+}
+
+// callPool calls a view method of the EVM pool
+func (p *DexProvider) callPool(ctx context.Context, client *ethclient.Client, pool config.DexPool, method string) ([]interface{}, error) {
+	data, err := p.poolABI.Pack(method)
+	if err != nil {
+		return nil, err
+	}
+
+	to := common.HexToAddress(pool.Address)
+	res, err := client.CallContract(ctx, ethereum.CallMsg{To: &to, Data: data}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.poolABI.Unpack(method, res)
+}
+
+// isBaseToken0 returns true if the base asset is the token0 of the EVM pool and
+// false if it's the token1, the pool tokens can't change so they are cached
+func (p *DexProvider) isBaseToken0(ctx context.Context, client *ethclient.Client, pool config.DexPool) (bool, error) {
+	p.mtx.RLock()
+	tokens, ok := p.tokens[pool.Address]
+	p.mtx.RUnlock()
+
+	if !ok {
+		for i, method := range []string{"token0", "token1"} {
+			out, err := p.callPool(ctx, client, pool, method)
+			if err != nil {
+				return false, err
+			}
+			tokens[i] = out[0].(common.Address)
+		}
+
+		p.mtx.Lock()
+		p.tokens[pool.Address] = tokens
+		p.mtx.Unlock()
+	}
+
+	switch common.HexToAddress(pool.BaseToken) {
+	case tokens[0]:
+		return true, nil
+	case tokens[1]:
+		return false, nil
+	default:
+		return false, fmt.Errorf("base token %s not found on the pool", pool.BaseToken)
+	}
+}
+
+// getUniswapV2Ticker returns the price and liquidity from the pool reserves
+func (p *DexProvider) getUniswapV2Ticker(ctx context.Context, client *ethclient.Client, pool config.DexPool) (TickerPrice, error) {
+	baseIsToken0, err := p.isBaseToken0(ctx, client, pool)
+	if err != nil {
+		return TickerPrice{}, err
+	}
+
+	out, err := p.callPool(ctx, client, pool, "getReserves")
+	if err != nil {
+		return TickerPrice{}, err
+	}
+	reserve0, reserve1 := out[0].(*big.Int), out[1].(*big.Int)
+
+	// the price of token0 in token1 is reserve1 / reserve0
+	baseReserve := reserve0
+	if !baseIsToken0 {
+		baseReserve = reserve1
+	}
+	return newDexTickerPrice(pool, baseIsToken0, reserve1, reserve0, baseReserve)
+}
+
+// getUniswapV3Ticker returns the price from the pool sqrt price and the liquidity
+// from the virtual reserves of the current tick
+func (p *DexProvider) getUniswapV3Ticker(ctx context.Context, client *ethclient.Client, pool config.DexPool) (TickerPrice, error) {
+	baseIsToken0, err := p.isBaseToken0(ctx, client, pool)
+	if err != nil {
+		return TickerPrice{}, err
+	}
+
+	out, err := p.callPool(ctx, client, pool, "slot0")
+	if err != nil {
+		return TickerPrice{}, err
+	}
+	sqrtPriceX96 := out[0].(*big.Int)
+	if sqrtPriceX96.Sign() == 0 {
+		return TickerPrice{}, fmt.Errorf("pool is not initialized")
+	}
+
+	out, err = p.callPool(ctx, client, pool, "liquidity")
+	if err != nil {
+		return TickerPrice{}, err
+	}
+	liquidity := out[0].(*big.Int)
+
+	// the virtual reserves are L / sqrtPrice for token0 and L * sqrtPrice for token1
+	var baseReserve *big.Int
+	if baseIsToken0 {
+		baseReserve = new(big.Int).Div(new(big.Int).Mul(liquidity, q96), sqrtPriceX96)
+	} else {
+		baseReserve = new(big.Int).Div(new(big.Int).Mul(liquidity, sqrtPriceX96), q96)
+	}
+
+	// the price of token0 in token1 is sqrtPrice^2
+	priceNum := new(big.Int).Mul(sqrtPriceX96, sqrtPriceX96)
+	priceDen := new(big.Int).Mul(q96, q96)
+	return newDexTickerPrice(pool, baseIsToken0, priceNum, priceDen, baseReserve)
+}
+
+// getCosmWasmTicker returns the price and liquidity from the assets of the pair contract
+func (p *DexProvider) getCosmWasmTicker(ctx context.Context, conn *grpc.ClientConn, pool config.DexPool) (TickerPrice, error) {
+	queryClient := wasmtypes.NewQueryClient(conn)
+	res, err := queryClient.SmartContractState(ctx, &wasmtypes.QuerySmartContractStateRequest{
+		Address:   pool.Address,
+		QueryData: []byte(dexCosmWasmPoolQuery),
+	})
+	if err != nil {
+		return TickerPrice{}, err
+	}
+
+	var poolResp DexCosmWasmPoolResponse
+	if err := json.Unmarshal(res.Data, &poolResp); err != nil {
+		return TickerPrice{}, err
+	}
+	if len(poolResp.Assets) != 2 {
+		return TickerPrice{}, fmt.Errorf("expected 2 pool assets, got %d", len(poolResp.Assets))
+	}
+
+	// find the base asset on the pool
+	baseIndex := -1
+	for i, asset := range poolResp.Assets {
+		if asset.Info.id() == pool.BaseToken {
+			baseIndex = i
+		}
+	}
+	if baseIndex < 0 {
+		return TickerPrice{}, fmt.Errorf("base token %s not found on the pool", pool.BaseToken)
+	}
+
+	baseAmount, ok := new(big.Int).SetString(poolResp.Assets[baseIndex].Amount, 10)
+	if !ok {
+		return TickerPrice{}, fmt.Errorf("invalid pool amount %s", poolResp.Assets[baseIndex].Amount)
+	}
+	quoteAmount, ok := new(big.Int).SetString(poolResp.Assets[1-baseIndex].Amount, 10)
+	if !ok {
+		return TickerPrice{}, fmt.Errorf("invalid pool amount %s", poolResp.Assets[1-baseIndex].Amount)
+	}
+
+	return newDexTickerPrice(pool, true, quoteAmount, baseAmount, baseAmount)
+}
+
+// id returns the denom of the native tokens or the contract address of the CW20 tokens
+func (info DexCosmWasmAssetInfo) id() string {
+	switch {
+	case info.NativeToken != nil:
+		return info.NativeToken.Denom
+	case info.Token != nil:
+		return info.Token.ContractAddr
+	}
+	return ""
+}
+
+// newDexTickerPrice creates the ticker of a pool where the price of token0 in token1,
+// in the smallest units, is priceNum / priceDen. The volume is the base reserve.
+func newDexTickerPrice(pool config.DexPool, baseIsToken0 bool, priceNum, priceDen, baseReserve *big.Int) (TickerPrice, error) {
+	if priceNum.Sign() <= 0 || priceDen.Sign() <= 0 {
+		return TickerPrice{}, fmt.Errorf("pool has no liquidity")
+	}
+
+	// invert the price when the base asset is the token1
+	if !baseIsToken0 {
+		priceNum, priceDen = priceDen, priceNum
+	}
+
+	// scale the amounts by the assets decimals
+	baseUnit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(pool.BaseDecimals)), nil)
+	quoteUnit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(pool.QuoteDecimals)), nil)
+
+	price := decFromRatio(new(big.Int).Mul(priceNum, baseUnit), new(big.Int).Mul(priceDen, quoteUnit))
+	volume := decFromRatio(baseReserve, baseUnit)
+	if price.IsZero() {
+		return TickerPrice{}, fmt.Errorf("pool price is lower than the decimal precision")
+	}
+
+	return TickerPrice{Price: price, Volume: volume}, nil
+}
+
+// decFromRatio returns num / den truncated to the decimal precision
+func decFromRatio(num, den *big.Int) sdk.Dec {
+	scaled := new(big.Int).Mul(num, sdk.OneDec().BigInt())
+	return sdk.NewDecFromBigIntWithPrec(scaled.Quo(scaled, den), sdk.Precision)
+}
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/kiichain/kiichain/oracle/price_feeder/config"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/types"
+)
+
+const (
+	dexV2PoolAddr = "0x1000000000000000000000000000000000000001"
+	dexV3PoolAddr = "0x1000000000000000000000000000000000000002"
+	dexKIIToken   = "0x2000000000000000000000000000000000000001"
+	dexUSDTToken  = "0x2000000000000000000000000000000000000002"
+	dexETHToken   = "0x2000000000000000000000000000000000000003"
+)
+
+// mockWasmQuerier answers the pool smart query of the CosmWasm pair contracts
+type mockWasmQuerier struct {
+	wasmtypes.UnimplementedQueryServer
+	pools map[string]string // contract address => pool response
+}
+
+func (q *mockWasmQuerier) SmartContractState(_ context.Context, req *wasmtypes.QuerySmartContractStateRequest) (*wasmtypes.QuerySmartContractStateResponse, error) {
+	return &wasmtypes.QuerySmartContractStateResponse{Data: []byte(q.pools[req.Address])}, nil
+}
+
+// newMockEVMHandler answers the eth_call requests with the outputs packed by the pool methods
+func newMockEVMHandler(t *testing.T, outputs map[string]map[string][]interface{}) http.HandlerFunc {
+	poolABI, err := abi.JSON(strings.NewReader(dexUniswapPoolABI))
+	require.NoError(t, err)
+
+	return func(rw http.ResponseWriter, req *http.Request) {
+		var rpcReq struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+			Params []json.RawMessage
+		}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&rpcReq))
+		require.Equal(t, "eth_call", rpcReq.Method)
+
+		var call struct {
+			To    common.Address `json:"to"`
+			Input hexutil.Bytes  `json:"input"`
+			Data  hexutil.Bytes  `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rpcReq.Params[0], &call))
+		input := call.Input
+		if len(input) == 0 {
+			input = call.Data
+		}
+
+		method, err := poolABI.MethodById(input)
+		require.NoError(t, err)
+		result, err := method.Outputs.Pack(outputs[strings.ToLower(call.To.Hex())][method.Name]...)
+		require.NoError(t, err)
+
+		rw.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(rw).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      rpcReq.ID,
+			"result":  hexutil.Bytes(result),
+		}))
+	}
+}
+
+func TestDexProvider_GetTickerPrices(t *testing.T) {
+	pools := []config.DexPool{
+		{
+			Base:          "KII",
+			Quote:         "USDT",
+			Type:          config.DexPoolUniswapV2,
+			Address:       dexV2PoolAddr,
+			BaseToken:     dexKIIToken,
+			BaseDecimals:  18,
+			QuoteDecimals: 6,
+		},
+		{
+			Base:          "ETH",
+			Quote:         "KII",
+			Type:          config.DexPoolUniswapV3,
+			Address:       dexV3PoolAddr,
+			BaseToken:     dexETHToken,
+			BaseDecimals:  18,
+			QuoteDecimals: 18,
+		},
+		{
+			Base:          "ATOM",
+			Quote:         "USDT",
+			Type:          config.DexPoolCosmWasm,
+			Address:       "kii1pair",
+			BaseToken:     "uatom",
+			BaseDecimals:  6,
+			QuoteDecimals: 6,
+		},
+	}
+
+	// the KII/USDT pool has USDT as token0, so the price is inverted
+	oneKII := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+	evmOutputs := map[string]map[string][]interface{}{
+		dexV2PoolAddr: {
+			"token0":      {common.HexToAddress(dexUSDTToken)},
+			"token1":      {common.HexToAddress(dexKIIToken)},
+			"getReserves": {big.NewInt(500_000_000), new(big.Int).Mul(big.NewInt(1000), oneKII), uint32(0)},
+		},
+		dexV3PoolAddr: {
+			"token0": {common.HexToAddress(dexETHToken)},
+			"token1": {common.HexToAddress(dexKIIToken)},
+			// sqrt price of 2 is a price of 4 KII per ETH
+			"slot0":     {new(big.Int).Mul(big.NewInt(2), q96), big.NewInt(0), uint16(0), uint16(0), uint16(0), uint8(0), true},
+			"liquidity": {new(big.Int).Mul(big.NewInt(1000), oneKII)},
+		},
+	}
+
+	evmServer := NewMockProviderServer()
+	evmServer.SetHandler(newMockEVMHandler(t, evmOutputs))
+	defer evmServer.Close()
+
+	grpcServer := grpc.NewServer()
+	wasmtypes.RegisterQueryServer(grpcServer, &mockWasmQuerier{pools: map[string]string{
+		"kii1pair": `{"assets":[{"info":{"token":{"contract_addr":"kii1usdt"}},"amount":"1000000"},{"info":{"native_token":{"denom":"uatom"}},"amount":"2000000"}],"total_share":"1"}`,
+	}})
+	chainServer := NewMockProviderServer()
+	chainServer.SetGRPCServer(grpcServer)
+	defer chainServer.Close()
+
+	p, err := NewDexProvider(
+		context.TODO(),
+		zerolog.Nop(),
+		config.ProviderEndpoint{
+			Name:  config.ProviderDex,
+			Rest:  "https://" + evmServer.GetBaseURL(),
+			GRPC:  chainServer.GetBaseURL(),
+			Pools: pools,
+		},
+		types.CurrencyPair{Base: "KII", Quote: "USDT"},
+	)
+	require.NoError(t, err)
+	p.httpClient = evmServer.GetHTTPClient()
+	p.grpcDialOpts = []grpc.DialOption{
+		grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(chainServer.GetCertPool(), "")),
+	}
+
+	t.Run("valid_request_uniswap_v2", func(t *testing.T) {
+		prices, err := p.GetTickerPrices(types.CurrencyPair{Base: "KII", Quote: "USDT"})
+		require.NoError(t, err)
+		require.Len(t, prices, 1)
+		require.Equal(t, sdk.MustNewDecFromStr("0.5"), prices["KIIUSDT"].Price)
+		require.Equal(t, sdk.MustNewDecFromStr("1000"), prices["KIIUSDT"].Volume)
+	})
+
+	t.Run("valid_request_uniswap_v3", func(t *testing.T) {
+		prices, err := p.GetTickerPrices(types.CurrencyPair{Base: "ETH", Quote: "KII"})
+		require.NoError(t, err)
+		require.Len(t, prices, 1)
+		require.Equal(t, sdk.MustNewDecFromStr("4"), prices["ETHKII"].Price)
+		require.Equal(t, sdk.MustNewDecFromStr("500"), prices["ETHKII"].Volume)
+	})
+
+	t.Run("valid_request_cosmwasm", func(t *testing.T) {
+		prices, err := p.GetTickerPrices(types.CurrencyPair{Base: "ATOM", Quote: "USDT"})
+		require.NoError(t, err)
+		require.Len(t, prices, 1)
+		require.Equal(t, sdk.MustNewDecFromStr("0.5"), prices["ATOMUSDT"].Price)
+		require.Equal(t, sdk.MustNewDecFromStr("2"), prices["ATOMUSDT"].Volume)
+	})
+
+	t.Run("valid_request_multi_ticker", func(t *testing.T) {
+		candles, err := p.GetCandlePrices(
+			types.CurrencyPair{Base: "KII", Quote: "USDT"},
+			types.CurrencyPair{Base: "ETH", Quote: "KII"},
+			types.CurrencyPair{Base: "ATOM", Quote: "USDT"},
+		)
+		require.NoError(t, err)
+		require.Len(t, candles, 3)
+		require.Len(t, candles["KIIUSDT"], 1)
+		require.Equal(t, sdk.MustNewDecFromStr("0.5"), candles["KIIUSDT"][0].Price)
+	})
+
+	t.Run("invalid_request_empty_pool", func(t *testing.T) {
+		evmOutputs[dexV2PoolAddr]["getReserves"] = []interface{}{big.NewInt(0), big.NewInt(0), uint32(0)}
+		prices, err := p.GetTickerPrices(types.CurrencyPair{Base: "KII", Quote: "USDT"})
+		require.ErrorContains(t, err, "pool has no liquidity")
+		require.Nil(t, prices)
+	})
+
+	t.Run("invalid_request_unknown_base_token", func(t *testing.T) {
+		p.pools["KIIUSDT"] = config.DexPool{
+			Base: "KII", Quote: "USDT", Type: config.DexPoolUniswapV2, Address: dexV2PoolAddr, BaseToken: dexETHToken,
+		}
+		defer func() { p.pools["KIIUSDT"] = pools[0] }()
+
+		prices, err := p.GetTickerPrices(types.CurrencyPair{Base: "KII", Quote: "USDT"})
+		require.ErrorContains(t, err, "base token "+dexETHToken+" not found on the pool")
+		require.Nil(t, prices)
+	})
+
+	t.Run("invalid_request_missing_pool", func(t *testing.T) {
+		prices, err := p.GetTickerPrices(types.CurrencyPair{Base: "BTC", Quote: "USDT"})
+		require.Error(t, err)
+		require.Nil(t, prices)
+	})
+}
+
+func TestDexProvider_New(t *testing.T) {
+	endpoint := config.ProviderEndpoint{
+		Name: config.ProviderDex,
+		Rest: "http://localhost:8545",
+		Pools: []config.DexPool{
+			{Base: "KII", Quote: "USDT", Type: config.DexPoolUniswapV2, Address: dexV2PoolAddr, BaseToken: dexKIIToken},
+		},
+	}
+
+	p, err := NewDexProvider(context.TODO(), zerolog.Nop(), endpoint, types.CurrencyPair{Base: "KII", Quote: "USDT"})
+	require.NoError(t, err)
+
+	pairs, err := p.GetAvailablePairs()
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{"KIIUSDT": {}}, pairs)
+
+	// every pair requires a pool
+	_, err = NewDexProvider(context.TODO(), zerolog.Nop(), endpoint, types.CurrencyPair{Base: "BTC", Quote: "USDT"})
+	require.Error(t, err)
+
+	// the dex endpoint is required
+	_, err = NewDexProvider(context.TODO(), zerolog.Nop(), config.ProviderEndpoint{}, types.CurrencyPair{Base: "KII", Quote: "USDT"})
+	require.Error(t, err)
+
+	// the EVM pools require an address
+	endpoint.Pools[0].Address = "kii1pair"
+	_, err = NewDexProvider(context.TODO(), zerolog.Nop(), endpoint, types.CurrencyPair{Base: "KII", Quote: "USDT"})
+	require.Error(t, err)
+}
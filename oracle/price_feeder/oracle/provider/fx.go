@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+
+	"github.com/kiichain/kiichain/oracle/price_feeder/config"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/types"
+)
+
+const (
+	frankfurterRestHost  = "https://api.frankfurter.app"
+	frankfurterRestPath  = "/latest?from=USD"
+	exchangeRateRestHost = "https://open.er-api.com"
+	exchangeRateRestPath = "/v6/latest/USD"
+	currencyAPIRestHost  = "https://cdn.jsdelivr.net/npm/@fawazahmed0/currency-api@latest"
+	currencyAPIRestPath  = "/v1/currencies/usd.json"
+	floatRatesRestHost   = "https://www.floatrates.com"
+	floatRatesRestPath   = "/daily/usd.json"
+
+	// fxPollInterval is the time between the rates requests, the candles must be
+	// recorded within the TVWAP period
+	fxPollInterval = time.Minute
+)
+
+var (
+	_ Provider = (*FXProvider)(nil)
+
+	// fxVolumeUSD is the volume reported by the FX pairs, in USD. The FX rates
+	// have no volume, the pairs report the same nominal volume so that the FX
+	// providers are weighted equally and the FX conversion paths are preferred
+	// over the thin crypto markets.
+	fxVolumeUSD = sdk.NewDec(1_000_000_000)
+
+	// fxSources defines the request and the response of every FX provider
+	fxSources = map[string]fxSource{
+		config.ProviderFrankfurter:  {restHost: frankfurterRestHost, ratesPath: frankfurterRestPath, decode: decodeFrankfurterRates},
+		config.ProviderExchangeRate: {restHost: exchangeRateRestHost, ratesPath: exchangeRateRestPath, decode: decodeExchangeRateRates},
+		config.ProviderCurrencyAPI:  {restHost: currencyAPIRestHost, ratesPath: currencyAPIRestPath, decode: decodeCurrencyAPIRates},
+		config.ProviderFloatRates:   {restHost: floatRatesRestHost, ratesPath: floatRatesRestPath, decode: decodeFloatRatesRates},
+	}
+)
+
+type (
+	// FXProvider defines an Oracle provider which polls the fiat exchange rates of
+	// a public FX API. A single request returns the rates of every currency
+	// against USD, the price of any pair between them is derived from the rates.
+	FXProvider struct {
+		*pollingProvider
+
+		name       string
+		endpoint   config.ProviderEndpoint
+		source     fxSource
+		httpClient *http.Client
+	}
+
+	// fxSource defines the USD rates request of an FX API and how its response
+	// is decoded into the units of every currency per USD
+	fxSource struct {
+		restHost  string
+		ratesPath string
+		decode    func(body io.Reader) (map[string]sdk.Dec, error)
+	}
+
+	// FrankfurterRatesResponse defines the response of the frankfurter (ECB) rates.
+	FrankfurterRatesResponse struct {
+		Base  string                 `json:"base"`
+		Rates map[string]json.Number `json:"rates"`
+	}
+
+	// ExchangeRateRatesResponse defines the response of the open.er-api.com rates.
+	ExchangeRateRatesResponse struct {
+		Result   string                 `json:"result"`
+		BaseCode string                 `json:"base_code"`
+		Rates    map[string]json.Number `json:"rates"`
+	}
+
+	// CurrencyAPIRatesResponse defines the response of the fawazahmed0 currency
+	// api rates, the rates are keyed by the lower case currencies.
+	CurrencyAPIRatesResponse struct {
+		Date  string                 `json:"date"`
+		Rates map[string]json.Number `json:"usd"`
+	}
+
+	// FloatRatesRate defines a currency rate of the floatrates.com response,
+	// which is keyed by the lower case currencies.
+	FloatRatesRate struct {
+		Code string      `json:"code"`
+		Rate json.Number `json:"rate"`
+	}
+)
+
+// NewFXProvider creates a new instance of the FX provider with the given name
+// and starts polling the rates until the context is canceled.
+func NewFXProvider(
+	ctx context.Context,
+	name string,
+	logger zerolog.Logger,
+	endpoint config.ProviderEndpoint,
+	pairs ...types.CurrencyPair,
+) (*FXProvider, error) {
+	source, ok := fxSources[name]
+	if !ok {
+		return nil, fmt.Errorf("%s is not an FX provider", name)
+	}
+	if endpoint.Name != name {
+		endpoint = config.ProviderEndpoint{
+			Name: name,
+			Rest: source.restHost,
+		}
+	}
+
+	provider := &FXProvider{
+		pollingProvider: newPollingProvider(logger.With().Str("provider", name).Logger()),
+		name:            name,
+		endpoint:        endpoint,
+		source:          source,
+		httpClient:      newDefaultHTTPClient(),
+	}
+
+	if err := provider.SubscribeCurrencyPairs(pairs...); err != nil {
+		return nil, err
+	}
+
+	go provider.poll(ctx)
+
+	return provider, nil
+}
+
+// poll requests the rates on every poll interval
+func (p *FXProvider) poll(ctx context.Context) {
+	ticker := time.NewTicker(fxPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.pollRates(ctx); err != nil {
+			p.logger.Warn().Err(err).Msg("failed to poll FX rates")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollRates requests the USD rates and records the tickers of the subscribed pairs
+func (p *FXProvider) pollRates(ctx context.Context) error {
+	rates, err := p.fetchRates(ctx)
+	if err != nil {
+		return err
+	}
+
+	polledAt := time.Now().UnixMilli()
+	for _, cp := range p.pairs() {
+		ticker, err := fxTickerPrice(rates, cp)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", cp.String()).Msg("failed to compute FX rate")
+			continue
+		}
+
+		p.setTickerPair(cp.String(), ticker, CandlePrice{
+			Price:     ticker.Price,
+			Volume:    ticker.Volume,
+			TimeStamp: polledAt,
+		})
+	}
+	return nil
+}
+
+// fetchRates requests the units of every currency per USD
+func (p *FXProvider) fetchRates(ctx context.Context) (map[string]sdk.Dec, error) {
+	url := strings.TrimSuffix(p.endpoint.Rest, "/") + p.source.ratesPath
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned status %d", p.name, resp.StatusCode)
+	}
+
+	rates, err := p.source.decode(io.LimitReader(resp.Body, maxGenericResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s rates: %w", p.name, err)
+	}
+	rates[config.DenomUSD] = sdk.OneDec()
+
+	return rates, nil
+}
+
+// fxTickerPrice derives the price of the pair from the USD rates, the volume is
+// the nominal FX volume in base units
+func fxTickerPrice(rates map[string]sdk.Dec, cp types.CurrencyPair) (TickerPrice, error) {
+	baseRate, ok := rates[strings.ToUpper(cp.Base)]
+	if !ok || !baseRate.IsPositive() {
+		return TickerPrice{}, fmt.Errorf("missing FX rate for %s", cp.Base)
+	}
+	quoteRate, ok := rates[strings.ToUpper(cp.Quote)]
+	if !ok || !quoteRate.IsPositive() {
+		return TickerPrice{}, fmt.Errorf("missing FX rate for %s", cp.Quote)
+	}
+
+	return TickerPrice{
+		Price:  quoteRate.Quo(baseRate),
+		Volume: fxVolumeUSD.Mul(baseRate),
+	}, nil
+}
+
+func decodeFrankfurterRates(body io.Reader) (map[string]sdk.Dec, error) {
+	var resp FrankfurterRatesResponse
+	if err := json.NewDecoder(body).Decode(&resp); err != nil {
+		return nil, err
+	}
+	if resp.Base != config.DenomUSD {
+		return nil, fmt.Errorf("unexpected base %s", resp.Base)
+	}
+	return fxRates(resp.Rates)
+}
+
+func decodeExchangeRateRates(body io.Reader) (map[string]sdk.Dec, error) {
+	var resp ExchangeRateRatesResponse
+	if err := json.NewDecoder(body).Decode(&resp); err != nil {
+		return nil, err
+	}
+	if resp.Result != "success" || resp.BaseCode != config.DenomUSD {
+		return nil, fmt.Errorf("unexpected result %s for base %s", resp.Result, resp.BaseCode)
+	}
+	return fxRates(resp.Rates)
+}
+
+func decodeCurrencyAPIRates(body io.Reader) (map[string]sdk.Dec, error) {
+	var resp CurrencyAPIRatesResponse
+	if err := json.NewDecoder(body).Decode(&resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Rates) == 0 {
+		return nil, fmt.Errorf("missing usd rates")
+	}
+	return fxRates(resp.Rates)
+}
+
+func decodeFloatRatesRates(body io.Reader) (map[string]sdk.Dec, error) {
+	var resp map[string]FloatRatesRate
+	if err := json.NewDecoder(body).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	rates := make(map[string]json.Number, len(resp))
+	for currency, rate := range resp {
+		rates[currency] = rate.Rate
+	}
+	return fxRates(rates)
+}
+
+// fxRates parses the rates of the currencies, the currencies are upper cased
+func fxRates(values map[string]json.Number) (map[string]sdk.Dec, error) {
+	rates := make(map[string]sdk.Dec, len(values)+1)
+	for currency, value := range values {
+		rate, err := parseFXRate(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s rate: %w", currency, err)
+		}
+		rates[strings.ToUpper(currency)] = rate
+	}
+	return rates, nil
+}
+
+// parseFXRate parses a rate, the small rates may be in scientific notation
+func parseFXRate(value json.Number) (sdk.Dec, error) {
+	str := value.String()
+	if strings.ContainsAny(str, "eE") {
+		rate, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		str = strconv.FormatFloat(rate, 'f', -1, 64)
+	}
+
+	// sdk.NewDecFromStr fails on more than 18 decimals
+	if i := strings.Index(str, "."); i >= 0 && len(str)-i-1 > sdk.Precision {
+		str = str[:i+1+sdk.Precision]
+	}
+	return sdk.NewDecFromStr(str)
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiichain/kiichain/oracle/price_feeder/config"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/types"
+)
+
+func TestFXSourcesDecode(t *testing.T) {
+	testCases := []struct {
+		name string
+		body string
+	}{
+		{
+			name: config.ProviderFrankfurter,
+			body: `{"amount": 1.0, "base": "USD", "date": "2024-03-06", "rates": {"EUR": 0.8, "MXN": 17.05}}`,
+		},
+		{
+			name: config.ProviderExchangeRate,
+			body: `{"result": "success", "base_code": "USD", "rates": {"USD": 1, "EUR": 0.8, "MXN": 17.05}}`,
+		},
+		{
+			name: config.ProviderCurrencyAPI,
+			body: `{"date": "2024-03-06", "usd": {"eur": 0.8, "mxn": 17.05, "btc": 1.6e-05}}`,
+		},
+		{
+			name: config.ProviderFloatRates,
+			body: `{"eur": {"code": "EUR", "rate": 0.8}, "mxn": {"code": "MXN", "rate": 17.0500000000000000001}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rates, err := fxSources[tc.name].decode(strings.NewReader(tc.body))
+			require.NoError(t, err)
+			require.Equal(t, sdk.MustNewDecFromStr("0.8"), rates["EUR"])
+			require.Equal(t, sdk.MustNewDecFromStr("17.05"), rates["MXN"])
+		})
+	}
+
+	rates, err := decodeCurrencyAPIRates(strings.NewReader(`{"usd": {"btc": 1.6e-05}}`))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.000016"), rates["BTC"])
+
+	_, err = decodeExchangeRateRates(strings.NewReader(`{"result": "error", "error-type": "unsupported-code"}`))
+	require.Error(t, err)
+
+	_, err = decodeFrankfurterRates(strings.NewReader(`{"base": "EUR", "rates": {"USD": 1.25}}`))
+	require.Error(t, err)
+}
+
+func TestFXTickerPrice(t *testing.T) {
+	rates := map[string]sdk.Dec{
+		config.DenomUSD: sdk.OneDec(),
+		"EUR":           sdk.MustNewDecFromStr("0.8"),
+		"COP":           sdk.MustNewDecFromStr("4000"),
+	}
+
+	// the price of the pairs is derived from the USD rates
+	ticker, err := fxTickerPrice(rates, types.CurrencyPair{Base: "COP", Quote: "USD"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.00025"), ticker.Price)
+	require.Equal(t, fxVolumeUSD.MulInt64(4000), ticker.Volume)
+
+	ticker, err = fxTickerPrice(rates, types.CurrencyPair{Base: "EUR", Quote: "COP"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("5000"), ticker.Price)
+
+	_, err = fxTickerPrice(rates, types.CurrencyPair{Base: "BRL", Quote: "USD"})
+	require.ErrorContains(t, err, "missing FX rate for BRL")
+}
+
+func TestFXProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		require.Equal(t, "/v6/latest/USD", req.URL.Path)
+		rw.Write([]byte(`{"result": "success", "base_code": "USD", "rates": {"USD": 1, "COP": 4000, "MXN": 20}}`))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	copPair := types.CurrencyPair{Base: "COP", Quote: "USD"}
+	mxnPair := types.CurrencyPair{Base: "MXN", Quote: "USD"}
+	p, err := NewFXProvider(
+		ctx,
+		config.ProviderExchangeRate,
+		zerolog.Nop(),
+		config.ProviderEndpoint{Name: config.ProviderExchangeRate, Rest: server.URL},
+		copPair,
+		mxnPair,
+	)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		prices, err := p.GetTickerPrices(copPair, mxnPair)
+		return err == nil && len(prices) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	prices, err := p.GetTickerPrices(copPair, mxnPair)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.00025"), prices["COPUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("0.05"), prices["MXNUSD"].Price)
+
+	candles, err := p.GetCandlePrices(copPair)
+	require.NoError(t, err)
+	require.Len(t, candles["COPUSD"], 1)
+	require.Equal(t, sdk.MustNewDecFromStr("0.00025"), candles["COPUSD"][0].Price)
+
+	_, err = NewFXProvider(ctx, config.ProviderBinance, zerolog.Nop(), config.ProviderEndpoint{}, copPair)
+	require.Error(t, err)
+}
@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/kiichain/kiichain/oracle/price_feeder/config"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/types"
+)
+
+const (
+	defaultGenericPollInterval = 10 * time.Second
+
+	// genericSymbolPlaceholder is replaced by the pair symbol on the ticker path
+	genericSymbolPlaceholder = "{symbol}"
+
+	// genericDefaultVolume is used when the provider doesn't report a volume
+	genericDefaultVolume = "1"
+
+	// maxGenericResponseSize limits the size of the ticker responses
+	maxGenericResponseSize = 10 << 20
+)
+
+var _ Provider = (*GenericProvider)(nil)
+
+type (
+	// GenericProvider defines an Oracle provider defined entirely by its endpoint
+	// settings. It polls the ticker of every pair from a REST endpoint and selects
+	// the price, volume and timestamp of the response with JSONPath selectors. A
+	// candle is recorded on every poll.
+	GenericProvider struct {
+		*pollingProvider
+
+		name          string
+		endpoint      config.ProviderEndpoint
+		pricePath     JSONPath
+		volumePath    *JSONPath
+		timestampPath *JSONPath
+		pollInterval  time.Duration
+		symbols       map[string]string // Symbol => provider symbol
+		httpClient    *http.Client
+	}
+)
+
+// NewGenericProvider creates a new instance of the GenericProvider and starts
+// polling the tickers of the pairs until the context is canceled.
+func NewGenericProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoint config.ProviderEndpoint,
+	pairs ...types.CurrencyPair,
+) (*GenericProvider, error) {
+	if endpoint.Type != config.ProviderTypeGeneric {
+		return nil, fmt.Errorf("the provider %s is not a %s provider", endpoint.Name, config.ProviderTypeGeneric)
+	}
+
+	pricePath, err := ParseJSONPath(endpoint.Generic.PricePath)
+	if err != nil {
+		return nil, err
+	}
+	volumePath, err := parseOptionalJSONPath(endpoint.Generic.VolumePath)
+	if err != nil {
+		return nil, err
+	}
+	timestampPath, err := parseOptionalJSONPath(endpoint.Generic.TimestampPath)
+	if err != nil {
+		return nil, err
+	}
+
+	pollInterval := defaultGenericPollInterval
+	if len(endpoint.Generic.PollInterval) > 0 {
+		pollInterval, err = time.ParseDuration(endpoint.Generic.PollInterval)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s poll interval: %w", endpoint.Name, err)
+		}
+	}
+
+	// the pairs symbols are upper case
+	symbols := make(map[string]string, len(endpoint.Generic.Symbols))
+	for pair, symbol := range endpoint.Generic.Symbols {
+		symbols[strings.ToUpper(pair)] = symbol
+	}
+
+	provider := &GenericProvider{
+		pollingProvider: newPollingProvider(logger.With().Str("provider", endpoint.Name).Logger()),
+		name:            endpoint.Name,
+		endpoint:        endpoint,
+		pricePath:       pricePath,
+		volumePath:      volumePath,
+		timestampPath:   timestampPath,
+		pollInterval:    pollInterval,
+		symbols:         symbols,
+		httpClient:      newDefaultHTTPClient(),
+	}
+
+	if err := provider.SubscribeCurrencyPairs(pairs...); err != nil {
+		return nil, err
+	}
+
+	go provider.poll(ctx)
+
+	return provider, nil
+}
+
+// poll requests the tickers of the subscribed pairs on every poll interval
+func (p *GenericProvider) poll(ctx context.Context) {
+	ticker := time.NewTicker(p.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		p.pollTickers(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollTickers requests the tickers of the subscribed pairs and records a candle per pair
+func (p *GenericProvider) pollTickers(ctx context.Context) {
+	for _, cp := range p.pairs() {
+		ticker, candle, err := p.fetchTicker(ctx, cp)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", cp.String()).Msg("failed to poll ticker")
+			continue
+		}
+		p.setTickerPair(cp.String(), ticker, candle)
+	}
+}
+
+// fetchTicker requests the ticker of the pair and selects its values
+func (p *GenericProvider) fetchTicker(ctx context.Context, cp types.CurrencyPair) (TickerPrice, CandlePrice, error) {
+	symbol := p.symbol(cp)
+	url := strings.TrimSuffix(p.endpoint.Rest, "/") + strings.ReplaceAll(p.endpoint.Generic.TickerPath, genericSymbolPlaceholder, symbol)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return TickerPrice{}, CandlePrice{}, err
+	}
+	for key, value := range p.endpoint.Generic.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return TickerPrice{}, CandlePrice{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return TickerPrice{}, CandlePrice{}, fmt.Errorf("%s returned status %d", p.name, resp.StatusCode)
+	}
+
+	// keep the precision of the numbers
+	decoder := json.NewDecoder(io.LimitReader(resp.Body, maxGenericResponseSize))
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return TickerPrice{}, CandlePrice{}, fmt.Errorf("failed to decode %s response: %w", p.name, err)
+	}
+
+	return p.parseTicker(symbol, document, time.Now())
+}
+
+// parseTicker selects the price, volume and timestamp of the ticker response, the
+// polling time is used when the response has no timestamp
+func (p *GenericProvider) parseTicker(symbol string, document interface{}, polledAt time.Time) (TickerPrice, CandlePrice, error) {
+	price, err := p.pricePath.Select(document)
+	if err != nil {
+		return TickerPrice{}, CandlePrice{}, err
+	}
+
+	volume := genericDefaultVolume
+	if p.volumePath != nil {
+		volume, err = p.volumePath.Select(document)
+		if err != nil {
+			return TickerPrice{}, CandlePrice{}, err
+		}
+	}
+
+	timestamp := polledAt.UnixMilli()
+	if p.timestampPath != nil {
+		value, err := p.timestampPath.Select(document)
+		if err != nil {
+			return TickerPrice{}, CandlePrice{}, err
+		}
+		timestamp, err = parseGenericTimestamp(value)
+		if err != nil {
+			return TickerPrice{}, CandlePrice{}, err
+		}
+	}
+
+	ticker, err := newTickerPrice(p.name, symbol, price, volume)
+	if err != nil {
+		return TickerPrice{}, CandlePrice{}, err
+	}
+	candle, err := newCandlePrice(p.name, symbol, price, volume, timestamp)
+	if err != nil {
+		return TickerPrice{}, CandlePrice{}, err
+	}
+
+	return ticker, candle, nil
+}
+
+// symbol returns the provider symbol of the pair, the base and the quote are
+// concatenated when the pair isn't mapped
+func (p *GenericProvider) symbol(cp types.CurrencyPair) string {
+	if symbol, ok := p.symbols[cp.String()]; ok {
+		return symbol
+	}
+	return cp.String()
+}
+
+// parseOptionalJSONPath parses the JSONPath selector, it returns nil if it is empty
+func parseOptionalJSONPath(path string) (*JSONPath, error) {
+	if len(path) == 0 {
+		return nil, nil
+	}
+
+	jsonPath, err := ParseJSONPath(path)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonPath, nil
+}
+
+// parseGenericTimestamp parses a unix timestamp in seconds or milliseconds, or a
+// RFC3339 date, and returns it in milliseconds
+func parseGenericTimestamp(value string) (int64, error) {
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+		// the timestamps after 2001 in milliseconds have 13 digits
+		if seconds >= 1e12 {
+			return int64(seconds), nil
+		}
+		return int64(seconds * 1000), nil
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timestamp %s", value)
+	}
+	return timestamp.UnixMilli(), nil
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiichain/kiichain/oracle/price_feeder/config"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/types"
+)
+
+// genericEndpoint returns the endpoint of a generic provider reading the mock server tickers
+func genericEndpoint(rest string) config.ProviderEndpoint {
+	return config.ProviderEndpoint{
+		Name: "bitso",
+		Type: config.ProviderTypeGeneric,
+		Rest: rest,
+		Generic: config.GenericProvider{
+			TickerPath:    "/v3/ticker?book={symbol}",
+			PricePath:     "$.payload.last",
+			VolumePath:    "$.payload['volume']",
+			TimestampPath: "$.payload.created_at",
+			PollInterval:  "10ms",
+			Symbols:       map[string]string{"usdcmxn": "usdc_mxn"},
+			Headers:       map[string]string{"X-Api-Key": "key"},
+		},
+	}
+}
+
+func TestParseJSONPath(t *testing.T) {
+	var document interface{}
+	decoder := json.NewDecoder(strings.NewReader(`{
+		"data": [{"price": 1.123456789012345678}, {"price": "2.5", "last price": 3}],
+		"time": 1700000000
+	}`))
+	decoder.UseNumber()
+	require.NoError(t, decoder.Decode(&document))
+
+	testCases := []struct {
+		path     string
+		expected string
+		err      string
+	}{
+		{path: "$.data[0].price", expected: "1.123456789012345678"},
+		{path: "$.data[-1].price", expected: "2.5"},
+		{path: `$.data[1]["last price"]`, expected: "3"},
+		{path: "$['time']", expected: "1700000000"},
+		{path: "$.data[2].price", err: "not found"},
+		{path: "$.data.price", err: "not found"},
+		{path: "$.data[0]", err: "is not a number or a string"},
+		{path: "data.price", err: "it must start with $"},
+		{path: "$.data[x]", err: "invalid index"},
+		{path: "$..price", err: "empty key"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			path, err := ParseJSONPath(tc.path)
+			if err == nil {
+				var value string
+				value, err = path.Select(document)
+				if len(tc.err) == 0 {
+					require.NoError(t, err)
+					require.Equal(t, tc.expected, value)
+					return
+				}
+			}
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestGenericProvider(t *testing.T) {
+	createdAt := time.Now().UTC().Truncate(time.Second)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		require.Equal(t, "/v3/ticker", req.URL.Path)
+		require.Equal(t, "key", req.Header.Get("X-Api-Key"))
+
+		switch req.URL.Query().Get("book") {
+		case "usdc_mxn":
+			fmt.Fprintf(rw, `{"payload": {"last": "17.05", "volume": 1250.5, "created_at": "%s"}}`, createdAt.Format(time.RFC3339))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	usdcPair := types.CurrencyPair{Base: "USDC", Quote: "MXN"}
+	p, err := NewGenericProvider(ctx, zerolog.Nop(), genericEndpoint(server.URL), usdcPair)
+	require.NoError(t, err)
+
+	t.Run("valid_request_single_ticker", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			prices, err := p.GetTickerPrices(usdcPair)
+			return err == nil && len(prices) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+
+		prices, err := p.GetTickerPrices(usdcPair)
+		require.NoError(t, err)
+		require.Equal(t, sdk.MustNewDecFromStr("17.05"), prices["USDCMXN"].Price)
+		require.Equal(t, sdk.MustNewDecFromStr("1250.5"), prices["USDCMXN"].Volume)
+	})
+
+	t.Run("valid_request_candles", func(t *testing.T) {
+		// the polls reporting the same ticker record a single candle
+		candles, err := p.GetCandlePrices(usdcPair)
+		require.NoError(t, err)
+		require.Len(t, candles["USDCMXN"], 1)
+		require.Equal(t, createdAt.UnixMilli(), candles["USDCMXN"][0].TimeStamp)
+		require.Equal(t, sdk.MustNewDecFromStr("17.05"), candles["USDCMXN"][0].Price)
+	})
+
+	t.Run("invalid_request_unknown_pair", func(t *testing.T) {
+		// the pairs without mapping use their symbol, the failed polls are skipped
+		eurPair := types.CurrencyPair{Base: "EUR", Quote: "MXN"}
+		require.NoError(t, p.SubscribeCurrencyPairs(eurPair))
+
+		pairs, err := p.GetAvailablePairs()
+		require.NoError(t, err)
+		require.Contains(t, pairs, "EURMXN")
+
+		prices, err := p.GetTickerPrices(eurPair)
+		require.NoError(t, err)
+		require.Empty(t, prices)
+	})
+}
+
+func TestGenericProviderParseTicker(t *testing.T) {
+	endpoint := genericEndpoint("http://localhost")
+	endpoint.Generic.VolumePath = ""
+	endpoint.Generic.TimestampPath = ""
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	p, err := NewGenericProvider(ctx, zerolog.Nop(), endpoint, types.CurrencyPair{Base: "USD", Quote: "MXN"})
+	require.NoError(t, err)
+
+	// the volume defaults to 1 and the timestamp to the polling time
+	polledAt := time.Now()
+	ticker, candle, err := p.parseTicker("USDMXN", map[string]interface{}{
+		"payload": map[string]interface{}{"last": json.Number("17.1")},
+	}, polledAt)
+	require.NoError(t, err)
+	require.Equal(t, TickerPrice{Price: sdk.MustNewDecFromStr("17.1"), Volume: sdk.OneDec()}, ticker)
+	require.Equal(t, polledAt.UnixMilli(), candle.TimeStamp)
+
+	// the prices go through the ticker parsing
+	_, _, err = p.parseTicker("USDMXN", map[string]interface{}{
+		"payload": map[string]interface{}{"last": "n/a"},
+	}, polledAt)
+	require.ErrorContains(t, err, "failed to parse bitso price (n/a) for USDMXN")
+
+	_, err = NewGenericProvider(ctx, zerolog.Nop(), config.ProviderEndpoint{Name: config.ProviderBinance})
+	require.Error(t, err)
+}
+
+func TestParseGenericTimestamp(t *testing.T) {
+	for value, expected := range map[string]int64{
+		"1700000000":           1700000000000,
+		"1700000000.5":         1700000000500,
+		"1700000000123":        1700000000123,
+		"2023-11-14T22:13:20Z": 1700000000000,
+	} {
+		timestamp, err := parseGenericTimestamp(value)
+		require.NoError(t, err)
+		require.Equal(t, expected, timestamp, value)
+	}
+
+	_, err := parseGenericTimestamp("yesterday")
+	require.Error(t, err)
+}
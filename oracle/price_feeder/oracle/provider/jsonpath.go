@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type (
+	// JSONPath defines a JSONPath selector of a single value, it supports the
+	// child keys in dot or bracket notation and the array indexes, negative
+	// indexes select from the end of the array, ex. "$.data[0]['last price']"
+	JSONPath struct {
+		path  string
+		steps []jsonPathStep
+	}
+
+	// jsonPathStep defines an object key or an array index of a JSONPath
+	jsonPathStep struct {
+		key     string
+		index   int
+		isIndex bool
+	}
+)
+
+// ParseJSONPath parses the JSONPath selector
+func ParseJSONPath(path string) (JSONPath, error) {
+	rest := strings.TrimSpace(path)
+	if !strings.HasPrefix(rest, "$") {
+		return JSONPath{}, fmt.Errorf("invalid JSONPath %s: it must start with $", path)
+	}
+	rest = rest[1:]
+
+	steps := []jsonPathStep{}
+	for len(rest) > 0 {
+		switch rest[0] {
+		case '.':
+			// the key ends at the next child or index
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return JSONPath{}, fmt.Errorf("invalid JSONPath %s: empty key", path)
+			}
+			steps = append(steps, jsonPathStep{key: rest[:end]})
+			rest = rest[end:]
+
+		case '[':
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return JSONPath{}, fmt.Errorf("invalid JSONPath %s: unclosed bracket", path)
+			}
+			selector := strings.TrimSpace(rest[1:end])
+			rest = rest[end+1:]
+
+			if len(selector) >= 2 && (selector[0] == '\'' || selector[0] == '"') && selector[len(selector)-1] == selector[0] {
+				steps = append(steps, jsonPathStep{key: selector[1 : len(selector)-1]})
+				continue
+			}
+			index, err := strconv.Atoi(selector)
+			if err != nil {
+				return JSONPath{}, fmt.Errorf("invalid JSONPath %s: invalid index %s", path, selector)
+			}
+			steps = append(steps, jsonPathStep{index: index, isIndex: true})
+
+		default:
+			return JSONPath{}, fmt.Errorf("invalid JSONPath %s: unexpected %q", path, rest[0])
+		}
+	}
+
+	return JSONPath{path: path, steps: steps}, nil
+}
+
+// String returns the JSONPath selector
+func (p JSONPath) String() string {
+	return p.path
+}
+
+// Select returns the value selected on the JSON document as a string, the
+// document must be decoded with json.Number numbers to keep their precision
+func (p JSONPath) Select(document interface{}) (string, error) {
+	value := document
+	for _, step := range p.steps {
+		switch node := value.(type) {
+		case map[string]interface{}:
+			child, ok := node[step.key]
+			if step.isIndex || !ok {
+				return "", fmt.Errorf("%s not found", p.path)
+			}
+			value = child
+
+		case []interface{}:
+			index := step.index
+			if index < 0 {
+				index += len(node)
+			}
+			if !step.isIndex || index < 0 || index >= len(node) {
+				return "", fmt.Errorf("%s not found", p.path)
+			}
+			value = node[index]
+
+		default:
+			return "", fmt.Errorf("%s not found", p.path)
+		}
+	}
+
+	switch v := value.(type) {
+	case json.Number:
+		return v.String(), nil
+	case string:
+		return v, nil
+	default:
+		return "", fmt.Errorf("%s is not a number or a string", p.path)
+	}
+}
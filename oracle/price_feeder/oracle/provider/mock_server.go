@@ -10,11 +10,13 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"google.golang.org/grpc"
 )
 
 type MockProviderServer struct {
 	handlerFunc http.HandlerFunc
 	server      *httptest.Server
+	http2       bool
 }
 
 func NewMockProviderServer() MockProviderServer {
@@ -30,8 +32,17 @@ func (m *MockProviderServer) SetHandler(handler http.HandlerFunc) {
 	m.Start()
 }
 
+// SetGRPCServer serves the gRPC server over HTTP/2, it is used to mock the chain gRPC endpoint
+func (m *MockProviderServer) SetGRPCServer(grpcServer *grpc.Server) {
+	m.Close()
+	m.handlerFunc = grpcServer.ServeHTTP
+	m.http2 = true
+	m.Start()
+}
+
 func (m *MockProviderServer) Start() {
 	server := httptest.NewUnstartedServer(m.handlerFunc)
+	server.EnableHTTP2 = m.http2
 	server.StartTLS()
 	m.server = server
 	m.InjectServerCertificatesIntoDefaultDialer()
@@ -62,7 +73,16 @@ func (m *MockProviderServer) GetWebsocketURL() string {
 	return ""
 }
 
-func (m *MockProviderServer) InjectServerCertificatesIntoDefaultDialer() {
+// GetHTTPClient returns a http client which trusts the server certificate
+func (m *MockProviderServer) GetHTTPClient() *http.Client {
+	if m.server != nil {
+		return m.server.Client()
+	}
+	return nil
+}
+
+// GetCertPool returns the pool with the server root certificates
+func (m *MockProviderServer) GetCertPool() *x509.CertPool {
 	certs := x509.NewCertPool()
 	for _, c := range m.server.TLS.Certificates {
 		roots, err := x509.ParseCertificates(c.Certificate[len(c.Certificate)-1])
@@ -73,6 +93,11 @@ func (m *MockProviderServer) InjectServerCertificatesIntoDefaultDialer() {
 			certs.AddCert(root)
 		}
 	}
+	return certs
+}
+
+func (m *MockProviderServer) InjectServerCertificatesIntoDefaultDialer() {
+	certs := m.GetCertPool()
 
 	testDialer := websocket.Dialer{
 		Subprotocols:    []string{"p1", "p2"},
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/rs/zerolog"
+
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/types"
+)
+
+// pollingProvider stores the tickers and candles of the providers which poll
+// the prices of their pairs from a REST endpoint, a candle is recorded on
+// every poll.
+type pollingProvider struct {
+	logger zerolog.Logger
+	mtx    sync.RWMutex
+
+	subscribedPairs map[string]types.CurrencyPair // Symbol => types.CurrencyPair
+	tickers         map[string]TickerPrice        // Symbol => TickerPrice
+	candles         map[string][]CandlePrice      // Symbol => []CandlePrice
+}
+
+func newPollingProvider(logger zerolog.Logger) *pollingProvider {
+	return &pollingProvider{
+		logger:          logger,
+		subscribedPairs: map[string]types.CurrencyPair{},
+		tickers:         map[string]TickerPrice{},
+		candles:         map[string][]CandlePrice{},
+	}
+}
+
+// GetTickerPrices returns the last polled tickers of the given pairs.
+func (p *pollingProvider) GetTickerPrices(pairs ...types.CurrencyPair) (map[string]TickerPrice, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	tickerPrices := make(map[string]TickerPrice, len(pairs))
+	for _, cp := range pairs {
+		ticker, ok := p.tickers[cp.String()]
+		if !ok {
+			p.logger.Debug().Msg(fmt.Sprint("failed to fetch tickers for pair ", cp))
+			continue
+		}
+		tickerPrices[cp.String()] = ticker
+	}
+
+	return tickerPrices, nil
+}
+
+// GetCandlePrices returns the candles recorded by the polls of the given pairs.
+func (p *pollingProvider) GetCandlePrices(pairs ...types.CurrencyPair) (map[string][]CandlePrice, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	candlePrices := make(map[string][]CandlePrice, len(pairs))
+	for _, cp := range pairs {
+		candles, ok := p.candles[cp.String()]
+		if !ok {
+			p.logger.Debug().Msg(fmt.Sprint("failed to fetch candles for pair ", cp))
+			continue
+		}
+		candlePrices[cp.String()] = append([]CandlePrice{}, candles...)
+	}
+
+	return candlePrices, nil
+}
+
+// GetAvailablePairs returns the subscribed pairs, the polled endpoints can't
+// list the pairs they support.
+func (p *pollingProvider) GetAvailablePairs() (map[string]struct{}, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	availablePairs := make(map[string]struct{}, len(p.subscribedPairs))
+	for symbol := range p.subscribedPairs {
+		availablePairs[symbol] = struct{}{}
+	}
+
+	return availablePairs, nil
+}
+
+// SubscribeCurrencyPairs adds the pairs to the polled pairs.
+func (p *pollingProvider) SubscribeCurrencyPairs(cps ...types.CurrencyPair) error {
+	if len(cps) == 0 {
+		return fmt.Errorf("currency pairs is empty")
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, cp := range cps {
+		p.subscribedPairs[cp.String()] = cp
+	}
+	return nil
+}
+
+// pairs returns the subscribed pairs
+func (p *pollingProvider) pairs() []types.CurrencyPair {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	return types.MapPairsToSlice(p.subscribedPairs)
+}
+
+// setTickerPair stores the ticker and adds the candle to the recent candles of the pair
+func (p *pollingProvider) setTickerPair(key string, ticker TickerPrice, candle CandlePrice) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.tickers[key] = ticker
+
+	// the provider may report the same ticker on several polls
+	staleTime := PastUnixTime(providerCandlePeriod)
+	candleList := []CandlePrice{candle}
+	for _, c := range p.candles[key] {
+		if staleTime < c.TimeStamp && c.TimeStamp != candle.TimeStamp {
+			candleList = append(candleList, c)
+		}
+	}
+	p.candles[key] = candleList
+}
@@ -35,6 +35,13 @@ type Provider interface {
 	SubscribeCurrencyPairs(...types.CurrencyPair) error
 }
 
+// WebsocketStatusProvider defines an interface implemented by the providers
+// which stream their prices through a WebsocketController.
+type WebsocketStatusProvider interface {
+	// WebsocketStatus returns the connection state of the provider websocket.
+	WebsocketStatus() WebsocketStatus
+}
+
 // TickerPrice defines price and volume information for a symbol or ticker
 // exchange rate.
 type TickerPrice struct {
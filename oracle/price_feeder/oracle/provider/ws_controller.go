@@ -23,6 +23,13 @@ const (
 type (
 	MessageHandler func(int, []byte)
 
+	// WebsocketStatus defines the connection state of a WebsocketController
+	WebsocketStatus struct {
+		Connected       bool      `json:"connected"`
+		LastMessageTime time.Time `json:"last_message_time"`
+		Reconnects      uint64    `json:"reconnects"`
+	}
+
 	// WebsocketController defines a provider agnostic websocket handler
 	// that manages reconnecting, subscribing, and receiving messages
 	WebsocketController struct {
@@ -41,6 +48,10 @@ type (
 		client           *websocket.Conn
 		reconnectCounter uint
 		dialer           *websocket.Dialer
+
+		// connection state reported by Status
+		lastMessageTime time.Time
+		reconnects      uint64
 	}
 )
 
@@ -254,9 +265,25 @@ func (wsc *WebsocketController) readSuccess(messageType int, bz []byte) {
 		return
 	}
 
+	wsc.mtx.Lock()
+	wsc.lastMessageTime = time.Now()
+	wsc.mtx.Unlock()
+
 	wsc.messageHandler(messageType, bz)
 }
 
+// Status returns the current connection state of the websocket
+func (wsc *WebsocketController) Status() WebsocketStatus {
+	wsc.mtx.Lock()
+	defer wsc.mtx.Unlock()
+
+	return WebsocketStatus{
+		Connected:       wsc.client != nil,
+		LastMessageTime: wsc.lastMessageTime,
+		Reconnects:      wsc.reconnects,
+	}
+}
+
 // close sends a close message to the websocket and sets the client to nil
 func (wsc *WebsocketController) close() {
 	wsc.mtx.Lock()
@@ -275,6 +302,11 @@ func (wsc *WebsocketController) close() {
 // reconnect closes the current websocket and starts a new connection process
 func (wsc *WebsocketController) reconnect() {
 	wsc.close()
+
+	wsc.mtx.Lock()
+	wsc.reconnects++
+	wsc.mtx.Unlock()
+
 	go wsc.Start()
 }
 
@@ -58,3 +58,27 @@ func TestWebsocketController_readSuccess(t *testing.T) {
 		})
 	}
 }
+
+func TestWebsocketController_Status(t *testing.T) {
+	provider := TestProvider{}
+	c := &WebsocketController{
+		providerName:   config.ProviderMock,
+		messageHandler: provider.messageHandler,
+	}
+
+	// the controller is disconnected until it dials the websocket
+	status := c.Status()
+	require.False(t, status.Connected)
+	require.True(t, status.LastMessageTime.IsZero())
+	require.Zero(t, status.Reconnects)
+
+	// the pong messages are not tracked
+	c.readSuccess(1, []byte("pong"))
+	require.True(t, c.Status().LastMessageTime.IsZero())
+
+	c.client = new(websocket.Conn)
+	c.readSuccess(1, []byte("asdf"))
+	status = c.Status()
+	require.True(t, status.Connected)
+	require.False(t, status.LastMessageTime.IsZero())
+}
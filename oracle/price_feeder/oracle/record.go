@@ -0,0 +1,80 @@
+package oracle
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/provider"
+)
+
+// maxRecordSize is the maximum size of a market data record line
+const maxRecordSize = 64 * 1024 * 1024
+
+type (
+	// ProviderMarketData defines the tickers and candles returned by a provider,
+	// both are keyed by the currency pair symbol, ex. "ATOMUSDT"
+	ProviderMarketData struct {
+		Tickers map[string]provider.TickerPrice   `json:"tickers"`
+		Candles map[string][]provider.CandlePrice `json:"candles"`
+	}
+
+	// MarketDataRecord defines the market data fetched from the providers on
+	// an oracle tick, it is stored as a JSON line by the Recorder
+	MarketDataRecord struct {
+		Timestamp time.Time                     `json:"timestamp"`
+		Providers map[string]ProviderMarketData `json:"providers"`
+	}
+)
+
+// Recorder writes the market data fetched by the oracle as JSON lines, the
+// records can be replayed by the backtest
+type Recorder struct {
+	mtx sync.Mutex
+	enc *json.Encoder
+}
+
+// NewRecorder creates a new instance of the Recorder which writes to w
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{enc: json.NewEncoder(w)}
+}
+
+// Record writes the record as a JSON line
+func (r *Recorder) Record(record MarketDataRecord) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	return r.enc.Encode(record)
+}
+
+// SetRecorder sets the recorder of the market data fetched on every tick
+func (o *Oracle) SetRecorder(recorder *Recorder) {
+	o.recorder = recorder
+}
+
+// ReadMarketDataRecords reads the JSON lines written by the Recorder
+func ReadMarketDataRecords(r io.Reader) ([]MarketDataRecord, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxRecordSize)
+
+	records := []MarketDataRecord{}
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var record MarketDataRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("invalid market data record on line %d: %w", line, err)
+		}
+		records = append(records, record)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
@@ -0,0 +1,121 @@
+package oracle
+
+import (
+	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/oracle/price_feeder/config"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/types"
+)
+
+// Reload applies the currency pairs, deviation thresholds and provider endpoints
+// of a new configuration without restarting the running providers. It waits for
+// the ongoing tick, the voting state of the validators is kept.
+//
+// The running providers subscribe to their new pairs. The providers which are no
+// longer listed are stopped, as well as the providers with a new endpoint, with
+// removed pairs or which failed to subscribe, the new and stopped providers are
+// started by the next tick with their current pairs.
+func (o *Oracle) Reload(
+	currencyPairs []config.CurrencyPair,
+	deviations map[string]sdk.Dec,
+	endpoints map[string]config.ProviderEndpoint,
+) {
+	o.tickMtx.Lock()
+	defer o.tickMtx.Unlock()
+
+	chainDenomMapping, providerPairs := createMappingsFromPairs(currencyPairs)
+
+	for providerName, currentPairs := range o.providerPairs {
+		newPairs, ok := providerPairs[providerName]
+
+		switch {
+		case !ok:
+			o.logger.Info().Str("provider", providerName).Msg("stopping removed provider")
+			o.stopProvider(providerName)
+
+		case !reflect.DeepEqual(o.endpoints[providerName], endpoints[providerName]):
+			o.logger.Info().Str("provider", providerName).Msg("restarting provider with a new endpoint")
+			o.stopProvider(providerName)
+
+		case len(missingPairs(newPairs, currentPairs)) > 0:
+			// the providers can't unsubscribe from the removed pairs
+			o.logger.Info().Str("provider", providerName).Msg("restarting provider without the removed pairs")
+			o.stopProvider(providerName)
+
+		default:
+			o.subscribeNewPairs(providerName, newPairs, currentPairs)
+		}
+	}
+
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	o.providerPairs = providerPairs
+	o.chainDenomMapping = chainDenomMapping
+	o.aggregations = createAggregationsFromPairs(currencyPairs)
+	o.deviations = deviations
+	o.endpoints = endpoints
+
+	o.logger.Info().Int("currency_pairs", len(currencyPairs)).Msg("reloaded price-feeder configuration")
+}
+
+// subscribeNewPairs subscribes the running provider to the pairs it isn't subscribed
+// to, the provider is stopped when the subscription fails
+func (o *Oracle) subscribeNewPairs(providerName string, newPairs, currentPairs []types.CurrencyPair) {
+	addedPairs := missingPairs(currentPairs, newPairs)
+	if len(addedPairs) == 0 {
+		return
+	}
+
+	o.mtx.Lock()
+	_, failed := o.failedProviders[providerName]
+	delete(o.failedProviders, providerName) // the failed providers are retried with the new pairs
+	priceProvider, ok := o.priceProviders[providerName]
+	o.mtx.Unlock()
+	if failed || !ok {
+		return
+	}
+
+	if err := priceProvider.SubscribeCurrencyPairs(addedPairs...); err != nil {
+		o.logger.Warn().Err(err).Str("provider", providerName).Msg("failed to subscribe to the new pairs, restarting provider")
+		o.stopProvider(providerName)
+		return
+	}
+
+	o.logger.Info().
+		Str("provider", providerName).
+		Interface("pairs", addedPairs).
+		Msg("subscribed provider to the new pairs")
+}
+
+// missingPairs returns the pairs which are not listed on the current pairs
+func missingPairs(currentPairs, pairs []types.CurrencyPair) []types.CurrencyPair {
+	current := make(map[string]struct{}, len(currentPairs))
+	for _, pair := range currentPairs {
+		current[pair.String()] = struct{}{}
+	}
+
+	missing := []types.CurrencyPair{}
+	for _, pair := range pairs {
+		if _, ok := current[pair.String()]; !ok {
+			missing = append(missing, pair)
+		}
+	}
+	return missing
+}
+
+// stopProvider cancels the context of the provider and removes it, so it is
+// created again if it is requested
+func (o *Oracle) stopProvider(providerName string) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	if cancel, ok := o.providerCancels[providerName]; ok {
+		cancel()
+	}
+	delete(o.providerCancels, providerName)
+	delete(o.priceProviders, providerName)
+	delete(o.failedProviders, providerName)
+}
@@ -0,0 +1,118 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiichain/kiichain/oracle/price_feeder/config"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/client"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/provider"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/types"
+)
+
+// subscriberProvider is a provider which keeps the pairs it is subscribed to
+type subscriberProvider struct {
+	provider.MockProvider
+	subscribed   []types.CurrencyPair
+	subscribeErr error
+}
+
+func (p *subscriberProvider) SubscribeCurrencyPairs(cps ...types.CurrencyPair) error {
+	if p.subscribeErr != nil {
+		return p.subscribeErr
+	}
+	p.subscribed = append(p.subscribed, cps...)
+	return nil
+}
+
+func TestReload(t *testing.T) {
+	pairs := []config.CurrencyPair{
+		{Base: "ATOM", ChainDenom: "uatom", Quote: "USDT", Providers: []string{config.ProviderBinance, config.ProviderKraken, config.ProviderOkx}},
+		{Base: "USDT", ChainDenom: "uusdt", Quote: "USD", Providers: []string{config.ProviderBinance, config.ProviderKraken, config.ProviderOkx}},
+	}
+	endpoints := map[string]config.ProviderEndpoint{
+		config.ProviderKraken: {Name: config.ProviderKraken, Rest: "https://kraken.example", Websocket: "kraken.example"},
+	}
+
+	oracle := New(
+		zerolog.Nop(),
+		client.OracleClient{Accounts: []client.FeederAccount{{ValidatorAddrString: "kiivaloper1"}}},
+		pairs,
+		0,
+		make(map[string]sdk.Dec),
+		endpoints,
+		nil,
+	)
+	oracle.voters[0].previousVotePeriod = 10
+
+	// start the running providers
+	cancelled := make(map[string]bool)
+	providers := make(map[string]*subscriberProvider)
+	for _, providerName := range []string{config.ProviderBinance, config.ProviderKraken, config.ProviderOkx} {
+		providerName := providerName
+		providers[providerName] = &subscriberProvider{}
+		oracle.priceProviders[providerName] = providers[providerName]
+		oracle.providerCancels[providerName] = func() { cancelled[providerName] = true }
+	}
+	providers[config.ProviderOkx].subscribeErr = fmt.Errorf("test error")
+
+	// add ETH to the providers and remove binance, the kraken endpoint is removed
+	newPairs := []config.CurrencyPair{
+		{Base: "ATOM", ChainDenom: "uatom", Quote: "USDT", Providers: []string{config.ProviderKraken, config.ProviderOkx, config.ProviderGate}},
+		{Base: "ETH", ChainDenom: "ueth", Quote: "USDT", Providers: []string{config.ProviderKraken, config.ProviderOkx, config.ProviderGate}, Aggregation: config.AggregationMedian},
+		{Base: "USDT", ChainDenom: "uusdt", Quote: "USD", Providers: []string{config.ProviderKraken, config.ProviderOkx, config.ProviderGate}},
+	}
+	newDeviations := map[string]sdk.Dec{"ETH": sdk.MustNewDecFromStr("2")}
+	oracle.Reload(newPairs, newDeviations, make(map[string]config.ProviderEndpoint))
+
+	// the removed provider and the provider with a new endpoint are stopped
+	require.True(t, cancelled[config.ProviderBinance])
+	require.True(t, cancelled[config.ProviderKraken])
+	require.NotContains(t, oracle.priceProviders, config.ProviderBinance)
+	require.NotContains(t, oracle.priceProviders, config.ProviderKraken)
+
+	// the provider which failed to subscribe is restarted by the next tick
+	require.True(t, cancelled[config.ProviderOkx])
+	require.NotContains(t, oracle.priceProviders, config.ProviderOkx)
+	require.Empty(t, providers[config.ProviderKraken].subscribed)
+
+	// the new settings are applied and the voting state is kept
+	_, expectedProviderPairs := createMappingsFromPairs(newPairs)
+	require.Equal(t, expectedProviderPairs, oracle.providerPairs)
+	require.Equal(t, "ueth", oracle.chainDenomMapping["ETH"])
+	require.Equal(t, map[string]string{"ETH": config.AggregationMedian}, oracle.aggregations)
+	require.Equal(t, newDeviations, oracle.deviations)
+	require.Equal(t, float64(10), oracle.voters[0].previousVotePeriod)
+}
+
+func TestReloadSubscribeNewPairs(t *testing.T) {
+	pairs := []config.CurrencyPair{
+		{Base: "ATOM", ChainDenom: "uatom", Quote: "USDT", Providers: []string{config.ProviderMock}},
+	}
+	oracle := New(zerolog.Nop(), client.OracleClient{}, pairs, 0, make(map[string]sdk.Dec), nil, nil)
+
+	mockProvider := &subscriberProvider{}
+	providerCtx, cancel := context.WithCancel(context.Background())
+	oracle.priceProviders[config.ProviderMock] = mockProvider
+	oracle.providerCancels[config.ProviderMock] = cancel
+
+	// only the new pair is subscribed
+	newPairs := append(pairs, config.CurrencyPair{Base: "ETH", ChainDenom: "ueth", Quote: "USDT", Providers: []string{config.ProviderMock}})
+	oracle.Reload(newPairs, make(map[string]sdk.Dec), nil)
+
+	require.Equal(t, []types.CurrencyPair{{Base: "ETH", Quote: "USDT"}}, mockProvider.subscribed)
+	require.Contains(t, oracle.priceProviders, config.ProviderMock)
+	require.NoError(t, providerCtx.Err())
+
+	// removing a pair restarts the provider, so the removed pair is no longer requested
+	oracle.Reload(pairs[:1], make(map[string]sdk.Dec), nil)
+	require.Len(t, mockProvider.subscribed, 1)
+	require.ErrorIs(t, providerCtx.Err(), context.Canceled)
+	require.NotContains(t, oracle.priceProviders, config.ProviderMock)
+	require.Equal(t, []types.CurrencyPair{{Base: "ATOM", Quote: "USDT"}}, oracle.providerPairs[config.ProviderMock])
+}
@@ -0,0 +1,206 @@
+package oracle
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/oracle/price_feeder/config"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/client"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/provider"
+)
+
+const (
+	// sources of the computed prices
+	PriceSourceCandle = "candle"
+	PriceSourceTicker = "ticker"
+)
+
+type (
+	// ProviderPrice defines the USD price and volume reported by a provider for
+	// an asset, the filtered providers were discarded by the deviation filter.
+	ProviderPrice struct {
+		Provider string  `json:"provider"`
+		Price    sdk.Dec `json:"price"`
+		Volume   sdk.Dec `json:"volume"`
+		Filtered bool    `json:"filtered"`
+	}
+
+	// PriceReport defines the latest computed price of an asset together with
+	// the prices of its providers.
+	PriceReport struct {
+		Base        string          `json:"base"`
+		ChainDenom  string          `json:"chain_denom"`
+		Price       sdk.Dec         `json:"price"`
+		Source      string          `json:"source"`
+		Aggregation string          `json:"aggregation"`
+		Providers   []ProviderPrice `json:"providers"`
+	}
+
+	// ProviderStatus defines the state of a price provider, the websocket
+	// state is only reported by the providers which implement it.
+	ProviderStatus struct {
+		Name        string                    `json:"name"`
+		Initialized bool                      `json:"initialized"`
+		Error       string                    `json:"error,omitempty"`
+		Websocket   *provider.WebsocketStatus `json:"websocket,omitempty"`
+	}
+
+	// VoteStatus defines the last vote broadcasted on behalf of a validator.
+	VoteStatus struct {
+		Validator    string    `json:"validator"`
+		Feeder       string    `json:"feeder"`
+		VotePeriod   uint64    `json:"vote_period"`
+		Height       int64     `json:"height"`
+		TxHash       string    `json:"tx_hash"`
+		ResponseCode uint32    `json:"response_code"`
+		Error        string    `json:"error,omitempty"`
+		Timestamp    time.Time `json:"timestamp"`
+	}
+)
+
+// GetLastPriceSyncTimestamp returns the time at which the prices were last updated.
+func (o *Oracle) GetLastPriceSyncTimestamp() time.Time {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	return o.lastPriceSyncTS
+}
+
+// GetPriceReports returns the latest computed prices sorted by base, along with
+// the prices of their providers.
+func (o *Oracle) GetPriceReports() []PriceReport {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	reports := make([]PriceReport, 0, len(o.priceReports))
+	for _, report := range o.priceReports {
+		reports = append(reports, report)
+	}
+	sort.Slice(reports, func(i, j int) bool { return reports[i].Base < reports[j].Base })
+
+	return reports
+}
+
+// GetProviderStatuses returns the state of the configured providers sorted by name.
+func (o *Oracle) GetProviderStatuses() []ProviderStatus {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	statuses := make([]ProviderStatus, 0, len(o.providerPairs))
+	for providerName := range o.providerPairs {
+		status := ProviderStatus{Name: providerName}
+		if err, ok := o.failedProviders[providerName]; ok {
+			status.Error = err.Error()
+		}
+
+		if priceProvider, ok := o.priceProviders[providerName]; ok {
+			status.Initialized = true
+			if wsProvider, ok := priceProvider.(provider.WebsocketStatusProvider); ok {
+				wsStatus := wsProvider.WebsocketStatus()
+				status.Websocket = &wsStatus
+			}
+		}
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+
+	return statuses
+}
+
+// GetVoteStatuses returns the last vote broadcasted for each validator, the
+// validators which haven't broadcasted a vote yet are not included.
+func (o *Oracle) GetVoteStatuses() []VoteStatus {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	statuses := make([]VoteStatus, 0, len(o.voters))
+	for _, v := range o.voters {
+		if v.lastVote != nil {
+			statuses = append(statuses, *v.lastVote)
+		}
+	}
+
+	return statuses
+}
+
+// GetEndpointStatuses returns the health of the nodes the oracle client connects to
+func (o *Oracle) GetEndpointStatuses() []client.EndpointStatus {
+	if o.oracleClient.Endpoints == nil {
+		return []client.EndpointStatus{}
+	}
+	return o.oracleClient.Endpoints.Status()
+}
+
+// newVoteStatus creates the status of the vote broadcasted for the voter's validator
+func newVoteStatus(v *voter, votePeriod float64, blockHeight int64, resp *sdk.TxResponse, err error) VoteStatus {
+	status := VoteStatus{
+		Validator:  v.account.ValidatorAddrString,
+		Feeder:     v.account.OracleAddrString,
+		VotePeriod: uint64(votePeriod),
+		Height:     blockHeight,
+		Timestamp:  time.Now().UTC(),
+	}
+	if resp != nil {
+		status.TxHash = resp.TxHash
+		status.ResponseCode = resp.Code
+	}
+	if err != nil {
+		status.Error = err.Error()
+	}
+	return status
+}
+
+// setLastVote stores the last vote broadcasted for the voter's validator
+func (o *Oracle) setLastVote(v *voter, status VoteStatus) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	v.lastVote = &status
+}
+
+// providerPriceBreakdown returns the prices of every provider by base sorted by
+// provider, the isFiltered function tells whether the deviation filter discarded it
+func providerPriceBreakdown(
+	points map[string][]PricePoint,
+	isFiltered func(providerName, base string) bool,
+) map[string][]ProviderPrice {
+	breakdown := make(map[string][]ProviderPrice, len(points))
+	for base, basePoints := range points {
+		for _, point := range basePoints {
+			breakdown[base] = append(breakdown[base], ProviderPrice{
+				Provider: point.Provider,
+				Price:    point.Price,
+				Volume:   point.Volume,
+				Filtered: isFiltered(point.Provider, base),
+			})
+		}
+		sort.Slice(breakdown[base], func(i, j int) bool {
+			return breakdown[base][i].Provider < breakdown[base][j].Provider
+		})
+	}
+	return breakdown
+}
+
+// newPriceReport creates the report of a computed price
+func newPriceReport(
+	base string,
+	price sdk.Dec,
+	source string,
+	aggregations map[string]string,
+	breakdown map[string][]ProviderPrice,
+) PriceReport {
+	aggregation := config.AggregationVWAP
+	if hasAggregationStrategy(aggregations, base) {
+		aggregation = aggregations[base]
+	}
+
+	return PriceReport{
+		Base:        base,
+		Price:       price,
+		Source:      source,
+		Aggregation: aggregation,
+		Providers:   breakdown[base],
+	}
+}
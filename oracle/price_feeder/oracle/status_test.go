@@ -0,0 +1,144 @@
+package oracle
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiichain/kiichain/oracle/price_feeder/config"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/provider"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/types"
+)
+
+func TestComputePricesReports(t *testing.T) {
+	pair := types.CurrencyPair{Base: "XAUT", Quote: "USD"}
+	providerPairs := map[string][]types.CurrencyPair{
+		config.ProviderHuobi: {pair},
+		config.ProviderOkx:   {pair},
+		config.ProviderGate:  {pair},
+	}
+
+	tickerPrice := func(price string) map[string]provider.TickerPrice {
+		return map[string]provider.TickerPrice{
+			pair.Base: {Price: sdk.MustNewDecFromStr(price), Volume: sdk.MustNewDecFromStr("1")},
+		}
+	}
+	providerPrices := provider.AggregatedProviderPrices{
+		config.ProviderHuobi: tickerPrice("2000"),
+		config.ProviderOkx:   tickerPrice("2002"),
+		config.ProviderGate:  tickerPrice("2600"),
+	}
+
+	expectedProviders := func(gateFiltered bool) []ProviderPrice {
+		return []ProviderPrice{
+			{Provider: config.ProviderGate, Price: sdk.MustNewDecFromStr("2600"), Volume: sdk.OneDec(), Filtered: gateFiltered},
+			{Provider: config.ProviderHuobi, Price: sdk.MustNewDecFromStr("2000"), Volume: sdk.OneDec()},
+			{Provider: config.ProviderOkx, Price: sdk.MustNewDecFromStr("2002"), Volume: sdk.OneDec()},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		aggregations   map[string]string
+		expectedReport PriceReport
+	}{
+		{
+			// the outlier is discarded by the standard deviation filter
+			name:         "default vwap",
+			aggregations: map[string]string{},
+			expectedReport: PriceReport{
+				Base:        pair.Base,
+				Price:       sdk.MustNewDecFromStr("2001"),
+				Source:      PriceSourceTicker,
+				Aggregation: config.AggregationVWAP,
+				Providers:   expectedProviders(true),
+			},
+		},
+		{
+			// the aggregation strategies don't use the deviation filter
+			name:         "median",
+			aggregations: map[string]string{pair.Base: config.AggregationMedian},
+			expectedReport: PriceReport{
+				Base:        pair.Base,
+				Price:       sdk.MustNewDecFromStr("2002"),
+				Source:      PriceSourceTicker,
+				Aggregation: config.AggregationMedian,
+				Providers:   expectedProviders(false),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, reports, err := computePrices(
+				zerolog.Nop(),
+				make(provider.AggregatedProviderCandles),
+				providerPrices,
+				providerPairs,
+				make(map[string]sdk.Dec),
+				tc.aggregations,
+				map[string]struct{}{pair.Base: {}},
+			)
+			require.NoError(t, err)
+			require.Equal(t, map[string]PriceReport{pair.Base: tc.expectedReport}, reports)
+		})
+	}
+}
+
+func TestComputePricesReportsCandles(t *testing.T) {
+	pair := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	candles := map[string][]provider.CandlePrice{
+		pair.Base: {
+			{
+				Price:     sdk.MustNewDecFromStr("10"),
+				Volume:    sdk.MustNewDecFromStr("2"),
+				TimeStamp: provider.PastUnixTime(1 * time.Minute),
+			},
+		},
+	}
+
+	_, reports, err := computePrices(
+		zerolog.Nop(),
+		provider.AggregatedProviderCandles{config.ProviderBinance: candles},
+		make(provider.AggregatedProviderPrices),
+		map[string][]types.CurrencyPair{config.ProviderBinance: {pair}},
+		make(map[string]sdk.Dec),
+		make(map[string]string),
+		map[string]struct{}{pair.Base: {}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, PriceReport{
+		Base:        pair.Base,
+		Price:       sdk.MustNewDecFromStr("10"),
+		Source:      PriceSourceCandle,
+		Aggregation: config.AggregationVWAP,
+		Providers: []ProviderPrice{
+			{Provider: config.ProviderBinance, Price: sdk.MustNewDecFromStr("10"), Volume: sdk.MustNewDecFromStr("2")},
+		},
+	}, reports[pair.Base])
+}
+
+func TestGetProviderStatuses(t *testing.T) {
+	pair := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	oracle := &Oracle{
+		providerPairs: map[string][]types.CurrencyPair{
+			config.ProviderMock:    {pair},
+			config.ProviderBinance: {pair},
+		},
+		priceProviders: map[string]provider.Provider{
+			config.ProviderMock: provider.NewMockProvider(),
+		},
+		failedProviders: map[string]error{
+			config.ProviderBinance: fmt.Errorf("test error"),
+		},
+	}
+
+	require.Equal(t, []ProviderStatus{
+		{Name: config.ProviderBinance, Error: "test error"},
+		{Name: config.ProviderMock, Initialized: true},
+	}, oracle.GetProviderStatuses())
+}
@@ -0,0 +1,73 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/client"
+)
+
+// health statuses reported by the healthz endpoint
+const (
+	StatusAvailable   = "available"
+	StatusUnavailable = "unavailable"
+)
+
+type (
+	// HealthZResponse defines the response type for the healthz endpoint.
+	HealthZResponse struct {
+		Status        string `json:"status"`
+		LastPriceSync string `json:"last_price_sync,omitempty"`
+	}
+
+	// PricesResponse defines the response type for the prices endpoint.
+	PricesResponse struct {
+		Prices  sdk.DecCoins         `json:"prices"`
+		Reports []oracle.PriceReport `json:"reports"`
+	}
+
+	// ProvidersResponse defines the response type for the providers endpoint.
+	ProvidersResponse struct {
+		Providers []oracle.ProviderStatus `json:"providers"`
+	}
+
+	// VotesResponse defines the response type for the votes endpoint.
+	VotesResponse struct {
+		Votes []oracle.VoteStatus `json:"votes"`
+	}
+
+	// EndpointsResponse defines the response type for the endpoints endpoint.
+	EndpointsResponse struct {
+		Endpoints []client.EndpointStatus `json:"endpoints"`
+	}
+
+	// ErrorResponse defines the response type of the failed requests.
+	ErrorResponse struct {
+		Error string `json:"error"`
+	}
+)
+
+// writeJSONResponse writes the response encoded as JSON with the given status code
+func writeJSONResponse(w http.ResponseWriter, statusCode int, logger zerolog.Logger, resp interface{}) {
+	bz, err := json.Marshal(resp)
+	if err != nil {
+		logger.Err(err).Msg("failed to encode response")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if _, err := w.Write(bz); err != nil {
+		logger.Err(err).Msg("failed to write response")
+	}
+}
+
+// writeErrorResponse writes the error message as JSON with the given status code
+func writeErrorResponse(w http.ResponseWriter, statusCode int, logger zerolog.Logger, msg string) {
+	writeJSONResponse(w, statusCode, logger, ErrorResponse{Error: msg})
+}
@@ -0,0 +1,151 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/mux"
+	"github.com/rs/zerolog"
+
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/client"
+)
+
+// maxPriceSyncAge is the time after the last price update at which the
+// price-feeder is reported as unhealthy
+const maxPriceSyncAge = time.Minute
+
+type (
+	// Oracle defines the Oracle interface contract that the router depends on.
+	Oracle interface {
+		GetLastPriceSyncTimestamp() time.Time
+		GetPrices() sdk.DecCoins
+		GetPriceReports() []oracle.PriceReport
+		GetProviderStatuses() []oracle.ProviderStatus
+		GetVoteStatuses() []oracle.VoteStatus
+		GetEndpointStatuses() []client.EndpointStatus
+	}
+
+	// Metrics defines the interface of the telemetry metrics gatherer.
+	Metrics interface {
+		Gather(format string) (telemetry.GatherResponse, error)
+	}
+
+	// Router defines a router wrapper used for registering the price-feeder
+	// status API routes.
+	Router struct {
+		logger  zerolog.Logger
+		oracle  Oracle
+		metrics Metrics
+	}
+)
+
+// New creates a new instance of the Router, the metrics are nil when the
+// telemetry is disabled
+func New(logger zerolog.Logger, oracle Oracle, metrics Metrics) *Router {
+	return &Router{
+		logger:  logger.With().Str("module", "router").Logger(),
+		oracle:  oracle,
+		metrics: metrics,
+	}
+}
+
+// RegisterRoutes register the status API routes
+func (r *Router) RegisterRoutes(rtr *mux.Router) {
+	rtr.Handle("/healthz", r.healthzHandler()).Methods(http.MethodGet)
+	rtr.Handle("/prices", r.pricesHandler()).Methods(http.MethodGet)
+	rtr.Handle("/providers", r.providersHandler()).Methods(http.MethodGet)
+	rtr.Handle("/votes", r.votesHandler()).Methods(http.MethodGet)
+	rtr.Handle("/endpoints", r.endpointsHandler()).Methods(http.MethodGet)
+	rtr.Handle("/metrics", r.metricsHandler()).Methods(http.MethodGet)
+}
+
+// healthzHandler reports the price-feeder as unhealthy when the prices
+// haven't been updated recently
+func (r *Router) healthzHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		lastSync := r.oracle.GetLastPriceSyncTimestamp()
+
+		resp := HealthZResponse{Status: StatusAvailable}
+		if !lastSync.IsZero() {
+			resp.LastPriceSync = lastSync.UTC().Format(time.RFC3339)
+		}
+
+		statusCode := http.StatusOK
+		if lastSync.IsZero() || time.Since(lastSync) > maxPriceSyncAge {
+			resp.Status = StatusUnavailable
+			statusCode = http.StatusServiceUnavailable
+		}
+
+		writeJSONResponse(w, statusCode, r.logger, resp)
+	}
+}
+
+// pricesHandler returns the latest computed prices and their providers prices
+func (r *Router) pricesHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		resp := PricesResponse{
+			Prices:  r.oracle.GetPrices(),
+			Reports: r.oracle.GetPriceReports(),
+		}
+
+		writeJSONResponse(w, http.StatusOK, r.logger, resp)
+	}
+}
+
+// providersHandler returns the state of the price providers
+func (r *Router) providersHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		resp := ProvidersResponse{Providers: r.oracle.GetProviderStatuses()}
+
+		writeJSONResponse(w, http.StatusOK, r.logger, resp)
+	}
+}
+
+// votesHandler returns the last vote broadcasted for each validator
+func (r *Router) votesHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		resp := VotesResponse{Votes: r.oracle.GetVoteStatuses()}
+
+		writeJSONResponse(w, http.StatusOK, r.logger, resp)
+	}
+}
+
+// endpointsHandler returns the health of the nodes the price-feeder connects to
+func (r *Router) endpointsHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		resp := EndpointsResponse{Endpoints: r.oracle.GetEndpointStatuses()}
+
+		writeJSONResponse(w, http.StatusOK, r.logger, resp)
+	}
+}
+
+// metricsHandler returns the telemetry metrics, on the Prometheus exposition
+// format unless another format is requested
+func (r *Router) metricsHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if r.metrics == nil {
+			writeErrorResponse(w, http.StatusBadRequest, r.logger, "telemetry is disabled")
+			return
+		}
+
+		format := req.URL.Query().Get("format")
+		if format == "" {
+			format = telemetry.FormatPrometheus
+		}
+
+		gr, err := r.metrics.Gather(format)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, r.logger, fmt.Sprintf("failed to gather metrics: %s", err))
+			return
+		}
+
+		w.Header().Set("Content-Type", gr.ContentType)
+		if _, err := w.Write(gr.Metrics); err != nil {
+			r.logger.Err(err).Msg("failed to write metrics response")
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package router_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/mux"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/client"
+	"github.com/kiichain/kiichain/oracle/price_feeder/oracle/provider"
+	"github.com/kiichain/kiichain/oracle/price_feeder/router"
+)
+
+var (
+	_ router.Oracle  = (*mockOracle)(nil)
+	_ router.Metrics = (*mockMetrics)(nil)
+)
+
+type mockOracle struct {
+	lastSync time.Time
+}
+
+func (m mockOracle) GetLastPriceSyncTimestamp() time.Time {
+	return m.lastSync
+}
+
+func (m mockOracle) GetPrices() sdk.DecCoins {
+	return sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("10.5")))
+}
+
+func (m mockOracle) GetPriceReports() []oracle.PriceReport {
+	return []oracle.PriceReport{
+		{
+			Base:        "ATOM",
+			ChainDenom:  "uatom",
+			Price:       sdk.MustNewDecFromStr("10.5"),
+			Source:      oracle.PriceSourceTicker,
+			Aggregation: "vwap",
+			Providers: []oracle.ProviderPrice{
+				{Provider: "binance", Price: sdk.MustNewDecFromStr("10.5"), Volume: sdk.OneDec()},
+				{Provider: "kraken", Price: sdk.MustNewDecFromStr("20"), Volume: sdk.OneDec(), Filtered: true},
+			},
+		},
+	}
+}
+
+func (m mockOracle) GetProviderStatuses() []oracle.ProviderStatus {
+	return []oracle.ProviderStatus{
+		{
+			Name:        "crypto",
+			Initialized: true,
+			Websocket:   &provider.WebsocketStatus{Connected: true, Reconnects: 2},
+		},
+	}
+}
+
+func (m mockOracle) GetVoteStatuses() []oracle.VoteStatus {
+	return []oracle.VoteStatus{
+		{Validator: "kiivaloper1", VotePeriod: 10, TxHash: "0xhash", ResponseCode: 5},
+	}
+}
+
+func (m mockOracle) GetEndpointStatuses() []client.EndpointStatus {
+	return []client.EndpointStatus{
+		{TMRPC: "http://node1:26657", GRPC: "node1:9090", Score: 5},
+		{TMRPC: "http://node2:26657", GRPC: "node2:9090", Score: 10, Current: true},
+	}
+}
+
+type mockMetrics struct{}
+
+func (mockMetrics) Gather(format string) (telemetry.GatherResponse, error) {
+	if format != telemetry.FormatPrometheus {
+		return telemetry.GatherResponse{}, fmt.Errorf("unsupported format")
+	}
+	return telemetry.GatherResponse{Metrics: []byte("price_feeder_tick 1"), ContentType: "text/plain"}, nil
+}
+
+type RouterTestSuite struct {
+	suite.Suite
+
+	mux    *mux.Router
+	oracle *mockOracle
+}
+
+// SetupSuite executes once before the suite's tests are executed.
+func (rts *RouterTestSuite) SetupSuite() {
+	rts.oracle = &mockOracle{lastSync: time.Now()}
+	rts.mux = mux.NewRouter()
+
+	r := router.New(zerolog.Nop(), rts.oracle, mockMetrics{})
+	r.RegisterRoutes(rts.mux)
+}
+
+func TestRouterTestSuite(t *testing.T) {
+	suite.Run(t, new(RouterTestSuite))
+}
+
+// executeRequest executes the request on the router and returns the recorded response
+func (rts *RouterTestSuite) executeRequest(req *http.Request) *httptest.ResponseRecorder {
+	rr := httptest.NewRecorder()
+	rts.mux.ServeHTTP(rr, req)
+	return rr
+}
+
+func (rts *RouterTestSuite) TestHealthz() {
+	req, err := http.NewRequest(http.MethodGet, "/healthz", nil)
+	rts.Require().NoError(err)
+
+	response := rts.executeRequest(req)
+	rts.Require().Equal(http.StatusOK, response.Code)
+
+	var respBody router.HealthZResponse
+	rts.Require().NoError(json.Unmarshal(response.Body.Bytes(), &respBody))
+	rts.Require().Equal(router.StatusAvailable, respBody.Status)
+	rts.Require().NotEmpty(respBody.LastPriceSync)
+
+	// the prices haven't been updated recently
+	rts.oracle.lastSync = time.Now().Add(-time.Hour)
+	defer func() { rts.oracle.lastSync = time.Now() }()
+
+	response = rts.executeRequest(req)
+	rts.Require().Equal(http.StatusServiceUnavailable, response.Code)
+	rts.Require().NoError(json.Unmarshal(response.Body.Bytes(), &respBody))
+	rts.Require().Equal(router.StatusUnavailable, respBody.Status)
+}
+
+func (rts *RouterTestSuite) TestPrices() {
+	req, err := http.NewRequest(http.MethodGet, "/prices", nil)
+	rts.Require().NoError(err)
+
+	response := rts.executeRequest(req)
+	rts.Require().Equal(http.StatusOK, response.Code)
+
+	var respBody router.PricesResponse
+	rts.Require().NoError(json.Unmarshal(response.Body.Bytes(), &respBody))
+	rts.Require().Equal(rts.oracle.GetPrices(), respBody.Prices)
+	rts.Require().Equal(rts.oracle.GetPriceReports(), respBody.Reports)
+}
+
+func (rts *RouterTestSuite) TestProviders() {
+	req, err := http.NewRequest(http.MethodGet, "/providers", nil)
+	rts.Require().NoError(err)
+
+	response := rts.executeRequest(req)
+	rts.Require().Equal(http.StatusOK, response.Code)
+
+	var respBody router.ProvidersResponse
+	rts.Require().NoError(json.Unmarshal(response.Body.Bytes(), &respBody))
+	rts.Require().Len(respBody.Providers, 1)
+	rts.Require().True(respBody.Providers[0].Websocket.Connected)
+	rts.Require().Equal(uint64(2), respBody.Providers[0].Websocket.Reconnects)
+}
+
+func (rts *RouterTestSuite) TestVotes() {
+	req, err := http.NewRequest(http.MethodGet, "/votes", nil)
+	rts.Require().NoError(err)
+
+	response := rts.executeRequest(req)
+	rts.Require().Equal(http.StatusOK, response.Code)
+
+	var respBody router.VotesResponse
+	rts.Require().NoError(json.Unmarshal(response.Body.Bytes(), &respBody))
+	rts.Require().Equal(rts.oracle.GetVoteStatuses(), respBody.Votes)
+}
+
+func (rts *RouterTestSuite) TestEndpoints() {
+	req, err := http.NewRequest(http.MethodGet, "/endpoints", nil)
+	rts.Require().NoError(err)
+
+	response := rts.executeRequest(req)
+	rts.Require().Equal(http.StatusOK, response.Code)
+
+	var respBody router.EndpointsResponse
+	rts.Require().NoError(json.Unmarshal(response.Body.Bytes(), &respBody))
+	rts.Require().Equal(rts.oracle.GetEndpointStatuses(), respBody.Endpoints)
+}
+
+func (rts *RouterTestSuite) TestMetrics() {
+	// the prometheus format is used by default
+	req, err := http.NewRequest(http.MethodGet, "/metrics", nil)
+	rts.Require().NoError(err)
+
+	response := rts.executeRequest(req)
+	rts.Require().Equal(http.StatusOK, response.Code)
+	rts.Require().Equal("price_feeder_tick 1", response.Body.String())
+
+	req, err = http.NewRequest(http.MethodGet, "/metrics?format=unknown", nil)
+	rts.Require().NoError(err)
+
+	response = rts.executeRequest(req)
+	rts.Require().Equal(http.StatusBadRequest, response.Code)
+}
+
+func TestMetricsDisabled(t *testing.T) {
+	rtr := mux.NewRouter()
+	router.New(zerolog.Nop(), mockOracle{}, nil).RegisterRoutes(rtr)
+
+	req, err := http.NewRequest(http.MethodGet, "/metrics", nil)
+	require.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	rtr.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
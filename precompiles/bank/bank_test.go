@@ -25,6 +25,8 @@ import (
 	"github.com/kiichain/kiichain/x/evm/state"
 	"github.com/kiichain/kiichain/x/evm/types"
 	"github.com/kiichain/kiichain/x/evm/types/ethtx"
+	tokenfactorykeeper "github.com/kiichain/kiichain/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/kiichain/kiichain/x/tokenfactory/types"
 	"github.com/stretchr/testify/require"
 	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
 )
@@ -338,6 +340,51 @@ func TestSendForUnlinkedReceiver(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestSendPausedDenom(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, tmtypes.Header{}).WithBlockHeight(2)
+	k := &testApp.EvmKeeper
+	tokenfactoryServer := tokenfactorykeeper.NewMsgServerImpl(testApp.TokenFactoryKeeper)
+
+	// Setup sender addresses and a factory denom with an ERC20 pointer
+	privKey := testkeeper.MockPrivateKey()
+	senderAddr, senderEVMAddr := testkeeper.PrivateKeyToAddresses(privKey)
+	k.SetAddressMapping(ctx, senderAddr, senderEVMAddr)
+	res, err := tokenfactoryServer.CreateDenom(sdk.WrapSDKContext(ctx), tokenfactorytypes.NewMsgCreateDenom(senderAddr.String(), "paused"))
+	require.Nil(t, err)
+	denom := res.GetNewTokenDenom()
+	_, err = tokenfactoryServer.Mint(sdk.WrapSDKContext(ctx), tokenfactorytypes.NewMsgMint(senderAddr.String(), sdk.NewInt64Coin(denom, 1000)))
+	require.Nil(t, err)
+	_, pointerAddr := testkeeper.MockAddressPair()
+	k.SetERC20NativePointer(ctx, denom, pointerAddr)
+
+	kiiAddr, evmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, kiiAddr, evmAddr)
+	p, err := bank.NewPrecompile(k.BankKeeper(), k, k.AccountKeeper())
+	require.Nil(t, err)
+	statedb := state.NewDBImpl(ctx, k, true)
+	evm := vm.EVM{
+		StateDB:   statedb,
+		TxContext: vm.TxContext{Origin: senderEVMAddr},
+	}
+	send, err := p.ABI.MethodById(p.GetExecutor().(*bank.PrecompileExecutor).SendID)
+	require.Nil(t, err)
+	args, err := send.Inputs.Pack(senderEVMAddr, evmAddr, denom, big.NewInt(100))
+	require.Nil(t, err)
+
+	// the pointer can't transfer the denom while it is paused
+	_, err = tokenfactoryServer.SetDenomPause(sdk.WrapSDKContext(ctx), tokenfactorytypes.NewMsgSetDenomPause(senderAddr.String(), denom, true))
+	require.Nil(t, err)
+	_, err = p.Run(&evm, pointerAddr, pointerAddr, append(p.GetExecutor().(*bank.PrecompileExecutor).SendID, args...), nil, false, false)
+	require.NotNil(t, err)
+
+	_, err = tokenfactoryServer.SetDenomPause(sdk.WrapSDKContext(ctx), tokenfactorytypes.NewMsgSetDenomPause(senderAddr.String(), denom, false))
+	require.Nil(t, err)
+	_, err = p.Run(&evm, pointerAddr, pointerAddr, append(p.GetExecutor().(*bank.PrecompileExecutor).SendID, args...), nil, false, false)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(100), k.BankKeeper().GetBalance(statedb.Ctx(), kiiAddr, denom).Amount)
+}
+
 func TestMetadata(t *testing.T) {
 	k := &testkeeper.EVMTestApp.EvmKeeper
 	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/ibc-go/v4/modules/core/exported"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -19,6 +20,7 @@ import (
 	"github.com/kiichain/kiichain/utils"
 
 	oracletypes "github.com/kiichain/kiichain/x/oracle/types"
+	tokenfactorytypes "github.com/kiichain/kiichain/x/tokenfactory/types"
 )
 
 type BankKeeper interface {
@@ -71,15 +73,33 @@ type AccountKeeper interface {
 
 type OracleKeeper interface {
 	IterateBaseExchangeRates(ctx sdk.Context, handler func(denom string, exchangeRate oracletypes.OracleExchangeRate) bool)
+	GetExchangeRateStatus(ctx sdk.Context, denom string, lastUpdate sdk.Int) oracletypes.ExchangeRateStatus
 	CalculateTwaps(ctx sdk.Context, lookBackSeconds uint64) (oracletypes.OracleTwaps, error)
+	CalculateEmas(ctx sdk.Context, lookBackSeconds uint64, halfLifeSeconds uint64) (oracletypes.OracleEmas, error)
+	CalculatePriceStats(ctx sdk.Context, lookBackSeconds uint64) (oracletypes.OraclePriceStatsList, error)
 	IterateVoteTargets(ctx sdk.Context, handler func(denom string, denomInfo oracletypes.Denom) bool)
 	IteratePriceSnapshots(ctx sdk.Context, handler func(snapshot oracletypes.PriceSnapshot) bool)
+	GetPriceAt(ctx sdk.Context, denom string, timestamp int64) (oracletypes.DenomPriceSnapshot, error)
+	GetPriceSnapshotRange(ctx sdk.Context, denom string, fromTimestamp, toTimestamp int64, pageReq *query.PageRequest) ([]oracletypes.DenomPriceSnapshot, *query.PageResponse, error)
+	GetPullPrice(ctx sdk.Context, denom string) (oracletypes.PullPrice, error)
 	GetFeederDelegation(ctx sdk.Context, valAddr sdk.ValAddress) sdk.AccAddress
 	GetMissCount(ctx sdk.Context, operator sdk.ValAddress) uint64
 	GetAbstainCount(ctx sdk.Context, operator sdk.ValAddress) uint64
 	GetSuccessCount(ctx sdk.Context, operator sdk.ValAddress) uint64
 }
 
+type TokenFactoryKeeper interface {
+	CreateDenom(goCtx context.Context, msg *tokenfactorytypes.MsgCreateDenom) (*tokenfactorytypes.MsgCreateDenomResponse, error)
+	Mint(goCtx context.Context, msg *tokenfactorytypes.MsgMint) (*tokenfactorytypes.MsgMintResponse, error)
+	Burn(goCtx context.Context, msg *tokenfactorytypes.MsgBurn) (*tokenfactorytypes.MsgBurnResponse, error)
+	ChangeAdmin(goCtx context.Context, msg *tokenfactorytypes.MsgChangeAdmin) (*tokenfactorytypes.MsgChangeAdminResponse, error)
+	SetDenomMetadata(goCtx context.Context, msg *tokenfactorytypes.MsgSetDenomMetadata) (*tokenfactorytypes.MsgSetDenomMetadataResponse, error)
+}
+
+type TokenFactoryQuerier interface {
+	DenomAuthorityMetadata(c context.Context, req *tokenfactorytypes.QueryDenomAuthorityMetadataRequest) (*tokenfactorytypes.QueryDenomAuthorityMetadataResponse, error)
+}
+
 type WasmdKeeper interface {
 	Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins) (sdk.AccAddress, []byte, error)
 	Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
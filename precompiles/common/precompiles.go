@@ -5,14 +5,17 @@ import (
 	"embed"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/tracing"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/kiichain/kiichain/utils"
 	"github.com/kiichain/kiichain/utils/metrics"
 	"github.com/kiichain/kiichain/x/evm/state"
 	"github.com/kiichain/kiichain/x/evm/types"
@@ -274,3 +277,22 @@ func GetKiiAddressFromArg(ctx sdk.Context, arg interface{}, evmKeeper EVMKeeper)
 	}
 	return GetKiiAddressByEvmAddress(ctx, addr, evmKeeper)
 }
+
+// GetERCNativeMetadata returns the ERC20 metadata of a native denom pointer, the
+// decimals, name and symbol are taken from the denom unit with the highest exponent
+func GetERCNativeMetadata(metadata banktypes.Metadata) utils.ERCMetadata {
+	name := metadata.Name
+	symbol := metadata.Symbol
+	var decimals uint8
+	for _, denomUnit := range metadata.DenomUnits {
+		if denomUnit.Exponent > uint32(decimals) && denomUnit.Exponent <= math.MaxUint8 {
+			decimals = uint8(denomUnit.Exponent)
+			name = denomUnit.Denom
+			symbol = denomUnit.Denom
+			if len(denomUnit.Aliases) > 0 {
+				name = denomUnit.Aliases[0]
+			}
+		}
+	}
+	return utils.ERCMetadata{Name: name, Symbol: symbol, Decimals: decimals}
+}
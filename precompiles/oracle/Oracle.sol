@@ -14,11 +14,30 @@ interface IOracle {
         view
         returns (DenomOracleExchangeRate[] memory);
 
+    // getExchangeRatesWithStatus queries the current exchange rates on the module with their status,
+    // contracts must not use stale or halted prices
+    function getExchangeRatesWithStatus()
+        external
+        view
+        returns (DenomOracleExchangeRateWithStatus[] memory);
+
     // getOracleTwaps queries the module's twap withing a lookback period
     function getOracleTwaps(
         uint256 lookback_seconds
     ) external view returns (OracleTwap[] memory);
 
+    // getOracleEmas queries the module's exponential moving averages withing a lookback period,
+    // the weight of a price decays by half every half_life_seconds
+    function getOracleEmas(
+        uint256 lookback_seconds,
+        uint256 half_life_seconds
+    ) external view returns (OracleEma[] memory);
+
+    // getOraclePriceStats queries the module's median, min, max and volatility of the prices withing a lookback period
+    function getOraclePriceStats(
+        uint256 lookback_seconds
+    ) external view returns (OraclePriceStats[] memory);
+
     // getActives queries the active assets list on the module
     function getActives() external view returns (string[] memory);
 
@@ -28,6 +47,28 @@ interface IOracle {
         view
         returns (PriceSnapshot[] memory);
 
+    // getPriceAt queries the price of a denom on the most recent snapshot at or before the timestamp
+    function getPriceAt(
+        string memory denom,
+        uint256 timestamp
+    ) external view returns (DenomPriceSnapshot memory);
+
+    // getPriceSnapshotRange queries the prices of a denom on the snapshots between two timestamps (both included),
+    // paginated by offset and limit, the limit is capped to 100 prices
+    function getPriceSnapshotRange(
+        string memory denom,
+        uint256 fromTimestamp,
+        uint256 toTimestamp,
+        uint256 offset,
+        uint256 limit
+    ) external view returns (DenomPriceSnapshot[] memory);
+
+    // getPullPrice queries the latest price of a denom attested by the price publishers,
+    // the pull oracle feed is updated independently of the vote period
+    function getPullPrice(
+        string memory denom
+    ) external view returns (PullPrice memory);
+
     // getFeederDelegation queries the feeder delegated based on the validator address
     function getFeederDelegation(
         string memory validatorAddress
@@ -46,12 +87,27 @@ interface IOracle {
         uint256 lastUpdateTimestamp;
     }
 
+    // ExchangeRateStatus represents if an exchange rate is safe to be used,
+    // contracts must not use stale or halted prices
+    enum ExchangeRateStatus {
+        Active,
+        Stale,
+        Halted
+    }
+
     // DenomOracleExchangeRate represents a exchange rate on the module
     struct DenomOracleExchangeRate {
         string denom;
         OracleExchangeRate oracleExchangeRate;
     }
 
+    // DenomOracleExchangeRateWithStatus represents a exchange rate on the module with its status
+    struct DenomOracleExchangeRateWithStatus {
+        string denom;
+        OracleExchangeRate oracleExchangeRate;
+        ExchangeRateStatus status;
+    }
+
     // OracleTwap represents the twap output from the module
     struct OracleTwap {
         string denom;
@@ -59,12 +115,48 @@ interface IOracle {
         uint256 lookbackSeconds;
     }
 
+    // OracleEma represents the ema output from the module
+    struct OracleEma {
+        string denom;
+        string ema;
+        uint256 lookbackSeconds;
+        uint256 halfLifeSeconds;
+    }
+
+    // OraclePriceStats represents the price statistics output from the module,
+    // volatility is the standard deviation of the prices
+    struct OraclePriceStats {
+        string denom;
+        string median;
+        string min;
+        string max;
+        string volatility;
+        uint256 sampleCount;
+        uint256 lookbackSeconds;
+    }
+
     // PriceSnapshot represents an snapshot
     struct PriceSnapshot {
         uint256 snapshotTimestamp;
         DenomOracleExchangeRate[] PriceSnapshotItems;
     }
 
+    // DenomPriceSnapshot represents the exchange rate of a denom on a snapshot
+    struct DenomPriceSnapshot {
+        uint256 snapshotTimestamp;
+        OracleExchangeRate oracleExchangeRate;
+    }
+
+    // PullPrice represents the latest price of a denom on the pull oracle feed, the price is
+    // the median of the publishers prices and publishTime is the oldest attestation timestamp
+    struct PullPrice {
+        string denom;
+        string price;
+        uint256 publishTime;
+        string[] publishers;
+        uint256 updateHeight;
+    }
+
     // VotePenaltyCounter represents the votepenalty result from module
     struct VotePenaltyCounter {
         uint256 missCount;
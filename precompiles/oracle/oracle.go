@@ -2,9 +2,11 @@ package oracle
 
 import (
 	"embed"
+	"fmt"
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -14,14 +16,23 @@ import (
 
 // precompiled functions
 const (
-	GetExchangeRatesMethod        = "getExchangeRates"
-	GetOracleTwapsMethod          = "getOracleTwaps"
-	GetActivesMethod              = "getActives"
-	GetPriceSnapshotHistoryMethod = "getPriceSnapshotHistory"
-	GetFeederDelegationMethod     = "getFeederDelegation"
-	GetVotePenaltyCounterMethod   = "getVotePenaltyCounter"
+	GetExchangeRatesMethod           = "getExchangeRates"
+	GetExchangeRatesWithStatusMethod = "getExchangeRatesWithStatus"
+	GetOracleTwapsMethod             = "getOracleTwaps"
+	GetOracleEmasMethod              = "getOracleEmas"
+	GetOraclePriceStatsMethod        = "getOraclePriceStats"
+	GetActivesMethod                 = "getActives"
+	GetPriceSnapshotHistoryMethod    = "getPriceSnapshotHistory"
+	GetPriceAtMethod                 = "getPriceAt"
+	GetPriceSnapshotRangeMethod      = "getPriceSnapshotRange"
+	GetPullPriceMethod               = "getPullPrice"
+	GetFeederDelegationMethod        = "getFeederDelegation"
+	GetVotePenaltyCounterMethod      = "getVotePenaltyCounter"
 )
 
+// MaxPriceSnapshotRangeLimit is the max number of prices returned by a getPriceSnapshotRange call
+const MaxPriceSnapshotRangeLimit = 100
+
 // precompiled address
 const OracleAddress = "0x0000000000000000000000000000000000001008"
 
@@ -34,12 +45,18 @@ type PrecompileExecutor struct {
 	oracleKeeper precommon.OracleKeeper // access point to the oracle module
 
 	// functions to be registered
-	GetExchangeRatesId        []byte
-	GetOracleTwapsId          []byte
-	GetActivesId              []byte
-	GetPriceSnapshotHistoryId []byte
-	GetFeederDelegationId     []byte
-	GetVotePenaltyCounterId   []byte
+	GetExchangeRatesId           []byte
+	GetExchangeRatesWithStatusId []byte
+	GetOracleTwapsId             []byte
+	GetOracleEmasId              []byte
+	GetOraclePriceStatsId        []byte
+	GetActivesId                 []byte
+	GetPriceSnapshotHistoryId    []byte
+	GetPriceAtId                 []byte
+	GetPriceSnapshotRangeId      []byte
+	GetPullPriceId               []byte
+	GetFeederDelegationId        []byte
+	GetVotePenaltyCounterId      []byte
 }
 
 // NewPrecompile registers the precompiled on the blockchain (this function is called on the app.go)
@@ -59,15 +76,33 @@ func NewPrecompile(oracleKeeper precommon.OracleKeeper, evmKeeper precommon.EVMK
 		case GetExchangeRatesMethod:
 			preExecutor.GetExchangeRatesId = method.ID
 
+		case GetExchangeRatesWithStatusMethod:
+			preExecutor.GetExchangeRatesWithStatusId = method.ID
+
 		case GetOracleTwapsMethod:
 			preExecutor.GetOracleTwapsId = method.ID
 
+		case GetOracleEmasMethod:
+			preExecutor.GetOracleEmasId = method.ID
+
+		case GetOraclePriceStatsMethod:
+			preExecutor.GetOraclePriceStatsId = method.ID
+
 		case GetActivesMethod:
 			preExecutor.GetActivesId = method.ID
 
 		case GetPriceSnapshotHistoryMethod:
 			preExecutor.GetPriceSnapshotHistoryId = method.ID
 
+		case GetPriceAtMethod:
+			preExecutor.GetPriceAtId = method.ID
+
+		case GetPriceSnapshotRangeMethod:
+			preExecutor.GetPriceSnapshotRangeId = method.ID
+
+		case GetPullPriceMethod:
+			preExecutor.GetPullPriceId = method.ID
+
 		case GetFeederDelegationMethod:
 			preExecutor.GetFeederDelegationId = method.ID
 
@@ -92,15 +127,33 @@ func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, caller
 	case GetExchangeRatesMethod:
 		return p.getExchangeRates(ctx, method, args, value)
 
+	case GetExchangeRatesWithStatusMethod:
+		return p.getExchangeRatesWithStatus(ctx, method, args, value)
+
 	case GetOracleTwapsMethod:
 		return p.getOracleTwaps(ctx, method, args, value)
 
+	case GetOracleEmasMethod:
+		return p.getOracleEmas(ctx, method, args, value)
+
+	case GetOraclePriceStatsMethod:
+		return p.getOraclePriceStats(ctx, method, args, value)
+
 	case GetActivesMethod:
 		return p.getActives(ctx, method, args, value)
 
 	case GetPriceSnapshotHistoryMethod:
 		return p.getPriceSnapshotHistory(ctx, method, args, value)
 
+	case GetPriceAtMethod:
+		return p.getPriceAt(ctx, method, args, value)
+
+	case GetPriceSnapshotRangeMethod:
+		return p.getPriceSnapshotRange(ctx, method, args, value)
+
+	case GetPullPriceMethod:
+		return p.getPullPrice(ctx, method, args, value)
+
 	case GetFeederDelegationMethod:
 		return p.getFeederDelegation(ctx, method, args, value)
 
@@ -165,6 +218,54 @@ func (p PrecompileExecutor) getExchangeRates(ctx sdk.Context, method *abi.Method
 	return bz, precommon.GetRemainingGas(ctx, p.evmKeeper), err
 }
 
+// DenomOracleExchangeRateWithStatus represents the exchange rate by denom with its status
+type DenomOracleExchangeRateWithStatus struct {
+	Denom              string
+	OracleExchangeRate OracleExchangeRate
+	Status             uint8
+}
+
+// getExchangeRatesWithStatus returns the current exchange rates with their status
+func (p PrecompileExecutor) getExchangeRatesWithStatus(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, uint64, error) {
+	// validate the function does not require payable
+	if err := precommon.ValidateNonPayable(value); err != nil {
+		return nil, 0, err
+	}
+
+	// validate the function does not receive args
+	if err := precommon.ValidateArgsLength(args, 0); err != nil {
+		return nil, 0, err
+	}
+
+	// Get exchange rates from oracle module
+	exchangeRates := make([]DenomOracleExchangeRateWithStatus, 0, 10)
+	p.oracleKeeper.IterateBaseExchangeRates(ctx, func(denom string, exchangeRate types.OracleExchangeRate) bool {
+		// parse the exchange rate from sdk.Dec to string
+		rate := DenomOracleExchangeRateWithStatus{
+			Denom: denom,
+			OracleExchangeRate: OracleExchangeRate{
+				ExchangeRate:        exchangeRate.String(),
+				LastUpdate:          exchangeRate.LastUpdate.String(),
+				LastUpdateTimestamp: big.NewInt(exchangeRate.LastUpdateTimestamp),
+			},
+			Status: uint8(p.oracleKeeper.GetExchangeRateStatus(ctx, denom, exchangeRate.LastUpdate)),
+		}
+
+		// store the exchange rates
+		exchangeRates = append(exchangeRates, rate)
+		return false
+	})
+
+	// convert from go struct to []byte data
+	bz, err := method.Outputs.Pack(exchangeRates)
+	if err != nil {
+		return nil, 0, err
+
+	}
+
+	return bz, precommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
 type OracleTwap struct {
 	Denom           string
 	Twap            string
@@ -215,6 +316,113 @@ func (p PrecompileExecutor) getOracleTwaps(ctx sdk.Context, method *abi.Method,
 	return bz, precommon.GetRemainingGas(ctx, p.evmKeeper), err
 }
 
+type OracleEma struct {
+	Denom           string
+	Ema             string
+	LookbackSeconds *big.Int
+	HalfLifeSeconds *big.Int
+}
+
+// getOracleEmas calls the oracle keeper to calculate emas within the lookback period
+func (p PrecompileExecutor) getOracleEmas(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, uint64, error) {
+	// validate the function does not require payable
+	if err := precommon.ValidateNonPayable(value); err != nil {
+		return nil, 0, err
+	}
+
+	// validate the function receive 2 args
+	if err := precommon.ValidateArgsLength(args, 2); err != nil {
+		return nil, 0, err
+	}
+
+	// receive input args
+	lookbackSeconds := args[0].(*big.Int) // obligate the input is uint64
+	halfLifeSeconds := args[1].(*big.Int) // obligate the input is uint64
+
+	// calculate ema
+	emas, err := p.oracleKeeper.CalculateEmas(ctx, lookbackSeconds.Uint64(), halfLifeSeconds.Uint64())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// convert emas to string
+	stringEmas := make([]OracleEma, 0, len(emas))
+	for _, ema := range emas {
+		stringEma := OracleEma{
+			Denom:           ema.Denom,
+			Ema:             ema.Ema.String(),
+			LookbackSeconds: big.NewInt(ema.LookbackSeconds),
+			HalfLifeSeconds: new(big.Int).SetUint64(ema.HalfLifeSeconds),
+		}
+
+		stringEmas = append(stringEmas, stringEma)
+	}
+
+	// convert from go struct to []byte data
+	bz, err := method.Outputs.Pack(stringEmas)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return bz, precommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+type OraclePriceStats struct {
+	Denom           string
+	Median          string
+	Min             string
+	Max             string
+	Volatility      string
+	SampleCount     *big.Int
+	LookbackSeconds *big.Int
+}
+
+// getOraclePriceStats calls the oracle keeper to calculate the price statistics within the lookback period
+func (p PrecompileExecutor) getOraclePriceStats(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, uint64, error) {
+	// validate the function does not require payable
+	if err := precommon.ValidateNonPayable(value); err != nil {
+		return nil, 0, err
+	}
+
+	// validate the function receive only 1 arg
+	if err := precommon.ValidateArgsLength(args, 1); err != nil {
+		return nil, 0, err
+	}
+
+	// receive input arg
+	lookbackSeconds := args[0].(*big.Int) // obligate the input is uint64
+
+	// calculate price statistics
+	priceStats, err := p.oracleKeeper.CalculatePriceStats(ctx, lookbackSeconds.Uint64())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// convert the statistics to string
+	stringPriceStats := make([]OraclePriceStats, 0, len(priceStats))
+	for _, stats := range priceStats {
+		stringStats := OraclePriceStats{
+			Denom:           stats.Denom,
+			Median:          stats.Median.String(),
+			Min:             stats.Min.String(),
+			Max:             stats.Max.String(),
+			Volatility:      stats.Volatility.String(),
+			SampleCount:     new(big.Int).SetUint64(stats.SampleCount),
+			LookbackSeconds: big.NewInt(stats.LookbackSeconds),
+		}
+
+		stringPriceStats = append(stringPriceStats, stringStats)
+	}
+
+	// convert from go struct to []byte data
+	bz, err := method.Outputs.Pack(stringPriceStats)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return bz, precommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
 // getActives returns the list of active assets
 func (p PrecompileExecutor) getActives(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, uint64, error) {
 	// validate the function does not require payable
@@ -307,6 +515,156 @@ func (p PrecompileExecutor) getPriceSnapshotHistory(ctx sdk.Context, method *abi
 
 }
 
+// DenomPriceSnapshot represents the exchange rate of a denom on a snapshot
+type DenomPriceSnapshot struct {
+	SnapshotTimestamp  *big.Int
+	OracleExchangeRate OracleExchangeRate
+}
+
+// newDenomPriceSnapshot converts the module price snapshot to the string struct
+func newDenomPriceSnapshot(price types.DenomPriceSnapshot) DenomPriceSnapshot {
+	return DenomPriceSnapshot{
+		SnapshotTimestamp: big.NewInt(price.SnapshotTimestamp),
+		OracleExchangeRate: OracleExchangeRate{
+			ExchangeRate:        price.OracleExchangeRate.ExchangeRate.String(),
+			LastUpdate:          price.OracleExchangeRate.LastUpdate.String(),
+			LastUpdateTimestamp: big.NewInt(price.OracleExchangeRate.LastUpdateTimestamp),
+		},
+	}
+}
+
+// getPriceAt returns the price of a denom on the most recent snapshot at or before the timestamp
+func (p PrecompileExecutor) getPriceAt(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, uint64, error) {
+	// validate the function does not require payable
+	if err := precommon.ValidateNonPayable(value); err != nil {
+		return nil, 0, err
+	}
+
+	// validate the function receive 2 args
+	if err := precommon.ValidateArgsLength(args, 2); err != nil {
+		return nil, 0, err
+	}
+
+	// receive input args
+	denom := args[0].(string)       // obligate the string data type
+	timestamp := args[1].(*big.Int) // obligate the input is uint64
+	if !timestamp.IsInt64() {
+		return nil, 0, types.ErrNoPriceAtTimestamp
+	}
+
+	// search the price
+	price, err := p.oracleKeeper.GetPriceAt(ctx, denom, timestamp.Int64())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// convert from go struct to []byte data
+	bz, err := method.Outputs.Pack(newDenomPriceSnapshot(price))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return bz, precommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+// getPriceSnapshotRange returns the prices of a denom on the snapshots between two timestamps, paginated by offset and limit
+func (p PrecompileExecutor) getPriceSnapshotRange(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, uint64, error) {
+	// validate the function does not require payable
+	if err := precommon.ValidateNonPayable(value); err != nil {
+		return nil, 0, err
+	}
+
+	// validate the function receive 5 args
+	if err := precommon.ValidateArgsLength(args, 5); err != nil {
+		return nil, 0, err
+	}
+
+	// receive input args
+	denom := args[0].(string)           // obligate the string data type
+	fromTimestamp := args[1].(*big.Int) // obligate the input is uint64
+	toTimestamp := args[2].(*big.Int)   // obligate the input is uint64
+	offset := args[3].(*big.Int)        // obligate the input is uint64
+	limit := args[4].(*big.Int)         // obligate the input is uint64
+	if !fromTimestamp.IsInt64() || !toTimestamp.IsInt64() {
+		return nil, 0, types.ErrInvalidTimestampRange
+	}
+	if !offset.IsUint64() {
+		return nil, 0, fmt.Errorf("invalid offset %s", offset)
+	}
+	if !limit.IsUint64() {
+		return nil, 0, fmt.Errorf("invalid limit %s", limit)
+	}
+
+	// get the prices, the page size is capped to keep the call gas bounded
+	pageReq := &query.PageRequest{Offset: offset.Uint64(), Limit: limit.Uint64()}
+	if pageReq.Limit > MaxPriceSnapshotRangeLimit {
+		pageReq.Limit = MaxPriceSnapshotRangeLimit
+	}
+	prices, _, err := p.oracleKeeper.GetPriceSnapshotRange(ctx, denom, fromTimestamp.Int64(), toTimestamp.Int64(), pageReq)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// convert the prices to string
+	stringPrices := make([]DenomPriceSnapshot, 0, len(prices))
+	for _, price := range prices {
+		stringPrices = append(stringPrices, newDenomPriceSnapshot(price))
+	}
+
+	// convert from go struct to []byte data
+	bz, err := method.Outputs.Pack(stringPrices)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return bz, precommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+// PullPrice represents the latest price attested by a publisher where the sdk.Dec is converted to string
+type PullPrice struct {
+	Denom        string
+	Price        string
+	PublishTime  *big.Int
+	Publishers   []string
+	UpdateHeight *big.Int
+}
+
+// getPullPrice returns the latest price of a denom on the pull oracle feed
+func (p PrecompileExecutor) getPullPrice(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, uint64, error) {
+	// validate the function does not require payable
+	if err := precommon.ValidateNonPayable(value); err != nil {
+		return nil, 0, err
+	}
+
+	// validate the function receive only 1 arg
+	if err := precommon.ValidateArgsLength(args, 1); err != nil {
+		return nil, 0, err
+	}
+
+	// receive input args
+	denom := args[0].(string) // obligate the string data type
+
+	// get the price
+	pullPrice, err := p.oracleKeeper.GetPullPrice(ctx, denom)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// convert from go struct to []byte data
+	bz, err := method.Outputs.Pack(PullPrice{
+		Denom:        pullPrice.Denom,
+		Price:        pullPrice.Price.String(),
+		PublishTime:  big.NewInt(pullPrice.PublishTime),
+		Publishers:   pullPrice.Publishers,
+		UpdateHeight: big.NewInt(pullPrice.UpdateHeight),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return bz, precommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
 // getFeederDelegation returns the delegation address based on the validator input arg
 func (p PrecompileExecutor) getFeederDelegation(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, uint64, error) {
 	// validate the function does not require payable
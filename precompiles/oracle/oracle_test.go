@@ -79,6 +79,63 @@ func TestGetExchangeRates(t *testing.T) {
 	require.Equal(t, utils.MicroAtomDenom, actual.Denom)
 }
 
+func TestGetExchangeRatesWithStatus(t *testing.T) {
+	// prepare env
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, types.Header{}).WithBlockHeight(2)
+	evmKeeper := testApp.EvmKeeper
+	oracleKeeper := testApp.OracleKeeper
+
+	// create user account
+	evm := setupEvmEnv(ctx, evmKeeper)
+
+	// register exchange rates on the module
+	rate := sdk.NewDec(1700)
+	testApp.OracleKeeper.SetBaseExchangeRate(ctx, utils.MicroAtomDenom, rate)
+	testApp.OracleKeeper.SetHaltedDenom(ctx, utils.MicroAtomDenom)
+
+	// create precompiled
+	precompile, err := oracle.NewPrecompile(oracleKeeper, &evmKeeper)
+	require.NoError(t, err)
+
+	executor := precompile.GetExecutor().(*oracle.PrecompileExecutor) // force to be an oracle executor
+	query, err := precompile.ABI.MethodById(executor.GetExchangeRatesWithStatusId)
+	require.NoError(t, err)
+
+	// perform a call to GetExchangeRatesWithStatus
+	precompileRes, _, err := precompile.RunAndCalculateGas(
+		evm,
+		common.Address{},
+		common.Address{},
+		executor.GetExchangeRatesWithStatusId,
+		100000,
+		nil, nil, true, false)
+	require.NoError(t, err)
+
+	// decode precompile response
+	exchangeRates, err := query.Outputs.Unpack(precompileRes)
+	require.NoError(t, err)
+
+	// validate response
+	require.Equal(t, 1, len(exchangeRates))
+
+	// type assertion of the []interface{} response
+	actualSlice, ok := exchangeRates[0].([]struct {
+		Denom              string `json:"denom"`
+		OracleExchangeRate struct {
+			ExchangeRate        string   `json:"exchangeRate"`
+			LastUpdate          string   `json:"lastUpdate"`
+			LastUpdateTimestamp *big.Int `json:"lastUpdateTimestamp"`
+		} `json:"oracleExchangeRate"`
+		Status uint8 `json:"status"`
+	})
+	require.True(t, ok)
+
+	actual := actualSlice[0]
+	require.Equal(t, utils.MicroAtomDenom, actual.Denom)
+	require.Equal(t, uint8(oracletypes.ExchangeRateStatusHalted), actual.Status)
+}
+
 func TestGetOracleTwaps(t *testing.T) {
 	// prepare env
 	testApp := testkeeper.EVMTestApp
@@ -153,6 +210,357 @@ func TestGetOracleTwaps(t *testing.T) {
 	// require.Equal(t, sdk.NewDec(1).String(), actualSlice[1].Twap)
 }
 
+func TestGetOracleEmas(t *testing.T) {
+	// prepare env
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, types.Header{}).WithBlockHeight(2).WithBlockTime(time.Unix(3600, 0))
+	evmKeeper := testApp.EvmKeeper
+	oracleKeeper := testApp.OracleKeeper
+
+	// Create test snapshots and insert on the module
+	snapshot1 := oracletypes.NewPriceSnapshot(3500, oracletypes.PriceSnapshotItems{
+		oracletypes.NewPriceSnapshotItem(utils.MicroEthDenom, oracletypes.OracleExchangeRate{ExchangeRate: sdk.NewDec(10), LastUpdate: sdk.NewInt(1), LastUpdateTimestamp: 3500}),
+	})
+	snapshot2 := oracletypes.NewPriceSnapshot(3550, oracletypes.PriceSnapshotItems{
+		oracletypes.NewPriceSnapshotItem(utils.MicroEthDenom, oracletypes.OracleExchangeRate{ExchangeRate: sdk.NewDec(20), LastUpdate: sdk.NewInt(2), LastUpdateTimestamp: 3550}),
+	})
+	oracleKeeper.SetPriceSnapshot(ctx, snapshot1)
+	oracleKeeper.SetPriceSnapshot(ctx, snapshot2)
+	defer oracleKeeper.DeletePriceSnapshot(ctx, 3500) // the test app is shared with the other tests
+	defer oracleKeeper.DeletePriceSnapshot(ctx, 3550)
+
+	// set vote target on params
+	params := oracletypes.DefaultParams()
+	oracleKeeper.SetParams(ctx, params)
+	for _, denom := range params.Whitelist {
+		oracleKeeper.SetVoteTarget(ctx, denom.Name)
+	}
+
+	// setup sender and env
+	evm := setupEvmEnv(ctx, evmKeeper)
+
+	// create precompiled
+	precompile, err := oracle.NewPrecompile(oracleKeeper, &evmKeeper)
+	require.NoError(t, err)
+
+	executor := precompile.GetExecutor().(*oracle.PrecompileExecutor) // force to be an oracle executor
+	query, err := precompile.ABI.MethodById(executor.GetOracleEmasId) // create querier pointing to the function GetOracleEmas
+	require.NoError(t, err)
+
+	// execute precompile, the half-life is the time between snapshots
+	args, err := query.Inputs.Pack(new(big.Int).SetUint64(100), new(big.Int).SetUint64(50)) // create the input args
+	require.NoError(t, err)
+	precompileRes, _, err := precompile.RunAndCalculateGas(
+		evm,
+		common.Address{},
+		common.Address{},
+		append(executor.GetOracleEmasId, args...),
+		100000,
+		nil, nil, true, false)
+	require.Nil(t, err)
+
+	ema, err := query.Outputs.Unpack(precompileRes)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(ema))
+
+	// type assertion of the []interface{} response
+	actualSlice, ok := ema[0].([]struct {
+		Denom           string   `json:"denom"`
+		Ema             string   `json:"ema"`
+		LookbackSeconds *big.Int `json:"lookbackSeconds"`
+		HalfLifeSeconds *big.Int `json:"halfLifeSeconds"`
+	})
+	require.True(t, ok)
+
+	require.Len(t, actualSlice, 1)
+	require.Equal(t, utils.MicroEthDenom, actualSlice[0].Denom)
+	actualEma := sdk.MustNewDecFromStr(actualSlice[0].Ema)
+	require.True(t, actualEma.Sub(sdk.NewDec(15)).Abs().LT(sdk.NewDecWithPrec(1, 8)))
+	require.Equal(t, int64(100), actualSlice[0].LookbackSeconds.Int64())
+	require.Equal(t, int64(50), actualSlice[0].HalfLifeSeconds.Int64())
+}
+
+func TestGetOraclePriceStats(t *testing.T) {
+	// prepare env
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, types.Header{}).WithBlockHeight(2).WithBlockTime(time.Unix(7200, 0))
+	evmKeeper := testApp.EvmKeeper
+	oracleKeeper := testApp.OracleKeeper
+
+	// Create test snapshots and insert on the module
+	snapshot1 := oracletypes.NewPriceSnapshot(7100, oracletypes.PriceSnapshotItems{
+		oracletypes.NewPriceSnapshotItem(utils.MicroEthDenom, oracletypes.OracleExchangeRate{ExchangeRate: sdk.NewDec(10), LastUpdate: sdk.NewInt(1), LastUpdateTimestamp: 7100}),
+	})
+	snapshot2 := oracletypes.NewPriceSnapshot(7150, oracletypes.PriceSnapshotItems{
+		oracletypes.NewPriceSnapshotItem(utils.MicroEthDenom, oracletypes.OracleExchangeRate{ExchangeRate: sdk.NewDec(20), LastUpdate: sdk.NewInt(2), LastUpdateTimestamp: 7150}),
+	})
+	oracleKeeper.SetPriceSnapshot(ctx, snapshot1)
+	oracleKeeper.SetPriceSnapshot(ctx, snapshot2)
+	defer oracleKeeper.DeletePriceSnapshot(ctx, 7100) // the test app is shared with the other tests
+	defer oracleKeeper.DeletePriceSnapshot(ctx, 7150)
+
+	// set vote target on params
+	params := oracletypes.DefaultParams()
+	oracleKeeper.SetParams(ctx, params)
+	for _, denom := range params.Whitelist {
+		oracleKeeper.SetVoteTarget(ctx, denom.Name)
+	}
+
+	// setup sender and env
+	evm := setupEvmEnv(ctx, evmKeeper)
+
+	// create precompiled
+	precompile, err := oracle.NewPrecompile(oracleKeeper, &evmKeeper)
+	require.NoError(t, err)
+
+	executor := precompile.GetExecutor().(*oracle.PrecompileExecutor)       // force to be an oracle executor
+	query, err := precompile.ABI.MethodById(executor.GetOraclePriceStatsId) // create querier pointing to the function GetOraclePriceStats
+	require.NoError(t, err)
+
+	// execute precompile
+	args, err := query.Inputs.Pack(new(big.Int).SetUint64(100)) // create the input arg
+	require.NoError(t, err)
+	precompileRes, _, err := precompile.RunAndCalculateGas(
+		evm,
+		common.Address{},
+		common.Address{},
+		append(executor.GetOraclePriceStatsId, args...),
+		100000,
+		nil, nil, true, false)
+	require.Nil(t, err)
+
+	priceStats, err := query.Outputs.Unpack(precompileRes)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(priceStats))
+
+	// type assertion of the []interface{} response
+	actualSlice, ok := priceStats[0].([]struct {
+		Denom           string   `json:"denom"`
+		Median          string   `json:"median"`
+		Min             string   `json:"min"`
+		Max             string   `json:"max"`
+		Volatility      string   `json:"volatility"`
+		SampleCount     *big.Int `json:"sampleCount"`
+		LookbackSeconds *big.Int `json:"lookbackSeconds"`
+	})
+	require.True(t, ok)
+
+	require.Len(t, actualSlice, 1)
+	require.Equal(t, utils.MicroEthDenom, actualSlice[0].Denom)
+	require.Equal(t, sdk.NewDec(15).String(), actualSlice[0].Median)
+	require.Equal(t, sdk.NewDec(10).String(), actualSlice[0].Min)
+	require.Equal(t, sdk.NewDec(20).String(), actualSlice[0].Max)
+	require.Equal(t, sdk.NewDec(5).String(), actualSlice[0].Volatility)
+	require.Equal(t, int64(2), actualSlice[0].SampleCount.Int64())
+	require.Equal(t, int64(100), actualSlice[0].LookbackSeconds.Int64())
+}
+
+func TestGetPriceAtAndSnapshotRange(t *testing.T) {
+	// prepare env
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, types.Header{}).WithBlockHeight(2)
+	evmKeeper := testApp.EvmKeeper
+	oracleKeeper := testApp.OracleKeeper
+
+	// Create test snapshots and insert on the module
+	for i, timestamp := range []int64{9000, 9100, 9200} {
+		snapshot := oracletypes.NewPriceSnapshot(timestamp, oracletypes.PriceSnapshotItems{
+			oracletypes.NewPriceSnapshotItem(utils.MicroEthDenom, oracletypes.OracleExchangeRate{
+				ExchangeRate:        sdk.NewDec(int64(i + 1)),
+				LastUpdate:          sdk.NewInt(int64(i + 1)),
+				LastUpdateTimestamp: timestamp,
+			}),
+		})
+		oracleKeeper.SetPriceSnapshot(ctx, snapshot)
+		defer oracleKeeper.DeletePriceSnapshot(ctx, timestamp) // the test app is shared with the other tests
+	}
+
+	// setup sender and env
+	evm := setupEvmEnv(ctx, evmKeeper)
+
+	// create precompiled
+	precompile, err := oracle.NewPrecompile(oracleKeeper, &evmKeeper)
+	require.NoError(t, err)
+	executor := precompile.GetExecutor().(*oracle.PrecompileExecutor) // force to be an oracle executor
+
+	type denomPriceSnapshot = struct {
+		SnapshotTimestamp  *big.Int `json:"snapshotTimestamp"`
+		OracleExchangeRate struct {
+			ExchangeRate        string   `json:"exchangeRate"`
+			LastUpdate          string   `json:"lastUpdate"`
+			LastUpdateTimestamp *big.Int `json:"lastUpdateTimestamp"`
+		} `json:"oracleExchangeRate"`
+	}
+
+	t.Run("price at timestamp", func(t *testing.T) {
+		query, err := precompile.ABI.MethodById(executor.GetPriceAtId) // create querier pointing to the function GetPriceAt
+		require.NoError(t, err)
+
+		args, err := query.Inputs.Pack(utils.MicroEthDenom, big.NewInt(9150))
+		require.NoError(t, err)
+		precompileRes, _, err := precompile.RunAndCalculateGas(
+			evm,
+			common.Address{},
+			common.Address{},
+			append(executor.GetPriceAtId, args...),
+			100000,
+			nil, nil, true, false)
+		require.Nil(t, err)
+
+		price, err := query.Outputs.Unpack(precompileRes)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(price))
+
+		// type assertion of the []interface{} response
+		actual, ok := price[0].(denomPriceSnapshot)
+		require.True(t, ok)
+		require.Equal(t, int64(9100), actual.SnapshotTimestamp.Int64())
+		require.Equal(t, sdk.NewDec(2).String(), actual.OracleExchangeRate.ExchangeRate)
+	})
+
+	t.Run("paged snapshot range", func(t *testing.T) {
+		query, err := precompile.ABI.MethodById(executor.GetPriceSnapshotRangeId) // create querier pointing to the function GetPriceSnapshotRange
+		require.NoError(t, err)
+
+		args, err := query.Inputs.Pack(utils.MicroEthDenom, big.NewInt(9000), big.NewInt(9200), big.NewInt(1), big.NewInt(5))
+		require.NoError(t, err)
+		precompileRes, _, err := precompile.RunAndCalculateGas(
+			evm,
+			common.Address{},
+			common.Address{},
+			append(executor.GetPriceSnapshotRangeId, args...),
+			100000,
+			nil, nil, true, false)
+		require.Nil(t, err)
+
+		prices, err := query.Outputs.Unpack(precompileRes)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(prices))
+
+		// type assertion of the []interface{} response
+		actualSlice, ok := prices[0].([]denomPriceSnapshot)
+		require.True(t, ok)
+		require.Len(t, actualSlice, 2) // the first snapshot is skipped by the offset
+		require.Equal(t, int64(9100), actualSlice[0].SnapshotTimestamp.Int64())
+		require.Equal(t, int64(9200), actualSlice[1].SnapshotTimestamp.Int64())
+	})
+
+	t.Run("limit above the max page size is capped", func(t *testing.T) {
+		query, err := precompile.ABI.MethodById(executor.GetPriceSnapshotRangeId) // create querier pointing to the function GetPriceSnapshotRange
+		require.NoError(t, err)
+
+		args, err := query.Inputs.Pack(utils.MicroEthDenom, big.NewInt(9000), big.NewInt(9200), big.NewInt(0), big.NewInt(oracle.MaxPriceSnapshotRangeLimit+1))
+		require.NoError(t, err)
+		precompileRes, _, err := precompile.RunAndCalculateGas(
+			evm,
+			common.Address{},
+			common.Address{},
+			append(executor.GetPriceSnapshotRangeId, args...),
+			100000,
+			nil, nil, true, false)
+		require.Nil(t, err)
+
+		prices, err := query.Outputs.Unpack(precompileRes)
+		require.Nil(t, err)
+		actualSlice, ok := prices[0].([]denomPriceSnapshot)
+		require.True(t, ok)
+		require.Len(t, actualSlice, 3)
+	})
+
+	t.Run("offset and limit overflowing uint64", func(t *testing.T) {
+		query, err := precompile.ABI.MethodById(executor.GetPriceSnapshotRangeId) // create querier pointing to the function GetPriceSnapshotRange
+		require.NoError(t, err)
+
+		overflow := new(big.Int).Lsh(big.NewInt(1), 64) // truncated to 0 by Uint64
+		for _, page := range [][2]*big.Int{{overflow, big.NewInt(5)}, {big.NewInt(0), overflow}} {
+			args, err := query.Inputs.Pack(utils.MicroEthDenom, big.NewInt(9000), big.NewInt(9200), page[0], page[1])
+			require.NoError(t, err)
+			_, _, err = precompile.RunAndCalculateGas(
+				evm,
+				common.Address{},
+				common.Address{},
+				append(executor.GetPriceSnapshotRangeId, args...),
+				100000,
+				nil, nil, true, false)
+			require.Error(t, err)
+		}
+	})
+}
+
+func TestGetPullPrice(t *testing.T) {
+	// prepare env
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, types.Header{}).WithBlockHeight(2)
+	evmKeeper := testApp.EvmKeeper
+	oracleKeeper := testApp.OracleKeeper
+
+	// Insert a price on the pull oracle feed
+	oracleKeeper.SetPullPrice(ctx, oracletypes.PullPrice{
+		Denom:        utils.MicroBtcDenom,
+		Price:        sdk.NewDec(100000),
+		PublishTime:  9000,
+		Publishers:   []string{"kii", "pyth"},
+		UpdateHeight: 2,
+	})
+	defer oracleKeeper.DeletePullPrice(ctx, utils.MicroBtcDenom) // the test app is shared with the other tests
+
+	// setup sender and env
+	evm := setupEvmEnv(ctx, evmKeeper)
+
+	// create precompiled
+	precompile, err := oracle.NewPrecompile(oracleKeeper, &evmKeeper)
+	require.NoError(t, err)
+	executor := precompile.GetExecutor().(*oracle.PrecompileExecutor) // force to be an oracle executor
+
+	query, err := precompile.ABI.MethodById(executor.GetPullPriceId) // create querier pointing to the function GetPullPrice
+	require.NoError(t, err)
+
+	t.Run("price on the feed", func(t *testing.T) {
+		args, err := query.Inputs.Pack(utils.MicroBtcDenom)
+		require.NoError(t, err)
+		precompileRes, _, err := precompile.RunAndCalculateGas(
+			evm,
+			common.Address{},
+			common.Address{},
+			append(executor.GetPullPriceId, args...),
+			100000,
+			nil, nil, true, false)
+		require.Nil(t, err)
+
+		price, err := query.Outputs.Unpack(precompileRes)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(price))
+
+		// type assertion of the []interface{} response
+		actual, ok := price[0].(struct {
+			Denom        string   `json:"denom"`
+			Price        string   `json:"price"`
+			PublishTime  *big.Int `json:"publishTime"`
+			Publishers   []string `json:"publishers"`
+			UpdateHeight *big.Int `json:"updateHeight"`
+		})
+		require.True(t, ok)
+		require.Equal(t, utils.MicroBtcDenom, actual.Denom)
+		require.Equal(t, sdk.NewDec(100000).String(), actual.Price)
+		require.Equal(t, int64(9000), actual.PublishTime.Int64())
+		require.Equal(t, []string{"kii", "pyth"}, actual.Publishers)
+		require.Equal(t, int64(2), actual.UpdateHeight.Int64())
+	})
+
+	t.Run("denom without price", func(t *testing.T) {
+		args, err := query.Inputs.Pack(utils.MicroEthDenom)
+		require.NoError(t, err)
+		_, _, err = precompile.RunAndCalculateGas(
+			evm,
+			common.Address{},
+			common.Address{},
+			append(executor.GetPullPriceId, args...),
+			100000,
+			nil, nil, true, false)
+		require.Error(t, err)
+	})
+}
+
 func TestGetActives(t *testing.T) {
 	// prepare env
 	testApp := testkeeper.EVMTestApp
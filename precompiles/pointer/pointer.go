@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"math"
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -100,20 +99,7 @@ func (p PrecompileExecutor) AddNative(ctx sdk.Context, method *ethabi.Method, ca
 	if !metadataExists {
 		return nil, 0, fmt.Errorf("denom %s does not have metadata stored and thus can only have its pointer set through gov proposal", token)
 	}
-	name := metadata.Name
-	symbol := metadata.Symbol
-	var decimals uint8
-	for _, denomUnit := range metadata.DenomUnits {
-		if denomUnit.Exponent > uint32(decimals) && denomUnit.Exponent <= math.MaxUint8 {
-			decimals = uint8(denomUnit.Exponent)
-			name = denomUnit.Denom
-			symbol = denomUnit.Denom
-			if len(denomUnit.Aliases) > 0 {
-				name = denomUnit.Aliases[0]
-			}
-		}
-	}
-	contractAddr, err := p.evmKeeper.UpsertERCNativePointer(ctx, evm, token, utils.ERCMetadata{Name: name, Symbol: symbol, Decimals: decimals})
+	contractAddr, err := p.evmKeeper.UpsertERCNativePointer(ctx, evm, token, pcommon.GetERCNativeMetadata(metadata))
 	if err != nil {
 		return nil, 0, err
 	}
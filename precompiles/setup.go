@@ -17,6 +17,7 @@ import (
 	"github.com/kiichain/kiichain/precompiles/pointer"
 	"github.com/kiichain/kiichain/precompiles/pointerview"
 	"github.com/kiichain/kiichain/precompiles/staking"
+	"github.com/kiichain/kiichain/precompiles/tokenfactory"
 	"github.com/kiichain/kiichain/precompiles/wasmd"
 )
 
@@ -54,6 +55,8 @@ func InitializePrecompiles(
 	channelKeeper common.ChannelKeeper,
 	accountKeeper common.AccountKeeper,
 	oracleKeeper common.OracleKeeper,
+	tokenfactoryKeeper common.TokenFactoryKeeper,
+	tokenfactoryQuerier common.TokenFactoryQuerier,
 ) error {
 	SetupMtx.Lock()
 	defer SetupMtx.Unlock()
@@ -104,6 +107,10 @@ func InitializePrecompiles(
 	if err != nil {
 		return err
 	}
+	tokenfactoryp, err := tokenfactory.NewPrecompile(tokenfactoryKeeper, tokenfactoryQuerier, evmKeeper, bankKeeper)
+	if err != nil {
+		return err
+	}
 
 	PrecompileNamesToInfo[bankp.GetName()] = PrecompileInfo{ABI: bankp.GetABI(), Address: bankp.Address()}
 	PrecompileNamesToInfo[wasmdp.GetName()] = PrecompileInfo{ABI: wasmdp.GetABI(), Address: wasmdp.Address()}
@@ -116,6 +123,7 @@ func InitializePrecompiles(
 	PrecompileNamesToInfo[pointerp.GetName()] = PrecompileInfo{ABI: pointerp.GetABI(), Address: pointerp.Address()}
 	PrecompileNamesToInfo[pointerviewp.GetName()] = PrecompileInfo{ABI: pointerviewp.GetABI(), Address: pointerviewp.Address()}
 	PrecompileNamesToInfo[oraclep.GetName()] = PrecompileInfo{ABI: oraclep.GetABI(), Address: oraclep.Address()}
+	PrecompileNamesToInfo[tokenfactoryp.GetName()] = PrecompileInfo{ABI: tokenfactoryp.GetABI(), Address: tokenfactoryp.Address()}
 	if !dryRun {
 		addPrecompileToVM(bankp)
 		addPrecompileToVM(wasmdp)
@@ -128,6 +136,7 @@ func InitializePrecompiles(
 		addPrecompileToVM(pointerp)
 		addPrecompileToVM(pointerviewp)
 		addPrecompileToVM(oraclep)
+		addPrecompileToVM(tokenfactoryp)
 		Initialized = true
 	}
 	return nil
@@ -136,7 +145,7 @@ func InitializePrecompiles(
 func GetPrecompileInfo(name string) PrecompileInfo {
 	if !Initialized {
 		// Precompile Info does not require any keeper state
-		_ = InitializePrecompiles(true, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		_ = InitializePrecompiles(true, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	}
 	i, ok := PrecompileNamesToInfo[name]
 	if !ok {
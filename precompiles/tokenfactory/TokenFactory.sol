@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+address constant TOKENFACTORY_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000100c;
+
+ITokenFactory constant TOKENFACTORY_CONTRACT = ITokenFactory(
+    TOKENFACTORY_PRECOMPILE_ADDRESS
+);
+
+interface ITokenFactory {
+    // Transactions
+    function createDenom(
+        string memory subdenom,
+        bool deployPointer
+    ) external returns (string memory denom, address pointer);
+
+    function mint(
+        string memory denom,
+        uint256 amount,
+        address recipient
+    ) external returns (bool success);
+
+    function burn(
+        string memory denom,
+        uint256 amount,
+        address from
+    ) external returns (bool success);
+
+    function changeAdmin(
+        string memory denom,
+        address newAdmin
+    ) external returns (bool success);
+
+    function setMetadata(
+        string memory denom,
+        string memory name,
+        string memory symbol,
+        uint8 decimals
+    ) external returns (bool success);
+
+    // Queries
+    function getAdmin(
+        string memory denom
+    ) external view returns (string memory admin);
+}
@@ -0,0 +1,397 @@
+package tokenfactory
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	pcommon "github.com/kiichain/kiichain/precompiles/common"
+	tokenfactorytypes "github.com/kiichain/kiichain/x/tokenfactory/types"
+)
+
+const (
+	CreateDenomMethod = "createDenom"
+	MintMethod        = "mint"
+	BurnMethod        = "burn"
+	ChangeAdminMethod = "changeAdmin"
+	SetMetadataMethod = "setMetadata"
+	GetAdminMethod    = "getAdmin"
+)
+
+const (
+	TokenFactoryAddress = "0x000000000000000000000000000000000000100c"
+)
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+type PrecompileExecutor struct {
+	tokenfactoryKeeper  pcommon.TokenFactoryKeeper
+	tokenfactoryQuerier pcommon.TokenFactoryQuerier
+	evmKeeper           pcommon.EVMKeeper
+	bankKeeper          pcommon.BankKeeper
+	address             common.Address
+
+	CreateDenomID []byte
+	MintID        []byte
+	BurnID        []byte
+	ChangeAdminID []byte
+	SetMetadataID []byte
+	GetAdminID    []byte
+}
+
+func NewPrecompile(tokenfactoryKeeper pcommon.TokenFactoryKeeper, tokenfactoryQuerier pcommon.TokenFactoryQuerier, evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper) (*pcommon.DynamicGasPrecompile, error) {
+	newAbi := pcommon.MustGetABI(f, "abi.json")
+
+	p := &PrecompileExecutor{
+		tokenfactoryKeeper:  tokenfactoryKeeper,
+		tokenfactoryQuerier: tokenfactoryQuerier,
+		evmKeeper:           evmKeeper,
+		bankKeeper:          bankKeeper,
+		address:             common.HexToAddress(TokenFactoryAddress),
+	}
+
+	for name, m := range newAbi.Methods {
+		switch name {
+		case CreateDenomMethod:
+			p.CreateDenomID = m.ID
+		case MintMethod:
+			p.MintID = m.ID
+		case BurnMethod:
+			p.BurnID = m.ID
+		case ChangeAdminMethod:
+			p.ChangeAdminID = m.ID
+		case SetMetadataMethod:
+			p.SetMetadataID = m.ID
+		case GetAdminMethod:
+			p.GetAdminID = m.ID
+		}
+	}
+
+	return pcommon.NewDynamicGasPrecompile(newAbi, p, p.address, "tokenfactory"), nil
+}
+
+func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, caller common.Address, callingContract common.Address, args []interface{}, value *big.Int, readOnly bool, evm *vm.EVM, suppliedGas uint64) (ret []byte, remainingGas uint64, err error) {
+	if ctx.EVMPrecompileCalledFromDelegateCall() {
+		return nil, 0, errors.New("cannot delegatecall tokenfactory")
+	}
+	switch method.Name {
+	case CreateDenomMethod:
+		if readOnly {
+			return nil, 0, errors.New("cannot call tokenfactory precompile from staticcall")
+		}
+		return p.createDenom(ctx, method, caller, args, value, evm)
+	case MintMethod:
+		if readOnly {
+			return nil, 0, errors.New("cannot call tokenfactory precompile from staticcall")
+		}
+		return p.mint(ctx, method, caller, args, value)
+	case BurnMethod:
+		if readOnly {
+			return nil, 0, errors.New("cannot call tokenfactory precompile from staticcall")
+		}
+		return p.burn(ctx, method, caller, args, value)
+	case ChangeAdminMethod:
+		if readOnly {
+			return nil, 0, errors.New("cannot call tokenfactory precompile from staticcall")
+		}
+		return p.changeAdmin(ctx, method, caller, args, value)
+	case SetMetadataMethod:
+		if readOnly {
+			return nil, 0, errors.New("cannot call tokenfactory precompile from staticcall")
+		}
+		return p.setMetadata(ctx, method, caller, args, value, evm)
+	case GetAdminMethod:
+		return p.getAdmin(ctx, method, args, value)
+	default:
+		err = fmt.Errorf("unknown method %s", method.Name)
+	}
+	return
+}
+
+func (p PrecompileExecutor) EVMKeeper() pcommon.EVMKeeper {
+	return p.evmKeeper
+}
+
+// createDenom creates a denom with the caller as admin, and deploys its ERC20
+// native pointer if requested
+func (p PrecompileExecutor) createDenom(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int, evm *vm.EVM) (ret []byte, remainingGas uint64, rerr error) {
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	if err := p.validateInput(value, args, 2); err != nil {
+		rerr = err
+		return
+	}
+	creator, err := pcommon.GetKiiAddressByEvmAddress(ctx, caller, p.evmKeeper)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	msg := tokenfactorytypes.NewMsgCreateDenom(creator.String(), args[0].(string))
+	if err := msg.ValidateBasic(); err != nil {
+		rerr = err
+		return
+	}
+	res, err := p.tokenfactoryKeeper.CreateDenom(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	var pointer common.Address
+	if args[1].(bool) {
+		pointer, err = p.upsertPointer(ctx, evm, res.NewTokenDenom)
+		if err != nil {
+			rerr = err
+			return
+		}
+	}
+	ret, rerr = method.Outputs.Pack(res.NewTokenDenom, pointer)
+	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
+	return
+}
+
+func (p PrecompileExecutor) mint(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	if err := p.validateInput(value, args, 3); err != nil {
+		rerr = err
+		return
+	}
+	sender, err := pcommon.GetKiiAddressByEvmAddress(ctx, caller, p.evmKeeper)
+	if err != nil {
+		rerr = err
+		return
+	}
+	recipient, err := p.accAddressFromArg(ctx, args[2])
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	amount := sdk.NewCoin(args[0].(string), sdk.NewIntFromBigInt(args[1].(*big.Int)))
+	msg := tokenfactorytypes.NewMsgMintTo(sender.String(), amount, recipient.String())
+	if err := msg.ValidateBasic(); err != nil {
+		rerr = err
+		return
+	}
+	if _, err := p.tokenfactoryKeeper.Mint(sdk.WrapSDKContext(ctx), msg); err != nil {
+		rerr = err
+		return
+	}
+	ret, rerr = method.Outputs.Pack(true)
+	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
+	return
+}
+
+func (p PrecompileExecutor) burn(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	if err := p.validateInput(value, args, 3); err != nil {
+		rerr = err
+		return
+	}
+	sender, err := pcommon.GetKiiAddressByEvmAddress(ctx, caller, p.evmKeeper)
+	if err != nil {
+		rerr = err
+		return
+	}
+	from, err := p.accAddressFromArg(ctx, args[2])
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	amount := sdk.NewCoin(args[0].(string), sdk.NewIntFromBigInt(args[1].(*big.Int)))
+	msg := tokenfactorytypes.NewMsgBurnFrom(sender.String(), amount, from.String())
+	if err := msg.ValidateBasic(); err != nil {
+		rerr = err
+		return
+	}
+	if _, err := p.tokenfactoryKeeper.Burn(sdk.WrapSDKContext(ctx), msg); err != nil {
+		rerr = err
+		return
+	}
+	ret, rerr = method.Outputs.Pack(true)
+	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
+	return
+}
+
+func (p PrecompileExecutor) changeAdmin(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	if err := p.validateInput(value, args, 2); err != nil {
+		rerr = err
+		return
+	}
+	sender, err := pcommon.GetKiiAddressByEvmAddress(ctx, caller, p.evmKeeper)
+	if err != nil {
+		rerr = err
+		return
+	}
+	// the new admin must be associated to manage the denom from the EVM
+	newAdmin, err := pcommon.GetKiiAddressFromArg(ctx, args[1], p.evmKeeper)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	msg := tokenfactorytypes.NewMsgChangeAdmin(sender.String(), args[0].(string), newAdmin.String())
+	if err := msg.ValidateBasic(); err != nil {
+		rerr = err
+		return
+	}
+	if _, err := p.tokenfactoryKeeper.ChangeAdmin(sdk.WrapSDKContext(ctx), msg); err != nil {
+		rerr = err
+		return
+	}
+	ret, rerr = method.Outputs.Pack(true)
+	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
+	return
+}
+
+// setMetadata sets the bank metadata of the denom, the display unit is the
+// symbol with the given decimals. The ERC20 native pointer of the denom, if
+// any, is upgraded to the new metadata.
+func (p PrecompileExecutor) setMetadata(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int, evm *vm.EVM) (ret []byte, remainingGas uint64, rerr error) {
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	if err := p.validateInput(value, args, 4); err != nil {
+		rerr = err
+		return
+	}
+	sender, err := pcommon.GetKiiAddressByEvmAddress(ctx, caller, p.evmKeeper)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	denom := args[0].(string)
+	name := args[1].(string)
+	symbol := args[2].(string)
+	decimals := args[3].(uint8)
+	metadata := banktypes.Metadata{
+		Base:       denom,
+		Display:    denom,
+		Name:       name,
+		Symbol:     symbol,
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+	}
+	if decimals > 0 {
+		metadata.Display = symbol
+		metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{
+			Denom:    symbol,
+			Exponent: uint32(decimals),
+			Aliases:  []string{name},
+		})
+	}
+
+	msg := tokenfactorytypes.NewMsgSetDenomMetadata(sender.String(), metadata)
+	if err := msg.ValidateBasic(); err != nil {
+		rerr = err
+		return
+	}
+	if _, err := p.tokenfactoryKeeper.SetDenomMetadata(sdk.WrapSDKContext(ctx), msg); err != nil {
+		rerr = err
+		return
+	}
+	if _, _, exists := p.evmKeeper.GetERC20NativePointer(ctx, denom); exists {
+		if _, err := p.upsertPointer(ctx, evm, denom); err != nil {
+			rerr = err
+			return
+		}
+	}
+	ret, rerr = method.Outputs.Pack(true)
+	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
+	return
+}
+
+func (p PrecompileExecutor) getAdmin(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+	if err := p.validateInput(value, args, 1); err != nil {
+		return nil, 0, err
+	}
+
+	res, err := p.tokenfactoryQuerier.DenomAuthorityMetadata(sdk.WrapSDKContext(ctx), &tokenfactorytypes.QueryDenomAuthorityMetadataRequest{
+		Denom: args[0].(string),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	ret, rerr = method.Outputs.Pack(res.AuthorityMetadata.Admin)
+	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
+	return
+}
+
+// upsertPointer deploys the ERC20 native pointer of the denom from its bank metadata
+func (p PrecompileExecutor) upsertPointer(ctx sdk.Context, evm *vm.EVM, denom string) (common.Address, error) {
+	metadata, found := p.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return common.Address{}, fmt.Errorf("denom %s does not have metadata stored", denom)
+	}
+	return p.evmKeeper.UpsertERCNativePointer(ctx, evm, denom, pcommon.GetERCNativeMetadata(metadata))
+}
+
+func (p PrecompileExecutor) validateInput(value *big.Int, args []interface{}, expectedArgsLength int) error {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return err
+	}
+
+	if err := pcommon.ValidateArgsLength(args, expectedArgsLength); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// accAddressFromArg returns the Kii address of the EVM address, the casted
+// address if it is not associated
+func (p PrecompileExecutor) accAddressFromArg(ctx sdk.Context, arg interface{}) (sdk.AccAddress, error) {
+	addr := arg.(common.Address)
+	if addr == (common.Address{}) {
+		return nil, errors.New("invalid addr")
+	}
+	kiiAddr, found := p.evmKeeper.GetKiiAddress(ctx, addr)
+	if !found {
+		return sdk.AccAddress(addr[:]), nil
+	}
+	return kiiAddr, nil
+}
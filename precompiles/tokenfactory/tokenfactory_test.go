@@ -0,0 +1,120 @@
+package tokenfactory_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/kiichain/kiichain/precompiles/tokenfactory"
+	testkeeper "github.com/kiichain/kiichain/testutil/keeper"
+	"github.com/kiichain/kiichain/x/evm/artifacts/native"
+	"github.com/kiichain/kiichain/x/evm/state"
+	"github.com/kiichain/kiichain/x/evm/types"
+	tokenfactorykeeper "github.com/kiichain/kiichain/x/tokenfactory/keeper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenFactory(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	p, err := tokenfactory.NewPrecompile(tokenfactorykeeper.NewMsgServerImpl(testApp.TokenFactoryKeeper), testApp.TokenFactoryKeeper, &testApp.EvmKeeper, testApp.BankKeeper)
+	require.Nil(t, err)
+	executor := p.GetExecutor().(*tokenfactory.PrecompileExecutor)
+	ctx := testApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx))
+	suppliedGas := uint64(10000000)
+	cfg := types.DefaultChainConfig().EthereumConfig(testApp.EvmKeeper.ChainID(ctx))
+
+	// setup the admin, the new admin and the recipient, which is not associated
+	adminAddr, adminEVMAddr := testkeeper.MockAddressPair()
+	testApp.EvmKeeper.SetAddressMapping(ctx, adminAddr, adminEVMAddr)
+	newAdminAddr, newAdminEVMAddr := testkeeper.MockAddressPair()
+	testApp.EvmKeeper.SetAddressMapping(ctx, newAdminAddr, newAdminEVMAddr)
+	_, recipientEVMAddr := testkeeper.MockAddressPair()
+	recipientAddr := testApp.EvmKeeper.GetKiiAddressOrDefault(ctx, recipientEVMAddr)
+
+	statedb := state.NewDBImpl(ctx, &testApp.EvmKeeper, false)
+	blockCtx, _ := testApp.EvmKeeper.GetVMBlockContext(ctx, core.GasPool(suppliedGas))
+	evm := vm.NewEVM(*blockCtx, vm.TxContext{}, statedb, cfg, vm.Config{})
+	run := func(caller common.Address, methodID []byte, readOnly bool, args ...interface{}) ([]interface{}, error) {
+		method, err := p.ABI.MethodById(methodID)
+		require.Nil(t, err)
+		input, err := method.Inputs.Pack(args...)
+		require.Nil(t, err)
+		ret, _, err := p.RunAndCalculateGas(evm, caller, caller, append(methodID, input...), suppliedGas, nil, nil, readOnly, false)
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Unpack(ret)
+	}
+
+	// the caller must be associated
+	_, err = run(recipientEVMAddr, executor.CreateDenomID, false, "evmcoin", true)
+	require.NotNil(t, err)
+	// transactions can't be called from staticcall
+	_, err = run(adminEVMAddr, executor.CreateDenomID, true, "evmcoin", true)
+	require.NotNil(t, err)
+
+	// create the denom and deploy its pointer
+	outputs, err := run(adminEVMAddr, executor.CreateDenomID, false, "evmcoin", true)
+	require.Nil(t, err)
+	denom := outputs[0].(string)
+	require.Equal(t, fmt.Sprintf("factory/%s/evmcoin", adminAddr.String()), denom)
+	pointerAddr, _, exists := testApp.EvmKeeper.GetERC20NativePointer(statedb.Ctx(), denom)
+	require.True(t, exists)
+	require.Equal(t, pointerAddr, outputs[1].(common.Address))
+
+	// the denom can be created without pointer
+	outputs, err = run(adminEVMAddr, executor.CreateDenomID, false, "nopointer", false)
+	require.Nil(t, err)
+	require.Equal(t, common.Address{}, outputs[1].(common.Address))
+	_, _, exists = testApp.EvmKeeper.GetERC20NativePointer(statedb.Ctx(), outputs[0].(string))
+	require.False(t, exists)
+
+	outputs, err = run(recipientEVMAddr, executor.GetAdminID, true, denom)
+	require.Nil(t, err)
+	require.Equal(t, adminAddr.String(), outputs[0].(string))
+
+	// mint to and burn from the recipient
+	_, err = run(adminEVMAddr, executor.MintID, false, denom, big.NewInt(1000), recipientEVMAddr)
+	require.Nil(t, err)
+	_, err = run(newAdminEVMAddr, executor.MintID, false, denom, big.NewInt(1000), recipientEVMAddr)
+	require.NotNil(t, err)
+	_, err = run(adminEVMAddr, executor.BurnID, false, denom, big.NewInt(400), recipientEVMAddr)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(600), testApp.BankKeeper.GetBalance(statedb.Ctx(), recipientAddr, denom).Amount)
+
+	// the pointer is upgraded with the metadata
+	_, err = run(adminEVMAddr, executor.SetMetadataID, false, denom, "EVM Coin", "EVMC", uint8(6))
+	require.Nil(t, err)
+	metadata, found := testApp.BankKeeper.GetDenomMetaData(statedb.Ctx(), denom)
+	require.True(t, found)
+	require.Equal(t, "EVMC", metadata.Display)
+	newPointerAddr, _, exists := testApp.EvmKeeper.GetERC20NativePointer(statedb.Ctx(), denom)
+	require.True(t, exists)
+	require.Equal(t, pointerAddr, newPointerAddr)
+	for method, expected := range map[string]interface{}{"name": "EVM Coin", "symbol": "EVMC", "decimals": uint8(6)} {
+		input, err := native.GetParsedABI().Pack(method)
+		require.Nil(t, err)
+		ret, _, err := evm.StaticCall(vm.AccountRef(adminEVMAddr), pointerAddr, input, suppliedGas)
+		require.Nil(t, err)
+		res, err := native.GetParsedABI().Unpack(method, ret)
+		require.Nil(t, err)
+		require.Equal(t, expected, res[0])
+	}
+
+	// the new admin must be associated
+	_, err = run(adminEVMAddr, executor.ChangeAdminID, false, denom, recipientEVMAddr)
+	require.NotNil(t, err)
+	_, err = run(adminEVMAddr, executor.ChangeAdminID, false, denom, newAdminEVMAddr)
+	require.Nil(t, err)
+	outputs, err = run(adminEVMAddr, executor.GetAdminID, true, denom)
+	require.Nil(t, err)
+	require.Equal(t, newAdminAddr.String(), outputs[0].(string))
+	_, err = run(adminEVMAddr, executor.MintID, false, denom, big.NewInt(1000), recipientEVMAddr)
+	require.NotNil(t, err)
+}
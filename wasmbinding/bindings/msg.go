@@ -26,12 +26,26 @@ type SetMetadata struct {
 	Metadata banktypes.Metadata `json:"metadata"`
 }
 
+// / MintTokens mints the tokens of a factory denom to the MintToAddress,
+// / or to the admin contract if it is empty.
 type MintTokens struct {
-	Amount sdk.Coin `json:"amount"`
+	Amount        sdk.Coin `json:"amount"`
+	MintToAddress string   `json:"mint_to_address,omitempty"`
 }
 
+// / BurnTokens burns the tokens of a factory denom from the BurnFromAddress,
+// / or from the admin contract if it is empty.
 type BurnTokens struct {
-	Amount sdk.Coin `json:"amount"`
+	Amount          sdk.Coin `json:"amount"`
+	BurnFromAddress string   `json:"burn_from_address,omitempty"`
+}
+
+// / ForceTransfer transfers the tokens of a factory denom between any two
+// / accounts. Only the admin of the denom can force a transfer.
+type ForceTransfer struct {
+	Amount              sdk.Coin `json:"amount"`
+	TransferFromAddress string   `json:"transfer_from_address"`
+	TransferToAddress   string   `json:"transfer_to_address"`
 }
 
 type CallEVM struct {
@@ -17,6 +17,7 @@ type KiiWasmMessage struct {
 	BurnTokens      json.RawMessage `json:"burn_tokens,omitempty"`
 	ChangeAdmin     json.RawMessage `json:"change_admin,omitempty"`
 	SetMetadata     json.RawMessage `json:"set_metadata,omitempty"`
+	ForceTransfer   json.RawMessage `json:"force_transfer,omitempty"`
 	CallEVM         json.RawMessage `json:"call_evm,omitempty"`
 	DelegateCallEVM json.RawMessage `json:"delegate_call_evm,omitempty"`
 }
@@ -37,6 +38,8 @@ func CustomEncoder(sender sdk.AccAddress, msg json.RawMessage, info wasmvmtypes.
 		return tokenfactorywasm.EncodeTokenFactoryChangeAdmin(parsedMessage.ChangeAdmin, sender)
 	case parsedMessage.SetMetadata != nil:
 		return tokenfactorywasm.EncodeTokenFactorySetMetadata(parsedMessage.SetMetadata, sender)
+	case parsedMessage.ForceTransfer != nil:
+		return tokenfactorywasm.EncodeTokenFactoryForceTransfer(parsedMessage.ForceTransfer, sender)
 	case parsedMessage.CallEVM != nil:
 		return evmwasm.EncodeCallEVM(parsedMessage.CallEVM, sender, info)
 	case parsedMessage.DelegateCallEVM != nil:
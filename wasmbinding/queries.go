@@ -207,6 +207,32 @@ func (qp QueryPlugin) HandleOracleQuery(ctx sdk.Context, queryData json.RawMessa
 
 		return byteData, nil
 
+	case querier.OracleEmas != nil:
+		res, err := qp.oracleHandler.GetOracleEmas(ctx, querier.OracleEmas)
+		if err != nil {
+			return nil, err
+		}
+
+		byteData, err := json.Marshal(res)
+		if err != nil {
+			return nil, err
+		}
+
+		return byteData, nil
+
+	case querier.OraclePriceStats != nil:
+		res, err := qp.oracleHandler.GetOraclePriceStats(ctx, querier.OraclePriceStats)
+		if err != nil {
+			return nil, err
+		}
+
+		byteData, err := json.Marshal(res)
+		if err != nil {
+			return nil, err
+		}
+
+		return byteData, nil
+
 	case querier.Actives != nil:
 		res, err := qp.oracleHandler.GetActives(ctx, querier.Actives)
 		if err != nil {
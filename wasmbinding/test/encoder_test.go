@@ -80,6 +80,74 @@ func TestEncodeBurn(t *testing.T) {
 	require.Equal(t, expectedMsg, *typedDecodedMsg)
 }
 
+func TestEncodeMintTo(t *testing.T) {
+	contractAddr, err := sdk.AccAddressFromBech32("kii1y3pxq5dp900czh0mkudhjdqjq5m8cpmm4hvczs")
+	require.NoError(t, err)
+	msg := bindings.MintTokens{
+		Amount:        sdk.Coin{Amount: sdk.NewInt(100), Denom: "subdenom"},
+		MintToAddress: "kii1hjfwcza3e3uzeznf3qthhakdr9juetl7uajv0t",
+	}
+	serializedMsg, _ := json.Marshal(msg)
+
+	decodedMsgs, err := tokenfactorywasm.EncodeTokenFactoryMint(serializedMsg, contractAddr)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(decodedMsgs))
+	typedDecodedMsg, ok := decodedMsgs[0].(*tokenfactorytypes.MsgMint)
+	require.True(t, ok)
+	expectedMsg := tokenfactorytypes.MsgMint{
+		Sender:        "kii1y3pxq5dp900czh0mkudhjdqjq5m8cpmm4hvczs",
+		Amount:        sdk.Coin{Amount: sdk.NewInt(100), Denom: "subdenom"},
+		MintToAddress: "kii1hjfwcza3e3uzeznf3qthhakdr9juetl7uajv0t",
+	}
+	require.Equal(t, expectedMsg, *typedDecodedMsg)
+}
+
+func TestEncodeBurnFrom(t *testing.T) {
+	contractAddr, err := sdk.AccAddressFromBech32("kii1y3pxq5dp900czh0mkudhjdqjq5m8cpmm4hvczs")
+	require.NoError(t, err)
+	msg := bindings.BurnTokens{
+		Amount:          sdk.Coin{Amount: sdk.NewInt(10), Denom: "subdenom"},
+		BurnFromAddress: "kii1hjfwcza3e3uzeznf3qthhakdr9juetl7uajv0t",
+	}
+	serializedMsg, _ := json.Marshal(msg)
+
+	decodedMsgs, err := tokenfactorywasm.EncodeTokenFactoryBurn(serializedMsg, contractAddr)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(decodedMsgs))
+	typedDecodedMsg, ok := decodedMsgs[0].(*tokenfactorytypes.MsgBurn)
+	require.True(t, ok)
+	expectedMsg := tokenfactorytypes.MsgBurn{
+		Sender:          "kii1y3pxq5dp900czh0mkudhjdqjq5m8cpmm4hvczs",
+		Amount:          sdk.Coin{Amount: sdk.NewInt(10), Denom: "subdenom"},
+		BurnFromAddress: "kii1hjfwcza3e3uzeznf3qthhakdr9juetl7uajv0t",
+	}
+	require.Equal(t, expectedMsg, *typedDecodedMsg)
+}
+
+func TestEncodeForceTransfer(t *testing.T) {
+	contractAddr, err := sdk.AccAddressFromBech32("kii1y3pxq5dp900czh0mkudhjdqjq5m8cpmm4hvczs")
+	require.NoError(t, err)
+	msg := bindings.ForceTransfer{
+		Amount:              sdk.Coin{Amount: sdk.NewInt(10), Denom: "subdenom"},
+		TransferFromAddress: "kii1hjfwcza3e3uzeznf3qthhakdr9juetl7uajv0t",
+		TransferToAddress:   "kii1y3pxq5dp900czh0mkudhjdqjq5m8cpmm4hvczs",
+	}
+	serializedMsg, _ := json.Marshal(msg)
+
+	decodedMsgs, err := tokenfactorywasm.EncodeTokenFactoryForceTransfer(serializedMsg, contractAddr)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(decodedMsgs))
+	typedDecodedMsg, ok := decodedMsgs[0].(*tokenfactorytypes.MsgForceTransfer)
+	require.True(t, ok)
+	expectedMsg := tokenfactorytypes.MsgForceTransfer{
+		Sender:              "kii1y3pxq5dp900czh0mkudhjdqjq5m8cpmm4hvczs",
+		Amount:              sdk.Coin{Amount: sdk.NewInt(10), Denom: "subdenom"},
+		TransferFromAddress: "kii1hjfwcza3e3uzeznf3qthhakdr9juetl7uajv0t",
+		TransferToAddress:   "kii1y3pxq5dp900czh0mkudhjdqjq5m8cpmm4hvczs",
+	}
+	require.Equal(t, expectedMsg, *typedDecodedMsg)
+}
+
 func TestEncodeChangeAdmin(t *testing.T) {
 	contractAddr, err := sdk.AccAddressFromBech32("kii1y3pxq5dp900czh0mkudhjdqjq5m8cpmm4hvczs")
 	require.NoError(t, err)
@@ -24,6 +24,7 @@ import (
 	"github.com/kiichain/kiichain/x/oracle/utils"
 	tokenfactorywasm "github.com/kiichain/kiichain/x/tokenfactory/client/wasm"
 	tokenfactorybinding "github.com/kiichain/kiichain/x/tokenfactory/client/wasm/bindings"
+	tokenfactorykeeper "github.com/kiichain/kiichain/x/tokenfactory/keeper"
 	tokenfactorytypes "github.com/kiichain/kiichain/x/tokenfactory/types"
 
 	oraclebinding "github.com/kiichain/kiichain/x/oracle/client/wasm/bindings"
@@ -88,8 +89,19 @@ func TestWasmGetDenomAuthorityMetadata(t *testing.T) {
 	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(11).WithBlockTime(time.Unix(3600, 0))
 	// Create denom
 	testWrapper.App.TokenFactoryKeeper.CreateDenom(testWrapper.Ctx, app.TestUser, "test")
+
+	// Grant a minter role with an allowance
+	minter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(testWrapper.App.TokenFactoryKeeper)
+	_, err := msgServer.GrantRole(sdk.WrapSDKContext(testWrapper.Ctx), tokenfactorytypes.NewMsgGrantRole(app.TestUser, denom, tokenfactorytypes.RoleMinter, minter))
+	require.NoError(t, err)
+	_, err = msgServer.SetMinterAllowance(sdk.WrapSDKContext(testWrapper.Ctx), tokenfactorytypes.NewMsgSetMinterAllowance(app.TestUser, denom, minter, sdk.NewInt(1000)))
+	require.NoError(t, err)
+
 	authorityMetadata := tokenfactorytypes.DenomAuthorityMetadata{
-		Admin: app.TestUser,
+		Admin:            app.TestUser,
+		Minters:          []string{minter},
+		MinterAllowances: []tokenfactorytypes.MinterAllowance{{Minter: minter, Allowance: sdk.NewInt(1000)}},
 	}
 
 	// Setup tfk query
@@ -271,6 +283,61 @@ func TestOracleGetOracleTwaps(t *testing.T) {
 	require.Equal(t, int64(100), parsedRes.OracleTwap[0].LookbackSeconds)
 }
 
+func TestOracleGetOracleEmasAndPriceStats(t *testing.T) {
+	// setup env
+	testWrapper, customQuerier := SetupWasmbindingTest(t)
+	oracleKeeper := testWrapper.App.OracleKeeper
+	ctx := testWrapper.Ctx.WithBlockHeight(12).WithBlockTime(time.Unix(3700, 0))
+
+	// simulate snapshots to have history data
+	for i, price := range []int64{10, 20} {
+		timestamp := int64(3600 + i*50)
+		snapshotItem := oracletypes.NewPriceSnapshotItem(utils.MicroAtomDenom, oracletypes.OracleExchangeRate{
+			ExchangeRate:        sdk.NewDec(price),
+			LastUpdate:          sdk.NewInt(10),
+			LastUpdateTimestamp: timestamp,
+		})
+		oracleKeeper.SetPriceSnapshot(ctx, oracletypes.NewPriceSnapshot(timestamp, oracletypes.PriceSnapshotItems{snapshotItem}))
+	}
+	oracleKeeper.SetVoteTarget(ctx, utils.MicroAtomDenom)
+
+	// create ema query request
+	req := oraclebinding.KiiOracleQuery{OracleEmas: &types.QueryEmasRequest{LookbackSeconds: 200, HalfLifeSeconds: 50}}
+	queryData, err := json.Marshal(req)
+	require.NoError(t, err)
+	rawQuery, err := json.Marshal(wasmbinding.KiiQueryWrapper{Route: wasmbinding.OracleRoute, QueryData: queryData})
+	require.NoError(t, err)
+
+	// execute query
+	res, err := customQuerier(ctx, rawQuery)
+	require.NoError(t, err)
+
+	emaRes := &oracletypes.QueryEmasResponse{}
+	err = json.Unmarshal(res, emaRes)
+	require.NoError(t, err)
+	require.Equal(t, utils.MicroAtomDenom, emaRes.OracleEma[0].Denom)
+	require.Equal(t, int64(100), emaRes.OracleEma[0].LookbackSeconds)
+
+	// create price stats query request
+	req = oraclebinding.KiiOracleQuery{OraclePriceStats: &types.QueryPriceStatsRequest{LookbackSeconds: 200}}
+	queryData, err = json.Marshal(req)
+	require.NoError(t, err)
+	rawQuery, err = json.Marshal(wasmbinding.KiiQueryWrapper{Route: wasmbinding.OracleRoute, QueryData: queryData})
+	require.NoError(t, err)
+
+	// execute query
+	res, err = customQuerier(ctx, rawQuery)
+	require.NoError(t, err)
+
+	statsRes := &oracletypes.QueryPriceStatsResponse{}
+	err = json.Unmarshal(res, statsRes)
+	require.NoError(t, err)
+	require.Equal(t, utils.MicroAtomDenom, statsRes.OraclePriceStats[0].Denom)
+	require.Equal(t, sdk.NewDec(15), statsRes.OraclePriceStats[0].Median)
+	require.Equal(t, sdk.NewDec(5), statsRes.OraclePriceStats[0].Volatility)
+	require.Equal(t, uint64(2), statsRes.OraclePriceStats[0].SampleCount)
+}
+
 func TestOracleGetActives(t *testing.T) {
 	// setup env
 	testWrapper, customQuerier := SetupWasmbindingTest(t)
@@ -9,6 +9,12 @@ import (
 	"github.com/kiichain/kiichain/x/oracle/utils"
 )
 
+// BeginBlocker is the function executed at the beginning of each block
+// this function moves the reward pool share of the collected fees into the oracle module account
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.FundRewardPool(ctx)
+}
+
 // MidBlocker is the function executed when each block has been completed
 // this function get the votes from the validators, calculate the exchange rate using
 // weighted median logic when the vote period is almost finished
@@ -41,6 +47,10 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 		// Get the voting targets from the KVStore
 		voteTargets := make(map[string]types.Denom)
 		k.IterateVoteTargets(ctx, func(denom string, denomInfo types.Denom) bool {
+			// use the whitelist entry to get the denom overrides
+			if whitelistDenom, ok := params.Whitelist.Get(denom); ok {
+				denomInfo = whitelistDenom
+			}
 			voteTargets[denom] = denomInfo
 			return false
 		})
@@ -73,18 +83,26 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 				}
 
 				// Get weighted median of cross exchange rates
-				exchangeRate := Tally(ctx, votingTally, params.RewardBand, validatorClaimMap)
+				exchangeRate := Tally(ctx, votingTally, params.DenomRewardBand(denom), validatorClaimMap)
 
 				// Validate invalid exchangeRate
 				if exchangeRate.IsZero() {
 					continue // skip this denom
 				}
 
+				// track how far each vote was from the final weighted median
+				recordVoteDeviation(votingTally, exchangeRate, validatorClaimMap)
+
 				// transform into the original form base/quote
 				if denom != referenceDenom {
 					exchangeRate = exchangeRateRD.Quo(exchangeRate)
 				}
 
+				// halt the denom if the price moved more than allowed, the previous price is kept
+				if k.CheckPriceDeviation(ctx, denom, exchangeRate, params.MaxPriceDeviation) {
+					continue
+				}
+
 				// set the exchange rate with event
 				k.SetBaseExchangeRateWithEvent(ctx, denom, exchangeRate)
 			}
@@ -100,11 +118,16 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 		// Calculate tally for below threshold assets lists
 		for _, denom := range belowThresholdDenoms {
 			ballot := belowThresholdVoteMap[denom]
-			Tally(ctx, ballot, params.RewardBand, validatorClaimMap)
+			Tally(ctx, ballot, params.DenomRewardBand(denom), validatorClaimMap)
 		}
 
 		// Validate miss voting process
 		for _, claim := range validatorClaimMap {
+			// add the vote deviation to the validator oracle stats
+			if claim.DeviationCount > 0 {
+				k.AddValidatorVoteDeviation(ctx, claim.Recipient, claim.DeviationSum, claim.DeviationCount)
+			}
+
 			if int(claim.WinCount) == len(voteTargets) {
 				k.IncrementSuccessCount(ctx, claim.Recipient)
 				continue
@@ -118,15 +141,22 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 			k.IncrementMissCount(ctx, claim.Recipient)
 		}
 
+		// Pay the reward pool share to the validators who voted inside the reward band
+		k.RewardBallotWinners(ctx, params.VotePeriod, params.RewardDistributionWindow, validatorClaimMap)
+
 		// Clear the ballot
-		k.ClearBallots(ctx)
+		k.ClearBallots(ctx, params.VotePeriod)
 
 		// Update vote target
 		k.ApplyWhitelist(ctx, params.Whitelist, voteTargets)
 
-		// take an snapshot for each price
+		// take an snapshot for each price, the halted ones are skipped
 		priceSnapshotItems := []types.PriceSnapshotItem{}
 		k.IterateBaseExchangeRates(ctx, func(denom string, exchangeRate types.OracleExchangeRate) bool {
+			if k.IsDenomHalted(ctx, denom) {
+				return false
+			}
+
 			priceSnapshotItem := types.PriceSnapshotItem{
 				Denom:              denom,
 				OracleExchangeRate: exchangeRate,
@@ -151,6 +181,11 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 func Endblocker(ctx sdk.Context, k keeper.Keeper) {
 	params := k.GetParams(ctx)
 
+	// Report the validators voting performance once the vote period is tallied
+	if utils.IsPeriodLastBlock(ctx, params.VotePeriod) {
+		k.EmitValidatorOracleStatsTelemetry(ctx)
+	}
+
 	// Slash who did miss voting over threshold
 	// reset miss counter of all validators at the last block of slash window
 	if utils.IsPeriodLastBlock(ctx, params.SlashWindow) {
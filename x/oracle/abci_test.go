@@ -44,7 +44,7 @@ func TestMidBlocker(t *testing.T) {
 
 		// Multiple validators submit their votes
 		for i := 0; i < 3; i++ {
-			voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
+			voteMsg := makeAggregatePrevoteAndVote(t, input, handler, 1, exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
 			_, err := handler(ctx, voteMsg)
 			require.NoError(t, err)
 		}
@@ -73,7 +73,7 @@ func TestMidBlocker(t *testing.T) {
 
 		// Multiple validators submit their votes
 		for i := 0; i < 3; i++ {
-			voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
+			voteMsg := makeAggregatePrevoteAndVote(t, input, handler, 1, exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
 			_, err := handler(ctx, voteMsg)
 			require.NoError(t, err)
 		}
@@ -88,6 +88,181 @@ func TestMidBlocker(t *testing.T) {
 		})
 	})
 
+	t.Run("Success case - vote deviation added to the validator oracle stats", func(t *testing.T) {
+		// Reset blockchain state
+		input, handler := SetUp(t)
+		oracleKeeper := input.OracleKeeper
+
+		// Sample exchange rate for the test
+		oracleKeeper.DeleteVoteTargets(input.Ctx)
+		oracleKeeper.SetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+		exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
+
+		ctx := input.Ctx.WithBlockHeight(1)
+
+		// Multiple validators submit the same vote
+		for i := 0; i < 3; i++ {
+			voteMsg := makeAggregatePrevoteAndVote(t, input, handler, 1, exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
+			_, err := handler(ctx, voteMsg)
+			require.NoError(t, err)
+		}
+
+		MidBlocker(ctx, oracleKeeper)
+		Endblocker(ctx, oracleKeeper)
+
+		// all the votes are equal to the weighted median
+		for i := 0; i < 3; i++ {
+			stats := oracleKeeper.GetValidatorOracleStats(ctx, keeper.ValAddrs[i])
+			require.Equal(t, uint64(1), stats.CurrentDeviationCount)
+			require.Equal(t, sdk.ZeroDec(), stats.CurrentDeviationSum)
+		}
+	})
+
+	t.Run("Success case - reward pool paid to the winners", func(t *testing.T) {
+		// Reset blockchain state
+		input, handler := SetUp(t)
+		ctx := input.Ctx
+		oracleKeeper := input.OracleKeeper
+
+		// pay 10% of the pool on each vote period
+		params := oracleKeeper.GetParams(ctx)
+		params.RewardDistributionWindow = params.VotePeriod * 10
+		oracleKeeper.SetParams(ctx, params)
+
+		// fund the reward pool
+		pool := sdk.NewCoins(sdk.NewInt64Coin(utils.MicroKiiDenom, 1000))
+		err := input.BankKeeper.SendCoinsFromAccountToModule(ctx, keeper.Addrs[5], types.ModuleName, pool)
+		require.NoError(t, err)
+
+		// Sample exchange rate for the test
+		oracleKeeper.DeleteVoteTargets(ctx)
+		oracleKeeper.SetVoteTarget(ctx, utils.MicroAtomDenom)
+		exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
+
+		ctx = input.Ctx.WithBlockHeight(1)
+
+		// Multiple validators submit their votes
+		for i := 0; i < 3; i++ {
+			voteMsg := makeAggregatePrevoteAndVote(t, input, handler, 1, exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
+			_, err := handler(ctx, voteMsg)
+			require.NoError(t, err)
+		}
+
+		MidBlocker(ctx, oracleKeeper)
+
+		// validators have the same power, so each one receives a third of the period rewards
+		for i := 0; i < 3; i++ {
+			rewards := input.DistKeeper.GetValidatorOutstandingRewards(ctx, keeper.ValAddrs[i]).Rewards
+			require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin(utils.MicroKiiDenom, 33)), rewards)
+		}
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(utils.MicroKiiDenom, 901)), oracleKeeper.GetRewardPool(ctx))
+	})
+
+	t.Run("Success case - denom halted by the circuit breaker", func(t *testing.T) {
+		// Reset blockchain state
+		input, handler := SetUp(t)
+		ctx := input.Ctx
+		oracleKeeper := input.OracleKeeper
+
+		// halt the denoms whose price moves more than 10%
+		params := oracleKeeper.GetParams(ctx)
+		params.MaxPriceDeviation = sdk.NewDecWithPrec(1, 1)
+		params.HaltRecoveryPeriods = 2
+		params.Whitelist = types.DenomList{{Name: utils.MicroAtomDenom}}
+		oracleKeeper.SetParams(ctx, params)
+
+		// Sample exchange rate for the test, the previous price is the half
+		oracleKeeper.DeleteVoteTargets(ctx)
+		oracleKeeper.SetVoteTarget(ctx, utils.MicroAtomDenom)
+		oracleKeeper.SetBaseExchangeRate(ctx, utils.MicroAtomDenom, randomAExchangeRate.QuoInt64(2))
+		exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
+
+		ctx = input.Ctx.WithBlockHeight(1)
+
+		// Multiple validators submit their votes
+		for i := 0; i < 3; i++ {
+			voteMsg := makeAggregatePrevoteAndVote(t, input, handler, 1, exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
+			_, err := handler(ctx, voteMsg)
+			require.NoError(t, err)
+		}
+
+		MidBlocker(ctx, oracleKeeper)
+		Endblocker(ctx, oracleKeeper)
+
+		// the previous price is kept and halted
+		rate, lastUpdate, _, err := oracleKeeper.GetBaseExchangeRate(ctx, utils.MicroAtomDenom)
+		require.NoError(t, err)
+		require.Equal(t, randomAExchangeRate.QuoInt64(2), rate)
+		require.Equal(t, types.ExchangeRateStatusHalted, oracleKeeper.GetExchangeRateStatus(ctx, utils.MicroAtomDenom, lastUpdate))
+
+		// the halted price is not added to the snapshots
+		require.Empty(t, oracleKeeper.GetPriceSnapshot(ctx, ctx.BlockTime().Unix()).PriceSnapshotItems)
+
+		// a second deviating vote keeps the denom halted
+		ctx = input.Ctx.WithBlockHeight(2)
+		for i := 0; i < 3; i++ {
+			voteMsg := makeAggregatePrevoteAndVote(t, input, handler, 2, exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
+			_, err := handler(ctx, voteMsg)
+			require.NoError(t, err)
+		}
+
+		MidBlocker(ctx, oracleKeeper)
+		Endblocker(ctx, oracleKeeper)
+
+		rate, lastUpdate, _, err = oracleKeeper.GetBaseExchangeRate(ctx, utils.MicroAtomDenom)
+		require.NoError(t, err)
+		require.Equal(t, randomAExchangeRate.QuoInt64(2), rate)
+		require.Equal(t, types.ExchangeRateStatusHalted, oracleKeeper.GetExchangeRateStatus(ctx, utils.MicroAtomDenom, lastUpdate))
+
+		// the denom resumes at the new price once the medians of two vote periods agree
+		ctx = input.Ctx.WithBlockHeight(3)
+		for i := 0; i < 3; i++ {
+			voteMsg := makeAggregatePrevoteAndVote(t, input, handler, 3, exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
+			_, err := handler(ctx, voteMsg)
+			require.NoError(t, err)
+		}
+
+		MidBlocker(ctx, oracleKeeper)
+		Endblocker(ctx, oracleKeeper)
+
+		rate, lastUpdate, _, err = oracleKeeper.GetBaseExchangeRate(ctx, utils.MicroAtomDenom)
+		require.NoError(t, err)
+		require.Equal(t, randomAExchangeRate, rate)
+		require.Equal(t, int64(3), lastUpdate.Int64())
+		require.Equal(t, types.ExchangeRateStatusActive, oracleKeeper.GetExchangeRateStatus(ctx, utils.MicroAtomDenom, lastUpdate))
+		require.Len(t, oracleKeeper.GetPriceSnapshot(ctx, ctx.BlockTime().Unix()).PriceSnapshotItems, 1)
+	})
+
+	t.Run("Error case - Less voters than the denom min voters", func(t *testing.T) {
+		// Reset blockchain state
+		input, handler := SetUp(t)
+		ctx := input.Ctx
+		oracleKeeper := input.OracleKeeper
+
+		// atom requires four voters
+		oracleKeeper.SetWhitelist(ctx, types.DenomList{{Name: utils.MicroAtomDenom, MinVoters: 4}})
+
+		// Sample exchange rate for the test
+		oracleKeeper.DeleteVoteTargets(ctx)
+		oracleKeeper.SetVoteTarget(ctx, utils.MicroAtomDenom)
+		exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
+
+		ctx = input.Ctx.WithBlockHeight(1)
+
+		// Only three validators vote
+		for i := 0; i < 3; i++ {
+			voteMsg := makeAggregatePrevoteAndVote(t, input, handler, 1, exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
+			_, err := handler(ctx, voteMsg)
+			require.NoError(t, err)
+		}
+
+		MidBlocker(ctx, oracleKeeper)
+		Endblocker(ctx, oracleKeeper)
+
+		_, _, _, err := oracleKeeper.GetBaseExchangeRate(ctx, utils.MicroAtomDenom)
+		require.ErrorIs(t, err, types.ErrUnknownDenom)
+	})
+
 	t.Run("Error case - Ballot power less than threshold", func(t *testing.T) {
 		// Reset blockchain state
 		input, handler := SetUp(t)
@@ -102,7 +277,7 @@ func TestMidBlocker(t *testing.T) {
 		ctx = input.Ctx.WithBlockHeight(1)
 
 		// Only one validator votes (insufficient power)
-		voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
+		voteMsg := makeAggregatePrevoteAndVote(t, input, handler, 1, exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
 		_, err := handler(ctx, voteMsg)
 		require.NoError(t, err)
 
@@ -128,7 +303,7 @@ func TestMidBlocker(t *testing.T) {
 
 		// Only two validators vote, one validator abstains
 		for i := 0; i < 2; i++ {
-			voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
+			voteMsg := makeAggregatePrevoteAndVote(t, input, handler, 1, exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
 			_, err := handler(ctx, voteMsg)
 			require.NoError(t, err)
 		}
@@ -155,13 +330,13 @@ func TestMidBlocker(t *testing.T) {
 
 		// Validator submits an incorrect exchange rate
 		wrongRate := "100000000.0" + utils.MicroAtomDenom
-		voteMsg := types.NewMsgAggregateExchangeRateVote(wrongRate, keeper.Addrs[0], keeper.ValAddrs[0])
+		voteMsg := makeAggregatePrevoteAndVote(t, input, handler, 1, wrongRate, keeper.Addrs[0], keeper.ValAddrs[0])
 		_, err := handler(ctx, voteMsg)
 		require.NoError(t, err)
 
 		// Other validators submit correct votes
 		for i := 1; i < 3; i++ {
-			voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
+			voteMsg := makeAggregatePrevoteAndVote(t, input, handler, 1, exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
 			_, err := handler(ctx, voteMsg)
 			require.NoError(t, err)
 		}
@@ -233,7 +408,7 @@ func TestOracleDrop(t *testing.T) {
 	exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
 
 	// simulate val 0 votation
-	voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
+	voteMsg := makeAggregatePrevoteAndVote(t, input, handler, 9, exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
 	_, err := handler(ctx.WithBlockHeight(9), voteMsg)
 	require.NoError(t, err)
 
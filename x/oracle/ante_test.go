@@ -1,11 +1,13 @@
 package oracle_test
 
 import (
+	"encoding/hex"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkacltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/kiichain/kiichain/x/oracle"
 	"github.com/kiichain/kiichain/x/oracle/keeper"
 	"github.com/kiichain/kiichain/x/oracle/types"
@@ -25,6 +27,7 @@ func TestVoteAloneHandle(t *testing.T) {
 
 	// these are the test messages
 	testOracleMsg := types.MsgAggregateExchangeRateVote{}
+	testOraclePrevoteMsg := types.MsgAggregateExchangeRatePrevote{}
 	testNoOracleMsg := banktypes.MsgSend{}
 	testNoOracleMsg2 := banktypes.MsgSend{}
 
@@ -39,6 +42,18 @@ func TestVoteAloneHandle(t *testing.T) {
 			tx:            app.NewTestTx([]sdk.Msg{&testOracleMsg}),
 		},
 
+		// ante handle wil continue this, the vote is revealed with the next prevote
+		{name: "oracle vote and prevote",
+			expectedError: false,
+			tx:            app.NewTestTx([]sdk.Msg{&testOracleMsg, &testOraclePrevoteMsg}),
+		},
+
+		// ante handle will return an error because the oracle prevote can not be with other messages
+		{name: "mixed prevote messages",
+			expectedError: true,
+			tx:            app.NewTestTx([]sdk.Msg{&testOraclePrevoteMsg, &testNoOracleMsg}),
+		},
+
 		// ante handle will ignore this message
 		{name: "only non-oracle votes",
 			expectedError: false,
@@ -83,8 +98,8 @@ func TestSpammingPreventionHandle(t *testing.T) {
 	randomAExchangeRate := sdk.NewDec(1700)
 	exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
 
-	voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
-	invalidVoteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[5], keeper.ValAddrs[2]) // addr 3 has not been delegated by val 2
+	voteMsg := types.NewMsgAggregateExchangeRateVote("abcd", exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
+	invalidVoteMsg := types.NewMsgAggregateExchangeRateVote("abcd", exchangeRate, keeper.Addrs[5], keeper.ValAddrs[2]) // addr 3 has not been delegated by val 2
 
 	// Register anti spamming decorator
 	spammingDecorator := oracle.NewSpammingPreventionDecorator(oracleKeeper)
@@ -129,7 +144,9 @@ func TestSpammingPreventionAnteDeps(t *testing.T) {
 	// Create test exchange rate
 	randomAExchangeRate := sdk.NewDec(1700)
 	exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
-	voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0]) // create aggregate exchange rate msg
+	voteMsg := types.NewMsgAggregateExchangeRateVote("abcd", exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0]) // create aggregate exchange rate msg
+	hash := types.GetAggregateVoteHash("abcd", exchangeRate, keeper.ValAddrs[0])
+	prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[0], keeper.ValAddrs[0]) // create aggregate exchange rate prevote msg
 
 	// Register spamming decorator
 	spammingDecorator := oracle.NewSpammingPreventionDecorator(oracleKeeper)
@@ -142,7 +159,7 @@ func TestSpammingPreventionAnteDeps(t *testing.T) {
 	ms := ctx.MultiStore()
 	msCache := ms.CacheMultiStore()
 	ctx = ctx.WithMultiStore(msCache)
-	tx := app.NewTestTx([]sdk.Msg{voteMsg})
+	tx := app.NewTestTx([]sdk.Msg{voteMsg, prevoteMsg})
 
 	_, err := anteHandler(ctx, tx, false)
 	require.NoError(t, err)
@@ -156,3 +173,37 @@ func TestSpammingPreventionAnteDeps(t *testing.T) {
 	missingAccessOps := ctx.MsgValidator().ValidateAccessOperations(newDeps, storeAccessOpEvents)
 	require.Equal(t, 0, len(missingAccessOps))
 }
+
+func TestSpammingPreventionAnteDepsValidatorKeys(t *testing.T) {
+	// Prepare env
+	input, _ := oracle.SetUp(t)
+	oracleKeeper := input.OracleKeeper
+
+	exchangeRate := sdk.NewDec(1700).String() + utils.MicroAtomDenom
+	voteMsg := types.NewMsgAggregateExchangeRateVote("abcd", exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
+	hash := types.GetAggregateVoteHash("abcd", exchangeRate, keeper.ValAddrs[0])
+	prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[0], keeper.ValAddrs[0])
+
+	spammingDecorator := oracle.NewSpammingPreventionDecorator(oracleKeeper)
+	_, depGen := sdk.ChainAnteDecorators(spammingDecorator)
+
+	// the dependencies are generated from the validator keys
+	feederKey := hex.EncodeToString(types.GetFeederDelegationKey(keeper.ValAddrs[0]))
+	validatorKey := hex.EncodeToString(stakingtypes.GetValidatorKey(keeper.ValAddrs[0]))
+	voteKey := hex.EncodeToString(types.GetAggregateExchangeRateVoteKey(keeper.ValAddrs[0]))
+
+	deps, err := depGen([]sdkacltypes.AccessOperation{}, app.NewTestTx([]sdk.Msg{prevoteMsg}), 0)
+	require.NoError(t, err)
+	require.Equal(t, []sdkacltypes.AccessOperation{
+		{ResourceType: sdkacltypes.ResourceType_KV_ORACLE_FEEDERS, AccessType: sdkacltypes.AccessType_READ, IdentifierTemplate: feederKey},
+		{ResourceType: sdkacltypes.ResourceType_KV_STAKING_VALIDATOR, AccessType: sdkacltypes.AccessType_READ, IdentifierTemplate: validatorKey},
+	}, deps)
+
+	deps, err = depGen([]sdkacltypes.AccessOperation{}, app.NewTestTx([]sdk.Msg{voteMsg}), 0)
+	require.NoError(t, err)
+	require.Equal(t, []sdkacltypes.AccessOperation{
+		{ResourceType: sdkacltypes.ResourceType_KV_ORACLE_FEEDERS, AccessType: sdkacltypes.AccessType_READ, IdentifierTemplate: feederKey},
+		{ResourceType: sdkacltypes.ResourceType_KV_STAKING_VALIDATOR, AccessType: sdkacltypes.AccessType_READ, IdentifierTemplate: validatorKey},
+		{ResourceType: sdkacltypes.ResourceType_KV_ORACLE_AGGREGATE_VOTES, AccessType: sdkacltypes.AccessType_READ, IdentifierTemplate: voteKey},
+	}, deps)
+}
@@ -0,0 +1,252 @@
+package cli
+
+import (
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	oraclerest "github.com/kiichain/kiichain/x/oracle/client/rest"
+	"github.com/kiichain/kiichain/x/oracle/types"
+	"github.com/spf13/cobra"
+)
+
+// Proposal handlers registered under "kiichaind tx gov submit-proposal"
+var (
+	AddOracleDenomProposalHandler    = govclient.NewProposalHandler(CmdAddOracleDenomProposal, oraclerest.AddOracleDenomProposalRESTHandler)
+	RemoveOracleDenomProposalHandler = govclient.NewProposalHandler(CmdRemoveOracleDenomProposal, oraclerest.RemoveOracleDenomProposalRESTHandler)
+
+	AddPricePublisherProposalHandler    = govclient.NewProposalHandler(CmdAddPricePublisherProposal, oraclerest.AddPricePublisherProposalRESTHandler)
+	RemovePricePublisherProposalHandler = govclient.NewProposalHandler(CmdRemovePricePublisherProposal, oraclerest.RemovePricePublisherProposalRESTHandler)
+)
+
+// CmdAddOracleDenomProposal is the command executed when users type
+// "$ kiichaind tx gov submit-proposal add-oracle-denom [proposal-file]" on the CLI
+func CmdAddOracleDenomProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-oracle-denom [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to add a denom to the oracle whitelist",
+		Long: strings.TrimSpace(`
+Submit a proposal to add a single denom to the oracle whitelist, the denom becomes a vote target at the end of the current vote period.
+		
+$ kiichaind tx gov submit-proposal add-oracle-denom [proposal-file] --deposit 10000000ukii
+		
+The proposal file should contain the following:
+{
+	"title": "Add uatom to the oracle",
+	"description": "Start voting the uatom exchange rate",
+	"denom": {
+		"name": "uatom",
+		"reward_band": "0.05",
+		"min_voters": "3",
+		"max_staleness": "100"
+	}
+}
+		
+where "reward_band", "min_voters" and "max_staleness" are optional.`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Read the proposal from the file
+			proposal := types.AddOracleDenomProposal{}
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			err = clientCtx.Codec.UnmarshalJSON(contents, &proposal)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewAddOracleDenomProposal(proposal.Title, proposal.Description, proposal.Denom)
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdRemoveOracleDenomProposal is the command executed when users type
+// "$ kiichaind tx gov submit-proposal remove-oracle-denom [proposal-file]" on the CLI
+func CmdRemoveOracleDenomProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-oracle-denom [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to remove a denom from the oracle whitelist",
+		Long: strings.TrimSpace(`
+Submit a proposal to remove a single denom from the oracle whitelist.
+The denom exchange rate and its price snapshot history are deleted when the proposal passes.
+		
+$ kiichaind tx gov submit-proposal remove-oracle-denom [proposal-file] --deposit 10000000ukii
+		
+The proposal file should contain the following:
+{
+	"title": "Remove uatom from the oracle",
+	"description": "Stop voting the uatom exchange rate",
+	"denom": "uatom"
+}`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Read the proposal from the file
+			proposal := types.RemoveOracleDenomProposal{}
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			err = clientCtx.Codec.UnmarshalJSON(contents, &proposal)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRemoveOracleDenomProposal(proposal.Title, proposal.Description, proposal.Denom)
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdAddPricePublisherProposal is the command executed when users type
+// "$ kiichaind tx gov submit-proposal add-price-publisher [proposal-file]" on the CLI
+func CmdAddPricePublisherProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-price-publisher [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to register a pull oracle price publisher",
+		Long: strings.TrimSpace(`
+Submit a proposal to register a price publisher, the publisher key can sign price attestations for the pull oracle.
+		
+$ kiichaind tx gov submit-proposal add-price-publisher [proposal-file] --deposit 10000000ukii
+		
+The proposal file should contain the following:
+{
+	"title": "Add the kii publisher",
+	"description": "Register the kii price publisher key",
+	"publisher": {
+		"name": "kii",
+		"key_type": "ed25519",
+		"pub_key": "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="
+	}
+}
+		
+where "key_type" is ed25519 or secp256k1 and "pub_key" is the base64 encoded public key.`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Read the proposal from the file
+			proposal := types.AddPricePublisherProposal{}
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			err = clientCtx.Codec.UnmarshalJSON(contents, &proposal)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewAddPricePublisherProposal(proposal.Title, proposal.Description, proposal.Publisher)
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdRemovePricePublisherProposal is the command executed when users type
+// "$ kiichaind tx gov submit-proposal remove-price-publisher [proposal-file]" on the CLI
+func CmdRemovePricePublisherProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-price-publisher [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to remove a pull oracle price publisher",
+		Long: strings.TrimSpace(`
+Submit a proposal to remove a price publisher, its attestations are rejected when the proposal passes.
+		
+$ kiichaind tx gov submit-proposal remove-price-publisher [proposal-file] --deposit 10000000ukii
+		
+The proposal file should contain the following:
+{
+	"title": "Remove the kii publisher",
+	"description": "Stop accepting the kii price attestations",
+	"name": "kii"
+}`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Read the proposal from the file
+			proposal := types.RemovePricePublisherProposal{}
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			err = clientCtx.Codec.UnmarshalJSON(contents, &proposal)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRemovePricePublisherProposal(proposal.Title, proposal.Description, proposal.Name)
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// submitProposal wraps the content on a submit proposal message with the deposit flag and broadcasts it
+func submitProposal(cmd *cobra.Command, clientCtx client.Context, content govtypes.Content) error {
+	depositInput, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositInput)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
@@ -26,11 +26,21 @@ func GetQueryCmd() *cobra.Command {
 	oracleQueryCmd.AddCommand(
 		CmdQueryExchangeRates(),
 		CmdQueryPriceSnapshotHistory(),
+		CmdQueryPriceAt(),
+		CmdQueryPriceSnapshotRange(),
 		CmdQueryTwaps(),
+		CmdQueryEmas(),
+		CmdQueryPriceStats(),
 		CmdQueryActives(),
 		CmdQueryParams(),
 		CmdQueryFeederDelegation(),
 		CmdQueryVotePenaltyCounter(),
+		CmdQueryValidatorOracleStats(),
+		CmdQueryPenalizedValidators(),
+		CmdQueryAggregatePrevotes(),
+		CmdQueryRewardPool(),
+		CmdQueryPullPrices(),
+		CmdQueryPricePublishers(),
 	)
 
 	return oracleQueryCmd
@@ -80,6 +90,79 @@ $kiichaind query oracle price-snapshot-history`),
 	return cmd
 }
 
+// CmdQueryPriceAt is the command executed when users type "price-at [denom] [timestamp]" command
+func CmdQueryPriceAt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-at [denom] [timestamp]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the price of a denom at a past time from prices snapshot data",
+		Long: strings.TrimSpace(`
+Query the price of a denom on the most recent snapshot at or before the given unix timestamp (seconds)
+		
+$kiichaind query oracle price-at ueth 1735689600`),
+		RunE: getPriceAt,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryPriceSnapshotRange is the command executed when users type "price-snapshot-range [denom] [from] [to]" command
+func CmdQueryPriceSnapshotRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-snapshot-range [denom] [from-timestamp] [to-timestamp]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Query the prices of a denom between two times from prices snapshot data",
+		Long: strings.TrimSpace(`
+Query the paginated prices of a denom on the snapshots between two unix timestamps (seconds), both included
+		
+$kiichaind query oracle price-snapshot-range ueth 1735689600 1735693200 --limit 10`),
+		RunE: getPriceSnapshotRange,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "price-snapshot-range")
+	return cmd
+}
+
+// CmdQueryEmas is the command executed when users type "emas [lookback-seconds] [half-life-seconds]" command
+func CmdQueryEmas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emas [lookback-seconds] [half-life-seconds]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the exponential moving average (Ema) prices for denom from prices snapshot data",
+		Long: strings.TrimSpace(`
+Query the exponential moving average prices for denoms from price snapshot data
+		
+$kiichaind query oracle emas 3600 600
+		
+where 3600 means the last hour of snapshots and 600 means the weight of a price decays by half every 10 minutes`),
+		RunE: getEmas,
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryPriceStats is the command executed when users type "price-stats [lookback-seconds]" command
+func CmdQueryPriceStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-stats [lookback-seconds]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the median, min, max and volatility of the prices for denom from prices snapshot data",
+		Long: strings.TrimSpace(`
+Query the median, min, max and volatility (standard deviation) of the prices for denoms from price snapshot data
+		
+$kiichaind query oracle price-stats 3600
+		
+where 3600 means the last hour of snapshots`),
+		RunE: getPriceStats,
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // CmdQueryTwaps is the command executed when users type "twaps [lookback-seconds]" command
 func CmdQueryTwaps() *cobra.Command {
 	cmd := &cobra.Command{
@@ -130,6 +213,24 @@ func CmdQueryParams() *cobra.Command {
 	return cmd
 }
 
+// CmdQueryRewardPool is the command executed when users type reward-pool command
+func CmdQueryRewardPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-pool",
+		Args:  cobra.NoArgs,
+		Short: "Query the coins waiting to be paid to the oracle voters",
+		Long: strings.TrimSpace(`
+Query the balance of the oracle reward pool, paid on each vote period to the validators who voted inside the reward band
+
+$kiichaind query oracle reward-pool
+		`),
+		RunE: getRewardPool,
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // CmdQueryFeederDelegation is the command executed when users type feeder [validator]
 func CmdQueryFeederDelegation() *cobra.Command {
 	cmd := &cobra.Command{
@@ -164,6 +265,97 @@ $kiichaind query oracle vote-penalty-counter kiivaloper...`),
 	return cmd
 }
 
+// CmdQueryValidatorOracleStats is the command executed when users type validator-oracle-stats [validator]
+func CmdQueryValidatorOracleStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-oracle-stats [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the oracle voting performance of a validator",
+		Long: strings.TrimSpace(`
+Query the participation rate, success rate, average deviation from the weighted median
+and slashes of a validator across the past slash windows
+
+$kiichaind query oracle validator-oracle-stats kiivaloper...`),
+		RunE: getValidatorOracleStats,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryPenalizedValidators is the command executed when users type penalized-validators
+func CmdQueryPenalizedValidators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "penalized-validators",
+		Args:  cobra.NoArgs,
+		Short: "Query the validators currently under oracle penalty",
+		Long: strings.TrimSpace(`
+Query the validators jailed by the oracle module for not voting validly, with the
+slash fraction applied and the time until they can unjail
+
+$kiichaind query oracle penalized-validators`),
+		RunE: getPenalizedValidators,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryPullPrices is the command executed when users type pull-prices [denom]
+func CmdQueryPullPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pull-prices [denom]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the latest prices of the pull oracle feed",
+		Long: strings.TrimSpace(`
+Query the latest prices attested by the price publishers, updated independently of the vote period.
+
+$kiichaind query oracle pull-prices
+
+Or filter by denom running
+
+$kiichaind query oracle pull-prices ubtc`),
+		RunE: getPullPrices,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryPricePublishers is the command executed when users type price-publishers
+func CmdQueryPricePublishers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-publishers",
+		Args:  cobra.NoArgs,
+		Short: "Query the price publishers registered by governance",
+		RunE:  getPricePublishers,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryAggregatePrevotes is the command executed when users type aggregate-prevotes [validator]
+func CmdQueryAggregatePrevotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-prevotes [validator]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the pending aggregate prevotes",
+		Long: strings.TrimSpace(`
+Query the aggregate prevotes waiting to be revealed on the next vote period.
+
+$kiichaind query oracle aggregate-prevotes
+
+Or filter by validator running
+
+$kiichaind query oracle aggregate-prevotes kiivaloper...`),
+		RunE: getAggregatePrevotes,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // CmdQueryVoteTargets is the command executed when users type vote-targets
 func CmdQueryVoteTargets() *cobra.Command {
 	cmd := &cobra.Command{
@@ -255,6 +447,130 @@ func getTwaps(cmd *cobra.Command, args []string) error {
 	return clientCtx.PrintProto(res) // print msg response
 }
 
+// getPriceAt returns the price of a denom at or before an specific time
+func getPriceAt(cmd *cobra.Command, args []string) error {
+	// get ctx
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	// create query client
+	queryClient := types.NewQueryClient(clientCtx)
+
+	// get timestamp
+	timestamp, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return err
+	}
+
+	// get price
+	res, err := queryClient.PriceAt(context.Background(), &types.QueryPriceAtRequest{Denom: args[0], Timestamp: timestamp})
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res) // print msg response
+}
+
+// getPriceSnapshotRange returns the paginated prices of a denom within an specific time period
+func getPriceSnapshotRange(cmd *cobra.Command, args []string) error {
+	// get ctx
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	// create query client
+	queryClient := types.NewQueryClient(clientCtx)
+
+	// get time range
+	fromTimestamp, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return err
+	}
+
+	toTimestamp, err := strconv.ParseInt(args[2], 10, 64)
+	if err != nil {
+		return err
+	}
+
+	pageReq, err := client.ReadPageRequest(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	// get prices
+	res, err := queryClient.PriceSnapshotRange(context.Background(), &types.QueryPriceSnapshotRangeRequest{
+		Denom:         args[0],
+		FromTimestamp: fromTimestamp,
+		ToTimestamp:   toTimestamp,
+		Pagination:    pageReq,
+	})
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res) // print msg response
+}
+
+// getEmas returns the exponential moving average price within an specific time period
+func getEmas(cmd *cobra.Command, args []string) error {
+	// get ctx
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	// create query client
+	queryClient := types.NewQueryClient(clientCtx)
+
+	// get lookback time and half-life
+	lookbackSeconds, err := strconv.ParseUint(args[0], 10, 64) // get uint64 from the string arg
+	if err != nil {
+		return err
+	}
+
+	halfLifeSeconds, err := strconv.ParseUint(args[1], 10, 64) // get uint64 from the string arg
+	if err != nil {
+		return err
+	}
+
+	// get ema
+	res, err := queryClient.Emas(context.Background(), &types.QueryEmasRequest{LookbackSeconds: lookbackSeconds, HalfLifeSeconds: halfLifeSeconds})
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res) // print msg response
+}
+
+// getPriceStats returns the median, min, max and volatility of the price within an specific time period
+func getPriceStats(cmd *cobra.Command, args []string) error {
+	// get ctx
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	// create query client
+	queryClient := types.NewQueryClient(clientCtx)
+
+	// get lookback time
+	lookbackSeconds, err := strconv.ParseUint(args[0], 10, 64) // get uint64 from the string arg
+	if err != nil {
+		return err
+	}
+
+	// get price stats
+	res, err := queryClient.PriceStats(context.Background(), &types.QueryPriceStatsRequest{LookbackSeconds: lookbackSeconds})
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res) // print msg response
+}
+
 // getActives returns the list of assets recognized by the oracle module
 func getActives(cmd *cobra.Command, args []string) error {
 	// get ctx
@@ -295,6 +611,26 @@ func getParams(cmd *cobra.Command, args []string) error {
 	return clientCtx.PrintProto(res) // print msg response
 }
 
+// getRewardPool returns the oracle reward pool balance
+func getRewardPool(cmd *cobra.Command, args []string) error {
+	// get ctx
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	// create query client
+	queryClient := types.NewQueryClient(clientCtx)
+
+	// get reward pool
+	res, err := queryClient.RewardPool(context.Background(), &types.QueryRewardPoolRequest{})
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res) // print msg response
+}
+
 // getFeederDelegation returns the validator's delegated account
 func getFeederDelegation(cmd *cobra.Command, arg []string) error {
 	// get ctx
@@ -349,6 +685,140 @@ func getVotePenaltyCounter(cmd *cobra.Command, arg []string) error {
 	return clientCtx.PrintProto(res) // print msg response
 }
 
+// getValidatorOracleStats returns the voting performance by validator address
+func getValidatorOracleStats(cmd *cobra.Command, arg []string) error {
+	// get ctx
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	// get validator address
+	validator, err := sdk.ValAddressFromBech32(arg[0])
+	if err != nil {
+		return err
+	}
+
+	// create query client
+	queryClient := types.NewQueryClient(clientCtx)
+
+	// get validator oracle stats
+	res, err := queryClient.ValidatorOracleStats(context.Background(), &types.QueryValidatorOracleStatsRequest{ValidatorAddr: validator.String()})
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res) // print msg response
+}
+
+// getPenalizedValidators returns the validators currently under oracle penalty
+func getPenalizedValidators(cmd *cobra.Command, _ []string) error {
+	// get ctx
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	// create query client
+	queryClient := types.NewQueryClient(clientCtx)
+
+	// get penalized validators
+	res, err := queryClient.PenalizedValidators(context.Background(), &types.QueryPenalizedValidatorsRequest{})
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res) // print msg response
+}
+
+// getPullPrices queries the pull oracle feed, returns all the prices or
+// the price of an specific denom if the user add it on the command
+func getPullPrices(cmd *cobra.Command, args []string) error {
+	// get ctx
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	// create query client
+	queryClient := types.NewQueryClient(clientCtx)
+
+	// Return all pull prices
+	if len(args) == 0 {
+		res, err := queryClient.PullPrices(context.Background(), &types.QueryPullPricesRequest{})
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintProto(res) // print msg response
+	}
+
+	// Return specific denom
+	res, err := queryClient.PullPrice(context.Background(), &types.QueryPullPriceRequest{Denom: args[0]})
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res) // print msg response
+}
+
+// getPricePublishers returns the price publishers registered by governance
+func getPricePublishers(cmd *cobra.Command, _ []string) error {
+	// get ctx
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	// create query client
+	queryClient := types.NewQueryClient(clientCtx)
+
+	// get price publishers
+	res, err := queryClient.PricePublishers(context.Background(), &types.QueryPricePublishersRequest{})
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res) // print msg response
+}
+
+// getAggregatePrevotes queries the pending prevotes on the oracle module, returns all or
+// the one of an specific validator if the user add it on the command
+func getAggregatePrevotes(cmd *cobra.Command, arg []string) error {
+	// get ctx
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	// create query client
+	queryClient := types.NewQueryClient(clientCtx)
+
+	// get all prevotes if the validator was not specified
+	if len(arg) == 0 {
+		res, err := queryClient.AggregatePrevotes(context.Background(), &types.QueryAggregatePrevotesRequest{})
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintProto(res) // print msg response
+	}
+
+	// get validator address
+	validator, err := sdk.ValAddressFromBech32(arg[0])
+	if err != nil {
+		return err
+	}
+
+	// get validator prevote
+	res, err := queryClient.AggregatePrevote(context.Background(), &types.QueryAggregatePrevoteRequest{ValidatorAddr: validator.String()})
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res) // print msg response
+}
+
 // getVoteTargets returs the current vote targets
 func getVoteTargets(cmd *cobra.Command, arg []string) error {
 	// get ctx
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -26,7 +27,9 @@ func GetTxCmd() *cobra.Command {
 	// Add Tx commands
 	oracleTxCmd.AddCommand(
 		CmdDelegateFeederPermission(),
+		CmdAggregateExchangeRatePrevote(),
 		CmdAggregateExchangeRateVote(),
+		CmdSubmitPriceAttestation(),
 	)
 
 	return oracleTxCmd
@@ -54,23 +57,49 @@ where "kii1..." is the address you want to delegate your voting rights to.`),
 	return cmd
 }
 
-// CmdAggregateExchangeRateVote is the command executed when users type ""
+// CmdAggregateExchangeRatePrevote is the command executed when users type "$ kiichaind tx oracle aggregate-prevote 1234 123.45ukii..."
+// on the CLI
+func CmdAggregateExchangeRatePrevote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-prevote [salt] [exchange-rates] [validator]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Submit an oracle aggregate prevote with the hash of the exchange rates",
+		Long: strings.TrimSpace(`
+Submit an aggregate prevote with the hash of the exchange rates, the vote must be revealed on the next vote period.
+The hash is computed with the salt, the exchange rates and the validator address.
+		
+$ kiichaind tx oracle aggregate-prevote 1234 123.45ukii,678.90uatom...
+		
+where "1234" is a hex salt used to hide the exchange rates, it must be used again on the aggregate-vote
+		
+If voting from a delegate account, set "validator" to the address of the validator you are voting on behalf of, i.e:
+		
+$ kiichaind tx oracle aggregate-prevote 1234 123.45ukii,678.90uatom... kiivaloper1...`),
+		RunE: aggregatePrevote,
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdAggregateExchangeRateVote is the command executed when users type "$ kiichaind tx oracle aggregate-vote 1234 123.45ukii..."
 // on the CLI
 func CmdAggregateExchangeRateVote() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "aggregate-vote [exchange-rates] [validator]",
-		Args:  cobra.RangeArgs(1, 2),
+		Use:   "aggregate-vote [salt] [exchange-rates] [validator]",
+		Args:  cobra.RangeArgs(2, 3),
 		Short: "Submit an oracle aggregate vote with the exchange rates",
 		Long: strings.TrimSpace(`
-Submit an aggregate vote with the exchange rates.
+Submit an aggregate vote with the exchange rates, revealing the prevote submitted on the previous vote period.
 		
-$kiichaind tx oracle aggregate-vote 123.45ukii,678.90uatom...
+$kiichaind tx oracle aggregate-vote 1234 123.45ukii,678.90uatom...
 		
-where "ukii,uatom,ueth..." are the denominating currencies and 123.45,678.90 are the exchange rates of micro USD in micro denoms
+where "1234" is the salt used on the prevote, "ukii,uatom,ueth..." are the denominating currencies and 123.45,678.90 are the exchange rates of micro USD in micro denoms
 		
 If voting from a delegate account, set "validator" to the address of the validator you are voting on behalf of, i.e:
 		
-$ kiichaind oracle aggregate-vote 123.45ukii,678.90uatom... kiivaloper1...`),
+$ kiichaind oracle aggregate-vote 1234 123.45ukii,678.90uatom... kiivaloper1...`),
 		RunE: aggregateVote,
 	}
 
@@ -79,6 +108,38 @@ $ kiichaind oracle aggregate-vote 123.45ukii,678.90uatom... kiivaloper1...`),
 	return cmd
 }
 
+// Flags of the submit price attestation command
+const (
+	FlagPublisherKey = "publisher-key"
+	FlagTimestamp    = "timestamp"
+)
+
+// CmdSubmitPriceAttestation is the command executed when users type "$ kiichaind tx oracle submit-price-attestation kii 123.45ubtc..."
+// on the CLI
+func CmdSubmitPriceAttestation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-price-attestation [publisher] [exchange-rates]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Sign a price attestation with a publisher key and submit it to the pull oracle",
+		Long: strings.TrimSpace(`
+Sign a batch of prices with a local publisher key and submit it to the pull oracle feed.
+The command works as a local signer, the publisher key must be registered by governance.
+		
+$ kiichaind tx oracle submit-price-attestation kii 123.45ubtc,678.90ueth --publisher-key publisher --from relayer
+		
+where "kii" is the registered publisher name and "publisher" is the keyring key signing the attestation,
+by default the attestation timestamp is the current time, it can be overridden with the --timestamp flag`),
+		RunE: submitPriceAttestation,
+	}
+
+	cmd.Flags().String(FlagPublisherKey, "", "The keyring key of the publisher used to sign the attestation")
+	cmd.Flags().Int64(FlagTimestamp, 0, "The attestation unix timestamp, defaults to the current time")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagPublisherKey)
+
+	return cmd
+}
+
 // setFeeder is executed with the command "set-feeder [feeder]". It delegates
 // the permission to submit exchange rate to an address
 func setFeeder(cmd *cobra.Command, args []string) error {
@@ -111,7 +172,50 @@ func setFeeder(cmd *cobra.Command, args []string) error {
 	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 }
 
-// aggregateVote is executed with the command "aggregate-vote [exchange-rates] [validator]"
+// aggregatePrevote is executed with the command "aggregate-prevote [salt] [exchange-rates] [validator]"
+// it sends the exchange rate prevote message with the vote hash
+func aggregatePrevote(cmd *cobra.Command, args []string) error {
+	// get ctx
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Get salt and exchange rates
+	salt := args[0]
+	exchangeRatesStr := args[1]
+	_, err = types.ParseExchangeRateTuples(exchangeRatesStr)
+	if err != nil {
+		return err
+	}
+
+	// Get from address
+	voter := clientCtx.GetFromAddress()
+
+	// by default the voter is voting on bhalf of itself
+	valAddress := sdk.ValAddress(voter)
+
+	// overide validator if validator's address is given
+	if len(args) == 3 {
+		parsedVal, err := sdk.ValAddressFromBech32(args[2])
+		if err != nil {
+			return errors.Wrap(err, "validator address is invalid")
+		}
+		valAddress = parsedVal
+	}
+
+	// Create aggregate exchange rate prevote message
+	hash := types.GetAggregateVoteHash(salt, exchangeRatesStr, valAddress)
+	msg := types.NewMsgAggregateExchangeRatePrevote(hash, voter, valAddress)
+	err = msg.ValidateBasic()
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+// aggregateVote is executed with the command "aggregate-vote [salt] [exchange-rates] [validator]"
 // it sends the exchange rate voting message
 func aggregateVote(cmd *cobra.Command, args []string) error {
 	// get ctx
@@ -120,8 +224,9 @@ func aggregateVote(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	// Get exchange rates
-	exchangeRatesStr := args[0]
+	// Get salt and exchange rates
+	salt := args[0]
+	exchangeRatesStr := args[1]
 	_, err = types.ParseExchangeRateTuples(exchangeRatesStr)
 	if err != nil {
 		return err
@@ -134,8 +239,8 @@ func aggregateVote(cmd *cobra.Command, args []string) error {
 	valAddress := sdk.ValAddress(voter)
 
 	// overide validator if validator's address is given
-	if len(args) == 2 {
-		parsedVal, err := sdk.ValAddressFromBech32(args[1])
+	if len(args) == 3 {
+		parsedVal, err := sdk.ValAddressFromBech32(args[2])
 		if err != nil {
 			return errors.Wrap(err, "validator address is invalid")
 		}
@@ -143,7 +248,53 @@ func aggregateVote(cmd *cobra.Command, args []string) error {
 	}
 
 	// Create aggregate exchange rate vote message
-	msg := types.NewMsgAggregateExchangeRateVote(exchangeRatesStr, voter, valAddress)
+	msg := types.NewMsgAggregateExchangeRateVote(salt, exchangeRatesStr, voter, valAddress)
+	err = msg.ValidateBasic()
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+// submitPriceAttestation is executed with the command "submit-price-attestation [publisher] [exchange-rates]"
+// it signs the attestation with the publisher key and sends the attestation message
+func submitPriceAttestation(cmd *cobra.Command, args []string) error {
+	// get ctx
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Get publisher and prices
+	publisher := args[0]
+	prices, err := types.ParseExchangeRateTuples(args[1])
+	if err != nil {
+		return err
+	}
+
+	publisherKey, err := cmd.Flags().GetString(FlagPublisherKey)
+	if err != nil {
+		return err
+	}
+
+	timestamp, err := cmd.Flags().GetInt64(FlagTimestamp)
+	if err != nil {
+		return err
+	}
+	if timestamp == 0 {
+		timestamp = time.Now().Unix()
+	}
+
+	// Sign the attestation with the publisher key
+	attestation := types.NewPriceAttestation(publisher, clientCtx.ChainID, timestamp, prices)
+	signature, _, err := clientCtx.Keyring.Sign(publisherKey, attestation.GetSignBytes())
+	if err != nil {
+		return errors.Wrap(err, "failed to sign the attestation")
+	}
+
+	// Create the submit price attestation message
+	msg := types.NewMsgSubmitPriceAttestation(clientCtx.GetFromAddress(), attestation, signature)
 	err = msg.ValidateBasic()
 	if err != nil {
 		return err
@@ -0,0 +1,152 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	typesrest "github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/kiichain/kiichain/x/oracle/types"
+)
+
+// AddOracleDenomRequest defines a proposal to add a denom to the oracle whitelist
+type AddOracleDenomRequest struct {
+	BaseReq     typesrest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string            `json:"title" yaml:"title"`
+	Description string            `json:"description" yaml:"description"`
+	Deposit     sdk.Coins         `json:"deposit" yaml:"deposit"`
+	Denom       types.Denom       `json:"denom" yaml:"denom"`
+}
+
+// RemoveOracleDenomRequest defines a proposal to remove a denom from the oracle whitelist
+type RemoveOracleDenomRequest struct {
+	BaseReq     typesrest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string            `json:"title" yaml:"title"`
+	Description string            `json:"description" yaml:"description"`
+	Deposit     sdk.Coins         `json:"deposit" yaml:"deposit"`
+	Denom       string            `json:"denom" yaml:"denom"`
+}
+
+// AddPricePublisherRequest defines a proposal to register a pull oracle price publisher
+type AddPricePublisherRequest struct {
+	BaseReq     typesrest.BaseReq    `json:"base_req" yaml:"base_req"`
+	Title       string               `json:"title" yaml:"title"`
+	Description string               `json:"description" yaml:"description"`
+	Deposit     sdk.Coins            `json:"deposit" yaml:"deposit"`
+	Publisher   types.PricePublisher `json:"publisher" yaml:"publisher"`
+}
+
+// RemovePricePublisherRequest defines a proposal to remove a pull oracle price publisher
+type RemovePricePublisherRequest struct {
+	BaseReq     typesrest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string            `json:"title" yaml:"title"`
+	Description string            `json:"description" yaml:"description"`
+	Deposit     sdk.Coins         `json:"deposit" yaml:"deposit"`
+	Name        string            `json:"name" yaml:"name"`
+}
+
+// AddOracleDenomProposalRESTHandler returns the REST handler of the add oracle denom proposal
+func AddOracleDenomProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "add_oracle_denom",
+		Handler:  newAddOracleDenomPostHandler(clientCtx),
+	}
+}
+
+// RemoveOracleDenomProposalRESTHandler returns the REST handler of the remove oracle denom proposal
+func RemoveOracleDenomProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "remove_oracle_denom",
+		Handler:  newRemoveOracleDenomPostHandler(clientCtx),
+	}
+}
+
+// AddPricePublisherProposalRESTHandler returns the REST handler of the add price publisher proposal
+func AddPricePublisherProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "add_price_publisher",
+		Handler:  newAddPricePublisherPostHandler(clientCtx),
+	}
+}
+
+// RemovePricePublisherProposalRESTHandler returns the REST handler of the remove price publisher proposal
+func RemovePricePublisherProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "remove_price_publisher",
+		Handler:  newRemovePricePublisherPostHandler(clientCtx),
+	}
+}
+
+func newAddOracleDenomPostHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req AddOracleDenomRequest
+		if !typesrest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		content := types.NewAddOracleDenomProposal(req.Title, req.Description, req.Denom)
+		writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit)
+	}
+}
+
+func newRemoveOracleDenomPostHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RemoveOracleDenomRequest
+		if !typesrest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		content := types.NewRemoveOracleDenomProposal(req.Title, req.Description, req.Denom)
+		writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit)
+	}
+}
+
+func newAddPricePublisherPostHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req AddPricePublisherRequest
+		if !typesrest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		content := types.NewAddPricePublisherProposal(req.Title, req.Description, req.Publisher)
+		writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit)
+	}
+}
+
+func newRemovePricePublisherPostHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RemovePricePublisherRequest
+		if !typesrest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		content := types.NewRemovePricePublisherProposal(req.Title, req.Description, req.Name)
+		writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit)
+	}
+}
+
+// writeProposalTx validates the base request and writes the generated submit proposal tx
+func writeProposalTx(clientCtx client.Context, w http.ResponseWriter, baseReq typesrest.BaseReq, content govtypes.Content, deposit sdk.Coins) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+	if typesrest.CheckBadRequestError(w, err) {
+		return
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, fromAddr)
+	if typesrest.CheckBadRequestError(w, err) {
+		return
+	}
+	if typesrest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+
+	tx.WriteGeneratedTxResponse(clientCtx, w, baseReq, msg)
+}
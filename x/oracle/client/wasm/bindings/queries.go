@@ -8,6 +8,10 @@ type KiiOracleQuery struct {
 	ExchangeRates *types.QueryExchangeRatesRequest `json:"exchange_rates,omitempty"`
 	// queries the oracle TWAPs
 	OracleTwaps *types.QueryTwapsRequest `json:"oracle_twaps,omitempty"`
+	// queries the oracle EMAs
+	OracleEmas *types.QueryEmasRequest `json:"oracle_emas,omitempty"`
+	// queries the oracle price statistics (median, min, max and volatility)
+	OraclePriceStats *types.QueryPriceStatsRequest `json:"oracle_price_stats,omitempty"`
 	// queries the actives assets
 	Actives *types.QueryActivesRequest `json:"actives,omitempty"`
 	// queries the price history
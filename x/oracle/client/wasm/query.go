@@ -32,6 +32,20 @@ func (handler OracleWasmQueryHandler) GetOracleTwaps(ctx sdk.Context, req *types
 	return querier.Twaps(context, req)
 }
 
+// GetOracleEmas executes the Emas query on the query_server
+func (handler OracleWasmQueryHandler) GetOracleEmas(ctx sdk.Context, req *types.QueryEmasRequest) (*types.QueryEmasResponse, error) {
+	querier := oraclekeeper.NewQueryServer(handler.oracleKeeper)
+	context := sdk.WrapSDKContext(ctx)
+	return querier.Emas(context, req)
+}
+
+// GetOraclePriceStats executes the PriceStats query on the query_server
+func (handler OracleWasmQueryHandler) GetOraclePriceStats(ctx sdk.Context, req *types.QueryPriceStatsRequest) (*types.QueryPriceStatsResponse, error) {
+	querier := oraclekeeper.NewQueryServer(handler.oracleKeeper)
+	context := sdk.WrapSDKContext(ctx)
+	return querier.PriceStats(context, req)
+}
+
 // GetActives executes the Actives query on the query_server
 func (handler OracleWasmQueryHandler) GetActives(ctx sdk.Context, req *types.QueryActivesRequest) (*types.QueryActivesResponse, error) {
 	querier := oraclekeeper.NewQueryServer(handler.oracleKeeper)
@@ -60,11 +60,59 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		keeper.SetAggregateExchangeRateVote(ctx, valAddress, aggregateExchange)
 	}
 
+	// Add the AggregateExchangeRatePrevotes to the KVStore defined on the input object
+	for _, aggregatePrevote := range data.AggregateExchangeRatePrevotes {
+		valAddress, err := sdk.ValAddressFromBech32(aggregatePrevote.Voter)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetAggregateExchangeRatePrevote(ctx, valAddress, aggregatePrevote)
+	}
+
 	// Add the price snapshots to the KVStore defined on the input object
 	for _, priceSnapshot := range data.PriceSnapshots {
 		keeper.AddPriceSnapshot(ctx, priceSnapshot)
 	}
 
+	// Mark the halted denoms on the KVStore
+	for _, denom := range data.HaltedDenoms {
+		keeper.SetHaltedDenom(ctx, denom)
+	}
+
+	// Add the validator oracle stats to the KVStore
+	for _, record := range data.ValidatorOracleStats {
+		operator, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetValidatorOracleStats(ctx, operator, record.Stats)
+	}
+
+	// Add the validator oracle penalties to the KVStore
+	for _, penalty := range data.ValidatorOraclePenalties {
+		operator, err := sdk.ValAddressFromBech32(penalty.ValidatorAddr)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetValidatorOraclePenalty(ctx, operator, penalty)
+	}
+
+	// Add the price publishers and the pull oracle prices to the KVStore
+	for _, publisher := range data.PricePublishers {
+		keeper.SetPricePublisher(ctx, publisher)
+	}
+
+	for _, pullPrice := range data.PullPrices {
+		keeper.SetPullPrice(ctx, pullPrice)
+	}
+
+	for _, publisherPrice := range data.PublisherPrices {
+		keeper.SetPublisherPrice(ctx, publisherPrice.Publishers[0], publisherPrice)
+	}
+
 	// Check if the module account exists
 	moduleAccount := keeper.GetOracleAccount(ctx)
 	if moduleAccount == nil {
@@ -110,6 +158,13 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
 		return false
 	})
 
+	// Extract Aggregate exchange rate prevotes
+	aggregateExchangeRatePrevotes := []types.AggregateExchangeRatePrevote{}
+	keeper.IterateAggregateExchangeRatePrevotes(ctx, func(voterAddr sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) bool {
+		aggregateExchangeRatePrevotes = append(aggregateExchangeRatePrevotes, aggregatePrevote)
+		return false
+	})
+
 	// Extract priceSnapshots
 	priceSnapshots := []types.PriceSnapshot{}
 	keeper.IteratePriceSnapshots(ctx, func(snapshot types.PriceSnapshot) bool {
@@ -124,7 +179,48 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
 		return false
 	})
 
+	// Extract halted denoms
+	haltedDenoms := []string{}
+	keeper.IterateHaltedDenoms(ctx, func(denom string) bool {
+		haltedDenoms = append(haltedDenoms, denom)
+		return false
+	})
+
+	// Extract validator oracle stats
+	validatorOracleStats := []types.ValidatorOracleStatsRecord{}
+	keeper.IterateValidatorOracleStats(ctx, func(operator sdk.ValAddress, stats types.ValidatorOracleStats) bool {
+		validatorOracleStats = append(validatorOracleStats, types.ValidatorOracleStatsRecord{ValidatorAddress: operator.String(), Stats: stats})
+		return false
+	})
+
+	// Extract validator oracle penalties
+	validatorOraclePenalties := []types.ValidatorOraclePenalty{}
+	keeper.IterateValidatorOraclePenalties(ctx, func(operator sdk.ValAddress, penalty types.ValidatorOraclePenalty) bool {
+		validatorOraclePenalties = append(validatorOraclePenalties, penalty)
+		return false
+	})
+
+	// Extract price publishers and pull oracle prices
+	pricePublishers := []types.PricePublisher{}
+	keeper.IteratePricePublishers(ctx, func(publisher types.PricePublisher) bool {
+		pricePublishers = append(pricePublishers, publisher)
+		return false
+	})
+
+	pullPrices := []types.PullPrice{}
+	keeper.IteratePullPrices(ctx, func(pullPrice types.PullPrice) bool {
+		pullPrices = append(pullPrices, pullPrice)
+		return false
+	})
+
+	publisherPrices := []types.PullPrice{}
+	keeper.IteratePublisherPrices(ctx, func(publisher string, price types.PullPrice) bool {
+		publisherPrices = append(publisherPrices, price)
+		return false
+	})
+
 	// Send data
-	return *types.NewGenesisState(params, exchangeRates, feederDelegations, penaltyCounters, aggregateExchangeRateVotes, priceSnapshots, votePenaltyCounters)
+	return *types.NewGenesisState(params, exchangeRates, feederDelegations, penaltyCounters, aggregateExchangeRateVotes, priceSnapshots, votePenaltyCounters, aggregateExchangeRatePrevotes, haltedDenoms, validatorOracleStats,
+		validatorOraclePenalties, pricePublishers, pullPrices, publisherPrices)
 
 }
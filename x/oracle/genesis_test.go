@@ -3,6 +3,7 @@ package oracle_test
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/kiichain/kiichain/x/oracle"
 	"github.com/kiichain/kiichain/x/oracle/keeper"
@@ -62,12 +63,27 @@ func TestExportInitGenesis(t *testing.T) {
 	oracleKeeper.SetFeederDelegation(ctx, keeper.ValAddrs[0], keeper.Addrs[1])
 	oracleKeeper.SetBaseExchangeRate(ctx, utils.MicroAtomDenom, sdk.NewDec(123))
 	oracleKeeper.SetAggregateExchangeRateVote(ctx, keeper.ValAddrs[0], exchangeRateVote)
+	oracleKeeper.SetAggregateExchangeRatePrevote(ctx, keeper.ValAddrs[1],
+		types.NewAggregateExchangeRatePrevote(types.GetAggregateVoteHash("1f2e3d4c", "123.0uatom", keeper.ValAddrs[1]), keeper.ValAddrs[1], 2))
 	oracleKeeper.SetVoteTarget(ctx, utils.MicroAtomDenom)
 	oracleKeeper.SetVoteTarget(ctx, utils.MicroEthDenom)
 	oracleKeeper.SetVotePenaltyCounter(ctx, keeper.ValAddrs[0], 2, 3, 0)
 	oracleKeeper.SetVotePenaltyCounter(ctx, keeper.ValAddrs[1], 4, 5, 0)
 	oracleKeeper.AddPriceSnapshot(ctx, snapshot1)
 	oracleKeeper.AddPriceSnapshot(ctx, snapshot2)
+	oracleKeeper.SetHaltedDenom(ctx, utils.MicroEthDenom)
+	oracleKeeper.AddValidatorVoteDeviation(ctx, keeper.ValAddrs[0], sdk.NewDecWithPrec(2, 2), 2)
+	stats := oracleKeeper.GetValidatorOracleStats(ctx, keeper.ValAddrs[0])
+	stats.CloseWindow(types.VotePenaltyCounter{MissCount: 2, AbstainCount: 3}, true, ctx.BlockHeight())
+	oracleKeeper.SetValidatorOracleStats(ctx, keeper.ValAddrs[0], stats)
+	oracleKeeper.SetValidatorOraclePenalty(ctx, keeper.ValAddrs[0], types.ValidatorOraclePenalty{
+		ValidatorAddr: keeper.ValAddrs[0].String(), ConsecutiveBadWindows: 1, SlashFraction: sdk.NewDecWithPrec(1, 2), JailedUntil: 3600,
+	})
+	publisher, err := types.NewPricePublisher("kii", ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	oracleKeeper.SetPricePublisher(ctx, publisher)
+	oracleKeeper.SetPullPrice(ctx, types.PullPrice{Denom: utils.MicroEthDenom, Price: sdk.NewDec(3000), PublishTime: 100, Publishers: []string{"kii"}, UpdateHeight: 1})
+	oracleKeeper.SetPublisherPrice(ctx, "kii", types.PullPrice{Denom: utils.MicroEthDenom, Price: sdk.NewDec(3000), PublishTime: 100, Publishers: []string{"kii"}, UpdateHeight: 1})
 
 	// Export genesis
 	genesis := oracle.ExportGenesis(ctx, oracleKeeper)
@@ -83,4 +99,12 @@ func TestExportInitGenesis(t *testing.T) {
 
 	// validation
 	require.Equal(t, genesis, newGenesis)
+	require.Len(t, newGenesis.AggregateExchangeRatePrevotes, 1)
+	require.Equal(t, []string{utils.MicroEthDenom}, newGenesis.HaltedDenoms)
+	require.Len(t, newGenesis.ValidatorOracleStats, 1)
+	require.Equal(t, uint64(1), newGenesis.ValidatorOracleStats[0].Stats.SlashCount())
+	require.Len(t, newGenesis.ValidatorOraclePenalties, 1)
+	require.Equal(t, []types.PricePublisher{publisher}, newGenesis.PricePublishers)
+	require.Len(t, newGenesis.PullPrices, 1)
+	require.Len(t, newGenesis.PublisherPrices, 1)
 }
@@ -0,0 +1,84 @@
+package oracle
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kiichain/kiichain/x/oracle/keeper"
+	"github.com/kiichain/kiichain/x/oracle/types"
+)
+
+// HandleAddOracleDenomProposal handles the add oracle denom governance proposal
+// the vote target is created by ApplyWhitelist at the end of the current vote period
+func HandleAddOracleDenomProposal(ctx sdk.Context, k *keeper.Keeper, p *types.AddOracleDenomProposal) error {
+	// Validate the denom and its overrides
+	if err := p.Denom.Validate(); err != nil {
+		return err
+	}
+
+	whitelist := k.Whitelist(ctx)
+	if whitelist.Contains(p.Denom.Name) {
+		return types.ErrDenomAlreadyWhitelisted.Wrap(p.Denom.Name)
+	}
+
+	// Append the denom to the whitelist
+	whitelist = append(whitelist, p.Denom)
+	k.SetWhitelist(ctx, whitelist)
+	return nil
+}
+
+// HandleRemoveOracleDenomProposal handles the remove oracle denom governance proposal
+// it deletes the denom from the whitelist and the vote targets, its exchange rate, halted flag,
+// snapshot history (used for the twaps) and pull oracle prices
+func HandleRemoveOracleDenomProposal(ctx sdk.Context, k *keeper.Keeper, p *types.RemoveOracleDenomProposal) error {
+	whitelist := k.Whitelist(ctx)
+	if !whitelist.Contains(p.Denom) {
+		return types.ErrUnknownDenom.Wrap(p.Denom)
+	}
+
+	// Remove the denom from the whitelist
+	newWhitelist := types.DenomList{}
+	for _, denom := range whitelist {
+		if denom.Name != p.Denom {
+			newWhitelist = append(newWhitelist, denom)
+		}
+	}
+	k.SetWhitelist(ctx, newWhitelist)
+
+	// Delete the vote target right away, so the denom is not tallied at the end of the vote period
+	k.DeleteVoteTarget(ctx, p.Denom)
+
+	// Delete the denom stored data
+	k.DeleteBaseExchangeRate(ctx, p.Denom)
+	k.DeleteHaltedDenom(ctx, p.Denom)
+	k.DeleteDenomPriceSnapshots(ctx, p.Denom)
+	k.DeletePullPrice(ctx, p.Denom)
+	k.DeleteDenomPublisherPrices(ctx, p.Denom)
+	return nil
+}
+
+// HandleAddPricePublisherProposal handles the add price publisher governance proposal
+// the publisher can sign price attestations for the pull oracle right away
+func HandleAddPricePublisherProposal(ctx sdk.Context, k *keeper.Keeper, p *types.AddPricePublisherProposal) error {
+	// Validate the publisher name and key
+	if err := p.Publisher.Validate(); err != nil {
+		return err
+	}
+
+	if _, found := k.GetPricePublisher(ctx, p.Publisher.Name); found {
+		return types.ErrPricePublisherExists.Wrap(p.Publisher.Name)
+	}
+
+	k.SetPricePublisher(ctx, p.Publisher)
+	return nil
+}
+
+// HandleRemovePricePublisherProposal handles the remove price publisher governance proposal
+// the prices signed by the publisher are deleted and the pull oracle prices aggregated without them
+func HandleRemovePricePublisherProposal(ctx sdk.Context, k *keeper.Keeper, p *types.RemovePricePublisherProposal) error {
+	if _, found := k.GetPricePublisher(ctx, p.Name); !found {
+		return types.ErrUnknownPricePublisher.Wrap(p.Name)
+	}
+
+	k.DeletePricePublisher(ctx, p.Name)
+	k.DeletePublisherPrices(ctx, p.Name)
+	return nil
+}
@@ -0,0 +1,192 @@
+package oracle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kiichain/kiichain/x/oracle/keeper"
+	"github.com/kiichain/kiichain/x/oracle/types"
+	"github.com/kiichain/kiichain/x/oracle/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddOracleDenomProposal(t *testing.T) {
+	// Prepare env
+	input := keeper.CreateTestInput(t)
+	ctx := input.Ctx
+	oracleKeeper := input.OracleKeeper
+	handler := NewProposalHandler(oracleKeeper)
+
+	rewardBand := sdk.NewDecWithPrec(5, 2)
+	denom := types.Denom{Name: utils.MicroAtomDenom, RewardBand: &rewardBand, MinVoters: 2}
+
+	t.Run("add a new denom", func(t *testing.T) {
+		proposal := types.NewAddOracleDenomProposal("add uatom", "add uatom to the oracle", denom)
+		require.NoError(t, handler(ctx, proposal))
+
+		whitelistDenom, found := oracleKeeper.Whitelist(ctx).Get(utils.MicroAtomDenom)
+		require.True(t, found)
+		require.True(t, denom.Equal(&whitelistDenom))
+		require.Len(t, oracleKeeper.Whitelist(ctx), len(types.DefaultWhitelist)+1)
+	})
+
+	t.Run("denom already whitelisted", func(t *testing.T) {
+		proposal := types.NewAddOracleDenomProposal("add uatom", "add uatom to the oracle", denom)
+		err := handler(ctx, proposal)
+		require.ErrorIs(t, err, types.ErrDenomAlreadyWhitelisted)
+	})
+
+	t.Run("invalid denom overrides", func(t *testing.T) {
+		invalidBand := sdk.NewDec(2)
+		proposal := types.NewAddOracleDenomProposal("add ukii", "add ukii to the oracle", types.Denom{Name: utils.MicroKiiDenom, RewardBand: &invalidBand})
+		err := handler(ctx, proposal)
+		require.ErrorIs(t, err, types.ErrInvalidDenom)
+		require.False(t, oracleKeeper.Whitelist(ctx).Contains(utils.MicroKiiDenom))
+	})
+
+	t.Run("vote target applied at the end of the vote period", func(t *testing.T) {
+		require.False(t, oracleKeeper.IsVoteTarget(ctx, utils.MicroAtomDenom))
+
+		voteTargets := make(map[string]types.Denom)
+		oracleKeeper.IterateVoteTargets(ctx, func(denom string, denomInfo types.Denom) bool {
+			voteTargets[denom] = denomInfo
+			return false
+		})
+		oracleKeeper.ApplyWhitelist(ctx, oracleKeeper.Whitelist(ctx), voteTargets)
+		require.True(t, oracleKeeper.IsVoteTarget(ctx, utils.MicroAtomDenom))
+	})
+}
+
+func TestRemoveOracleDenomProposal(t *testing.T) {
+	// Prepare env
+	input := keeper.CreateTestInput(t)
+	ctx := input.Ctx
+	oracleKeeper := input.OracleKeeper
+	handler := NewProposalHandler(oracleKeeper)
+
+	// Store data for the denom to be removed and a denom to be kept
+	rate := types.OracleExchangeRate{ExchangeRate: sdk.NewDec(10), LastUpdate: sdk.NewInt(1), LastUpdateTimestamp: 1}
+	oracleKeeper.SetBaseExchangeRate(ctx, utils.MicroEthDenom, rate.ExchangeRate)
+	oracleKeeper.SetBaseExchangeRate(ctx, utils.MicroBtcDenom, rate.ExchangeRate)
+	oracleKeeper.SetHaltedDenom(ctx, utils.MicroEthDenom)
+	oracleKeeper.SetPriceSnapshot(ctx, types.NewPriceSnapshot(1, types.PriceSnapshotItems{
+		types.NewPriceSnapshotItem(utils.MicroEthDenom, rate),
+		types.NewPriceSnapshotItem(utils.MicroBtcDenom, rate),
+	}))
+	oracleKeeper.SetPullPrice(ctx, types.PullPrice{Denom: utils.MicroEthDenom, Price: rate.ExchangeRate, PublishTime: 1, Publishers: []string{"kii"}})
+	oracleKeeper.SetPublisherPrice(ctx, "kii", types.PullPrice{Denom: utils.MicroEthDenom, Price: rate.ExchangeRate, PublishTime: 1, Publishers: []string{"kii"}})
+
+	t.Run("unknown denom", func(t *testing.T) {
+		proposal := types.NewRemoveOracleDenomProposal("remove uatom", "remove uatom from the oracle", utils.MicroAtomDenom)
+		err := handler(ctx, proposal)
+		require.ErrorIs(t, err, types.ErrUnknownDenom)
+	})
+
+	t.Run("remove a whitelisted denom", func(t *testing.T) {
+		proposal := types.NewRemoveOracleDenomProposal("remove ueth", "remove ueth from the oracle", utils.MicroEthDenom)
+		require.NoError(t, handler(ctx, proposal))
+
+		// whitelist and vote targets
+		require.False(t, oracleKeeper.Whitelist(ctx).Contains(utils.MicroEthDenom))
+		require.Len(t, oracleKeeper.Whitelist(ctx), len(types.DefaultWhitelist)-1)
+		require.False(t, oracleKeeper.IsVoteTarget(ctx, utils.MicroEthDenom))
+		require.True(t, oracleKeeper.IsVoteTarget(ctx, utils.MicroBtcDenom))
+
+		// exchange rate and halted flag
+		_, _, _, err := oracleKeeper.GetBaseExchangeRate(ctx, utils.MicroEthDenom)
+		require.Error(t, err)
+		require.False(t, oracleKeeper.IsDenomHalted(ctx, utils.MicroEthDenom))
+		_, _, _, err = oracleKeeper.GetBaseExchangeRate(ctx, utils.MicroBtcDenom)
+		require.NoError(t, err)
+
+		// snapshot history
+		snapshot := oracleKeeper.GetPriceSnapshot(ctx, 1)
+		require.Len(t, snapshot.PriceSnapshotItems, 1)
+		require.Equal(t, utils.MicroBtcDenom, snapshot.PriceSnapshotItems[0].Denom)
+
+		// pull oracle prices
+		_, err = oracleKeeper.GetPullPrice(ctx, utils.MicroEthDenom)
+		require.ErrorIs(t, err, types.ErrNoPullPrice)
+		_, found := oracleKeeper.GetPublisherPrice(ctx, utils.MicroEthDenom, "kii")
+		require.False(t, found)
+	})
+}
+
+func TestPricePublisherProposals(t *testing.T) {
+	// Prepare env
+	input := keeper.CreateTestInput(t)
+	ctx := input.Ctx
+	oracleKeeper := input.OracleKeeper
+	handler := NewProposalHandler(oracleKeeper)
+
+	publisher, err := types.NewPricePublisher("kii", ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	t.Run("add a new publisher", func(t *testing.T) {
+		proposal := types.NewAddPricePublisherProposal("add kii", "add the kii publisher", publisher)
+		require.NoError(t, handler(ctx, proposal))
+
+		stored, found := oracleKeeper.GetPricePublisher(ctx, "kii")
+		require.True(t, found)
+		require.Equal(t, publisher, stored)
+	})
+
+	t.Run("publisher already registered", func(t *testing.T) {
+		proposal := types.NewAddPricePublisherProposal("add kii", "add the kii publisher", publisher)
+		require.ErrorIs(t, handler(ctx, proposal), types.ErrPricePublisherExists)
+	})
+
+	t.Run("invalid publisher key", func(t *testing.T) {
+		invalid := types.PricePublisher{Name: "other", KeyType: types.KeyTypeSecp256k1, PubKey: publisher.PubKey}
+		proposal := types.NewAddPricePublisherProposal("add other", "add the other publisher", invalid)
+		require.ErrorIs(t, handler(ctx, proposal), types.ErrInvalidPricePublisher)
+	})
+
+	t.Run("remove a publisher", func(t *testing.T) {
+		// the pull oracle prices are the median of at least two publishers
+		ctx := ctx.WithBlockTime(time.Unix(1000, 0))
+		params := oracleKeeper.GetParams(ctx)
+		params.MaxAttestationAge = 10
+		params.MinPricePublishers = 2
+		oracleKeeper.SetParams(ctx, params)
+
+		publisherPrices := map[string]map[string]int64{
+			"kii":  {utils.MicroBtcDenom: 100, utils.MicroEthDenom: 10},
+			"pyth": {utils.MicroBtcDenom: 110, utils.MicroEthDenom: 12},
+			"band": {utils.MicroBtcDenom: 120},
+		}
+		for publisher, prices := range publisherPrices {
+			for denom, price := range prices {
+				oracleKeeper.SetPublisherPrice(ctx, publisher, types.PullPrice{Denom: denom, Price: sdk.NewDec(price), PublishTime: 1000, Publishers: []string{publisher}})
+			}
+		}
+		for _, denom := range []string{utils.MicroBtcDenom, utils.MicroEthDenom} {
+			_, updated := oracleKeeper.UpdatePullPrice(ctx, denom)
+			require.True(t, updated)
+		}
+
+		proposal := types.NewRemovePricePublisherProposal("remove kii", "remove the kii publisher", "kii")
+		require.NoError(t, handler(ctx, proposal))
+
+		_, found := oracleKeeper.GetPricePublisher(ctx, "kii")
+		require.False(t, found)
+
+		// the publisher prices are deleted and the pull oracle prices aggregated without them
+		_, found = oracleKeeper.GetPublisherPrice(ctx, utils.MicroBtcDenom, "kii")
+		require.False(t, found)
+		pullPrice, err := oracleKeeper.GetPullPrice(ctx, utils.MicroBtcDenom)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDec(115), pullPrice.Price)
+		require.Equal(t, []string{"band", "pyth"}, pullPrice.Publishers)
+
+		// ueth is left with a single publisher
+		_, err = oracleKeeper.GetPullPrice(ctx, utils.MicroEthDenom)
+		require.ErrorIs(t, err, types.ErrNoPullPrice)
+		_, found = oracleKeeper.GetPublisherPrice(ctx, utils.MicroEthDenom, "pyth")
+		require.True(t, found)
+
+		require.ErrorIs(t, handler(ctx, proposal), types.ErrUnknownPricePublisher)
+	})
+}
@@ -3,6 +3,7 @@ package oracle
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/kiichain/kiichain/x/oracle/keeper"
 	"github.com/kiichain/kiichain/x/oracle/types"
 )
@@ -19,9 +20,15 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgDelegateFeedConsent:
 			res, err := msgServer.DelegateFeedConsent(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAggregateExchangeRatePrevote:
+			res, err := msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgAggregateExchangeRateVote:
 			res, err := msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSubmitPriceAttestation:
+			res, err := msgServer.SubmitPriceAttestation(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized oracle message type: %T", msg)
 		}
@@ -29,3 +36,21 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 
 	return handler
 }
+
+// NewProposalHandler returns a new handler for Oracle governance proposals
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AddOracleDenomProposal:
+			return HandleAddOracleDenomProposal(ctx, &k, c)
+		case *types.RemoveOracleDenomProposal:
+			return HandleRemoveOracleDenomProposal(ctx, &k, c)
+		case *types.AddPricePublisherProposal:
+			return HandleAddPricePublisherProposal(ctx, &k, c)
+		case *types.RemovePricePublisherProposal:
+			return HandleRemovePricePublisherProposal(ctx, &k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized oracle proposal content type: %T", c)
+		}
+	}
+}
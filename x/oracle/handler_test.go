@@ -30,8 +30,8 @@ func TestOracleFilters(t *testing.T) {
 
 	t.Run("oracle message received", func(t *testing.T) {
 		exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
-		msg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
-		_, err := handler(ctx, msg)
+		msg := makeAggregatePrevoteAndVote(t, input, handler, 1, exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
+		_, err := handler(ctx.WithBlockHeight(1), msg)
 		require.NoError(t, err)
 	})
 
@@ -39,7 +39,10 @@ func TestOracleFilters(t *testing.T) {
 		nonValidatorPub := secp256k1.GenPrivKey().PubKey()
 		nonValidatorAddr := nonValidatorPub.Address()
 		exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
-		voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRate, sdk.AccAddress(nonValidatorAddr), sdk.ValAddress(nonValidatorAddr))
+		hash := types.GetAggregateVoteHash(testSalt, exchangeRate, sdk.ValAddress(nonValidatorAddr))
+		prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, sdk.AccAddress(nonValidatorAddr), sdk.ValAddress(nonValidatorAddr))
+		require.Panics(t, func() { handler(ctx, prevoteMsg) })
+		voteMsg := types.NewMsgAggregateExchangeRateVote(testSalt, exchangeRate, sdk.AccAddress(nonValidatorAddr), sdk.ValAddress(nonValidatorAddr))
 		require.Panics(t, func() { handler(ctx, voteMsg) })
 	})
 }
@@ -62,15 +65,20 @@ func TestMsgDelegateFeedConsent(t *testing.T) {
 
 	t.Run("success vote without delegation", func(t *testing.T) {
 		exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
-		msg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
-		_, err := handler(ctx, msg)
+		msg := makeAggregatePrevoteAndVote(t, input, handler, 1, exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
+		_, err := handler(ctx.WithBlockHeight(1), msg)
 		require.NoError(t, err)
 	})
 
 	t.Run("fail vote with failed delegation ", func(t *testing.T) {
 		exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
-		msg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[1], keeper.ValAddrs[0])
-		_, err := handler(ctx, msg)
+		hash := types.GetAggregateVoteHash(testSalt, exchangeRate, keeper.ValAddrs[0])
+		prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[1], keeper.ValAddrs[0])
+		_, err := handler(ctx, prevoteMsg)
+		require.Error(t, err)
+
+		msg := types.NewMsgAggregateExchangeRateVote(testSalt, exchangeRate, keeper.Addrs[1], keeper.ValAddrs[0])
+		_, err = handler(ctx, msg)
 		require.Error(t, err)
 	})
 
@@ -82,8 +90,8 @@ func TestMsgDelegateFeedConsent(t *testing.T) {
 
 		// vote
 		exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
-		msgVote := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[0], keeper.ValAddrs[1])
-		_, err = handler(ctx, msgVote)
+		msgVote := makeAggregatePrevoteAndVote(t, input, handler, 1, exchangeRate, keeper.Addrs[0], keeper.ValAddrs[1])
+		_, err = handler(ctx.WithBlockHeight(1), msgVote)
 		require.NoError(t, err)
 	})
 }
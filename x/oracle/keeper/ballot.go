@@ -47,8 +47,18 @@ func (k Keeper) OrganizeBallotByDenom(ctx sdk.Context, validatorClaimMap map[str
 	return votes
 }
 
-// ClearBallots clears all votes from the KV Store
-func (k Keeper) ClearBallots(ctx sdk.Context) {
+// ClearBallots clears all votes from the KV Store and the prevotes that can not
+// be revealed anymore (submitted before the current vote period)
+func (k Keeper) ClearBallots(ctx sdk.Context, votePeriod uint64) {
+	// Clear the expired prevotes
+	currentPeriod := uint64(ctx.BlockHeight()) / votePeriod
+	k.IterateAggregateExchangeRatePrevotes(ctx, func(voterAddr sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) bool {
+		if aggregatePrevote.SubmitBlock/votePeriod < currentPeriod {
+			k.DeleteAggregateExchangeRatePrevote(ctx, voterAddr)
+		}
+		return false
+	})
+
 	// Clear all aggregate votes
 	k.IterateAggregateExchangeRateVotes(ctx, func(voterAddr sdk.ValAddress, aggregateVote types.AggregateExchangeRateVote) bool {
 		k.DeleteAggregateExchangeRateVote(ctx, voterAddr)
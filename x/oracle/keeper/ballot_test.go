@@ -160,14 +160,27 @@ func TestClearBallots(t *testing.T) {
 	oracleKeeper.SetAggregateExchangeRateVote(ctx, ValAddrs[1], exchangeRateVote2)
 	require.NoError(t, err)
 
+	// Simulate prevotes, one expired (previous vote period) and one of the current vote period
+	votePeriod := oracleKeeper.GetParams(ctx).VotePeriod
+	ctx = ctx.WithBlockHeight(int64(votePeriod * 3))
+	hash := types.GetAggregateVoteHash("1f2e3d4c", exchangeRate1.String(), ValAddrs[0])
+	oracleKeeper.SetAggregateExchangeRatePrevote(ctx, ValAddrs[0], types.NewAggregateExchangeRatePrevote(hash, ValAddrs[0], votePeriod*2))
+	oracleKeeper.SetAggregateExchangeRatePrevote(ctx, ValAddrs[1], types.NewAggregateExchangeRatePrevote(hash, ValAddrs[1], votePeriod*3))
+
 	// Delete the added exchange rate
-	oracleKeeper.ClearBallots(ctx)
+	oracleKeeper.ClearBallots(ctx, votePeriod)
 
 	// Validate process
 	_, err = oracleKeeper.GetAggregateExchangeRateVote(ctx, ValAddrs[0])
 	require.Error(t, err)
 	_, err = oracleKeeper.GetAggregateExchangeRateVote(ctx, ValAddrs[1])
 	require.Error(t, err)
+
+	// The expired prevote is deleted, the current one is kept to be revealed
+	_, err = oracleKeeper.GetAggregateExchangeRatePrevote(ctx, ValAddrs[0])
+	require.Error(t, err)
+	_, err = oracleKeeper.GetAggregateExchangeRatePrevote(ctx, ValAddrs[1])
+	require.NoError(t, err)
 }
 
 func TestApplyWhitelist(t *testing.T) {
@@ -1,14 +1,17 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
 
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/kiichain/kiichain/x/oracle/types"
@@ -21,9 +24,11 @@ type Keeper struct {
 	memKey     sdk.StoreKey
 	paramSpace paramstypes.Subspace // Manages the module's parameters allowing dynamical settings
 
-	accountKeeper types.AccountKeeper
-	bankKeeper    types.BankKeeper
-	StakingKeeper types.StakingKeeper
+	accountKeeper  types.AccountKeeper
+	bankKeeper     types.BankKeeper
+	StakingKeeper  types.StakingKeeper
+	slashingKeeper types.SlashingKeeper
+	distrKeeper    types.DistributionKeeper
 
 	distrName string
 }
@@ -31,7 +36,7 @@ type Keeper struct {
 // NewKeeper creates an oracle Keeper instance
 func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, memKey sdk.StoreKey, paramSpace paramstypes.Subspace,
 	accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, StakingKeeper types.StakingKeeper,
-	distrName string) Keeper {
+	slashingKeeper types.SlashingKeeper, distrKeeper types.DistributionKeeper, distrName string) Keeper {
 	// Ensure oracle module account is set
 	addr := accountKeeper.GetModuleAddress(types.ModuleName)
 	if addr == nil {
@@ -44,14 +49,16 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, memKey sdk.StoreKey
 	}
 
 	return Keeper{
-		cdc:           cdc,
-		storeKey:      storeKey,
-		memKey:        memKey,
-		paramSpace:    paramSpace,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
-		StakingKeeper: StakingKeeper,
-		distrName:     distrName,
+		cdc:            cdc,
+		storeKey:       storeKey,
+		memKey:         memKey,
+		paramSpace:     paramSpace,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
+		StakingKeeper:  StakingKeeper,
+		slashingKeeper: slashingKeeper,
+		distrKeeper:    distrKeeper,
+		distrName:      distrName,
 	}
 }
 
@@ -136,6 +143,154 @@ func (k Keeper) IterateBaseExchangeRates(ctx sdk.Context, handler func(denom str
 
 // ****************************************************************************
 
+// **************************** Exchange Rate Status logic ********************
+
+// GetExchangeRateStatus returns if the exchange rate updated on lastUpdate is active, stale or halted
+func (k Keeper) GetExchangeRateStatus(ctx sdk.Context, denom string, lastUpdate sdk.Int) types.ExchangeRateStatus {
+	if k.IsDenomHalted(ctx, denom) {
+		return types.ExchangeRateStatusHalted
+	}
+
+	if k.IsExchangeRateStale(ctx, denom, lastUpdate) {
+		return types.ExchangeRateStatusStale
+	}
+
+	return types.ExchangeRateStatusActive
+}
+
+// IsExchangeRateStale returns true if the exchange rate updated on lastUpdate missed more vote periods
+// than MaxMissedVotePeriods or if it is older than the max staleness set on the denom whitelist entry
+func (k Keeper) IsExchangeRateStale(ctx sdk.Context, denom string, lastUpdate sdk.Int) bool {
+	params := k.GetParams(ctx)
+	blockHeight := ctx.BlockHeight()
+
+	// missedVotePeriods = blocks since the last update / vote period
+	if params.MaxMissedVotePeriods > 0 {
+		missedVotePeriods := uint64(blockHeight-lastUpdate.Int64()) / params.VotePeriod
+		if missedVotePeriods > params.MaxMissedVotePeriods {
+			return true
+		}
+	}
+
+	whitelistDenom, found := params.Whitelist.Get(denom)
+	return found && whitelistDenom.IsStale(lastUpdate.Int64(), blockHeight)
+}
+
+// CheckPriceDeviation halts the denom and returns true if the new exchange rate moves more than
+// MaxPriceDeviation from the stored exchange rate, otherwise the halt is lifted. A halted denom
+// resumes with the new exchange rate once the medians of HaltRecoveryPeriods consecutive vote
+// periods agree with each other within MaxPriceDeviation
+func (k Keeper) CheckPriceDeviation(ctx sdk.Context, denom string, exchangeRate sdk.Dec, maxPriceDeviation sdk.Dec) bool {
+	previousRate, _, _, err := k.GetBaseExchangeRate(ctx, denom)
+	if !maxPriceDeviation.IsPositive() || err != nil || !previousRate.IsPositive() {
+		k.DeleteHaltedDenom(ctx, denom) // circuit breaker disabled or first price
+		return false
+	}
+
+	if priceDeviation(exchangeRate, previousRate).LTE(maxPriceDeviation) {
+		k.DeleteHaltedDenom(ctx, denom)
+		return false
+	}
+
+	// count the consecutive medians which agree with the previous one, the count
+	// starts again when the median moves
+	halt, found := k.GetDenomHalt(ctx, denom)
+	if found && halt.LastExchangeRate.IsPositive() && priceDeviation(exchangeRate, halt.LastExchangeRate).LTE(maxPriceDeviation) {
+		halt.AgreeingPeriods++
+	} else {
+		halt.AgreeingPeriods = 0
+	}
+	halt.LastExchangeRate = exchangeRate
+
+	// the denom resumes with the new exchange rate
+	if halt.AgreeingPeriods >= k.HaltRecoveryPeriods(ctx) {
+		k.DeleteHaltedDenom(ctx, denom)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExchangeRateResumed,
+				sdk.NewAttribute(types.AttributeKeyDenom, denom),
+				sdk.NewAttribute(types.AttributeKeyExchangeRate, exchangeRate.String()),
+				sdk.NewAttribute(types.AttributeKeyPreviousExchangeRate, previousRate.String()),
+			),
+		)
+		return false
+	}
+
+	k.SetDenomHalt(ctx, denom, halt)
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExchangeRateHalted,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyExchangeRate, exchangeRate.String()),
+			sdk.NewAttribute(types.AttributeKeyPreviousExchangeRate, previousRate.String()),
+		),
+	)
+
+	return true
+}
+
+// priceDeviation returns the relative deviation of the exchange rate from the reference rate,
+// deviation = |exchangeRate - referenceRate| / referenceRate
+func priceDeviation(exchangeRate, referenceRate sdk.Dec) sdk.Dec {
+	return exchangeRate.Sub(referenceRate).Abs().Quo(referenceRate)
+}
+
+// IsDenomHalted returns true if the denom was halted by the circuit breaker
+func (k Keeper) IsDenomHalted(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetHaltedDenomKey(denom))
+}
+
+// GetDenomHalt returns the recovery of the halted denom, false if the denom is not halted
+func (k Keeper) GetDenomHalt(ctx sdk.Context, denom string) (types.DenomHalt, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetHaltedDenomKey(denom))
+	if bz == nil {
+		return types.DenomHalt{LastExchangeRate: sdk.ZeroDec()}, false
+	}
+
+	var halt types.DenomHalt
+	k.cdc.MustUnmarshal(bz, &halt)
+	return halt, true
+}
+
+// SetDenomHalt stores the recovery of the halted denom
+func (k Keeper) SetDenomHalt(ctx sdk.Context, denom string, halt types.DenomHalt) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&halt)
+	store.Set(types.GetHaltedDenomKey(denom), bz)
+}
+
+// SetHaltedDenom marks the denom as halted on the KVStore, its recovery starts on the next median
+func (k Keeper) SetHaltedDenom(ctx sdk.Context, denom string) {
+	k.SetDenomHalt(ctx, denom, types.DenomHalt{LastExchangeRate: sdk.ZeroDec()})
+}
+
+// DeleteHaltedDenom lifts the halt of the denom
+func (k Keeper) DeleteHaltedDenom(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetHaltedDenomKey(denom))
+}
+
+// IterateHaltedDenoms iterates over the halted denoms and perform callback function
+func (k Keeper) IterateHaltedDenoms(ctx sdk.Context, handler func(denom string) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.HaltedDenomKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(types.HaltedDenomKey):])
+		if handler(denom) {
+			break
+		}
+	}
+}
+
+// ****************************************************************************
+
 // **************************** Oracle Delegation Logic ***********************
 
 // GetFeederDelegation returns the delegated address by validator address
@@ -292,6 +447,351 @@ func (k Keeper) IterateVotePenaltyCounters(ctx sdk.Context, handler func(operato
 
 // ****************************************************************************
 
+// **************************** Validator oracle stats logic *****************
+
+// GetValidatorOracleStats returns the rolling voting performance of a validator
+func (k Keeper) GetValidatorOracleStats(ctx sdk.Context, operator sdk.ValAddress) types.ValidatorOracleStats {
+	store := ctx.KVStore(k.storeKey)
+	byteData := store.Get(types.GetValidatorOracleStatsKey(operator))
+	if byteData == nil {
+		return types.NewValidatorOracleStats()
+	}
+
+	// Decode information
+	stats := types.ValidatorOracleStats{}
+	k.cdc.MustUnmarshal(byteData, &stats)
+	return stats
+}
+
+// SetValidatorOracleStats stores the rolling voting performance of a validator
+func (k Keeper) SetValidatorOracleStats(ctx sdk.Context, operator sdk.ValAddress, stats types.ValidatorOracleStats) {
+	store := ctx.KVStore(k.storeKey)
+	byteData := k.cdc.MustMarshal(&stats)
+	store.Set(types.GetValidatorOracleStatsKey(operator), byteData)
+}
+
+// DeleteValidatorOracleStats deletes the rolling voting performance of a validator
+func (k Keeper) DeleteValidatorOracleStats(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorOracleStatsKey(operator))
+}
+
+// IterateValidatorOracleStats iterates over the validator oracle stats in the store and perform callback function
+func (k Keeper) IterateValidatorOracleStats(ctx sdk.Context, handler func(operator sdk.ValAddress, stats types.ValidatorOracleStats) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorOracleStatsKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[2:])
+
+		stats := types.ValidatorOracleStats{}
+		k.cdc.MustUnmarshal(iter.Value(), &stats)
+
+		if handler(operator, stats) {
+			break
+		}
+	}
+}
+
+// ****************************************************************************
+
+// **************************** Validator oracle penalty logic ***************
+
+// GetValidatorOraclePenalty returns the last oracle penalty applied to a validator
+func (k Keeper) GetValidatorOraclePenalty(ctx sdk.Context, operator sdk.ValAddress) (types.ValidatorOraclePenalty, bool) {
+	store := ctx.KVStore(k.storeKey)
+	byteData := store.Get(types.GetValidatorOraclePenaltyKey(operator))
+	if byteData == nil {
+		return types.ValidatorOraclePenalty{}, false
+	}
+
+	// Decode information
+	penalty := types.ValidatorOraclePenalty{}
+	k.cdc.MustUnmarshal(byteData, &penalty)
+	return penalty, true
+}
+
+// SetValidatorOraclePenalty stores the last oracle penalty applied to a validator
+func (k Keeper) SetValidatorOraclePenalty(ctx sdk.Context, operator sdk.ValAddress, penalty types.ValidatorOraclePenalty) {
+	store := ctx.KVStore(k.storeKey)
+	byteData := k.cdc.MustMarshal(&penalty)
+	store.Set(types.GetValidatorOraclePenaltyKey(operator), byteData)
+}
+
+// DeleteValidatorOraclePenalty deletes the oracle penalty of a validator
+func (k Keeper) DeleteValidatorOraclePenalty(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorOraclePenaltyKey(operator))
+}
+
+// IterateValidatorOraclePenalties iterates over the validator oracle penalties in the store and perform callback function
+func (k Keeper) IterateValidatorOraclePenalties(ctx sdk.Context, handler func(operator sdk.ValAddress, penalty types.ValidatorOraclePenalty) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorOraclePenaltyKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[2:])
+
+		penalty := types.ValidatorOraclePenalty{}
+		k.cdc.MustUnmarshal(iter.Value(), &penalty)
+
+		if handler(operator, penalty) {
+			break
+		}
+	}
+}
+
+// ****************************************************************************
+
+// **************************** Pull oracle logic *****************************
+
+// GetPricePublisher returns a price publisher registered by governance
+func (k Keeper) GetPricePublisher(ctx sdk.Context, name string) (types.PricePublisher, bool) {
+	store := ctx.KVStore(k.storeKey)
+	byteData := store.Get(types.GetPricePublisherKey(name))
+	if byteData == nil {
+		return types.PricePublisher{}, false
+	}
+
+	// Decode information
+	publisher := types.PricePublisher{}
+	k.cdc.MustUnmarshal(byteData, &publisher)
+	return publisher, true
+}
+
+// SetPricePublisher stores a price publisher
+func (k Keeper) SetPricePublisher(ctx sdk.Context, publisher types.PricePublisher) {
+	store := ctx.KVStore(k.storeKey)
+	byteData := k.cdc.MustMarshal(&publisher)
+	store.Set(types.GetPricePublisherKey(publisher.Name), byteData)
+}
+
+// DeletePricePublisher deletes a price publisher from the store
+func (k Keeper) DeletePricePublisher(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPricePublisherKey(name))
+}
+
+// IteratePricePublishers iterates over the price publishers in the store and perform callback function
+func (k Keeper) IteratePricePublishers(ctx sdk.Context, handler func(publisher types.PricePublisher) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PricePublisherKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		publisher := types.PricePublisher{}
+		k.cdc.MustUnmarshal(iter.Value(), &publisher)
+
+		if handler(publisher) {
+			break
+		}
+	}
+}
+
+// GetPullPrice returns the latest attested price of a denom
+func (k Keeper) GetPullPrice(ctx sdk.Context, denom string) (types.PullPrice, error) {
+	store := ctx.KVStore(k.storeKey)
+	byteData := store.Get(types.GetPullPriceKey(denom))
+	if byteData == nil {
+		return types.PullPrice{}, sdkerrors.Wrap(types.ErrNoPullPrice, denom)
+	}
+
+	// Decode information
+	pullPrice := types.PullPrice{}
+	k.cdc.MustUnmarshal(byteData, &pullPrice)
+	return pullPrice, nil
+}
+
+// SetPullPrice stores the latest attested price of a denom
+func (k Keeper) SetPullPrice(ctx sdk.Context, pullPrice types.PullPrice) {
+	store := ctx.KVStore(k.storeKey)
+	byteData := k.cdc.MustMarshal(&pullPrice)
+	store.Set(types.GetPullPriceKey(pullPrice.Denom), byteData)
+}
+
+// DeletePullPrice deletes the attested price of a denom
+func (k Keeper) DeletePullPrice(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPullPriceKey(denom))
+}
+
+// IteratePullPrices iterates over the attested prices in the store and perform callback function
+func (k Keeper) IteratePullPrices(ctx sdk.Context, handler func(pullPrice types.PullPrice) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PullPriceKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		pullPrice := types.PullPrice{}
+		k.cdc.MustUnmarshal(iter.Value(), &pullPrice)
+
+		if handler(pullPrice) {
+			break
+		}
+	}
+}
+
+// GetPublisherPrice returns the latest price of a denom signed by a publisher
+func (k Keeper) GetPublisherPrice(ctx sdk.Context, denom, publisher string) (types.PullPrice, bool) {
+	store := ctx.KVStore(k.storeKey)
+	byteData := store.Get(types.GetPublisherPriceKey(denom, publisher))
+	if byteData == nil {
+		return types.PullPrice{}, false
+	}
+
+	// Decode information
+	price := types.PullPrice{}
+	k.cdc.MustUnmarshal(byteData, &price)
+	return price, true
+}
+
+// SetPublisherPrice stores the latest price of a denom signed by a publisher
+func (k Keeper) SetPublisherPrice(ctx sdk.Context, publisher string, price types.PullPrice) {
+	store := ctx.KVStore(k.storeKey)
+	byteData := k.cdc.MustMarshal(&price)
+	store.Set(types.GetPublisherPriceKey(price.Denom, publisher), byteData)
+}
+
+// IteratePublisherPrices iterates over the prices signed by the publishers in the store and perform callback function
+func (k Keeper) IteratePublisherPrices(ctx sdk.Context, handler func(publisher string, price types.PullPrice) bool) {
+	k.iteratePublisherPrices(ctx, types.PublisherPriceKey, handler)
+}
+
+// IterateDenomPublisherPrices iterates over the prices of a denom signed by the publishers and perform callback function
+func (k Keeper) IterateDenomPublisherPrices(ctx sdk.Context, denom string, handler func(publisher string, price types.PullPrice) bool) {
+	k.iteratePublisherPrices(ctx, types.GetPublisherPricesKey(denom), handler)
+}
+
+// iteratePublisherPrices iterates over the publisher prices under the input prefix
+func (k Keeper) iteratePublisherPrices(ctx sdk.Context, prefix []byte, handler func(publisher string, price types.PullPrice) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		price := types.PullPrice{}
+		k.cdc.MustUnmarshal(iter.Value(), &price)
+
+		// key = prefix | len(denom) | denom | publisher
+		publisher := string(iter.Key()[len(types.GetPublisherPricesKey(price.Denom)):])
+		if handler(publisher, price) {
+			break
+		}
+	}
+}
+
+// DeleteDenomPublisherPrices deletes the prices of a denom signed by the publishers
+func (k Keeper) DeleteDenomPublisherPrices(ctx sdk.Context, denom string) {
+	publishers := []string{}
+	k.IterateDenomPublisherPrices(ctx, denom, func(publisher string, price types.PullPrice) bool {
+		publishers = append(publishers, publisher)
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, publisher := range publishers {
+		store.Delete(types.GetPublisherPriceKey(denom, publisher))
+	}
+}
+
+// DeletePublisherPrices deletes the prices signed by a publisher, the pull oracle prices of the denoms are
+// aggregated again without them and deleted when there are not enough publishers left
+func (k Keeper) DeletePublisherPrices(ctx sdk.Context, publisher string) {
+	denoms := []string{}
+	k.IteratePublisherPrices(ctx, func(pricePublisher string, price types.PullPrice) bool {
+		if pricePublisher == publisher {
+			denoms = append(denoms, price.Denom)
+		}
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, denom := range denoms {
+		store.Delete(types.GetPublisherPriceKey(denom, publisher))
+
+		if _, updated := k.UpdatePullPrice(ctx, denom); !updated {
+			k.DeletePullPrice(ctx, denom)
+		}
+	}
+}
+
+// UpdatePullPrice stores the median of the fresh publisher prices of a denom as its pull oracle price,
+// the price is not updated if less than MinPricePublishers publishers have a fresh price
+func (k Keeper) UpdatePullPrice(ctx sdk.Context, denom string) (types.PullPrice, bool) {
+	maxAge := int64(k.MaxAttestationAge(ctx))
+	blockTime := ctx.BlockTime().Unix()
+
+	// Get the fresh prices of the registered publishers
+	prices := []types.PullPrice{}
+	k.IterateDenomPublisherPrices(ctx, denom, func(publisher string, price types.PullPrice) bool {
+		if blockTime-price.PublishTime <= maxAge {
+			prices = append(prices, price)
+		}
+		return false
+	})
+
+	if uint64(len(prices)) < k.MinPricePublishers(ctx) {
+		return types.PullPrice{}, false
+	}
+
+	pullPrice := types.AggregatePullPrices(denom, prices, ctx.BlockHeight())
+	k.SetPullPrice(ctx, pullPrice)
+	return pullPrice, true
+}
+
+// ****************************************************************************
+
+// **************************** Aggregate Exchange Rate Prevote logic *********
+
+// GetAggregateExchangeRatePrevote returns the exchange rate prevote from the store by an specific voter
+func (k Keeper) GetAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress) (types.AggregateExchangeRatePrevote, error) {
+	store := ctx.KVStore(k.storeKey)
+	byteData := store.Get(types.GetAggregateExchangeRatePrevoteKey(voter))
+	if byteData == nil {
+		err := sdkerrors.Wrap(types.ErrNoAggregatePrevote, voter.String())
+		return types.AggregateExchangeRatePrevote{}, err // Return custom error
+	}
+
+	// Decode information
+	aggregatePrevote := types.AggregateExchangeRatePrevote{}
+	k.cdc.MustUnmarshal(byteData, &aggregatePrevote)
+	return aggregatePrevote, nil
+}
+
+// SetAggregateExchangeRatePrevote adds an oracle exchange rate prevote to the KVStore
+func (k Keeper) SetAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress, prevote types.AggregateExchangeRatePrevote) {
+	store := ctx.KVStore(k.storeKey)
+	byteData := k.cdc.MustMarshal(&prevote)
+	store.Set(types.GetAggregateExchangeRatePrevoteKey(voter), byteData)
+}
+
+// DeleteAggregateExchangeRatePrevote deletes an oracle exchange rate prevote from the store
+func (k Keeper) DeleteAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAggregateExchangeRatePrevoteKey(voter))
+}
+
+// IterateAggregateExchangeRatePrevotes iterates over exchange rate prevotes in the store and perform callback function
+func (k Keeper) IterateAggregateExchangeRatePrevotes(ctx sdk.Context, handler func(voterAddr sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AggregateExchangeRatePrevoteKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		voterAddr := sdk.ValAddress(iter.Key()[2:])
+
+		aggregatePrevote := types.AggregateExchangeRatePrevote{}
+		k.cdc.MustUnmarshal(iter.Value(), &aggregatePrevote)
+		if handler(voterAddr, aggregatePrevote) {
+			break
+		}
+	}
+}
+
+// ****************************************************************************
+
 // **************************** Aggregate Exchange Rate Vote logic ************
 
 // GetAggregateExchangeRateVote returns the exchange rate voted from the store by an specific voter
@@ -411,6 +911,12 @@ func (k Keeper) IterateVoteTargets(ctx sdk.Context, handler func(denom string, d
 	}
 }
 
+// DeleteVoteTarget deletes a single denom from the vote targets
+func (k Keeper) DeleteVoteTarget(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetVoteTargetKey(denom))
+}
+
 // DeleteVoteTargets deletes all elements on VoteTargetKey prefix
 func (k Keeper) DeleteVoteTargets(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
@@ -523,12 +1029,152 @@ func (k Keeper) IteratePriceSnapshotsReverse(ctx sdk.Context, handler func(snaps
 	}
 }
 
+// GetPriceAt returns the price of the denom on the most recent snapshot at or before the given timestamp.
+// The reverse iterator seeks the timestamp key on the store tree (a binary search), then walks back until
+// a snapshot with the denom is found
+func (k Keeper) GetPriceAt(ctx sdk.Context, denom string, timestamp int64) (types.DenomPriceSnapshot, error) {
+	if timestamp < 0 {
+		return types.DenomPriceSnapshot{}, sdkerrors.Wrapf(types.ErrNoPriceAtTimestamp, "%s at %d", denom, timestamp)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.ReverseIterator(types.PriceSnapshotKey, types.GetPriceSnapshotKey(uint64(timestamp)+1))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.PriceSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+
+		for _, item := range snapshot.PriceSnapshotItems {
+			if item.Denom == denom {
+				return types.DenomPriceSnapshot{
+					SnapshotTimestamp:  snapshot.SnapshotTimestamp,
+					OracleExchangeRate: item.OracleExchangeRate,
+				}, nil
+			}
+		}
+	}
+
+	return types.DenomPriceSnapshot{}, sdkerrors.Wrapf(types.ErrNoPriceAtTimestamp, "%s at %d", denom, timestamp)
+}
+
+// GetPriceSnapshotRange returns the paginated prices of the denom on the snapshots between the from and to
+// timestamps (both included)
+func (k Keeper) GetPriceSnapshotRange(ctx sdk.Context, denom string, fromTimestamp, toTimestamp int64, pageReq *query.PageRequest) ([]types.DenomPriceSnapshot, *query.PageResponse, error) {
+	if fromTimestamp < 0 || fromTimestamp > toTimestamp {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidTimestampRange, "from %d to %d", fromTimestamp, toTimestamp)
+	}
+
+	// The pagination only iterates the snapshots between the from and to timestamp keys
+	rangeStore := timestampRangeStore{
+		KVStore: prefix.NewStore(ctx.KVStore(k.storeKey), types.PriceSnapshotKey),
+		start:   sdk.Uint64ToBigEndian(uint64(fromTimestamp)),
+		end:     sdk.Uint64ToBigEndian(uint64(toTimestamp) + 1),
+	}
+	prices := []types.DenomPriceSnapshot{}
+	pageRes, err := query.FilteredPaginate(rangeStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var snapshot types.PriceSnapshot
+		if err := k.cdc.Unmarshal(value, &snapshot); err != nil {
+			return false, err
+		}
+
+		// Only the snapshots with the denom are counted on the page
+		for _, item := range snapshot.PriceSnapshotItems {
+			if item.Denom != denom {
+				continue
+			}
+
+			if accumulate {
+				prices = append(prices, types.DenomPriceSnapshot{
+					SnapshotTimestamp:  snapshot.SnapshotTimestamp,
+					OracleExchangeRate: item.OracleExchangeRate,
+				})
+			}
+			return true, nil
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return prices, pageRes, nil
+}
+
+// timestampRangeStore bounds the iterators of the price snapshot store to the [start, end) timestamp keys
+type timestampRangeStore struct {
+	sdk.KVStore
+	start []byte
+	end   []byte
+}
+
+// Iterator returns an iterator over the requested keys inside the timestamp range
+func (s timestampRangeStore) Iterator(start, end []byte) sdk.Iterator {
+	start, end = s.bounds(start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+// ReverseIterator returns a reverse iterator over the requested keys inside the timestamp range
+func (s timestampRangeStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	start, end = s.bounds(start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// bounds clamps the requested keys to the timestamp range, an empty range is returned
+// if they are outside of it
+func (s timestampRangeStore) bounds(start, end []byte) ([]byte, []byte) {
+	if start == nil || bytes.Compare(start, s.start) < 0 {
+		start = s.start
+	}
+	if end == nil || bytes.Compare(end, s.end) > 0 {
+		end = s.end
+	}
+	if bytes.Compare(start, end) > 0 {
+		start = end
+	}
+	return start, end
+}
+
 // DeletePriceSnapshot deletes an snapshot based by the given timestamp
 func (k Keeper) DeletePriceSnapshot(ctx sdk.Context, timestamp int64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetPriceSnapshotKey(uint64(timestamp)))
 }
 
+// DeleteDenomPriceSnapshots removes the denom items from every stored snapshot, snapshots left
+// without items are deleted
+func (k Keeper) DeleteDenomPriceSnapshots(ctx sdk.Context, denom string) {
+	var snapshots []types.PriceSnapshot
+
+	k.IteratePriceSnapshots(ctx, func(snapshot types.PriceSnapshot) bool {
+		snapshots = append(snapshots, snapshot)
+		return false
+	})
+
+	for _, snapshot := range snapshots {
+		items := types.PriceSnapshotItems{}
+		for _, item := range snapshot.PriceSnapshotItems {
+			if item.Denom != denom {
+				items = append(items, item)
+			}
+		}
+
+		// Nothing to change on this snapshot
+		if len(items) == len(snapshot.PriceSnapshotItems) {
+			continue
+		}
+
+		if len(items) == 0 {
+			k.DeletePriceSnapshot(ctx, snapshot.SnapshotTimestamp)
+			continue
+		}
+
+		snapshot.PriceSnapshotItems = items
+		k.SetPriceSnapshot(ctx, snapshot)
+	}
+}
+
 // ****************************************************************************
 
 // **************************** Spam Prevention Counter logic *****************
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	distTypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/kiichain/kiichain/x/oracle/types"
@@ -28,6 +29,8 @@ func TestNewKeeper(t *testing.T) {
 			init.AccountKeeper,
 			init.BankKeeper,
 			init.StakingKeeper,
+			init.SlashingKeeper,
+			init.DistKeeper,
 			distTypes.ModuleName,
 		)
 	}, "NewKeeper should not panic if the Oracle module account is properly set")
@@ -41,6 +44,8 @@ func TestNewKeeper(t *testing.T) {
 		init.AccountKeeper,
 		init.BankKeeper,
 		init.StakingKeeper,
+		init.SlashingKeeper,
+		init.DistKeeper,
 		distTypes.ModuleName,
 	)
 
@@ -166,8 +171,20 @@ func TestParams(t *testing.T) {
 	slashFraccion := sdk.NewDecWithPrec(1, 2)  // 0.01
 	slashwindow := uint64(1000)
 	minValPerWindow := sdk.NewDecWithPrec(1, 4) // 0.0001
-	whiteList := types.DenomList{{Name: utils.MicroKiiDenom}, {Name: utils.MicroAtomDenom}}
+	atomRewardBand := sdk.NewDecWithPrec(5, 2)  // 0.05
+	whiteList := types.DenomList{{Name: utils.MicroKiiDenom}, {Name: utils.MicroAtomDenom, RewardBand: &atomRewardBand, MinVoters: 2, MaxStaleness: 100}}
 	lookbackDuration := uint64(3600)
+	rewardDistributionWindow := uint64(10000)
+	rewardPoolShare := sdk.NewDecWithPrec(5, 2) // 0.05
+	maxMissedVotePeriods := uint64(5)
+	maxPriceDeviation := sdk.NewDecWithPrec(2, 1) // 0.2
+	jailDuration := uint64(600)
+	penaltyEscalationWindows := uint64(3)
+	penaltyEscalationFactor := sdk.NewDecWithPrec(15, 1) // 1.5
+	maxAttestationAge := uint64(30)
+	maxPenaltyEscalationLevel := uint64(4)
+	minPricePublishers := uint64(2)
+	haltRecoveryPeriods := uint64(5)
 
 	params := types.Params{
 		VotePeriod:        votePeriod,
@@ -178,6 +195,19 @@ func TestParams(t *testing.T) {
 		SlashWindow:       slashwindow,
 		MinValidPerWindow: minValPerWindow,
 		LookbackDuration:  lookbackDuration,
+
+		RewardDistributionWindow: rewardDistributionWindow,
+		RewardPoolShare:          rewardPoolShare,
+		MaxMissedVotePeriods:     maxMissedVotePeriods,
+		MaxPriceDeviation:        maxPriceDeviation,
+		JailDuration:             jailDuration,
+		PenaltyEscalationWindows: penaltyEscalationWindows,
+		PenaltyEscalationFactor:  penaltyEscalationFactor,
+		MaxAttestationAge:        maxAttestationAge,
+
+		MaxPenaltyEscalationLevel: maxPenaltyEscalationLevel,
+		MinPricePublishers:        minPricePublishers,
+		HaltRecoveryPeriods:       haltRecoveryPeriods,
 	}
 	oracleKeeper.SetParams(ctx, params)
 
@@ -186,6 +216,71 @@ func TestParams(t *testing.T) {
 	require.Equal(t, params, storedParams)
 }
 
+func TestExchangeRateStatusLogic(t *testing.T) {
+	// Prepare the test environment
+	init := CreateTestInput(t)
+	oracleKeeper := init.OracleKeeper
+	ctx := init.Ctx.WithBlockHeight(100)
+
+	// a denom can miss 2 vote periods
+	params := oracleKeeper.GetParams(ctx)
+	params.VotePeriod = 10
+	params.SlashWindow = 100
+	params.MaxMissedVotePeriods = 2
+	oracleKeeper.SetParams(ctx, params)
+
+	oracleKeeper.SetBaseExchangeRate(ctx, utils.MicroAtomDenom, sdk.NewDec(100))
+	_, lastUpdate, _, err := oracleKeeper.GetBaseExchangeRate(ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+
+	// active until the third missed vote period
+	require.Equal(t, types.ExchangeRateStatusActive, oracleKeeper.GetExchangeRateStatus(ctx, utils.MicroAtomDenom, lastUpdate))
+	require.Equal(t, types.ExchangeRateStatusActive, oracleKeeper.GetExchangeRateStatus(ctx.WithBlockHeight(129), utils.MicroAtomDenom, lastUpdate))
+	require.Equal(t, types.ExchangeRateStatusStale, oracleKeeper.GetExchangeRateStatus(ctx.WithBlockHeight(130), utils.MicroAtomDenom, lastUpdate))
+
+	// circuit breaker disabled
+	require.False(t, oracleKeeper.CheckPriceDeviation(ctx, utils.MicroAtomDenom, sdk.NewDec(200), sdk.ZeroDec()))
+	require.False(t, oracleKeeper.IsDenomHalted(ctx, utils.MicroAtomDenom))
+
+	// price moves less than 20%
+	require.False(t, oracleKeeper.CheckPriceDeviation(ctx, utils.MicroAtomDenom, sdk.NewDec(120), sdk.NewDecWithPrec(2, 1)))
+	require.False(t, oracleKeeper.IsDenomHalted(ctx, utils.MicroAtomDenom))
+
+	// price moves more than 20%
+	require.True(t, oracleKeeper.CheckPriceDeviation(ctx, utils.MicroAtomDenom, sdk.NewDec(79), sdk.NewDecWithPrec(2, 1)))
+	require.True(t, oracleKeeper.IsDenomHalted(ctx, utils.MicroAtomDenom))
+	require.Equal(t, types.ExchangeRateStatusHalted, oracleKeeper.GetExchangeRateStatus(ctx, utils.MicroAtomDenom, lastUpdate))
+
+	// halted denoms iteration
+	haltedDenoms := []string{}
+	oracleKeeper.IterateHaltedDenoms(ctx, func(denom string) bool {
+		haltedDenoms = append(haltedDenoms, denom)
+		return false
+	})
+	require.Equal(t, []string{utils.MicroAtomDenom}, haltedDenoms)
+
+	// the agreeing medians are counted until the median moves again
+	require.True(t, oracleKeeper.CheckPriceDeviation(ctx, utils.MicroAtomDenom, sdk.NewDec(78), sdk.NewDecWithPrec(2, 1)))
+	halt, found := oracleKeeper.GetDenomHalt(ctx, utils.MicroAtomDenom)
+	require.True(t, found)
+	require.Equal(t, types.DenomHalt{LastExchangeRate: sdk.NewDec(78), AgreeingPeriods: 1}, halt)
+	require.True(t, oracleKeeper.CheckPriceDeviation(ctx, utils.MicroAtomDenom, sdk.NewDec(50), sdk.NewDecWithPrec(2, 1)))
+	halt, _ = oracleKeeper.GetDenomHalt(ctx, utils.MicroAtomDenom)
+	require.Equal(t, types.DenomHalt{LastExchangeRate: sdk.NewDec(50), AgreeingPeriods: 0}, halt)
+
+	// the halt is lifted when the price is back on the deviation limit
+	require.False(t, oracleKeeper.CheckPriceDeviation(ctx, utils.MicroAtomDenom, sdk.NewDec(110), sdk.NewDecWithPrec(2, 1)))
+	require.False(t, oracleKeeper.IsDenomHalted(ctx, utils.MicroAtomDenom))
+
+	// the denom resumes at the new price once the medians of HaltRecoveryPeriods vote periods agree
+	require.Equal(t, uint64(3), oracleKeeper.HaltRecoveryPeriods(ctx))
+	for _, rate := range []int64{70, 72, 71} {
+		require.True(t, oracleKeeper.CheckPriceDeviation(ctx, utils.MicroAtomDenom, sdk.NewDec(rate), sdk.NewDecWithPrec(2, 1)))
+	}
+	require.False(t, oracleKeeper.CheckPriceDeviation(ctx, utils.MicroAtomDenom, sdk.NewDec(73), sdk.NewDecWithPrec(2, 1)))
+	require.False(t, oracleKeeper.IsDenomHalted(ctx, utils.MicroAtomDenom))
+}
+
 func TestDelegationLogic(t *testing.T) {
 	// Prepare the test environment
 	init := CreateTestInput(t)
@@ -345,6 +440,42 @@ func TestMissCounterIterate(t *testing.T) {
 	oracleKeeper.IterateVotePenaltyCounters(ctx, handler)
 }
 
+func TestAggregateExchangeRatePrevoteLogic(t *testing.T) {
+	// Prepare the test environment
+	init := CreateTestInput(t)
+	oracleKeeper := init.OracleKeeper
+	ctx := init.Ctx
+
+	// Create and set the prevotes
+	hash1 := types.GetAggregateVoteHash("1f2e3d4c", "1.0BTC/USD,2.0ETH/USD", ValAddrs[0])
+	prevote1 := types.NewAggregateExchangeRatePrevote(hash1, ValAddrs[0], 2)
+	oracleKeeper.SetAggregateExchangeRatePrevote(ctx, ValAddrs[0], prevote1)
+
+	hash2 := types.GetAggregateVoteHash("1f2e3d4c", "3.0BTC/USD,4.0ETH/USD", ValAddrs[1])
+	prevote2 := types.NewAggregateExchangeRatePrevote(hash2, ValAddrs[1], 3)
+	oracleKeeper.SetAggregateExchangeRatePrevote(ctx, ValAddrs[1], prevote2)
+
+	// Get the prevote and validate
+	gotPrevote, err := oracleKeeper.GetAggregateExchangeRatePrevote(ctx, ValAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, prevote1, gotPrevote)
+	require.Equal(t, hash1.String(), gotPrevote.Hash)
+
+	// Iterate the prevotes
+	count := 0
+	oracleKeeper.IterateAggregateExchangeRatePrevotes(ctx, func(voterAddr sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) bool {
+		require.Equal(t, voterAddr.String(), aggregatePrevote.Voter)
+		count++
+		return false
+	})
+	require.Equal(t, 2, count)
+
+	// Delete prevote
+	oracleKeeper.DeleteAggregateExchangeRatePrevote(ctx, ValAddrs[0])
+	_, err = oracleKeeper.GetAggregateExchangeRatePrevote(ctx, ValAddrs[0])
+	require.ErrorIs(t, err, types.ErrNoAggregatePrevote)
+}
+
 func TestAggregateExchangeRateLogic(t *testing.T) {
 	// Prepare the test environment
 	init := CreateTestInput(t)
@@ -542,6 +673,130 @@ func TestPriceSnapshotLogic(t *testing.T) {
 	require.Equal(t, expected, result)
 }
 
+func TestDeleteDenomPriceSnapshots(t *testing.T) {
+	// Prepare the test environment
+	init := CreateTestInput(t)
+	oracleKeeper := init.OracleKeeper
+	ctx := init.Ctx
+
+	// Snapshot Data
+	exchangeRate := types.OracleExchangeRate{
+		ExchangeRate:        sdk.NewDec(1),
+		LastUpdate:          sdk.NewInt(1),
+		LastUpdateTimestamp: 1,
+	}
+	kiiItem := types.NewPriceSnapshotItem(utils.MicroKiiDenom, exchangeRate)
+	ethItem := types.NewPriceSnapshotItem(utils.MicroEthDenom, exchangeRate)
+	oracleKeeper.SetPriceSnapshot(ctx, types.NewPriceSnapshot(1, types.PriceSnapshotItems{kiiItem, ethItem}))
+	oracleKeeper.SetPriceSnapshot(ctx, types.NewPriceSnapshot(2, types.PriceSnapshotItems{kiiItem}))
+	oracleKeeper.SetPriceSnapshot(ctx, types.NewPriceSnapshot(3, types.PriceSnapshotItems{ethItem}))
+
+	// remove the kii items
+	oracleKeeper.DeleteDenomPriceSnapshots(ctx, utils.MicroKiiDenom)
+
+	require.Equal(t, types.NewPriceSnapshot(1, types.PriceSnapshotItems{ethItem}), oracleKeeper.GetPriceSnapshot(ctx, 1))
+	require.Equal(t, types.PriceSnapshot{}, oracleKeeper.GetPriceSnapshot(ctx, 2)) // snapshot left empty is deleted
+	require.Equal(t, types.NewPriceSnapshot(3, types.PriceSnapshotItems{ethItem}), oracleKeeper.GetPriceSnapshot(ctx, 3))
+}
+
+func TestGetPriceAt(t *testing.T) {
+	// Prepare the test environment
+	init := CreateTestInput(t)
+	oracleKeeper := init.OracleKeeper
+	ctx := init.Ctx
+
+	setPriceHistory(ctx, oracleKeeper, map[int64]map[string]int64{
+		100: {utils.MicroEthDenom: 1, utils.MicroBtcDenom: 10},
+		200: {utils.MicroEthDenom: 2},
+		300: {utils.MicroEthDenom: 3, utils.MicroBtcDenom: 30},
+	})
+
+	testCases := []struct {
+		name              string
+		denom             string
+		timestamp         int64
+		expectedPrice     int64
+		expectedTimestamp int64
+		expectedErr       error
+	}{
+		{"exact timestamp", utils.MicroEthDenom, 200, 2, 200, nil},
+		{"between snapshots", utils.MicroEthDenom, 299, 2, 200, nil},
+		{"after the last snapshot", utils.MicroEthDenom, 1000, 3, 300, nil},
+		{"denom missing on the nearest snapshot", utils.MicroBtcDenom, 250, 10, 100, nil},
+		{"before the first snapshot", utils.MicroEthDenom, 99, 0, 0, types.ErrNoPriceAtTimestamp},
+		{"negative timestamp", utils.MicroEthDenom, -1, 0, 0, types.ErrNoPriceAtTimestamp},
+		{"unknown denom", utils.MicroAtomDenom, 300, 0, 0, types.ErrNoPriceAtTimestamp},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := oracleKeeper.GetPriceAt(ctx, tc.denom, tc.timestamp)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTimestamp, price.SnapshotTimestamp)
+			require.Equal(t, sdk.NewDec(tc.expectedPrice), price.OracleExchangeRate.ExchangeRate)
+		})
+	}
+}
+
+func TestGetPriceSnapshotRange(t *testing.T) {
+	// Prepare the test environment
+	init := CreateTestInput(t)
+	oracleKeeper := init.OracleKeeper
+	ctx := init.Ctx
+
+	setPriceHistory(ctx, oracleKeeper, map[int64]map[string]int64{
+		100: {utils.MicroEthDenom: 1},
+		200: {utils.MicroEthDenom: 2, utils.MicroBtcDenom: 20},
+		300: {utils.MicroBtcDenom: 30},
+		400: {utils.MicroEthDenom: 4},
+		500: {utils.MicroEthDenom: 5},
+	})
+
+	// invalid range
+	_, _, err := oracleKeeper.GetPriceSnapshotRange(ctx, utils.MicroEthDenom, 300, 200, nil)
+	require.ErrorIs(t, err, types.ErrInvalidTimestampRange)
+
+	// the snapshot without the denom is skipped
+	prices, pageRes, err := oracleKeeper.GetPriceSnapshotRange(ctx, utils.MicroEthDenom, 200, 500, &query.PageRequest{Limit: 2, CountTotal: true})
+	require.NoError(t, err)
+	require.Len(t, prices, 2)
+	require.Equal(t, int64(200), prices[0].SnapshotTimestamp)
+	require.Equal(t, int64(400), prices[1].SnapshotTimestamp)
+	require.Equal(t, uint64(3), pageRes.Total)
+	require.NotNil(t, pageRes.NextKey)
+
+	// next page
+	prices, pageRes, err = oracleKeeper.GetPriceSnapshotRange(ctx, utils.MicroEthDenom, 200, 500, &query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, prices, 1)
+	require.Equal(t, int64(500), prices[0].SnapshotTimestamp)
+	require.Equal(t, sdk.NewDec(5), prices[0].OracleExchangeRate.ExchangeRate)
+	require.Nil(t, pageRes.NextKey)
+
+	// offset pagination
+	prices, _, err = oracleKeeper.GetPriceSnapshotRange(ctx, utils.MicroBtcDenom, 0, 1000, &query.PageRequest{Offset: 1, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, prices, 1)
+	require.Equal(t, int64(300), prices[0].SnapshotTimestamp)
+
+	// reverse pagination starts on the to timestamp
+	prices, pageRes, err = oracleKeeper.GetPriceSnapshotRange(ctx, utils.MicroEthDenom, 100, 400, &query.PageRequest{Limit: 2, Reverse: true})
+	require.NoError(t, err)
+	require.Len(t, prices, 2)
+	require.Equal(t, int64(400), prices[0].SnapshotTimestamp)
+	require.Equal(t, int64(200), prices[1].SnapshotTimestamp)
+
+	prices, _, err = oracleKeeper.GetPriceSnapshotRange(ctx, utils.MicroEthDenom, 100, 400, &query.PageRequest{Key: pageRes.NextKey, Limit: 2, Reverse: true})
+	require.NoError(t, err)
+	require.Len(t, prices, 1)
+	require.Equal(t, int64(100), prices[0].SnapshotTimestamp)
+}
+
 func TestAddPriceSnapshot(t *testing.T) {
 	// Prepare the test environment
 	init := CreateTestInput(t)
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kiichain/kiichain/x/oracle/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate6to7 migrates from version 6 to 7.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	// Add the reward pool, exchange rate status, penalty and pull oracle params, the existing params are kept
+	m.keeper.paramSpace.Set(ctx, types.KeyRewardDistributionWindow, types.DefaultRewardDistributionWindow)
+	m.keeper.paramSpace.Set(ctx, types.KeyRewardPoolShare, types.DefaultRewardPoolShare)
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxMissedVotePeriods, types.DefaultMaxMissedVotePeriods)
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxPriceDeviation, types.DefaultMaxPriceDeviation)
+	m.keeper.paramSpace.Set(ctx, types.KeyJailDuration, types.DefaultJailDuration)
+	m.keeper.paramSpace.Set(ctx, types.KeyPenaltyEscalationWindows, types.DefaultPenaltyEscalationWindows)
+	m.keeper.paramSpace.Set(ctx, types.KeyPenaltyEscalationFactor, types.DefaultPenaltyEscalationFactor)
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxAttestationAge, types.DefaultMaxAttestationAge)
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxPenaltyEscalationLevel, types.DefaultMaxPenaltyEscalationLevel)
+	m.keeper.paramSpace.Set(ctx, types.KeyMinPricePublishers, types.DefaultMinPricePublishers)
+	m.keeper.paramSpace.Set(ctx, types.KeyHaltRecoveryPeriods, types.DefaultHaltRecoveryPeriods)
+	return nil
+}
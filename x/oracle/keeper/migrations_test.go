@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/kiichain/kiichain/x/oracle/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate6to7(t *testing.T) {
+	// prepare env
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper
+	ctx := input.Ctx
+
+	// change an existing param
+	params := oracleKeeper.GetParams(ctx)
+	params.VotePeriod = 5
+	oracleKeeper.SetParams(ctx, params)
+
+	migrator := NewMigrator(oracleKeeper)
+	require.NoError(t, migrator.Migrate6to7(ctx))
+
+	// existing params are kept and the new ones are set
+	params = oracleKeeper.GetParams(ctx)
+	require.Equal(t, uint64(5), params.VotePeriod)
+	require.Equal(t, types.DefaultRewardDistributionWindow, params.RewardDistributionWindow)
+	require.Equal(t, types.DefaultRewardPoolShare, params.RewardPoolShare)
+	require.Equal(t, types.DefaultMaxMissedVotePeriods, params.MaxMissedVotePeriods)
+	require.Equal(t, types.DefaultMaxPriceDeviation, params.MaxPriceDeviation)
+	require.Equal(t, types.DefaultJailDuration, params.JailDuration)
+	require.Equal(t, types.DefaultPenaltyEscalationWindows, params.PenaltyEscalationWindows)
+	require.Equal(t, types.DefaultPenaltyEscalationFactor, params.PenaltyEscalationFactor)
+	require.Equal(t, types.DefaultMaxAttestationAge, params.MaxAttestationAge)
+	require.Equal(t, types.DefaultMaxPenaltyEscalationLevel, params.MaxPenaltyEscalationLevel)
+	require.Equal(t, types.DefaultMinPricePublishers, params.MinPricePublishers)
+	require.Equal(t, types.DefaultHaltRecoveryPeriods, params.HaltRecoveryPeriods)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -23,8 +24,59 @@ func NewMsgServer(keeper Keeper) types.MsgServer {
 	}
 }
 
+// AggregateExchangeRatePrevote receive the hash of the exchange rate vote, validate the feeder address and store it,
+// the vote must be revealed on the next vote period through the AggregateExchangeRateVote message
+func (ms msgServer) AggregateExchangeRatePrevote(ctx context.Context, msg *types.MsgAggregateExchangeRatePrevote) (*types.MsgAggregateExchangeRatePrevoteResponse, error) {
+	// Get cosmos sdk context from golang context
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Get the validator address who send the prevote from the input data
+	valAddress, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	// convert feeder address to Account data type
+	feederAddress, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate feeder address
+	err = ms.ValidateFeeder(sdkCtx, feederAddress, valAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert hex string to the vote hash
+	voteHash, err := types.AggregateVoteHashFromHexString(msg.Hash)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidHash, err.Error())
+	}
+
+	// Store the prevote with the current block height
+	aggregatePrevote := types.NewAggregateExchangeRatePrevote(voteHash, valAddress, uint64(sdkCtx.BlockHeight()))
+	ms.SetAggregateExchangeRatePrevote(sdkCtx, valAddress, aggregatePrevote)
+
+	// Trigger events (prevote saved and the feeder address)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent( // Event with the prevote hash added into the module
+			types.EventTypeAggregatePrevote,
+			sdk.NewAttribute(types.AttributeKeyVoter, msg.Validator),
+			sdk.NewAttribute(types.AttributeKeyHash, msg.Hash),
+		),
+		sdk.NewEvent( //the Event with the information who send the information (the feeder address and the module name)
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Feeder),
+		),
+	})
+
+	return &types.MsgAggregateExchangeRatePrevoteResponse{}, nil
+}
+
 // AggregateExchangeRateVote receive the exchange rate information, validate the feeder address (if it is allowed to perform that operation),
-// then, check if the information is valid and finally add it into the exchange rate KVStore
+// then, check the information matches the prevote submitted on the previous vote period and finally add it into the exchange rate KVStore
 func (ms msgServer) AggregateExchangeRateVote(ctx context.Context, msg *types.MsgAggregateExchangeRateVote) (*types.MsgAggregateExchangeRateVoteResponse, error) {
 	// Get cosmos sdk context from golang context
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -47,6 +99,18 @@ func (ms msgServer) AggregateExchangeRateVote(ctx context.Context, msg *types.Ms
 		return nil, err
 	}
 
+	// Get the prevote submitted on the previous vote period
+	params := ms.GetParams(sdkCtx)
+	aggregatePrevote, err := ms.GetAggregateExchangeRatePrevote(sdkCtx, valAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrNoAggregatePrevote, msg.Validator)
+	}
+
+	// Check the vote is revealed in the vote period following the prevote
+	if (uint64(sdkCtx.BlockHeight())/params.VotePeriod)-(aggregatePrevote.SubmitBlock/params.VotePeriod) != 1 {
+		return nil, types.ErrRevealPeriodMissMatch
+	}
+
 	// Convert string exchange rates to specific data types
 	exchangeRates, err := types.ParseExchangeRateTuples(msg.ExchangeRates)
 	if err != nil {
@@ -66,6 +130,14 @@ func (ms msgServer) AggregateExchangeRateVote(ctx context.Context, msg *types.Ms
 		return nil, sdkerrors.Wrap(types.ErrAggregateVoteInvalidRate, exchangeRates.String())
 	}
 
+	// Verify the revealed vote against the prevote hash
+	voteHash := types.GetAggregateVoteHash(msg.Salt, msg.ExchangeRates, valAddress)
+	if aggregatePrevote.Hash != voteHash.String() {
+		return nil, sdkerrors.Wrapf(types.ErrVerificationFailed, "must be given %s not %s", aggregatePrevote.Hash, voteHash)
+	}
+
+	// The prevote is consumed by the vote
+	ms.DeleteAggregateExchangeRatePrevote(sdkCtx, valAddress)
 	ms.SetAggregateExchangeRateVote(sdkCtx, valAddress, aggregateExchangeRateVote)
 
 	// Trigger events (exchange rate saved and the feeder address)
@@ -126,3 +198,92 @@ func (ms msgServer) DelegateFeedConsent(ctx context.Context, msg *types.MsgDeleg
 
 	return &types.MsgDelegateFeedConsentResponse{}, nil
 }
+
+// SubmitPriceAttestation verifies a batch of prices signed by a registered price publisher and stores the
+// fresh prices of the publisher, the pull oracle feed is updated with the median of the publisher prices
+// independently of the vote period
+func (ms msgServer) SubmitPriceAttestation(ctx context.Context, msg *types.MsgSubmitPriceAttestation) (*types.MsgSubmitPriceAttestationResponse, error) {
+	// Get cosmos sdk context from golang context
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// The pull oracle is enabled by governance through the max attestation age
+	maxAge := ms.MaxAttestationAge(sdkCtx)
+	if maxAge == 0 {
+		return nil, types.ErrPullOracleDisabled
+	}
+
+	attestation := msg.Attestation
+
+	// The attestation must be signed for this chain, otherwise it could be replayed from other chains
+	if attestation.ChainId != sdkCtx.ChainID() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAttestation, "expected chain id %s got %s", sdkCtx.ChainID(), attestation.ChainId)
+	}
+
+	// Get the publisher registered by governance and verify the signature
+	publisher, found := ms.GetPricePublisher(sdkCtx, attestation.Publisher)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownPricePublisher, attestation.Publisher)
+	}
+
+	err := types.VerifyPriceAttestation(publisher, attestation, msg.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the attestation freshness against the block time, prices from the future are rejected
+	age := sdkCtx.BlockTime().Unix() - attestation.Timestamp
+	if age < 0 {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAttestation, "attestation timestamp %d is after the block time", attestation.Timestamp)
+	}
+	if uint64(age) > maxAge {
+		return nil, sdkerrors.Wrapf(types.ErrStaleAttestation, "attestation age %ds, max age %ds", age, maxAge)
+	}
+
+	// Check all denoms are in the vote target
+	for _, price := range attestation.Prices {
+		if !ms.IsVoteTarget(sdkCtx, price.Denom) {
+			return nil, sdkerrors.Wrap(types.ErrUnknownDenom, price.Denom)
+		}
+	}
+
+	events := sdk.Events{}
+	for _, price := range attestation.Prices {
+		// Only newer prices replace the publisher price, an old attestation cannot roll back the feed
+		current, found := ms.GetPublisherPrice(sdkCtx, price.Denom, attestation.Publisher)
+		if found && current.PublishTime >= attestation.Timestamp {
+			continue
+		}
+
+		ms.SetPublisherPrice(sdkCtx, attestation.Publisher, types.PullPrice{
+			Denom:        price.Denom,
+			Price:        price.ExchangeRate,
+			PublishTime:  attestation.Timestamp,
+			Publishers:   []string{attestation.Publisher},
+			UpdateHeight: sdkCtx.BlockHeight(),
+		})
+
+		// The pull oracle price is the median of the fresh publisher prices
+		pullPrice, updated := ms.UpdatePullPrice(sdkCtx, price.Denom)
+		if !updated {
+			continue
+		}
+
+		events = append(events, sdk.NewEvent( // Event with the price added into the pull oracle feed
+			types.EventTypePullPriceUpdate,
+			sdk.NewAttribute(types.AttributeKeyDenom, price.Denom),
+			sdk.NewAttribute(types.AttributeKeyExchangeRate, pullPrice.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyPublisher, attestation.Publisher),
+			sdk.NewAttribute(types.AttributeKeyPublishTime, fmt.Sprintf("%d", pullPrice.PublishTime)),
+		))
+	}
+
+	// Trigger events (prices updated and the sender address)
+	events = append(events, sdk.NewEvent( //the Event with the information who send the information (the sender address and the module name)
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+	sdkCtx.EventManager().EmitEvents(events)
+
+	return &types.MsgSubmitPriceAttestationResponse{}, nil
+}
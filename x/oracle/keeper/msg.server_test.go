@@ -2,7 +2,10 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/kiichain/kiichain/x/oracle/types"
@@ -32,13 +35,86 @@ func TestAggregateExchangeRateVote(t *testing.T) {
 	// execute staking endblocker to start validators bonding
 	staking.EndBlocker(ctx, stakingKeeper)
 
+	// send the prevote on the first vote period (vote period = 2 blocks)
+	salt := "1f2e3d4c"
+	exchangeRate := sdk.NewDec(12).String() + utils.MicroUsdcDenom
+	hash := types.GetAggregateVoteHash(salt, exchangeRate, ValAddrs[0])
+	prevoteCtx := sdk.WrapSDKContext(ctx.WithBlockHeight(1))
+	_, err = msgServer.AggregateExchangeRatePrevote(prevoteCtx, types.NewMsgAggregateExchangeRatePrevote(hash, Addrs[0], ValAddrs[0]))
+	require.NoError(t, err)
+
+	// the vote can not be revealed on the same vote period
+	_, err = msgServer.AggregateExchangeRateVote(prevoteCtx, types.NewMsgAggregateExchangeRateVote(salt, exchangeRate, Addrs[0], ValAddrs[0]))
+	require.ErrorIs(t, err, types.ErrRevealPeriodMissMatch)
+
+	// the vote can not be revealed two vote periods later
+	lateCtx := sdk.WrapSDKContext(ctx.WithBlockHeight(4))
+	_, err = msgServer.AggregateExchangeRateVote(lateCtx, types.NewMsgAggregateExchangeRateVote(salt, exchangeRate, Addrs[0], ValAddrs[0]))
+	require.ErrorIs(t, err, types.ErrRevealPeriodMissMatch)
+
+	// the revealed vote must match the prevote hash
+	voteCtx := sdk.WrapSDKContext(ctx.WithBlockHeight(2))
+	_, err = msgServer.AggregateExchangeRateVote(voteCtx, types.NewMsgAggregateExchangeRateVote("abcd", exchangeRate, Addrs[0], ValAddrs[0]))
+	require.ErrorIs(t, err, types.ErrVerificationFailed)
+
+	otherRate := sdk.NewDec(13).String() + utils.MicroUsdcDenom
+	_, err = msgServer.AggregateExchangeRateVote(voteCtx, types.NewMsgAggregateExchangeRateVote(salt, otherRate, Addrs[0], ValAddrs[0]))
+	require.ErrorIs(t, err, types.ErrVerificationFailed)
+
+	// reveal the vote on the next vote period
+	_, err = msgServer.AggregateExchangeRateVote(voteCtx, types.NewMsgAggregateExchangeRateVote(salt, exchangeRate, Addrs[0], ValAddrs[0]))
+	require.NoError(t, err)
+
+	// validation, the prevote was consumed by the vote
+	_, err = oracleKeeper.GetAggregateExchangeRateVote(ctx, ValAddrs[0])
+	require.NoError(t, err)
+	_, err = oracleKeeper.GetAggregateExchangeRatePrevote(ctx, ValAddrs[0])
+	require.ErrorIs(t, err, types.ErrNoAggregatePrevote)
+
+	// the vote can not be revealed without a prevote
+	_, err = msgServer.AggregateExchangeRateVote(voteCtx, types.NewMsgAggregateExchangeRateVote(salt, exchangeRate, Addrs[0], ValAddrs[0]))
+	require.ErrorIs(t, err, types.ErrNoAggregatePrevote)
+}
+
+func TestAggregateExchangeRatePrevote(t *testing.T) {
+	// prepare env
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper
+	stakingKeeper := input.StakingKeeper
+	ctx := input.Ctx.WithBlockHeight(3)
+	stakingHandler := staking.NewHandler(stakingKeeper)
+
+	// create msg server
+	msgServer := NewMsgServer(oracleKeeper)
+
+	// Create validators
+	stakingAmount := sdk.TokensFromConsensusPower(50, sdk.DefaultPowerReduction)
+	val := NewTestMsgCreateValidator(ValAddrs[0], ValPubKeys[0], stakingAmount)
+
+	// Register validators
+	_, err := stakingHandler(ctx, val)
+	require.NoError(t, err)
+
+	// execute staking endblocker to start validators bonding
+	staking.EndBlocker(ctx, stakingKeeper)
+
 	// send messages
 	exchangeRate := sdk.NewDec(12).String() + utils.MicroUsdcDenom
+	hash := types.GetAggregateVoteHash("1f2e3d4c", exchangeRate, ValAddrs[0])
 	context := sdk.WrapSDKContext(ctx)
-	_, err = msgServer.AggregateExchangeRateVote(context, types.NewMsgAggregateExchangeRateVote(exchangeRate, Addrs[0], ValAddrs[0]))
+	_, err = msgServer.AggregateExchangeRatePrevote(context, types.NewMsgAggregateExchangeRatePrevote(hash, Addrs[0], ValAddrs[0]))
+	require.NoError(t, err)
+
+	// the feeder must be allowed to vote
+	_, err = msgServer.AggregateExchangeRatePrevote(context, types.NewMsgAggregateExchangeRatePrevote(hash, Addrs[1], ValAddrs[0]))
+	require.ErrorIs(t, err, types.ErrNoVotingPermission)
 
 	// validation
+	prevote, err := oracleKeeper.GetAggregateExchangeRatePrevote(ctx, ValAddrs[0])
 	require.NoError(t, err)
+	require.Equal(t, hash.String(), prevote.Hash)
+	require.Equal(t, ValAddrs[0].String(), prevote.Voter)
+	require.Equal(t, uint64(3), prevote.SubmitBlock)
 }
 
 func TestDelegateFeedConsent(t *testing.T) {
@@ -76,3 +152,128 @@ func TestDelegateFeedConsent(t *testing.T) {
 	// validation
 	require.Equal(t, Addrs[0].String(), res.FeedAddr)
 }
+
+func TestSubmitPriceAttestation(t *testing.T) {
+	// prepare env
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper
+	ctx := input.Ctx.WithChainID("kiichain").WithBlockTime(time.Unix(1000, 0)).WithBlockHeight(10)
+	msgServer := NewMsgServer(oracleKeeper)
+
+	// register the publishers
+	privKeys := map[string]cryptotypes.PrivKey{}
+	for _, name := range []string{"kii", "pyth", "band"} {
+		privKeys[name] = ed25519.GenPrivKey()
+		publisher, err := types.NewPricePublisher(name, privKeys[name].PubKey())
+		require.NoError(t, err)
+		oracleKeeper.SetPricePublisher(ctx, publisher)
+	}
+
+	// sign an attestation with the publisher key
+	newMsg := func(attestation types.PriceAttestation) *types.MsgSubmitPriceAttestation {
+		privKey, found := privKeys[attestation.Publisher]
+		if !found {
+			privKey = privKeys["kii"]
+		}
+		signature, err := types.SignPriceAttestation(privKey, attestation)
+		require.NoError(t, err)
+		return types.NewMsgSubmitPriceAttestation(Addrs[0], attestation, signature)
+	}
+	prices := types.ExchangeRateTuples{{Denom: utils.MicroBtcDenom, ExchangeRate: sdk.NewDec(100000)}}
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// the pull oracle is disabled by default
+	_, err := msgServer.SubmitPriceAttestation(goCtx, newMsg(types.NewPriceAttestation("kii", "kiichain", 1000, prices)))
+	require.ErrorIs(t, err, types.ErrPullOracleDisabled)
+
+	params := oracleKeeper.GetParams(ctx)
+	params.MaxAttestationAge = 10
+	params.MinPricePublishers = 2
+	oracleKeeper.SetParams(ctx, params)
+
+	t.Run("invalid attestations", func(t *testing.T) {
+		// signed for other chain
+		_, err := msgServer.SubmitPriceAttestation(goCtx, newMsg(types.NewPriceAttestation("kii", "otherchain", 1000, prices)))
+		require.ErrorIs(t, err, types.ErrInvalidAttestation)
+
+		// unknown publisher
+		_, err = msgServer.SubmitPriceAttestation(goCtx, newMsg(types.NewPriceAttestation("other", "kiichain", 1000, prices)))
+		require.ErrorIs(t, err, types.ErrUnknownPricePublisher)
+
+		// invalid signature
+		msg := newMsg(types.NewPriceAttestation("kii", "kiichain", 1000, prices))
+		msg.Attestation.Timestamp = 999
+		_, err = msgServer.SubmitPriceAttestation(goCtx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidAttestationSig)
+
+		// stale and future attestations
+		_, err = msgServer.SubmitPriceAttestation(goCtx, newMsg(types.NewPriceAttestation("kii", "kiichain", 989, prices)))
+		require.ErrorIs(t, err, types.ErrStaleAttestation)
+		_, err = msgServer.SubmitPriceAttestation(goCtx, newMsg(types.NewPriceAttestation("kii", "kiichain", 1001, prices)))
+		require.ErrorIs(t, err, types.ErrInvalidAttestation)
+
+		// denom not in the vote targets
+		unknownPrices := types.ExchangeRateTuples{{Denom: utils.MicroAtomDenom, ExchangeRate: sdk.NewDec(5)}}
+		_, err = msgServer.SubmitPriceAttestation(goCtx, newMsg(types.NewPriceAttestation("kii", "kiichain", 1000, unknownPrices)))
+		require.ErrorIs(t, err, types.ErrUnknownDenom)
+
+		_, err = oracleKeeper.GetPullPrice(ctx, utils.MicroBtcDenom)
+		require.ErrorIs(t, err, types.ErrNoPullPrice)
+	})
+
+	t.Run("aggregate the prices of the publishers", func(t *testing.T) {
+		// a single publisher is below the quorum
+		_, err := msgServer.SubmitPriceAttestation(goCtx, newMsg(types.NewPriceAttestation("kii", "kiichain", 995, prices)))
+		require.NoError(t, err)
+		_, err = oracleKeeper.GetPullPrice(ctx, utils.MicroBtcDenom)
+		require.ErrorIs(t, err, types.ErrNoPullPrice)
+
+		publisherPrice, found := oracleKeeper.GetPublisherPrice(ctx, utils.MicroBtcDenom, "kii")
+		require.True(t, found)
+		require.Equal(t, sdk.NewDec(100000), publisherPrice.Price)
+
+		// the quorum is reached with a second publisher
+		pythPrices := types.ExchangeRateTuples{{Denom: utils.MicroBtcDenom, ExchangeRate: sdk.NewDec(102000)}}
+		_, err = msgServer.SubmitPriceAttestation(goCtx, newMsg(types.NewPriceAttestation("pyth", "kiichain", 997, pythPrices)))
+		require.NoError(t, err)
+
+		pullPrice, err := oracleKeeper.GetPullPrice(ctx, utils.MicroBtcDenom)
+		require.NoError(t, err)
+		require.Equal(t, types.PullPrice{
+			Denom:        utils.MicroBtcDenom,
+			Price:        sdk.NewDec(101000),
+			PublishTime:  995,
+			Publishers:   []string{"kii", "pyth"},
+			UpdateHeight: 10,
+		}, pullPrice)
+
+		// an outlier publisher does not move the median
+		bandPrices := types.ExchangeRateTuples{{Denom: utils.MicroBtcDenom, ExchangeRate: sdk.NewDec(1)}}
+		_, err = msgServer.SubmitPriceAttestation(goCtx, newMsg(types.NewPriceAttestation("band", "kiichain", 998, bandPrices)))
+		require.NoError(t, err)
+
+		pullPrice, err = oracleKeeper.GetPullPrice(ctx, utils.MicroBtcDenom)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDec(100000), pullPrice.Price)
+		require.Equal(t, []string{"band", "kii", "pyth"}, pullPrice.Publishers)
+	})
+
+	t.Run("older attestations do not replace the price", func(t *testing.T) {
+		olderPrices := types.ExchangeRateTuples{{Denom: utils.MicroBtcDenom, ExchangeRate: sdk.NewDec(90000)}}
+		_, err := msgServer.SubmitPriceAttestation(goCtx, newMsg(types.NewPriceAttestation("kii", "kiichain", 992, olderPrices)))
+		require.NoError(t, err)
+
+		publisherPrice, found := oracleKeeper.GetPublisherPrice(ctx, utils.MicroBtcDenom, "kii")
+		require.True(t, found)
+		require.Equal(t, sdk.NewDec(100000), publisherPrice.Price)
+
+		newerPrices := types.ExchangeRateTuples{{Denom: utils.MicroBtcDenom, ExchangeRate: sdk.NewDec(110000)}}
+		_, err = msgServer.SubmitPriceAttestation(goCtx, newMsg(types.NewPriceAttestation("kii", "kiichain", 1000, newerPrices)))
+		require.NoError(t, err)
+
+		pullPrice, err := oracleKeeper.GetPullPrice(ctx, utils.MicroBtcDenom)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDec(102000), pullPrice.Price)
+		require.Equal(t, int64(997), pullPrice.PublishTime)
+	})
+}
@@ -42,7 +42,6 @@ func (k Keeper) Whitelist(ctx sdk.Context) (res types.DenomList) {
 }
 
 // SetWhitelist store new whitelist to param store
-// this function is only for test purpose
 func (k Keeper) SetWhitelist(ctx sdk.Context, whitelist types.DenomList) {
 	k.paramSpace.Set(ctx, types.KeyWhitelist, whitelist)
 }
@@ -70,3 +69,69 @@ func (k Keeper) LookbackDuration(ctx sdk.Context) (res uint64) {
 	k.paramSpace.Get(ctx, types.KeyLookbackDuration, &res)
 	return
 }
+
+// RewardDistributionWindow returns the number of blocks over which the reward pool is paid out
+func (k Keeper) RewardDistributionWindow(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyRewardDistributionWindow, &res)
+	return
+}
+
+// RewardPoolShare returns the share of the collected fees moved into the oracle reward pool
+func (k Keeper) RewardPoolShare(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyRewardPoolShare, &res)
+	return
+}
+
+// MaxMissedVotePeriods returns the number of vote periods a denom can miss before being stale
+func (k Keeper) MaxMissedVotePeriods(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxMissedVotePeriods, &res)
+	return
+}
+
+// MaxPriceDeviation returns the max change between two exchange rates before halting the denom
+func (k Keeper) MaxPriceDeviation(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyMaxPriceDeviation, &res)
+	return
+}
+
+// JailDuration returns the time (in seconds) a validator penalized by the oracle stays jailed
+func (k Keeper) JailDuration(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyJailDuration, &res)
+	return
+}
+
+// PenaltyEscalationWindows returns the number of consecutive bad windows to escalate the penalties
+func (k Keeper) PenaltyEscalationWindows(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyPenaltyEscalationWindows, &res)
+	return
+}
+
+// PenaltyEscalationFactor returns the multiplier applied to the penalties on each escalation level
+func (k Keeper) PenaltyEscalationFactor(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyPenaltyEscalationFactor, &res)
+	return
+}
+
+// MaxPenaltyEscalationLevel returns the max escalation level applied to the penalties
+func (k Keeper) MaxPenaltyEscalationLevel(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxPenaltyEscalationLevel, &res)
+	return
+}
+
+// MinPricePublishers returns the min number of publishers with a fresh price to update a pull oracle price
+func (k Keeper) MinPricePublishers(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMinPricePublishers, &res)
+	return
+}
+
+// HaltRecoveryPeriods returns the number of consecutive agreeing medians required to resume a halted denom
+func (k Keeper) HaltRecoveryPeriods(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyHaltRecoveryPeriods, &res)
+	return
+}
+
+// MaxAttestationAge returns the max age (in seconds) of a price attestation accepted by the pull oracle
+func (k Keeper) MaxAttestationAge(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxAttestationAge, &res)
+	return
+}
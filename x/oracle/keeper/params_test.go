@@ -134,3 +134,40 @@ func LookbackDuration(t *testing.T) {
 	require.NotNil(t, params)
 	require.Equal(t, types.DefaultLookbackDuration, params.LookbackDuration)
 }
+
+func TestRewardDistributionWindow(t *testing.T) {
+	init := CreateTestInput(t)
+	oracleKeeper := init.OracleKeeper
+	ctx := init.Ctx
+
+	require.Equal(t, types.DefaultRewardDistributionWindow, oracleKeeper.RewardDistributionWindow(ctx))
+}
+
+func TestRewardPoolShare(t *testing.T) {
+	init := CreateTestInput(t)
+	oracleKeeper := init.OracleKeeper
+	ctx := init.Ctx
+
+	require.Equal(t, types.DefaultRewardPoolShare, oracleKeeper.RewardPoolShare(ctx))
+}
+
+func TestPenaltyParams(t *testing.T) {
+	init := CreateTestInput(t)
+	oracleKeeper := init.OracleKeeper
+	ctx := init.Ctx
+
+	require.Equal(t, types.DefaultJailDuration, oracleKeeper.JailDuration(ctx))
+	require.Equal(t, types.DefaultPenaltyEscalationWindows, oracleKeeper.PenaltyEscalationWindows(ctx))
+	require.Equal(t, types.DefaultPenaltyEscalationFactor, oracleKeeper.PenaltyEscalationFactor(ctx))
+	require.Equal(t, types.DefaultMaxPenaltyEscalationLevel, oracleKeeper.MaxPenaltyEscalationLevel(ctx))
+	require.Equal(t, types.DefaultHaltRecoveryPeriods, oracleKeeper.HaltRecoveryPeriods(ctx))
+}
+
+func TestMaxAttestationAge(t *testing.T) {
+	init := CreateTestInput(t)
+	oracleKeeper := init.OracleKeeper
+	ctx := init.Ctx
+
+	require.Equal(t, types.DefaultMaxAttestationAge, oracleKeeper.MaxAttestationAge(ctx))
+	require.Equal(t, types.DefaultMinPricePublishers, oracleKeeper.MinPricePublishers(ctx))
+}
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kiichain/kiichain/x/oracle/types"
+)
+
+// snapshotPrice is a price taken from a snapshot with its timestamp
+type snapshotPrice struct {
+	timestamp int64
+	price     sdk.Dec
+}
+
+// CalculateEmas calculates the exponential moving average to each vote target with the prices stored on the snapshots
+// inside the lookback period. The weight of the previous average decays by half every halfLifeSeconds
+func (k Keeper) CalculateEmas(ctx sdk.Context, lookBackSeconds uint64, halfLifeSeconds uint64) (types.OracleEmas, error) {
+	oracleEmas := types.OracleEmas{}
+	err := k.ValidateLookBackSeconds(ctx, lookBackSeconds) // validate the input lookback
+	if err != nil {
+		return oracleEmas, err
+	}
+
+	if halfLifeSeconds == 0 {
+		return oracleEmas, types.ErrInvalidEmaHalfLife
+	}
+
+	// decay factor per second, 0.5^(1/halfLife)
+	decayPerSecond, err := sdk.NewDecWithPrec(5, 1).ApproxRoot(halfLifeSeconds)
+	if err != nil {
+		return oracleEmas, err
+	}
+
+	pricesByDenom, denoms := k.getSnapshotPricesByDenom(ctx, lookBackSeconds)
+	for _, denom := range denoms {
+		prices := pricesByDenom[denom]
+
+		// the first price on the period is the initial average
+		ema := prices[0].price
+		for i := 1; i < len(prices); i++ {
+			// the previous average weight decays with the time elapsed since the last price
+			decay := decayPerSecond.Power(uint64(prices[i].timestamp - prices[i-1].timestamp))
+			ema = ema.Mul(decay).Add(prices[i].price.Mul(sdk.OneDec().Sub(decay)))
+		}
+
+		oracleEmas = append(oracleEmas, types.OracleEma{
+			Denom:           denom,
+			Ema:             ema,
+			LookbackSeconds: ctx.BlockTime().Unix() - prices[0].timestamp,
+			HalfLifeSeconds: halfLifeSeconds,
+		})
+	}
+
+	if len(oracleEmas) == 0 {
+		return oracleEmas, types.ErrNoPriceStatsData
+	}
+
+	return oracleEmas, nil
+}
+
+// CalculatePriceStats calculates the median, min, max and volatility (standard deviation) to each vote target with the
+// prices stored on the snapshots inside the lookback period
+func (k Keeper) CalculatePriceStats(ctx sdk.Context, lookBackSeconds uint64) (types.OraclePriceStatsList, error) {
+	oraclePriceStats := types.OraclePriceStatsList{}
+	err := k.ValidateLookBackSeconds(ctx, lookBackSeconds) // validate the input lookback
+	if err != nil {
+		return oraclePriceStats, err
+	}
+
+	pricesByDenom, denoms := k.getSnapshotPricesByDenom(ctx, lookBackSeconds)
+	for _, denom := range denoms {
+		prices := pricesByDenom[denom]
+
+		// Sort the prices to get the median, min and max
+		sorted := make([]sdk.Dec, len(prices))
+		sum := sdk.ZeroDec()
+		for i, item := range prices {
+			sorted[i] = item.price
+			sum = sum.Add(item.price)
+		}
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i].LT(sorted[j]) })
+
+		// Median, the average of the two middle prices if the number of prices is even
+		count := len(sorted)
+		median := sorted[count/2]
+		if count%2 == 0 {
+			median = sorted[count/2-1].Add(sorted[count/2]).QuoInt64(2)
+		}
+
+		// Population standard deviation
+		mean := sum.QuoInt64(int64(count))
+		variance := sdk.ZeroDec()
+		for _, price := range sorted {
+			diff := price.Sub(mean)
+			variance = variance.Add(diff.Mul(diff))
+		}
+		volatility, err := variance.QuoInt64(int64(count)).ApproxSqrt()
+		if err != nil {
+			return oraclePriceStats, err
+		}
+
+		oraclePriceStats = append(oraclePriceStats, types.OraclePriceStats{
+			Denom:           denom,
+			Median:          median,
+			Min:             sorted[0],
+			Max:             sorted[count-1],
+			Volatility:      volatility,
+			SampleCount:     uint64(count),
+			LookbackSeconds: ctx.BlockTime().Unix() - prices[0].timestamp,
+		})
+	}
+
+	if len(oraclePriceStats) == 0 {
+		return oraclePriceStats, types.ErrNoPriceStatsData
+	}
+
+	return oraclePriceStats, nil
+}
+
+// getSnapshotPricesByDenom returns the prices of the vote targets stored on the snapshots inside the lookback
+// period ordered from the oldest to the most recent, and the sorted list of denoms with prices
+func (k Keeper) getSnapshotPricesByDenom(ctx sdk.Context, lookBackSeconds uint64) (map[string][]snapshotPrice, []string) {
+	startTime := ctx.BlockTime().Unix() - int64(lookBackSeconds)
+
+	// get targets exchange rate
+	targetsMap := make(map[string]struct{})
+	k.IterateVoteTargets(ctx, func(denom string, denomInfo types.Denom) bool {
+		targetsMap[denom] = struct{}{}
+		return false
+	})
+
+	pricesByDenom := make(map[string][]snapshotPrice)
+	k.IteratePriceSnapshots(ctx, func(snapshot types.PriceSnapshot) bool {
+		// Skip the snapshots older than the lookback period
+		if snapshot.SnapshotTimestamp < startTime {
+			return false
+		}
+
+		for _, priceItem := range snapshot.PriceSnapshotItems {
+			if _, ok := targetsMap[priceItem.Denom]; !ok {
+				continue // The denom that is not targeted does not care
+			}
+
+			pricesByDenom[priceItem.Denom] = append(pricesByDenom[priceItem.Denom], snapshotPrice{
+				timestamp: snapshot.SnapshotTimestamp,
+				price:     priceItem.OracleExchangeRate.ExchangeRate,
+			})
+		}
+		return false
+	})
+
+	// Order the denoms (just to have an order)
+	denoms := make([]string, 0, len(pricesByDenom))
+	for denom := range pricesByDenom {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	return pricesByDenom, denoms
+}
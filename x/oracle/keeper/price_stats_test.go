@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kiichain/kiichain/x/oracle/types"
+	"github.com/kiichain/kiichain/x/oracle/utils"
+	"github.com/stretchr/testify/require"
+)
+
+// setPriceHistory stores a snapshot by timestamp with the given prices
+func setPriceHistory(ctx sdk.Context, oracleKeeper Keeper, history map[int64]map[string]int64) {
+	for timestamp, prices := range history {
+		items := types.PriceSnapshotItems{}
+		for denom, price := range prices {
+			items = append(items, types.NewPriceSnapshotItem(denom, types.OracleExchangeRate{
+				ExchangeRate:        sdk.NewDec(price),
+				LastUpdate:          sdk.NewInt(timestamp),
+				LastUpdateTimestamp: timestamp,
+			}))
+		}
+		oracleKeeper.SetPriceSnapshot(ctx, types.NewPriceSnapshot(timestamp, items))
+	}
+}
+
+// requireApproxEqual validates two decimals are equal up to 8 decimal places
+func requireApproxEqual(t *testing.T, expected, actual sdk.Dec) {
+	require.True(t, expected.Sub(actual).Abs().LT(sdk.NewDecWithPrec(1, 8)), "expected %s, got %s", expected, actual)
+}
+
+func TestCalculateEmas(t *testing.T) {
+	// Prepare the test environment
+	init := CreateTestInput(t)
+	oracleKeeper := init.OracleKeeper
+	ctx := init.Ctx.WithBlockTime(time.Unix(3600, 0))
+
+	// empty history
+	_, err := oracleKeeper.CalculateEmas(ctx, 3600, 100)
+	require.ErrorIs(t, err, types.ErrNoPriceStatsData)
+
+	setPriceHistory(ctx, oracleKeeper, map[int64]map[string]int64{
+		100:  {utils.MicroEthDenom: 1000}, // outside the lookback period
+		1000: {utils.MicroEthDenom: 10, utils.MicroBtcDenom: 7, utils.MicroKiiDenom: 1},
+		1100: {utils.MicroEthDenom: 20},
+		1300: {utils.MicroEthDenom: 40},
+	})
+
+	// invalid inputs
+	_, err = oracleKeeper.CalculateEmas(ctx, 0, 100)
+	require.ErrorIs(t, err, types.ErrInvalidTwapLookback)
+	_, err = oracleKeeper.CalculateEmas(ctx, 3000, 0)
+	require.ErrorIs(t, err, types.ErrInvalidEmaHalfLife)
+
+	emas, err := oracleKeeper.CalculateEmas(ctx, 3000, 100)
+	require.NoError(t, err)
+	require.Len(t, emas, 2) // ukii is not a vote target
+
+	// ubtc has a single price
+	require.Equal(t, utils.MicroBtcDenom, emas[0].Denom)
+	require.Equal(t, sdk.NewDec(7), emas[0].Ema)
+	require.Equal(t, int64(2600), emas[0].LookbackSeconds)
+	require.Equal(t, uint64(100), emas[0].HalfLifeSeconds)
+
+	// ueth: 10 -> (one half-life) 15 -> (two half-lifes) 15 * 0.25 + 40 * 0.75
+	require.Equal(t, utils.MicroEthDenom, emas[1].Denom)
+	requireApproxEqual(t, sdk.MustNewDecFromStr("33.75"), emas[1].Ema)
+}
+
+func TestCalculatePriceStats(t *testing.T) {
+	// Prepare the test environment
+	init := CreateTestInput(t)
+	oracleKeeper := init.OracleKeeper
+	ctx := init.Ctx.WithBlockTime(time.Unix(3600, 0))
+
+	// empty history
+	_, err := oracleKeeper.CalculatePriceStats(ctx, 3600)
+	require.ErrorIs(t, err, types.ErrNoPriceStatsData)
+
+	setPriceHistory(ctx, oracleKeeper, map[int64]map[string]int64{
+		100:  {utils.MicroEthDenom: 1000}, // outside the lookback period
+		1000: {utils.MicroEthDenom: 3, utils.MicroBtcDenom: 7},
+		1100: {utils.MicroEthDenom: 1, utils.MicroBtcDenom: 9},
+		1200: {utils.MicroEthDenom: 4, utils.MicroBtcDenom: 8},
+		1300: {utils.MicroEthDenom: 2},
+	})
+
+	// invalid lookback
+	_, err = oracleKeeper.CalculatePriceStats(ctx, 0)
+	require.ErrorIs(t, err, types.ErrInvalidTwapLookback)
+
+	stats, err := oracleKeeper.CalculatePriceStats(ctx, 3000)
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+
+	testCases := []struct {
+		denom      string
+		median     sdk.Dec
+		min        sdk.Dec
+		max        sdk.Dec
+		volatility sdk.Dec
+		count      uint64
+	}{
+		// odd number of prices: 7, 8, 9
+		{utils.MicroBtcDenom, sdk.NewDec(8), sdk.NewDec(7), sdk.NewDec(9), sdk.MustNewDecFromStr("0.816496580927726033"), 3},
+		// even number of prices: 1, 2, 3, 4
+		{utils.MicroEthDenom, sdk.MustNewDecFromStr("2.5"), sdk.NewDec(1), sdk.NewDec(4), sdk.MustNewDecFromStr("1.118033988749894848"), 4},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, tc.denom, stats[i].Denom)
+		require.Equal(t, tc.median, stats[i].Median)
+		require.Equal(t, tc.min, stats[i].Min)
+		require.Equal(t, tc.max, stats[i].Max)
+		requireApproxEqual(t, tc.volatility, stats[i].Volatility)
+		require.Equal(t, tc.count, stats[i].SampleCount)
+		require.Equal(t, int64(2600), stats[i].LookbackSeconds)
+	}
+}
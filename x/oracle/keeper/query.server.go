@@ -59,6 +59,7 @@ func (qs queryServer) ExchangeRate(ctx context.Context, req *types.QueryExchange
 			LastUpdate:          lastUpdate,
 			LastUpdateTimestamp: lastUpdateTimestamp,
 		},
+		Status: qs.Keeper.GetExchangeRateStatus(sdkCtx, req.Denom, lastUpdate),
 	}
 
 	return response, nil
@@ -70,7 +71,8 @@ func (qs queryServer) ExchangeRates(ctx context.Context, req *types.QueryExchang
 
 	exchangeRates := []types.DenomOracleExchangeRate{}
 	qs.Keeper.IterateBaseExchangeRates(sdkCtx, func(denom string, exchangeRate types.OracleExchangeRate) bool {
-		exchangeRates = append(exchangeRates, types.DenomOracleExchangeRate{Denom: denom, OracleExchangeRate: &exchangeRate})
+		status := qs.Keeper.GetExchangeRateStatus(sdkCtx, denom, exchangeRate.LastUpdate)
+		exchangeRates = append(exchangeRates, types.DenomOracleExchangeRate{Denom: denom, OracleExchangeRate: &exchangeRate, Status: status})
 		return false
 	})
 
@@ -110,6 +112,40 @@ func (qs queryServer) PriceSnapshotHistory(ctx context.Context, req *types.Query
 	return &types.QueryPriceSnapshotHistoryResponse{PriceSnapshot: priceSnapshots}, nil
 }
 
+// PriceAt queries the price of a denom on the most recent snapshot at or before a timestamp
+func (qs queryServer) PriceAt(ctx context.Context, req *types.QueryPriceAtRequest) (*types.QueryPriceAtResponse, error) {
+	// Validate request information
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	price, err := qs.Keeper.GetPriceAt(sdkCtx, req.Denom, req.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPriceAtResponse{Price: price}, nil
+}
+
+// PriceSnapshotRange queries the paginated prices of a denom on the snapshots between two timestamps
+func (qs queryServer) PriceSnapshotRange(ctx context.Context, req *types.QueryPriceSnapshotRangeRequest) (*types.QueryPriceSnapshotRangeResponse, error) {
+	// Validate request information
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	prices, pageRes, err := qs.Keeper.GetPriceSnapshotRange(sdkCtx, req.Denom, req.FromTimestamp, req.ToTimestamp, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPriceSnapshotRangeResponse{Prices: prices, Pagination: pageRes}, nil
+}
+
 // Twaps queries the Time-weighted average price (TWAPs) whitin an specific period of time
 func (qs queryServer) Twaps(ctx context.Context, req *types.QueryTwapsRequest) (*types.QueryTwapsResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -122,6 +158,30 @@ func (qs queryServer) Twaps(ctx context.Context, req *types.QueryTwapsRequest) (
 	return &types.QueryTwapsResponse{OracleTwap: twaps}, err
 }
 
+// Emas queries the exponential moving average prices (EMAs) with the given half-life whitin an specific period of time
+func (qs queryServer) Emas(ctx context.Context, req *types.QueryEmasRequest) (*types.QueryEmasResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	emas, err := qs.Keeper.CalculateEmas(sdkCtx, req.LookbackSeconds, req.HalfLifeSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEmasResponse{OracleEma: emas}, nil
+}
+
+// PriceStats queries the median, min, max and volatility of the prices whitin an specific period of time
+func (qs queryServer) PriceStats(ctx context.Context, req *types.QueryPriceStatsRequest) (*types.QueryPriceStatsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	priceStats, err := qs.Keeper.CalculatePriceStats(sdkCtx, req.LookbackSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPriceStatsResponse{OraclePriceStats: priceStats}, nil
+}
+
 // FeederDelegation queries the account data address assigned as a delegator by a validator
 func (qs queryServer) FeederDelegation(ctx context.Context, req *types.QueryFeederDelegationRequest) (*types.QueryFeederDelegationResponse, error) {
 	// Validate request information
@@ -171,6 +231,71 @@ func (qs queryServer) VotePenaltyCounter(ctx context.Context, req *types.QueryVo
 
 }
 
+// ValidatorOracleStats queries the voting performance of a validator across the past slash windows
+func (qs queryServer) ValidatorOracleStats(ctx context.Context, req *types.QueryValidatorOracleStatsRequest) (*types.QueryValidatorOracleStatsResponse, error) {
+	// Validate request information
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	participationRate, successRate, averageDeviation, stats := qs.Keeper.CalculateValidatorOracleStats(sdkCtx, valAddr)
+
+	return &types.QueryValidatorOracleStatsResponse{
+		ParticipationRate: participationRate,
+		SuccessRate:       successRate,
+		AverageDeviation:  averageDeviation,
+		SlashCount:        stats.SlashCount(),
+		Stats:             stats,
+	}, nil
+}
+
+// PenalizedValidators queries the validators currently under oracle penalty
+func (qs queryServer) PenalizedValidators(ctx context.Context, req *types.QueryPenalizedValidatorsRequest) (*types.QueryPenalizedValidatorsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryPenalizedValidatorsResponse{Penalties: qs.Keeper.GetPenalizedValidators(sdkCtx)}, nil
+}
+
+// AggregatePrevote queries the pending prevote of a validator
+func (qs queryServer) AggregatePrevote(ctx context.Context, req *types.QueryAggregatePrevoteRequest) (*types.QueryAggregatePrevoteResponse, error) {
+	// Validate request information
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Get the prevote by the validator address
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	aggregatePrevote, err := qs.Keeper.GetAggregateExchangeRatePrevote(sdkCtx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAggregatePrevoteResponse{AggregatePrevote: aggregatePrevote}, nil
+}
+
+// AggregatePrevotes queries the pending prevotes of all validators
+func (qs queryServer) AggregatePrevotes(ctx context.Context, req *types.QueryAggregatePrevotesRequest) (*types.QueryAggregatePrevotesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	prevotes := []types.AggregateExchangeRatePrevote{}
+	qs.Keeper.IterateAggregateExchangeRatePrevotes(sdkCtx, func(voterAddr sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) bool {
+		prevotes = append(prevotes, aggregatePrevote)
+		return false
+	})
+
+	return &types.QueryAggregatePrevotesResponse{AggregatePrevotes: prevotes}, nil
+}
+
 // SlashWindow queries the
 func (qs queryServer) SlashWindow(ctx context.Context, req *types.QuerySlashWindowRequest) (*types.QuerySlashWindowResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -182,3 +307,55 @@ func (qs queryServer) SlashWindow(ctx context.Context, req *types.QuerySlashWind
 
 	return &types.QuerySlashWindowResponse{WindowProgress: windowProgress}, nil
 }
+
+// RewardPool returns the coins waiting on the oracle module account to be paid to the voters
+func (qs queryServer) RewardPool(ctx context.Context, req *types.QueryRewardPoolRequest) (*types.QueryRewardPoolResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryRewardPoolResponse{Pool: qs.Keeper.GetRewardPool(sdkCtx)}, nil
+}
+
+// PullPrices returns the latest prices of the pull oracle feed
+func (qs queryServer) PullPrices(ctx context.Context, req *types.QueryPullPricesRequest) (*types.QueryPullPricesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pullPrices := []types.PullPrice{}
+	qs.Keeper.IteratePullPrices(sdkCtx, func(pullPrice types.PullPrice) bool {
+		pullPrices = append(pullPrices, pullPrice)
+		return false
+	})
+
+	return &types.QueryPullPricesResponse{PullPrices: pullPrices}, nil
+}
+
+// PullPrice returns the latest price of the pull oracle feed by denom
+func (qs queryServer) PullPrice(ctx context.Context, req *types.QueryPullPriceRequest) (*types.QueryPullPriceResponse, error) {
+	// Validate request
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pullPrice, err := qs.Keeper.GetPullPrice(sdkCtx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPullPriceResponse{PullPrice: pullPrice}, nil
+}
+
+// PricePublishers returns the price publishers registered by governance
+func (qs queryServer) PricePublishers(ctx context.Context, req *types.QueryPricePublishersRequest) (*types.QueryPricePublishersResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	publishers := []types.PricePublisher{}
+	qs.Keeper.IteratePricePublishers(sdkCtx, func(publisher types.PricePublisher) bool {
+		publishers = append(publishers, publisher)
+		return false
+	})
+
+	return &types.QueryPricePublishersResponse{PricePublishers: publishers}, nil
+}
@@ -2,8 +2,12 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/kiichain/kiichain/x/oracle/types"
 	"github.com/kiichain/kiichain/x/oracle/utils"
 	"github.com/stretchr/testify/require"
@@ -48,6 +52,47 @@ func TestQueryExchangeRate(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, rate, res.OracleExchangeRate.ExchangeRate)
 }
+func TestQueryExchangeRateStatus(t *testing.T) {
+	// prepare env
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper
+	ctx := input.Ctx.WithBlockHeight(100)
+
+	// create query server
+	querier := NewQueryServer(oracleKeeper)
+
+	// the atom price is stale after 10 blocks, eth is halted
+	oracleKeeper.SetWhitelist(ctx, types.DenomList{{Name: utils.MicroAtomDenom, MaxStaleness: 10}, {Name: utils.MicroEthDenom}})
+	oracleKeeper.SetBaseExchangeRate(ctx, utils.MicroAtomDenom, sdk.NewDec(12))
+	oracleKeeper.SetBaseExchangeRate(ctx, utils.MicroEthDenom, sdk.NewDec(3000))
+	oracleKeeper.SetHaltedDenom(ctx, utils.MicroEthDenom)
+
+	// fresh price
+	res, err := querier.ExchangeRate(sdk.WrapSDKContext(ctx.WithBlockHeight(110)), &types.QueryExchangeRateRequest{Denom: utils.MicroAtomDenom})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(12), res.OracleExchangeRate.ExchangeRate)
+	require.Equal(t, types.ExchangeRateStatusActive, res.Status)
+
+	// stale price
+	staleCtx := sdk.WrapSDKContext(ctx.WithBlockHeight(111))
+	res, err = querier.ExchangeRate(staleCtx, &types.QueryExchangeRateRequest{Denom: utils.MicroAtomDenom})
+	require.NoError(t, err)
+	require.Equal(t, types.ExchangeRateStatusStale, res.Status)
+
+	// the status is listed with the rates
+	resRates, err := querier.ExchangeRates(staleCtx, &types.QueryExchangeRatesRequest{})
+	require.NoError(t, err)
+	require.Len(t, resRates.DenomOracleExchangeRate, 2)
+	for _, rate := range resRates.DenomOracleExchangeRate {
+		switch rate.Denom {
+		case utils.MicroAtomDenom:
+			require.Equal(t, types.ExchangeRateStatusStale, rate.Status)
+		case utils.MicroEthDenom:
+			require.Equal(t, types.ExchangeRateStatusHalted, rate.Status)
+		}
+	}
+}
+
 func TestQueryExchangeRates(t *testing.T) {
 	// prepare env
 	input := CreateTestInput(t)
@@ -213,6 +258,130 @@ func TestQueryTwaps(t *testing.T) {
 	require.Equal(t, sdk.NewDec(2), res.OracleTwap[0].Twap)
 }
 
+func TestQueryPriceAt(t *testing.T) {
+	// prepare env
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper
+	ctx := input.Ctx
+
+	// create query server
+	querier := NewQueryServer(oracleKeeper)
+	context := sdk.WrapSDKContext(ctx)
+
+	// empty request
+	_, err := querier.PriceAt(context, nil)
+	require.Error(t, err)
+
+	// insert data on the module
+	setPriceHistory(ctx, oracleKeeper, map[int64]map[string]int64{
+		100: {utils.MicroEthDenom: 1},
+		200: {utils.MicroEthDenom: 2},
+	})
+
+	// query price
+	res, err := querier.PriceAt(context, &types.QueryPriceAtRequest{Denom: utils.MicroEthDenom, Timestamp: 150})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), res.Price.SnapshotTimestamp)
+	require.Equal(t, sdk.NewDec(1), res.Price.OracleExchangeRate.ExchangeRate)
+}
+
+func TestQueryPriceSnapshotRange(t *testing.T) {
+	// prepare env
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper
+	ctx := input.Ctx
+
+	// create query server
+	querier := NewQueryServer(oracleKeeper)
+	context := sdk.WrapSDKContext(ctx)
+
+	// empty request
+	_, err := querier.PriceSnapshotRange(context, nil)
+	require.Error(t, err)
+
+	// insert data on the module
+	setPriceHistory(ctx, oracleKeeper, map[int64]map[string]int64{
+		100: {utils.MicroEthDenom: 1},
+		200: {utils.MicroEthDenom: 2},
+		300: {utils.MicroEthDenom: 3},
+	})
+
+	// query prices
+	res, err := querier.PriceSnapshotRange(context, &types.QueryPriceSnapshotRangeRequest{
+		Denom:         utils.MicroEthDenom,
+		FromTimestamp: 150,
+		ToTimestamp:   300,
+		Pagination:    &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Prices, 1)
+	require.Equal(t, int64(200), res.Prices[0].SnapshotTimestamp)
+	require.NotNil(t, res.Pagination.NextKey)
+}
+
+func TestQueryEmas(t *testing.T) {
+	// prepare env
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper
+	ctx := input.Ctx.WithBlockTime(time.Unix(3600, 0))
+
+	// create query server
+	querier := NewQueryServer(oracleKeeper)
+	context := sdk.WrapSDKContext(ctx)
+
+	// invalid half-life
+	_, err := querier.Emas(context, &types.QueryEmasRequest{LookbackSeconds: 3600})
+	require.ErrorIs(t, err, types.ErrInvalidEmaHalfLife)
+
+	// insert data on the module
+	setPriceHistory(ctx, oracleKeeper, map[int64]map[string]int64{
+		1000: {utils.MicroEthDenom: 10},
+		1100: {utils.MicroEthDenom: 20},
+	})
+
+	// query emas
+	res, err := querier.Emas(context, &types.QueryEmasRequest{LookbackSeconds: 3600, HalfLifeSeconds: 100})
+
+	// validation
+	require.NoError(t, err)
+	require.Len(t, res.OracleEma, 1)
+	require.Equal(t, utils.MicroEthDenom, res.OracleEma[0].Denom)
+	requireApproxEqual(t, sdk.NewDec(15), res.OracleEma[0].Ema)
+}
+
+func TestQueryPriceStats(t *testing.T) {
+	// prepare env
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper
+	ctx := input.Ctx.WithBlockTime(time.Unix(3600, 0))
+
+	// create query server
+	querier := NewQueryServer(oracleKeeper)
+	context := sdk.WrapSDKContext(ctx)
+
+	// no data
+	_, err := querier.PriceStats(context, &types.QueryPriceStatsRequest{LookbackSeconds: 3600})
+	require.ErrorIs(t, err, types.ErrNoPriceStatsData)
+
+	// insert data on the module
+	setPriceHistory(ctx, oracleKeeper, map[int64]map[string]int64{
+		1000: {utils.MicroEthDenom: 10},
+		1100: {utils.MicroEthDenom: 20},
+	})
+
+	// query price stats
+	res, err := querier.PriceStats(context, &types.QueryPriceStatsRequest{LookbackSeconds: 3600})
+
+	// validation
+	require.NoError(t, err)
+	require.Len(t, res.OraclePriceStats, 1)
+	require.Equal(t, utils.MicroEthDenom, res.OraclePriceStats[0].Denom)
+	require.Equal(t, sdk.NewDec(15), res.OraclePriceStats[0].Median)
+	require.Equal(t, sdk.NewDec(10), res.OraclePriceStats[0].Min)
+	require.Equal(t, sdk.NewDec(20), res.OraclePriceStats[0].Max)
+	requireApproxEqual(t, sdk.NewDec(5), res.OraclePriceStats[0].Volatility)
+}
+
 func TestQueryFeederDelegation(t *testing.T) {
 	// prepare env
 	input := CreateTestInput(t)
@@ -234,6 +403,44 @@ func TestQueryFeederDelegation(t *testing.T) {
 	require.Equal(t, Addrs[0].String(), res.FeedAddr)
 }
 
+func TestQueryAggregatePrevote(t *testing.T) {
+	// prepare env
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper
+	ctx := input.Ctx
+
+	// create query server
+	querier := NewQueryServer(oracleKeeper)
+
+	// set the prevotes
+	hash1 := types.GetAggregateVoteHash("1f2e3d4c", "1.0uatom", ValAddrs[0])
+	prevote1 := types.NewAggregateExchangeRatePrevote(hash1, ValAddrs[0], 1)
+	oracleKeeper.SetAggregateExchangeRatePrevote(ctx, ValAddrs[0], prevote1)
+
+	hash2 := types.GetAggregateVoteHash("1f2e3d4c", "2.0uatom", ValAddrs[1])
+	prevote2 := types.NewAggregateExchangeRatePrevote(hash2, ValAddrs[1], 1)
+	oracleKeeper.SetAggregateExchangeRatePrevote(ctx, ValAddrs[1], prevote2)
+
+	// query the prevote by validator
+	context := sdk.WrapSDKContext(ctx)
+	res, err := querier.AggregatePrevote(context, &types.QueryAggregatePrevoteRequest{ValidatorAddr: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, prevote1, res.AggregatePrevote)
+
+	// query with invalid inputs
+	_, err = querier.AggregatePrevote(context, nil)
+	require.Error(t, err)
+	_, err = querier.AggregatePrevote(context, &types.QueryAggregatePrevoteRequest{ValidatorAddr: "kiivaloper"})
+	require.Error(t, err)
+	_, err = querier.AggregatePrevote(context, &types.QueryAggregatePrevoteRequest{ValidatorAddr: ValAddrs[2].String()})
+	require.Error(t, err)
+
+	// query all the prevotes
+	resAll, err := querier.AggregatePrevotes(context, &types.QueryAggregatePrevotesRequest{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []types.AggregateExchangeRatePrevote{prevote1, prevote2}, resAll.AggregatePrevotes)
+}
+
 func TestQueryVotePenaltyCounter(t *testing.T) {
 	// prepare env
 	input := CreateTestInput(t)
@@ -260,6 +467,82 @@ func TestQueryVotePenaltyCounter(t *testing.T) {
 	require.Equal(t, successCounter, res.VotePenaltyCounter.SuccessCount)
 }
 
+func TestQueryValidatorOracleStats(t *testing.T) {
+	// prepare env
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper
+	ctx := input.Ctx
+
+	// create query server
+	querier := NewQueryServer(oracleKeeper)
+	context := sdk.WrapSDKContext(ctx)
+
+	// closed window with 2 success, 1 miss and 1 abstain, and current window with 1 success
+	oracleKeeper.AddValidatorVoteDeviation(ctx, ValAddrs[0], sdk.NewDecWithPrec(3, 2), 3)
+	stats := oracleKeeper.GetValidatorOracleStats(ctx, ValAddrs[0])
+	stats.CloseWindow(types.VotePenaltyCounter{SuccessCount: 2, MissCount: 1, AbstainCount: 1}, true, ctx.BlockHeight())
+	oracleKeeper.SetValidatorOracleStats(ctx, ValAddrs[0], stats)
+	oracleKeeper.AddValidatorVoteDeviation(ctx, ValAddrs[0], sdk.NewDecWithPrec(1, 2), 1)
+	oracleKeeper.SetVotePenaltyCounter(ctx, ValAddrs[0], 0, 0, 1)
+
+	res, err := querier.ValidatorOracleStats(context, &types.QueryValidatorOracleStatsRequest{ValidatorAddr: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(8, 1), res.ParticipationRate) // 4 / 5
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), res.SuccessRate)       // 3 / 5
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), res.AverageDeviation)  // 0.04 / 4
+	require.Equal(t, uint64(1), res.SlashCount)
+	require.Len(t, res.Stats.Windows, 1)
+
+	// validator without stats
+	res, err = querier.ValidatorOracleStats(context, &types.QueryValidatorOracleStatsRequest{ValidatorAddr: ValAddrs[1].String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.ZeroDec(), res.ParticipationRate)
+	require.Zero(t, res.SlashCount)
+
+	// invalid requests
+	_, err = querier.ValidatorOracleStats(context, nil)
+	require.Error(t, err)
+	_, err = querier.ValidatorOracleStats(context, &types.QueryValidatorOracleStatsRequest{ValidatorAddr: "invalid"})
+	require.Error(t, err)
+}
+
+func TestQueryPenalizedValidators(t *testing.T) {
+	// prepare env
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper
+	ctx := input.Ctx
+
+	// create query server
+	querier := NewQueryServer(oracleKeeper)
+	context := sdk.WrapSDKContext(ctx)
+
+	// create the validators
+	sh := staking.NewHandler(input.StakingKeeper)
+	amount := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	for i := 0; i < 2; i++ {
+		_, err := sh(ctx, NewTestMsgCreateValidator(ValAddrs[i], ValPubKeys[i], amount))
+		require.NoError(t, err)
+	}
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	// the first validator is still jailed, the jail time of the second one expired
+	penalty := types.ValidatorOraclePenalty{
+		ValidatorAddr: ValAddrs[0].String(),
+		SlashFraction: sdk.NewDecWithPrec(1, 2),
+		JailedUntil:   ctx.BlockTime().Unix() + 600,
+	}
+	oracleKeeper.SetValidatorOraclePenalty(ctx, ValAddrs[0], penalty)
+	oracleKeeper.SetValidatorOraclePenalty(ctx, ValAddrs[1], types.ValidatorOraclePenalty{
+		ValidatorAddr: ValAddrs[1].String(),
+		SlashFraction: sdk.NewDecWithPrec(1, 2),
+		JailedUntil:   ctx.BlockTime().Unix() - 1,
+	})
+
+	res, err := querier.PenalizedValidators(context, &types.QueryPenalizedValidatorsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ValidatorOraclePenalty{penalty}, res.Penalties)
+}
+
 func TestQuerySlashWindow(t *testing.T) {
 	// prepare env
 	input := CreateTestInput(t)
@@ -281,3 +564,86 @@ func TestQuerySlashWindow(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedWindowProgress, res.WindowProgress)
 }
+
+func TestQueryRewardPool(t *testing.T) {
+	// prepare env
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper
+	ctx := input.Ctx
+
+	// create query server
+	querier := NewQueryServer(oracleKeeper)
+
+	// fund the reward pool
+	pool := sdk.NewCoins(sdk.NewInt64Coin(utils.MicroKiiDenom, 1000))
+	err := input.BankKeeper.SendCoinsFromModuleToModule(ctx, faucetAccountName, types.ModuleName, pool)
+	require.NoError(t, err)
+
+	// query reward pool
+	context := sdk.WrapSDKContext(ctx)
+	res, err := querier.RewardPool(context, &types.QueryRewardPoolRequest{})
+
+	// validation
+	require.NoError(t, err)
+	require.Equal(t, pool, res.Pool)
+}
+
+func TestQueryPullPrices(t *testing.T) {
+	// prepare env
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper
+	ctx := input.Ctx
+
+	// create query server
+	querier := NewQueryServer(oracleKeeper)
+	context := sdk.WrapSDKContext(ctx)
+
+	// no prices on the feed
+	res, err := querier.PullPrices(context, &types.QueryPullPricesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.PullPrices)
+
+	_, err = querier.PullPrice(context, &types.QueryPullPriceRequest{Denom: utils.MicroBtcDenom})
+	require.ErrorIs(t, err, types.ErrNoPullPrice)
+
+	_, err = querier.PullPrice(context, &types.QueryPullPriceRequest{})
+	require.Error(t, err)
+
+	// add prices to the feed
+	btcPrice := types.PullPrice{Denom: utils.MicroBtcDenom, Price: sdk.NewDec(100000), PublishTime: 100, Publishers: []string{"kii"}, UpdateHeight: 1}
+	ethPrice := types.PullPrice{Denom: utils.MicroEthDenom, Price: sdk.NewDec(3000), PublishTime: 100, Publishers: []string{"kii"}, UpdateHeight: 1}
+	oracleKeeper.SetPullPrice(ctx, btcPrice)
+	oracleKeeper.SetPullPrice(ctx, ethPrice)
+
+	res, err = querier.PullPrices(context, &types.QueryPullPricesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.PullPrice{btcPrice, ethPrice}, res.PullPrices)
+
+	priceRes, err := querier.PullPrice(context, &types.QueryPullPriceRequest{Denom: utils.MicroEthDenom})
+	require.NoError(t, err)
+	require.Equal(t, ethPrice, priceRes.PullPrice)
+}
+
+func TestQueryPricePublishers(t *testing.T) {
+	// prepare env
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper
+	ctx := input.Ctx
+
+	// create query server
+	querier := NewQueryServer(oracleKeeper)
+	context := sdk.WrapSDKContext(ctx)
+
+	res, err := querier.PricePublishers(context, &types.QueryPricePublishersRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.PricePublishers)
+
+	// register a publisher
+	publisher, err := types.NewPricePublisher("kii", ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	oracleKeeper.SetPricePublisher(ctx, publisher)
+
+	res, err = querier.PricePublishers(context, &types.QueryPricePublishersRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.PricePublisher{publisher}, res.PricePublishers)
+}
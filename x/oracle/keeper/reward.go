@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"sort"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/kiichain/kiichain/x/oracle/types"
+)
+
+// GetRewardPool returns the coins held by the oracle module account, those coins are
+// paid to the validators who voted inside the reward band
+func (k Keeper) GetRewardPool(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+}
+
+// FundRewardPool moves the RewardPoolShare of the fee collector balance into the oracle reward pool.
+// It must run before the distribution module drains the fee collector, so the collected amount includes
+// the transaction fees of the previous block and the coins minted on the current one
+func (k Keeper) FundRewardPool(ctx sdk.Context) {
+	rewardPoolShare := k.RewardPoolShare(ctx) // get from params
+	if !rewardPoolShare.IsPositive() {
+		return
+	}
+
+	// Calculate the share of the collected fees
+	feeCollector := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	collectedFees := k.bankKeeper.GetAllBalances(ctx, feeCollector)
+	poolFunds, _ := sdk.NewDecCoinsFromCoins(collectedFees...).MulDecTruncate(rewardPoolShare).TruncateDecimal()
+	if poolFunds.IsZero() {
+		return
+	}
+
+	// Move the funds into the oracle module account
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, poolFunds)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundRewardPool,
+			sdk.NewAttribute(types.AttributeKeyAmount, poolFunds.String()),
+		),
+	)
+}
+
+// RewardBallotWinners pays the vote period share of the reward pool to the validators whose votes
+// were inside the reward band. The reward of each validator is weighted by its claim weight, which
+// is the sum of the voting power of all its winning votes
+func (k Keeper) RewardBallotWinners(ctx sdk.Context, votePeriod uint64, rewardDistributionWindow uint64, validatorClaimMap map[string]types.Claim) {
+	// Sort the validators to pay the rewards in a deterministic order
+	validators := make([]string, 0, len(validatorClaimMap))
+	totalWeight := int64(0)
+	for validator, claim := range validatorClaimMap {
+		validators = append(validators, validator)
+		totalWeight += claim.Weight
+	}
+	sort.Strings(validators)
+
+	// Nobody voted inside the reward band
+	if totalWeight == 0 {
+		return
+	}
+
+	rewardPool := k.GetRewardPool(ctx)
+	if rewardPool.IsZero() {
+		return
+	}
+
+	// periodRewards = rewardPool * votePeriod / rewardDistributionWindow
+	distributionRatio := sdk.NewDec(int64(votePeriod)).QuoInt64(int64(rewardDistributionWindow))
+	periodRewards := sdk.NewDecCoinsFromCoins(rewardPool...).MulDecTruncate(distributionRatio)
+	if periodRewards.IsZero() {
+		return
+	}
+
+	// Dispense the rewards to each winner
+	distributedReward := sdk.NewCoins()
+	for _, validator := range validators {
+		claim := validatorClaimMap[validator]
+		if claim.Weight == 0 {
+			continue
+		}
+
+		receiverVal := k.StakingKeeper.Validator(ctx, claim.Recipient)
+		if receiverVal == nil {
+			continue // the validator does not exist anymore
+		}
+
+		// reward = periodRewards * weight / totalWeight
+		rewardCoins, _ := periodRewards.MulDecTruncate(sdk.NewDec(claim.Weight).QuoInt64(totalWeight)).TruncateDecimal()
+		if rewardCoins.IsZero() {
+			continue
+		}
+
+		k.distrKeeper.AllocateTokensToValidator(ctx, receiverVal, sdk.NewDecCoinsFromCoins(rewardCoins...))
+		distributedReward = distributedReward.Add(rewardCoins...)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRewardDistribution,
+				sdk.NewAttribute(types.AttributeKeyOperator, claim.Recipient.String()),
+				sdk.NewAttribute(types.AttributeKeyWeight, strconv.FormatInt(claim.Weight, 10)),
+				sdk.NewAttribute(types.AttributeKeyAmount, rewardCoins.String()),
+			),
+		)
+	}
+
+	if distributedReward.IsZero() {
+		return
+	}
+
+	// Move the distributed rewards to the distribution module, where the validators withdraw them
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.distrName, distributedReward)
+	if err != nil {
+		panic(err)
+	}
+}
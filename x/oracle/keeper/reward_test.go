@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/kiichain/kiichain/x/oracle/types"
+	"github.com/kiichain/kiichain/x/oracle/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFundRewardPool(t *testing.T) {
+	// prepare env
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper
+	bankKeeper := input.BankKeeper
+	ctx := input.Ctx
+
+	// simulate the collected fees
+	collectedFees := sdk.NewCoins(sdk.NewInt64Coin(utils.MicroKiiDenom, 1000))
+	err := bankKeeper.SendCoinsFromModuleToModule(ctx, faucetAccountName, authtypes.FeeCollectorName, collectedFees)
+	require.NoError(t, err)
+	feeCollector := input.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	// the default share does not fund the pool
+	oracleKeeper.FundRewardPool(ctx)
+	require.True(t, oracleKeeper.GetRewardPool(ctx).IsZero())
+	require.Equal(t, collectedFees, bankKeeper.GetAllBalances(ctx, feeCollector))
+
+	// take 10% of the collected fees
+	params := oracleKeeper.GetParams(ctx)
+	params.RewardPoolShare = sdk.NewDecWithPrec(1, 1)
+	oracleKeeper.SetParams(ctx, params)
+
+	oracleKeeper.FundRewardPool(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(utils.MicroKiiDenom, 100)), oracleKeeper.GetRewardPool(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(utils.MicroKiiDenom, 900)), bankKeeper.GetAllBalances(ctx, feeCollector))
+}
+
+func TestRewardBallotWinners(t *testing.T) {
+	// prepare env
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper
+	bankKeeper := input.BankKeeper
+	stakingKeeper := input.StakingKeeper
+	distKeeper := input.DistKeeper
+	ctx := input.Ctx
+
+	// create validators
+	amount := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(stakingKeeper)
+	_, err := sh(ctx, NewTestMsgCreateValidator(ValAddrs[0], ValPubKeys[0], amount))
+	require.NoError(t, err)
+	_, err = sh(ctx, NewTestMsgCreateValidator(ValAddrs[1], ValPubKeys[1], amount))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, stakingKeeper)
+
+	// fund the reward pool
+	pool := sdk.NewCoins(sdk.NewInt64Coin(utils.MicroKiiDenom, 1000))
+	err = bankKeeper.SendCoinsFromModuleToModule(ctx, faucetAccountName, types.ModuleName, pool)
+	require.NoError(t, err)
+	distrBalance := bankKeeper.GetAllBalances(ctx, input.AccountKeeper.GetModuleAddress(distrtypes.ModuleName))
+
+	// val 0 and 1 voted inside the reward band, val 2 didn't
+	claims := map[string]types.Claim{
+		ValAddrs[0].String(): types.NewClaim(100, 100, 1, true, ValAddrs[0]),
+		ValAddrs[1].String(): types.NewClaim(100, 300, 3, true, ValAddrs[1]),
+		ValAddrs[2].String(): types.NewClaim(100, 0, 0, false, ValAddrs[2]),
+	}
+
+	// pay 10% of the pool (vote period 10, distribution window 100)
+	oracleKeeper.RewardBallotWinners(ctx, 10, 100, claims)
+
+	// validate the validator rewards
+	rewards0 := distKeeper.GetValidatorOutstandingRewards(ctx, ValAddrs[0]).Rewards
+	rewards1 := distKeeper.GetValidatorOutstandingRewards(ctx, ValAddrs[1]).Rewards
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin(utils.MicroKiiDenom, 25)), rewards0)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin(utils.MicroKiiDenom, 75)), rewards1)
+
+	// validate the pool and the distribution module balance
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(utils.MicroKiiDenom, 900)), oracleKeeper.GetRewardPool(ctx))
+	newDistrBalance := bankKeeper.GetAllBalances(ctx, input.AccountKeeper.GetModuleAddress(distrtypes.ModuleName))
+	require.Equal(t, distrBalance.Add(sdk.NewInt64Coin(utils.MicroKiiDenom, 100)), newDistrBalance)
+
+	// nobody won, nothing is paid
+	emptyClaims := map[string]types.Claim{
+		ValAddrs[0].String(): types.NewClaim(100, 0, 0, true, ValAddrs[0]),
+	}
+	oracleKeeper.RewardBallotWinners(ctx, 10, 100, emptyClaims)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(utils.MicroKiiDenom, 900)), oracleKeeper.GetRewardPool(ctx))
+}
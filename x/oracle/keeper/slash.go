@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"math"
 	"strconv"
+	"time"
 
 	cosmostelemetry "github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,11 +17,17 @@ func (k Keeper) SlashAndResetCounters(ctx sdk.Context) {
 	distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
 	minValidPerWindow := k.MinValidPerWindow(ctx) // get from params
-	slashFraction := k.SlashFraction(ctx)         // get from params
 	powerReduction := k.StakingKeeper.PowerReduction(ctx)
 
 	// Iterate each voting result per validator
 	k.IterateVotePenaltyCounters(ctx, func(operator sdk.ValAddress, votePenaltyCounter types.VotePenaltyCounter) bool {
+		// the counters of the validators removed from the staking module are dropped with their history
+		validator := k.StakingKeeper.Validator(ctx, operator)
+		if validator == nil {
+			k.DeleteVotePenaltyCounter(ctx, operator)
+			return false
+		}
+
 		successCount := votePenaltyCounter.SuccessCount
 		abstainCount := votePenaltyCounter.AbstainCount
 		missCount := votePenaltyCounter.MissCount
@@ -34,19 +42,55 @@ func (k Keeper) SlashAndResetCounters(ctx sdk.Context) {
 		// rate = successVotes / total votes
 		validVoteRate := sdk.NewDec(int64(successCount)).QuoInt64(int64(totalVotes))
 
+		// count the consecutive windows below the min threshold
+		stats := k.GetValidatorOracleStats(ctx, operator)
+		isBadWindow := validVoteRate.LT(minValidPerWindow)
+		if isBadWindow {
+			stats.ConsecutiveBadWindows++
+		} else {
+			stats.ConsecutiveBadWindows = 0
+			k.DeleteValidatorOraclePenalty(ctx, operator) // the validator is voting again
+		}
+
 		// penalize the validator whose the valid rate is smaller than the min threshold
-		if validVoteRate.LT(minValidPerWindow) {
-			validator := k.StakingKeeper.Validator(ctx, operator) // get validator
-			if validator.IsBonded() && !validator.IsJailed() {    // only bonded validators can be slashed
+		slashed := false
+		if isBadWindow {
+			if validator.IsBonded() && !validator.IsJailed() { // only bonded validators can be slashed
 				consAddr, err := validator.GetConsAddr()
 				if err != nil {
 					panic(err)
 				}
 
+				// escalate the penalties after consecutive bad windows
+				escalationLevel, slashFraction, jailDuration := k.calculatePenalty(ctx, stats.ConsecutiveBadWindows)
+
 				consensusPower := validator.GetConsensusPower(powerReduction)
 				k.StakingKeeper.Slash(ctx, consAddr, distributionHeight, consensusPower, slashFraction) // slash validator
 				k.StakingKeeper.Jail(ctx, consAddr)                                                     // Jail validator
+				jailedUntil := k.jailUntil(ctx, consAddr, jailDuration)                                 // keep validator jailed
 				cosmostelemetry.IncrValidatorSlashedCounter(consAddr.String(), "oracle")
+				slashed = true
+
+				penalty := types.ValidatorOraclePenalty{
+					ValidatorAddr:         operator.String(),
+					ConsecutiveBadWindows: stats.ConsecutiveBadWindows,
+					EscalationLevel:       escalationLevel,
+					SlashFraction:         slashFraction,
+					JailedUntil:           jailedUntil,
+					PenaltyHeight:         height,
+				}
+				k.SetValidatorOraclePenalty(ctx, operator, penalty)
+
+				// Emit an event with the penalty applied to the validator
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(types.EventTypeOraclePenalty,
+						sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+						sdk.NewAttribute(types.AttributeKeySlashFraction, slashFraction.String()),
+						sdk.NewAttribute(types.AttributeKeyJailedUntil, strconv.FormatInt(jailedUntil, 10)),
+						sdk.NewAttribute(types.AttributeKeyConsecutiveBadWindows, strconv.FormatUint(stats.ConsecutiveBadWindows, 10)),
+						sdk.NewAttribute(types.AttributeKeyEscalationLevel, strconv.FormatUint(escalationLevel, 10)),
+					),
+				)
 			}
 		}
 
@@ -60,8 +104,111 @@ func (k Keeper) SlashAndResetCounters(ctx sdk.Context) {
 			),
 		)
 
-		// Reset voting counter
+		// Keep the window results on the validator history, then reset voting counter
+		stats.CloseWindow(votePenaltyCounter, slashed, height)
+		k.SetValidatorOracleStats(ctx, operator, stats)
 		k.DeleteVotePenaltyCounter(ctx, operator)
 		return false
 	})
+
+	k.pruneRemovedValidators(ctx)
+}
+
+// pruneRemovedValidators deletes the oracle stats and penalties of the validators removed from the staking module
+func (k Keeper) pruneRemovedValidators(ctx sdk.Context) {
+	removed := []sdk.ValAddress{}
+	k.IterateValidatorOracleStats(ctx, func(operator sdk.ValAddress, _ types.ValidatorOracleStats) bool {
+		if k.StakingKeeper.Validator(ctx, operator) == nil {
+			removed = append(removed, operator)
+		}
+		return false
+	})
+	k.IterateValidatorOraclePenalties(ctx, func(operator sdk.ValAddress, _ types.ValidatorOraclePenalty) bool {
+		if k.StakingKeeper.Validator(ctx, operator) == nil {
+			removed = append(removed, operator)
+		}
+		return false
+	})
+
+	// the stores are not modified while they are iterated
+	for _, operator := range removed {
+		k.DeleteValidatorOracleStats(ctx, operator)
+		k.DeleteValidatorOraclePenalty(ctx, operator)
+	}
+}
+
+// calculatePenalty returns the escalation level, slash fraction and jail duration for a validator with the input
+// consecutive bad windows. Each PenaltyEscalationWindows consecutive bad windows the slash fraction and jail duration
+// are multiplied by PenaltyEscalationFactor up to MaxPenaltyEscalationLevel times, the slash fraction is capped at 1
+// and the jail duration at the max time.Duration
+func (k Keeper) calculatePenalty(ctx sdk.Context, consecutiveBadWindows uint64) (uint64, sdk.Dec, time.Duration) {
+	slashFraction := k.SlashFraction(ctx) // get from params
+	jailSeconds := sdk.NewDecFromInt(sdk.NewIntFromUint64(k.JailDuration(ctx)))
+	maxJailSeconds := sdk.NewDec(int64(math.MaxInt64 / time.Second))
+
+	escalationLevel := uint64(0)
+	if escalationWindows := k.PenaltyEscalationWindows(ctx); escalationWindows > 0 {
+		escalationLevel = consecutiveBadWindows / escalationWindows
+	}
+	if maxLevel := k.MaxPenaltyEscalationLevel(ctx); escalationLevel > maxLevel {
+		escalationLevel = maxLevel
+	}
+
+	// multiply one level at a time, the values over the caps are clamped before the multiplication overflows
+	factor := k.PenaltyEscalationFactor(ctx)
+	for level := uint64(0); level < escalationLevel && factor.GT(sdk.OneDec()); level++ {
+		if slashFraction.GT(sdk.OneDec().Quo(factor)) {
+			slashFraction = sdk.OneDec()
+		} else {
+			slashFraction = slashFraction.Mul(factor)
+		}
+
+		if jailSeconds.GT(maxJailSeconds.Quo(factor)) {
+			jailSeconds = maxJailSeconds
+		} else {
+			jailSeconds = jailSeconds.Mul(factor)
+		}
+
+		if slashFraction.Equal(sdk.OneDec()) && jailSeconds.Equal(maxJailSeconds) {
+			break // both penalties are capped
+		}
+	}
+
+	jailSeconds = sdk.MinDec(jailSeconds, maxJailSeconds)
+	return escalationLevel, slashFraction, time.Duration(jailSeconds.TruncateInt64()) * time.Second
+}
+
+// jailUntil sets the time until the validator can not unjail and returns it as unix time. The jail time
+// is only extended, a longer jail applied by other module is kept
+func (k Keeper) jailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailDuration time.Duration) int64 {
+	signingInfo, found := k.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return ctx.BlockTime().Unix() // validator without signing info can not be kept jailed
+	}
+
+	jailedUntil := ctx.BlockTime().Add(jailDuration)
+	if signingInfo.JailedUntil.After(jailedUntil) {
+		return signingInfo.JailedUntil.Unix()
+	}
+
+	k.slashingKeeper.JailUntil(ctx, consAddr, jailedUntil)
+	return jailedUntil.Unix()
+}
+
+// GetPenalizedValidators returns the oracle penalties of the validators that are still jailed
+func (k Keeper) GetPenalizedValidators(ctx sdk.Context) []types.ValidatorOraclePenalty {
+	penalties := []types.ValidatorOraclePenalty{}
+	k.IterateValidatorOraclePenalties(ctx, func(operator sdk.ValAddress, penalty types.ValidatorOraclePenalty) bool {
+		validator := k.StakingKeeper.Validator(ctx, operator)
+		if validator == nil {
+			return false // the validator does not exist anymore
+		}
+
+		if validator.IsJailed() || penalty.JailedUntil > ctx.BlockTime().Unix() {
+			penalties = append(penalties, penalty)
+		}
+		return false
+	})
+
+	return penalties
 }
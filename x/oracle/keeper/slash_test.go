@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"math"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/kiichain/kiichain/x/oracle/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -69,6 +72,14 @@ func TestSlashAndResetMissCounters(t *testing.T) {
 		validator, _ := stakingKeeper.GetValidator(input.Ctx, ValAddrs[0])
 		require.Equal(t, amount.Sub(slashFraction.MulInt(amount).TruncateInt()), validator.GetBondedTokens())
 		require.True(t, validator.IsJailed())
+
+		// the closed window is kept on the validator oracle stats
+		stats := oracleKeeper.GetValidatorOracleStats(input.Ctx, ValAddrs[0])
+		require.Len(t, stats.Windows, 3)
+		require.False(t, stats.Windows[1].Slashed)
+		require.True(t, stats.Windows[2].Slashed)
+		require.Equal(t, uint64(minValidVotes-1), stats.Windows[2].SuccessCount)
+		require.Equal(t, uint64(1), stats.SlashCount())
 	})
 
 	t.Run("slash and jail for abstaining too much along with misses", func(t *testing.T) {
@@ -112,4 +123,178 @@ func TestSlashAndResetMissCounters(t *testing.T) {
 		validator, _ = stakingKeeper.GetValidator(input.Ctx, ValAddrs[0])
 		require.Equal(t, amount, validator.Tokens)
 	})
+	t.Run("prune removed validator", func(t *testing.T) {
+		// ValAddrs[2] is not a validator on the staking module
+		removed := ValAddrs[2]
+		stats := types.NewValidatorOracleStats()
+		stats.ConsecutiveBadWindows = 2
+		oracleKeeper.SetValidatorOracleStats(input.Ctx, removed, stats)
+		oracleKeeper.SetValidatorOraclePenalty(input.Ctx, removed, types.ValidatorOraclePenalty{ValidatorAddr: removed.String()})
+		oracleKeeper.SetVotePenaltyCounter(input.Ctx, removed, uint64(votePeriodsPerWindow), 0, 0)
+
+		oracleKeeper.SlashAndResetCounters(input.Ctx)
+
+		// the stats, penalty and counter of the removed validator are deleted
+		oracleKeeper.IterateValidatorOracleStats(input.Ctx, func(operator sdk.ValAddress, _ types.ValidatorOracleStats) bool {
+			require.NotEqual(t, removed, operator)
+			return false
+		})
+		_, found := oracleKeeper.GetValidatorOraclePenalty(input.Ctx, removed)
+		require.False(t, found)
+		oracleKeeper.IterateVotePenaltyCounters(input.Ctx, func(operator sdk.ValAddress, _ types.VotePenaltyCounter) bool {
+			require.NotEqual(t, removed, operator)
+			return false
+		})
+
+		// the history of the existing validators is kept
+		require.NotEmpty(t, oracleKeeper.GetValidatorOracleStats(input.Ctx, ValAddrs[0]).Windows)
+	})
+}
+
+func TestOraclePenaltyEscalation(t *testing.T) {
+	// initial setup
+	input := CreateTestInput(t)
+	stakingKeeper := input.StakingKeeper
+	slashingKeeper := input.SlashingKeeper
+	oracleKeeper := input.OracleKeeper
+	ctx := input.Ctx
+
+	addr, val := ValAddrs[0], ValPubKeys[0]
+	amount := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(stakingKeeper)
+
+	// Validator created
+	_, err := sh(ctx, NewTestMsgCreateValidator(addr, val, amount))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, stakingKeeper)
+
+	// jail 10 minutes and double the penalties each 2 consecutive bad windows
+	params := oracleKeeper.GetParams(ctx)
+	params.SlashFraction = sdk.NewDecWithPrec(1, 2) // 0.01
+	params.JailDuration = 600
+	params.PenaltyEscalationWindows = 2
+	params.PenaltyEscalationFactor = sdk.NewDec(2)
+	oracleKeeper.SetParams(ctx, params)
+
+	consAddr := sdk.ConsAddress(val.Address())
+	unjail := func() {
+		validator, _ := stakingKeeper.GetValidator(ctx, addr)
+		validator.Jailed = false
+		validator.Tokens = amount
+		stakingKeeper.SetValidator(ctx, validator)
+	}
+
+	// first bad window, base penalty
+	oracleKeeper.SetVotePenaltyCounter(ctx, addr, 0, 10, 0)
+	oracleKeeper.SlashAndResetCounters(ctx)
+
+	validator, _ := stakingKeeper.GetValidator(ctx, addr)
+	require.True(t, validator.IsJailed())
+	require.Equal(t, amount.Sub(sdk.NewDecWithPrec(1, 2).MulInt(amount).TruncateInt()), validator.GetBondedTokens())
+
+	signingInfo, found := slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(600*time.Second).Unix(), signingInfo.JailedUntil.Unix())
+
+	penalty, found := oracleKeeper.GetValidatorOraclePenalty(ctx, addr)
+	require.True(t, found)
+	require.Equal(t, uint64(1), penalty.ConsecutiveBadWindows)
+	require.Equal(t, uint64(0), penalty.EscalationLevel)
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), penalty.SlashFraction)
+	require.Equal(t, []types.ValidatorOraclePenalty{penalty}, oracleKeeper.GetPenalizedValidators(ctx))
+
+	// second consecutive bad window, the penalties are doubled
+	unjail()
+	oracleKeeper.SetVotePenaltyCounter(ctx, addr, 10, 0, 0)
+	oracleKeeper.SlashAndResetCounters(ctx)
+
+	validator, _ = stakingKeeper.GetValidator(ctx, addr)
+	require.True(t, validator.IsJailed())
+	require.Equal(t, amount.Sub(sdk.NewDecWithPrec(2, 2).MulInt(amount).TruncateInt()), validator.GetBondedTokens())
+
+	signingInfo, _ = slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(t, ctx.BlockTime().Add(1200*time.Second).Unix(), signingInfo.JailedUntil.Unix())
+
+	penalty, _ = oracleKeeper.GetValidatorOraclePenalty(ctx, addr)
+	require.Equal(t, uint64(2), penalty.ConsecutiveBadWindows)
+	require.Equal(t, uint64(1), penalty.EscalationLevel)
+	require.Equal(t, sdk.NewDecWithPrec(2, 2), penalty.SlashFraction)
+
+	// a good window clears the penalty
+	unjail()
+	oracleKeeper.SetVotePenaltyCounter(ctx, addr, 0, 0, 10)
+	oracleKeeper.SlashAndResetCounters(ctx)
+
+	_, found = oracleKeeper.GetValidatorOraclePenalty(ctx, addr)
+	require.False(t, found)
+	require.Empty(t, oracleKeeper.GetPenalizedValidators(ctx))
+	require.Zero(t, oracleKeeper.GetValidatorOracleStats(ctx, addr).ConsecutiveBadWindows)
+}
+
+func TestCalculatePenalty(t *testing.T) {
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper
+	ctx := input.Ctx
+
+	params := oracleKeeper.GetParams(ctx)
+	params.SlashFraction = sdk.NewDecWithPrec(3, 1) // 0.3
+	params.JailDuration = 100
+	params.PenaltyEscalationWindows = 3
+	params.PenaltyEscalationFactor = sdk.NewDec(2)
+	oracleKeeper.SetParams(ctx, params)
+
+	testCases := []struct {
+		name                  string
+		consecutiveBadWindows uint64
+		escalationLevel       uint64
+		slashFraction         sdk.Dec
+		jailDuration          time.Duration
+	}{
+		{"first bad window", 1, 0, sdk.NewDecWithPrec(3, 1), 100 * time.Second},
+		{"below the escalation windows", 2, 0, sdk.NewDecWithPrec(3, 1), 100 * time.Second},
+		{"first escalation", 3, 1, sdk.NewDecWithPrec(6, 1), 200 * time.Second},
+		{"slash fraction capped", 6, 2, sdk.OneDec(), 400 * time.Second},
+		{"escalation level capped", 60, 10, sdk.OneDec(), 102400 * time.Second},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			escalationLevel, slashFraction, jailDuration := oracleKeeper.calculatePenalty(ctx, tc.consecutiveBadWindows)
+			require.Equal(t, tc.escalationLevel, escalationLevel)
+			require.Equal(t, tc.slashFraction, slashFraction)
+			require.Equal(t, tc.jailDuration, jailDuration)
+		})
+	}
+}
+
+func TestCalculatePenaltyHighEscalationLevel(t *testing.T) {
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper
+	ctx := input.Ctx
+
+	// a day of jail doubled on each bad window overflows time.Duration after 17 levels
+	params := oracleKeeper.GetParams(ctx)
+	params.SlashFraction = sdk.NewDecWithPrec(1, 2) // 0.01
+	params.JailDuration = 86400
+	params.PenaltyEscalationWindows = 1
+	params.PenaltyEscalationFactor = sdk.NewDec(2)
+	params.MaxPenaltyEscalationLevel = math.MaxUint64
+	oracleKeeper.SetParams(ctx, params)
+
+	maxJailDuration := time.Duration(math.MaxInt64/time.Second) * time.Second
+	for _, consecutiveBadWindows := range []uint64{17, 18, 1000, math.MaxUint64} {
+		require.NotPanics(t, func() {
+			escalationLevel, slashFraction, jailDuration := oracleKeeper.calculatePenalty(ctx, consecutiveBadWindows)
+			require.Equal(t, consecutiveBadWindows, escalationLevel)
+			require.Equal(t, sdk.OneDec(), slashFraction)
+			require.Equal(t, maxJailDuration, jailDuration)
+		})
+	}
+
+	// a huge factor is also capped
+	params.PenaltyEscalationFactor = sdk.NewDec(1e18).Power(3)
+	oracleKeeper.SetParams(ctx, params)
+	_, slashFraction, jailDuration := oracleKeeper.calculatePenalty(ctx, 3)
+	require.Equal(t, sdk.OneDec(), slashFraction)
+	require.Equal(t, maxJailDuration, jailDuration)
 }
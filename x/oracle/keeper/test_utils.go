@@ -24,12 +24,14 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
 	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distTypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramsTypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingTypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -97,13 +99,14 @@ var ModuleBasics = module.NewBasicManager(
 
 // TestInput nolint
 type TestInput struct {
-	Ctx           sdk.Context
-	Cdc           *codec.LegacyAmino
-	AccountKeeper authkeeper.AccountKeeper
-	BankKeeper    bankkeeper.Keeper
-	OracleKeeper  Keeper
-	StakingKeeper stakingkeeper.Keeper
-	DistKeeper    distkeeper.Keeper
+	Ctx            sdk.Context
+	Cdc            *codec.LegacyAmino
+	AccountKeeper  authkeeper.AccountKeeper
+	BankKeeper     bankkeeper.Keeper
+	OracleKeeper   Keeper
+	StakingKeeper  stakingkeeper.Keeper
+	SlashingKeeper slashingkeeper.Keeper
+	DistKeeper     distkeeper.Keeper
 }
 
 // CreateTestInput prepate the testing env, initializes modules, creates ctx,
@@ -114,6 +117,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	keyBank := sdk.NewKVStoreKey(bankTypes.StoreKey)
 	keyDist := sdk.NewKVStoreKey(distTypes.StoreKey)
 	keyStaking := sdk.NewKVStoreKey(stakingTypes.StoreKey)
+	keySlashing := sdk.NewKVStoreKey(slashingTypes.StoreKey)
 	keyOracle := sdk.NewKVStoreKey(types.StoreKey)
 	keyParams := sdk.NewKVStoreKey(paramsTypes.StoreKey)
 
@@ -134,6 +138,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	ms.MountStoreWithDB(keyOracle, sdk.StoreTypeIAVL, db)                    // mount as Merkle trees type
 	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)                   // mount as Merkle trees type
 	ms.MountStoreWithDB(keyDist, sdk.StoreTypeIAVL, db)                      // mount as Merkle trees type
+	ms.MountStoreWithDB(keySlashing, sdk.StoreTypeIAVL, db)                  // mount as Merkle trees type
 	ms.MountStoreWithDB(memKeys[types.MemStoreKey], sdk.StoreTypeMemory, db) // mount as temporal memory type
 
 	require.NoError(t, ms.LoadLatestVersion()) // Test multistore doesn't returns error
@@ -179,7 +184,11 @@ func CreateTestInput(t *testing.T) TestInput {
 	distParams.BaseProposerReward = sdk.NewDecWithPrec(1, 2)  // 0.01
 	distParams.BonusProposerReward = sdk.NewDecWithPrec(4, 2) // 0.04
 	distKeeper.SetParams(ctx, distParams)                     // Assign new params on the module
-	stakingKeeper.SetHooks(stakingTypes.NewMultiStakingHooks(distKeeper.Hooks()))
+
+	// Set slashing module on my testing environment, the hooks create the validators signing info
+	slashingKeeper := slashingkeeper.NewKeeper(appCodec, keySlashing, stakingKeeper, paramsKeeper.Subspace(slashingTypes.ModuleName))
+	slashingKeeper.SetParams(ctx, slashingTypes.DefaultParams())
+	stakingKeeper.SetHooks(stakingTypes.NewMultiStakingHooks(distKeeper.Hooks(), slashingKeeper.Hooks()))
 
 	// Create empty module accounts and assign permissions
 	faucetAcc := authTypes.NewEmptyModuleAccount(faucetAccountName, authTypes.Minter, authTypes.Burner) // Account with the tokens
@@ -223,7 +232,7 @@ func CreateTestInput(t *testing.T) TestInput {
 
 	// Set Oracle module
 	oracleKeeper := NewKeeper(appCodec, keyOracle, memKeys[types.MemStoreKey], paramsKeeper.Subspace(types.ModuleName),
-		accountKeeper, bankKeeper, stakingKeeper, distTypes.ModuleName)
+		accountKeeper, bankKeeper, stakingKeeper, slashingKeeper, distKeeper, distTypes.ModuleName)
 
 	oracleParams := types.DefaultParams()
 	oracleKeeper.SetParams(ctx, oracleParams)
@@ -234,13 +243,14 @@ func CreateTestInput(t *testing.T) TestInput {
 	}
 
 	return TestInput{
-		Ctx:           ctx,
-		Cdc:           legacyAmino,
-		AccountKeeper: accountKeeper,
-		BankKeeper:    bankKeeper,
-		OracleKeeper:  oracleKeeper,
-		StakingKeeper: stakingKeeper,
-		DistKeeper:    distKeeper,
+		Ctx:            ctx,
+		Cdc:            legacyAmino,
+		AccountKeeper:  accountKeeper,
+		BankKeeper:     bankKeeper,
+		OracleKeeper:   oracleKeeper,
+		StakingKeeper:  stakingKeeper,
+		SlashingKeeper: slashingKeeper,
+		DistKeeper:     distKeeper,
 	}
 }
 
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kiichain/kiichain/x/oracle/types"
+)
+
+// AddValidatorVoteDeviation adds the deviation of the validator votes on a vote period to the slash window in progress
+func (k Keeper) AddValidatorVoteDeviation(ctx sdk.Context, operator sdk.ValAddress, deviationSum sdk.Dec, deviationCount uint64) {
+	stats := k.GetValidatorOracleStats(ctx, operator)
+	stats.CurrentDeviationSum = stats.CurrentDeviationSum.Add(deviationSum)
+	stats.CurrentDeviationCount += deviationCount
+	k.SetValidatorOracleStats(ctx, operator, stats)
+}
+
+// CalculateValidatorOracleStats returns the participation rate, success rate and average deviation of a validator
+// over the stored windows and the slash window in progress
+func (k Keeper) CalculateValidatorOracleStats(ctx sdk.Context, operator sdk.ValAddress) (participationRate, successRate, averageDeviation sdk.Dec, stats types.ValidatorOracleStats) {
+	stats = k.GetValidatorOracleStats(ctx, operator)
+	current := k.GetVotePenaltyCounter(ctx, operator)
+
+	// add the slash window in progress
+	successCount := current.SuccessCount
+	abstainCount := current.AbstainCount
+	missCount := current.MissCount
+	deviationSum := stats.CurrentDeviationSum
+	deviationCount := stats.CurrentDeviationCount
+
+	for _, window := range stats.Windows {
+		successCount += window.SuccessCount
+		abstainCount += window.AbstainCount
+		missCount += window.MissCount
+		deviationSum = deviationSum.Add(window.DeviationSum)
+		deviationCount += window.DeviationCount
+	}
+
+	participationRate = sdk.ZeroDec()
+	successRate = sdk.ZeroDec()
+	averageDeviation = sdk.ZeroDec()
+
+	// rate = voted periods / total periods, abstain periods are the only ones without vote
+	totalVotes := successCount + abstainCount + missCount
+	if totalVotes > 0 {
+		participationRate = sdk.NewDec(int64(successCount + missCount)).QuoInt64(int64(totalVotes))
+		successRate = sdk.NewDec(int64(successCount)).QuoInt64(int64(totalVotes))
+	}
+
+	if deviationCount > 0 {
+		averageDeviation = deviationSum.QuoInt64(int64(deviationCount))
+	}
+
+	return participationRate, successRate, averageDeviation, stats
+}
+
+// EmitValidatorOracleStatsTelemetry emits the participation rate, success rate and average deviation gauges
+// of each validator with oracle stats
+func (k Keeper) EmitValidatorOracleStatsTelemetry(ctx sdk.Context) {
+	k.IterateValidatorOracleStats(ctx, func(operator sdk.ValAddress, _ types.ValidatorOracleStats) bool {
+		participationRate, successRate, averageDeviation, _ := k.CalculateValidatorOracleStats(ctx, operator)
+		labels := []metrics.Label{telemetry.NewLabel("validator", operator.String())}
+
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, "validator", "participation_rate"},
+			float32(participationRate.MustFloat64()), labels)
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, "validator", "success_rate"},
+			float32(successRate.MustFloat64()), labels)
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, "validator", "average_deviation"},
+			float32(averageDeviation.MustFloat64()), labels)
+		return false
+	})
+}
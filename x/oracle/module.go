@@ -119,12 +119,15 @@ func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, accountKeeper types.Acc
 
 // ********************* IMPLEMENT AppModule INTERFACE ************************
 // ConsensusVersion returns the version the module's version
-func (AppModule) ConsensusVersion() uint64 { return 6 }
+func (AppModule) ConsensusVersion() uint64 { return 7 }
 
 // RegisterServices registers the module services
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(am.Kepper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.Kepper))
+
+	m := keeper.NewMigrator(am.Kepper)
+	_ = cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7)
 }
 
 // RegisterInvariants
@@ -168,7 +171,9 @@ func (am AppModule) Route() sdk.Route {
 func (am AppModule) QuerierRoute() string { return types.QuerierRoute }
 
 // BeginBlock returns the module's begin blocker
-func (am AppModule) BeginBlock(_ sdk.Context, _ int64) {}
+func (am AppModule) BeginBlock(ctx sdk.Context, _ int64) {
+	BeginBlocker(ctx, am.Kepper)
+}
 
 // MidBlock returns the module's mid blocker
 func (am AppModule) MidBlock(ctx sdk.Context, _ int64) {
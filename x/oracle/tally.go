@@ -28,14 +28,16 @@ func pickReferenceDenom(ctx sdk.Context, k keeper.Keeper, voteTargets map[string
 
 		// If a denom is not in the vote targets or the ballot for it has failed
 		// that denom is removed from votemap (for efficiency)
-		_, exists := voteTargets[denom]
+		denomInfo, exists := voteTargets[denom]
 		if !exists {
 			delete(voteMap, denom)
 			continue
 		}
 
-		// Get ballot power and check if is greater than the threshold
+		// Get ballot power and check if is greater than the threshold and
+		// if enough validators voted the denom
 		ballotPower, ok := ballotIsPassing(ballot, thresholdVotes)
+		ok = ok && ballotHasMinVoters(ballot, denomInfo.MinVoters)
 
 		// if the ballot power is lower than threshold, add denom in below
 		// threshold map to separe for tally evaluation
@@ -68,6 +70,18 @@ func ballotIsPassing(ballot types.ExchangeRateBallot, thresholdVotes sdk.Int) (s
 	return ballotPower, !ballotPower.IsZero() && ballotPower.GTE(thresholdVotes)
 }
 
+// ballotHasMinVoters returns true if the number of validators with valid votes
+// on the ballot reaches the min voters required by the denom
+func ballotHasMinVoters(ballot types.ExchangeRateBallot, minVoters uint64) bool {
+	voters := uint64(0)
+	for _, vote := range ballot {
+		if vote.Power > 0 {
+			voters++
+		}
+	}
+	return voters >= minVoters
+}
+
 // Tally calculates the median and returns it. Sets the set of voters to be rewarded, i.e. voted within
 // a reasonable spread from the weighted median to the store
 // CONTRACT: ex must be sorted
@@ -101,3 +115,28 @@ func Tally(_ sdk.Context, ex types.ExchangeRateBallot, rewardBand sdk.Dec, valid
 
 	return
 }
+
+// recordVoteDeviation adds the relative deviation of each positive vote from the final weighted median
+// to the voter claim, |vote - median| / median
+func recordVoteDeviation(ex types.ExchangeRateBallot, weightedMedian sdk.Dec, validatorClaimMap map[string]types.Claim) {
+	if !weightedMedian.IsPositive() {
+		return
+	}
+
+	for _, vote := range ex {
+		if !vote.ExchangeRate.IsPositive() {
+			continue // abstain votes do not have deviation
+		}
+
+		voter := vote.Voter.String()
+		claim, ok := validatorClaimMap[voter]
+		if !ok {
+			continue
+		}
+
+		deviation := vote.ExchangeRate.Sub(weightedMedian).Abs().Quo(weightedMedian)
+		claim.DeviationSum = claim.DeviationSum.Add(deviation)
+		claim.DeviationCount++
+		validatorClaimMap[voter] = claim
+	}
+}
@@ -114,6 +114,18 @@ func TestBallotIsPassing(t *testing.T) {
 	require.False(t, ispassing)
 }
 
+func TestBallotHasMinVoters(t *testing.T) {
+	ballot := types.ExchangeRateBallot{
+		{Denom: utils.MicroAtomDenom, ExchangeRate: sdk.NewDec(4000), Power: int64(20), Voter: keeper.ValAddrs[0]},
+		{Denom: utils.MicroAtomDenom, ExchangeRate: sdk.NewDec(4100), Power: int64(10), Voter: keeper.ValAddrs[1]},
+		{Denom: utils.MicroAtomDenom, ExchangeRate: sdk.ZeroDec(), Power: int64(0), Voter: keeper.ValAddrs[2]}, // abstain
+	}
+
+	require.True(t, ballotHasMinVoters(ballot, 0))
+	require.True(t, ballotHasMinVoters(ballot, 2))
+	require.False(t, ballotHasMinVoters(ballot, 3))
+}
+
 func TestTally(t *testing.T) {
 	input := keeper.CreateTestInput(t)
 	stakingKeeper := input.StakingKeeper
@@ -180,3 +192,31 @@ func TestTally(t *testing.T) {
 		require.NotZero(t, claim.Weight) // val 0, 1 and 2 voted
 	}
 }
+
+func TestRecordVoteDeviation(t *testing.T) {
+	validatorClaimMap := map[string]types.Claim{
+		keeper.ValAddrs[0].String(): types.NewClaim(10, 0, 0, false, keeper.ValAddrs[0]),
+		keeper.ValAddrs[1].String(): types.NewClaim(10, 0, 0, false, keeper.ValAddrs[1]),
+		keeper.ValAddrs[2].String(): types.NewClaim(10, 0, 0, false, keeper.ValAddrs[2]),
+	}
+
+	ballot := types.ExchangeRateBallot{
+		{Denom: utils.MicroAtomDenom, ExchangeRate: sdk.ZeroDec(), Power: int64(10), Voter: keeper.ValAddrs[0]}, // abstain
+		{Denom: utils.MicroAtomDenom, ExchangeRate: sdk.NewDec(90), Power: int64(10), Voter: keeper.ValAddrs[1]},
+		{Denom: utils.MicroAtomDenom, ExchangeRate: sdk.NewDec(100), Power: int64(10), Voter: keeper.ValAddrs[2]},
+	}
+
+	// the median is 100, so the deviations are 0.1 and 0
+	recordVoteDeviation(ballot, sdk.NewDec(100), validatorClaimMap)
+
+	require.Equal(t, uint64(0), validatorClaimMap[keeper.ValAddrs[0].String()].DeviationCount)
+	require.Equal(t, sdk.ZeroDec(), validatorClaimMap[keeper.ValAddrs[0].String()].DeviationSum)
+	require.Equal(t, uint64(1), validatorClaimMap[keeper.ValAddrs[1].String()].DeviationCount)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), validatorClaimMap[keeper.ValAddrs[1].String()].DeviationSum)
+	require.Equal(t, uint64(1), validatorClaimMap[keeper.ValAddrs[2].String()].DeviationCount)
+	require.Equal(t, sdk.ZeroDec(), validatorClaimMap[keeper.ValAddrs[2].String()].DeviationSum)
+
+	// a zero median does not record deviation
+	recordVoteDeviation(ballot, sdk.ZeroDec(), validatorClaimMap)
+	require.Equal(t, uint64(1), validatorClaimMap[keeper.ValAddrs[1].String()].DeviationCount)
+}
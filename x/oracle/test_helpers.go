@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/kiichain/kiichain/x/oracle/keeper"
+	"github.com/kiichain/kiichain/x/oracle/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -13,6 +14,7 @@ var (
 	stakingAmount       = sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	randomAExchangeRate = sdk.NewDec(1700)
 	randomBExchangeRate = sdk.NewDecWithPrec(4882, 2)
+	testSalt            = "1f2e3d4c"
 )
 
 func SetUp(t *testing.T) (keeper.TestInput, sdk.Handler) {
@@ -53,3 +55,18 @@ func SetUp(t *testing.T) (keeper.TestInput, sdk.Handler) {
 
 	return input, oracleHandler
 }
+
+// makeAggregatePrevoteAndVote submits the prevote on the vote period before the input height
+// and returns the vote message that reveals it
+func makeAggregatePrevoteAndVote(t *testing.T, input keeper.TestInput, handler sdk.Handler, height int64, exchangeRates string,
+	feeder sdk.AccAddress, validator sdk.ValAddress) *types.MsgAggregateExchangeRateVote {
+	votePeriod := int64(input.OracleKeeper.GetParams(input.Ctx).VotePeriod)
+
+	// Submit the prevote with the hash of the exchange rates
+	hash := types.GetAggregateVoteHash(testSalt, exchangeRates, validator)
+	prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, feeder, validator)
+	_, err := handler(input.Ctx.WithBlockHeight(height-votePeriod), prevoteMsg)
+	require.NoError(t, err)
+
+	return types.NewMsgAggregateExchangeRateVote(testSalt, exchangeRates, feeder, validator)
+}
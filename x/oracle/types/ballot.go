@@ -12,21 +12,24 @@ import (
 // Claim represents a claim action ticket from the validator, it will store the information
 // about voting and who will receive the reward (or slashing)
 type Claim struct {
-	Power     int64
-	Weight    int64
-	WinCount  int64
-	DidVote   bool
-	Recipient sdk.ValAddress
+	Power          int64
+	Weight         int64
+	WinCount       int64
+	DidVote        bool
+	Recipient      sdk.ValAddress
+	DeviationSum   sdk.Dec // sum of the relative deviations of the votes from the final weighted median
+	DeviationCount uint64  // amount of votes added on the deviation sum
 }
 
 // NewClaim creates a new instance of Claim with the input parameters
 func NewClaim(power, weight, winCount int64, didVote bool, recipient sdk.ValAddress) Claim {
 	return Claim{
-		Power:     power,
-		Weight:    power,
-		WinCount:  winCount,
-		DidVote:   didVote,
-		Recipient: recipient,
+		Power:        power,
+		Weight:       weight,
+		WinCount:     winCount,
+		DidVote:      didVote,
+		Recipient:    recipient,
+		DeviationSum: sdk.ZeroDec(),
 	}
 }
 
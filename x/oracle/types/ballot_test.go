@@ -16,11 +16,12 @@ func TestNewClaim(t *testing.T) {
 	recipient := sdk.ValAddress([]byte("validator1"))
 
 	reference := Claim{
-		Power:     power,
-		Weight:    weight,
-		WinCount:  winCount,
-		DidVote:   didVote,
-		Recipient: recipient,
+		Power:        power,
+		Weight:       weight,
+		WinCount:     winCount,
+		DidVote:      didVote,
+		Recipient:    recipient,
+		DeviationSum: sdk.ZeroDec(),
 	}
 
 	claim := NewClaim(power, weight, winCount, didVote, recipient)
@@ -5,19 +5,34 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterCodec registers the messages for transactions
 func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgAggregateExchangeRatePrevote{}, "oracle/MsgAggregateExchangeRatePrevote", nil)
 	cdc.RegisterConcrete(&MsgAggregateExchangeRateVote{}, "oracle/MsgAggregateExchangeRateVote", nil)
 	cdc.RegisterConcrete(&MsgDelegateFeedConsent{}, "oracle/MsgDelegateFeedConsent", nil)
+	cdc.RegisterConcrete(&MsgSubmitPriceAttestation{}, "oracle/MsgSubmitPriceAttestation", nil)
+	cdc.RegisterConcrete(&AddOracleDenomProposal{}, "oracle/AddOracleDenomProposal", nil)
+	cdc.RegisterConcrete(&RemoveOracleDenomProposal{}, "oracle/RemoveOracleDenomProposal", nil)
+	cdc.RegisterConcrete(&AddPricePublisherProposal{}, "oracle/AddPricePublisherProposal", nil)
+	cdc.RegisterConcrete(&RemovePricePublisherProposal{}, "oracle/RemovePricePublisherProposal", nil)
 }
 
 // RegisterInterfaces registers the request messages on the tx rpc
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAggregateExchangeRatePrevote{},
 		&MsgAggregateExchangeRateVote{},
 		&MsgDelegateFeedConsent{},
+		&MsgSubmitPriceAttestation{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&AddOracleDenomProposal{},
+		&RemoveOracleDenomProposal{},
+		&AddPricePublisherProposal{},
+		&RemovePricePublisherProposal{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -3,6 +3,7 @@ package types
 import (
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"gopkg.in/yaml.v2"
 )
 
@@ -14,7 +15,43 @@ func (d Denom) String() string {
 
 // Equal implements equal interface
 func (d Denom) Equal(d1 *Denom) bool {
-	return d.Name == d1.Name
+	if d.RewardBand == nil || d1.RewardBand == nil {
+		if d.RewardBand != d1.RewardBand {
+			return false
+		}
+	} else if !d.RewardBand.Equal(*d1.RewardBand) {
+		return false
+	}
+
+	return d.Name == d1.Name &&
+		d.MinVoters == d1.MinVoters &&
+		d.MaxStaleness == d1.MaxStaleness
+}
+
+// RewardBandOrDefault returns the reward band override of the denom, or the default band if it is not set
+func (d Denom) RewardBandOrDefault(defaultRewardBand sdk.Dec) sdk.Dec {
+	if d.RewardBand == nil || d.RewardBand.IsNil() {
+		return defaultRewardBand
+	}
+	return *d.RewardBand
+}
+
+// IsStale returns true if the exchange rate updated on lastUpdate is older than the denom MaxStaleness
+func (d Denom) IsStale(lastUpdate int64, blockHeight int64) bool {
+	return d.MaxStaleness > 0 && blockHeight-lastUpdate > int64(d.MaxStaleness)
+}
+
+// Validate performs basic validation on the denom and its overrides
+func (d Denom) Validate() error {
+	if len(d.Name) == 0 {
+		return ErrInvalidDenom.Wrap("denom must have name")
+	}
+
+	if d.RewardBand != nil && !d.RewardBand.IsNil() && (d.RewardBand.GT(sdk.OneDec()) || d.RewardBand.IsNegative()) {
+		return ErrInvalidDenom.Wrapf("%s reward band must be between [0, 1]", d.Name)
+	}
+
+	return nil
 }
 
 // DenomList represents an array of Denom elements
@@ -30,10 +67,16 @@ func (dl DenomList) String() (out string) {
 
 // Contains iterates the denomList and return true if the demon is placed on the list
 func (dl DenomList) Contains(denom string) bool {
+	_, found := dl.Get(denom)
+	return found
+}
+
+// Get iterates the denomList and return the denom and true if it is placed on the list
+func (dl DenomList) Get(denom string) (Denom, bool) {
 	for _, d := range dl {
 		if d.Name == denom {
-			return true
+			return d, true
 		}
 	}
-	return false
+	return Denom{}, false
 }
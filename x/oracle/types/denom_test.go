@@ -1,6 +1,11 @@
 package types
 
-import "testing"
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
 
 type testStruct struct {
 	name      string
@@ -51,3 +56,37 @@ func TestDenomList(t *testing.T) {
 	}
 
 }
+
+func TestDenomOverrides(t *testing.T) {
+	defaultBand := sdk.NewDecWithPrec(2, 2)
+	band := sdk.NewDecWithPrec(5, 2)
+
+	// reward band
+	require.Equal(t, defaultBand, Denom{Name: "ubtc"}.RewardBandOrDefault(defaultBand))
+	require.Equal(t, band, Denom{Name: "ubtc", RewardBand: &band}.RewardBandOrDefault(defaultBand))
+
+	// staleness
+	require.False(t, Denom{Name: "ubtc"}.IsStale(1, 1000))
+	require.False(t, Denom{Name: "ubtc", MaxStaleness: 10}.IsStale(1, 11))
+	require.True(t, Denom{Name: "ubtc", MaxStaleness: 10}.IsStale(1, 12))
+
+	// validation
+	invalidBand := sdk.NewDecWithPrec(11, 1)
+	require.NoError(t, Denom{Name: "ubtc", RewardBand: &band, MinVoters: 3, MaxStaleness: 10}.Validate())
+	require.Error(t, Denom{Name: ""}.Validate())
+	require.Error(t, Denom{Name: "ubtc", RewardBand: &invalidBand}.Validate())
+
+	// equal
+	otherBand := sdk.NewDecWithPrec(5, 2)
+	require.True(t, Denom{Name: "ubtc", RewardBand: &band}.Equal(&Denom{Name: "ubtc", RewardBand: &otherBand}))
+	require.False(t, Denom{Name: "ubtc", RewardBand: &band}.Equal(&Denom{Name: "ubtc"}))
+	require.False(t, Denom{Name: "ubtc", MinVoters: 1}.Equal(&Denom{Name: "ubtc"}))
+
+	// get
+	denomList := DenomList{{Name: "ubtc", MinVoters: 3}, {Name: "ueth"}}
+	denom, found := denomList.Get("ubtc")
+	require.True(t, found)
+	require.Equal(t, uint64(3), denom.MinVoters)
+	_, found = denomList.Get("usol")
+	require.False(t, found)
+}
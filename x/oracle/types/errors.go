@@ -15,6 +15,9 @@ var (
 	ErrInvalidHash              = sdkerrors.Register(ModuleName, 6, "invalid hash")
 	ErrInvalidHashLength        = sdkerrors.Register(ModuleName, 7, fmt.Sprintf("invalid hash length; should equal %d", ed25519.TruncatedSize))
 	ErrVerificationFailed       = sdkerrors.Register(ModuleName, 8, "hash verification failed")
+	ErrRevealPeriodMissMatch    = sdkerrors.Register(ModuleName, 9, "reveal period of submitted vote do not match with registered prevote")
+	ErrInvalidSaltLength        = sdkerrors.Register(ModuleName, 10, "invalid salt length; should be 1~64")
+	ErrNoAggregatePrevote       = sdkerrors.Register(ModuleName, 11, "no aggregate prevote")
 	ErrNoAggregateVote          = sdkerrors.Register(ModuleName, 12, "no aggregate vote")
 	ErrNoVoteTarget             = sdkerrors.Register(ModuleName, 13, "no vote target")
 	ErrUnknownDenom             = sdkerrors.Register(ModuleName, 14, "unknown denom")
@@ -29,4 +32,20 @@ var (
 	ErrUnknownKiiOracleQuery    = sdkerrors.Register(ModuleName, 23, "Error unknown kii oracle query")
 	ErrAggregateVoteExist       = sdkerrors.Register(ModuleName, 24, "aggregate vote still present in current voting window")
 	ErrAggregateVoteInvalidRate = sdkerrors.Register(ModuleName, 25, "aggregate vote has invalid exchange rate")
+	ErrInvalidSaltFormat        = sdkerrors.Register(ModuleName, 26, "invalid salt format")
+	ErrAggregatePrevoteExist    = sdkerrors.Register(ModuleName, 27, "aggregate prevote still present in current voting window")
+	ErrInvalidDenom             = sdkerrors.Register(ModuleName, 28, "invalid denom")
+	ErrDenomAlreadyWhitelisted  = sdkerrors.Register(ModuleName, 29, "denom already whitelisted")
+	ErrInvalidEmaHalfLife       = sdkerrors.Register(ModuleName, 30, "Ema half-life seconds must be greater than 0")
+	ErrNoPriceStatsData         = sdkerrors.Register(ModuleName, 31, "No snapshot data for the price statistics calculation")
+	ErrNoPriceAtTimestamp       = sdkerrors.Register(ModuleName, 32, "no price snapshot at or before the timestamp")
+	ErrInvalidTimestampRange    = sdkerrors.Register(ModuleName, 33, "invalid timestamp range")
+	ErrInvalidPricePublisher    = sdkerrors.Register(ModuleName, 34, "invalid price publisher")
+	ErrPricePublisherExists     = sdkerrors.Register(ModuleName, 35, "price publisher already registered")
+	ErrUnknownPricePublisher    = sdkerrors.Register(ModuleName, 36, "unknown price publisher")
+	ErrInvalidAttestation       = sdkerrors.Register(ModuleName, 37, "invalid price attestation")
+	ErrInvalidAttestationSig    = sdkerrors.Register(ModuleName, 38, "invalid price attestation signature")
+	ErrStaleAttestation         = sdkerrors.Register(ModuleName, 39, "price attestation is not fresh")
+	ErrPullOracleDisabled       = sdkerrors.Register(ModuleName, 40, "pull oracle is disabled")
+	ErrNoPullPrice              = sdkerrors.Register(ModuleName, 41, "no pull oracle price")
 )
@@ -6,7 +6,15 @@ const (
 	EventTypeVote               = "vote"
 	EventTypeFeedDelegate       = "feed_delegate"
 	EventTypeAggregateVote      = "aggregate_vote"
+	EventTypeAggregatePrevote   = "aggregate_prevote"
 	EventTypeEndSlashWindow     = "end_slash_window"
+	EventTypeFundRewardPool     = "fund_reward_pool"
+	EventTypeRewardDistribution = "reward_distribution"
+	EventTypeExchangeRateHalted = "exchange_rate_halted"
+	EventTypeOraclePenalty      = "oracle_penalty"
+	EventTypePullPriceUpdate    = "pull_price_update"
+
+	EventTypeExchangeRateResumed = "exchange_rate_resumed"
 )
 
 // Oracle module Attribute key
@@ -21,6 +29,17 @@ const (
 	AttributeKeyAbstainCount  = "abstain_count"
 	AttributeKeyWinCount      = "win_count"
 	AttributeKeySuccessCount  = "success_count"
+	AttributeKeyHash          = "hash"
+	AttributeKeyAmount        = "amount"
+	AttributeKeyWeight        = "weight"
+
+	AttributeKeyPreviousExchangeRate  = "previous_exchange_rate"
+	AttributeKeySlashFraction         = "slash_fraction"
+	AttributeKeyJailedUntil           = "jailed_until"
+	AttributeKeyConsecutiveBadWindows = "consecutive_bad_windows"
+	AttributeKeyEscalationLevel       = "escalation_level"
+	AttributeKeyPublisher             = "publisher"
+	AttributeKeyPublishTime           = "publish_time"
 
 	AttributeValueCategory = ModuleName
 )
@@ -1,9 +1,12 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -19,6 +22,13 @@ type StakingKeeper interface {
 	PowerReduction(ctx sdk.Context) (res sdk.Int)                              //Returns the power reduction factor,
 }
 
+// SlashingKeeper defines the expected slashing keeper used to keep the validators
+// penalized by the oracle jailed for a minimum duration
+type SlashingKeeper interface {
+	GetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, bool) // Retrieves the validator signing info
+	JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time)                                     // Set the time until the validator can unjail
+}
+
 // AccountKeeper is expected keeper for auth module, because I need to handle
 type AccountKeeper interface {
 	GetModuleAddress(name string) sdk.AccAddress                                  //Ensures the oracle module has an account
@@ -35,3 +45,9 @@ type BankKeeper interface {
 	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
 	SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
 }
+
+// DistributionKeeper defines the expected distribution keeper used to allocate
+// the oracle rewards to the validators and their delegators
+type DistributionKeeper interface {
+	AllocateTokensToValidator(ctx sdk.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) // Assign the reward to the validator and its delegators
+}
@@ -8,7 +8,9 @@ import (
 
 // NewGenesisState creates a new GenesisState object with the imput parameters
 func NewGenesisState(params Params, exchangeRateTuple []ExchangeRateTuple, feederDelegation []FeederDelegation,
-	penaltyCounters []PenaltyCounter, aggregateExchangeRateVote []AggregateExchangeRateVote, priceSnapshot PriceSnapshots, votePenaltyCounters []VotePenaltyCounter) *GenesisState {
+	penaltyCounters []PenaltyCounter, aggregateExchangeRateVote []AggregateExchangeRateVote, priceSnapshot PriceSnapshots, votePenaltyCounters []VotePenaltyCounter,
+	aggregateExchangeRatePrevotes []AggregateExchangeRatePrevote, haltedDenoms []string, validatorOracleStats []ValidatorOracleStatsRecord,
+	validatorOraclePenalties []ValidatorOraclePenalty, pricePublishers []PricePublisher, pullPrices []PullPrice, publisherPrices []PullPrice) *GenesisState {
 	return &GenesisState{
 		Params:                     params,
 		ExchangeRates:              exchangeRateTuple,
@@ -17,6 +19,14 @@ func NewGenesisState(params Params, exchangeRateTuple []ExchangeRateTuple, feede
 		AggregateExchangeRateVotes: aggregateExchangeRateVote,
 		PriceSnapshots:             priceSnapshot,
 		VotePenaltyCounters:        votePenaltyCounters,
+
+		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevotes,
+		HaltedDenoms:                  haltedDenoms,
+		ValidatorOracleStats:          validatorOracleStats,
+		ValidatorOraclePenalties:      validatorOraclePenalties,
+		PricePublishers:               pricePublishers,
+		PullPrices:                    pullPrices,
+		PublisherPrices:               publisherPrices,
 	}
 }
 
@@ -30,11 +40,33 @@ func DefaultGenesisState() *GenesisState {
 		AggregateExchangeRateVotes: []AggregateExchangeRateVote{},
 		PriceSnapshots:             PriceSnapshots{},
 		VotePenaltyCounters:        []VotePenaltyCounter{},
+
+		AggregateExchangeRatePrevotes: []AggregateExchangeRatePrevote{},
+		HaltedDenoms:                  []string{},
+		ValidatorOracleStats:          []ValidatorOracleStatsRecord{},
+		ValidatorOraclePenalties:      []ValidatorOraclePenalty{},
+		PricePublishers:               []PricePublisher{},
+		PullPrices:                    []PullPrice{},
+		PublisherPrices:               []PullPrice{},
 	}
 }
 
 // ValidateGenesis executes the Validate function for an input genesis data
 func ValidateGenesis(data *GenesisState) error {
+	// Validate the price publisher keys
+	for _, publisher := range data.PricePublishers {
+		if err := publisher.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Each publisher price is signed by a single publisher
+	for _, price := range data.PublisherPrices {
+		if len(price.Publishers) != 1 {
+			return ErrInvalidPricePublisher.Wrapf("price of %s must have one publisher", price.Denom)
+		}
+	}
+
 	return data.Params.Validate()
 }
 
@@ -38,6 +38,20 @@ type GenesisState struct {
 	PriceSnapshots PriceSnapshots `protobuf:"bytes,6,rep,name=price_snapshots,json=priceSnapshots,proto3,castrepeated=PriceSnapshots" json:"price_snapshots"`
 	// penalty_counters represents the array with the penalty counter by validator
 	PenaltyCounters []PenaltyCounter `protobuf:"bytes,7,rep,name=penalty_counters,json=penaltyCounters,proto3" json:"penalty_counters"`
+	// aggregate_exchange_rate_prevotes represents the array with the pending prevotes by validator
+	AggregateExchangeRatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,8,rep,name=aggregate_exchange_rate_prevotes,json=aggregateExchangeRatePrevotes,proto3" json:"aggregate_exchange_rate_prevotes"`
+	// halted_denoms represents the denoms halted by the price deviation circuit breaker
+	HaltedDenoms []string `protobuf:"bytes,9,rep,name=halted_denoms,json=haltedDenoms,proto3" json:"halted_denoms,omitempty"`
+	// validator_oracle_stats represents the rolling voting performance by validator
+	ValidatorOracleStats []ValidatorOracleStatsRecord `protobuf:"bytes,10,rep,name=validator_oracle_stats,json=validatorOracleStats,proto3" json:"validator_oracle_stats"`
+	// validator_oracle_penalties represents the last oracle penalty by validator
+	ValidatorOraclePenalties []ValidatorOraclePenalty `protobuf:"bytes,11,rep,name=validator_oracle_penalties,json=validatorOraclePenalties,proto3" json:"validator_oracle_penalties"`
+	// price_publishers represents the publishers allowed to sign the pull oracle prices
+	PricePublishers []PricePublisher `protobuf:"bytes,12,rep,name=price_publishers,json=pricePublishers,proto3" json:"price_publishers"`
+	// pull_prices represents the latest prices on the pull oracle feed
+	PullPrices []PullPrice `protobuf:"bytes,13,rep,name=pull_prices,json=pullPrices,proto3" json:"pull_prices"`
+	// publisher_prices represents the latest prices signed by each price publisher
+	PublisherPrices []PullPrice `protobuf:"bytes,14,rep,name=publisher_prices,json=publisherPrices,proto3" json:"publisher_prices"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -122,6 +136,55 @@ func (m *GenesisState) GetPenaltyCounters() []PenaltyCounter {
 	return nil
 }
 
+func (m *GenesisState) GetAggregateExchangeRatePrevotes() []AggregateExchangeRatePrevote {
+	if m != nil {
+		return m.AggregateExchangeRatePrevotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHaltedDenoms() []string {
+	if m != nil {
+		return m.HaltedDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorOracleStats() []ValidatorOracleStatsRecord {
+	if m != nil {
+		return m.ValidatorOracleStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorOraclePenalties() []ValidatorOraclePenalty {
+	if m != nil {
+		return m.ValidatorOraclePenalties
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPricePublishers() []PricePublisher {
+	if m != nil {
+		return m.PricePublishers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPullPrices() []PullPrice {
+	if m != nil {
+		return m.PullPrices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPublisherPrices() []PullPrice {
+	if m != nil {
+		return m.PublisherPrices
+	}
+	return nil
+}
+
 // FeederDelegation is the structure on the genesis regarding the delegation process
 type FeederDelegation struct {
 	// feeder_address is the address delegated
@@ -230,48 +293,114 @@ func (m *PenaltyCounter) GetVotePenaltyCounter() *VotePenaltyCounter {
 	return nil
 }
 
+// ValidatorOracleStatsRecord is the structure on the keeper that link the voting performance with a validator address
+type ValidatorOracleStatsRecord struct {
+	ValidatorAddress string               `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Stats            ValidatorOracleStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats"`
+}
+
+func (m *ValidatorOracleStatsRecord) Reset()         { *m = ValidatorOracleStatsRecord{} }
+func (m *ValidatorOracleStatsRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorOracleStatsRecord) ProtoMessage()    {}
+func (*ValidatorOracleStatsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce0b3a2b4a184fc3, []int{3}
+}
+func (m *ValidatorOracleStatsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorOracleStatsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorOracleStatsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorOracleStatsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorOracleStatsRecord.Merge(m, src)
+}
+func (m *ValidatorOracleStatsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorOracleStatsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorOracleStatsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorOracleStatsRecord proto.InternalMessageInfo
+
+func (m *ValidatorOracleStatsRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorOracleStatsRecord) GetStats() ValidatorOracleStats {
+	if m != nil {
+		return m.Stats
+	}
+	return ValidatorOracleStats{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kiichain.kiichain3.oracle.GenesisState")
 	proto.RegisterType((*FeederDelegation)(nil), "kiichain.kiichain3.oracle.FeederDelegation")
 	proto.RegisterType((*PenaltyCounter)(nil), "kiichain.kiichain3.oracle.PenaltyCounter")
+	proto.RegisterType((*ValidatorOracleStatsRecord)(nil), "kiichain.kiichain3.oracle.ValidatorOracleStatsRecord")
 }
 
 func init() { proto.RegisterFile("oracle/genesis.proto", fileDescriptor_ce0b3a2b4a184fc3) }
 
 var fileDescriptor_ce0b3a2b4a184fc3 = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0xfd, 0x29, 0xc2, 0x63, 0x59, 0xe7, 0x15, 0x14, 0x2a, 0x91, 0x8d, 0x4a, 0x48,
-	0x45, 0x1b, 0x89, 0xb4, 0x72, 0x47, 0x2b, 0x30, 0xae, 0x53, 0x86, 0x38, 0xec, 0x12, 0xbc, 0xe4,
-	0x6d, 0x1a, 0x91, 0xc5, 0x96, 0xed, 0x56, 0xdb, 0x81, 0xef, 0xc0, 0x27, 0xe0, 0x03, 0xf0, 0x49,
-	0x76, 0x1c, 0x37, 0x4e, 0x80, 0xda, 0x2f, 0x82, 0x62, 0xbb, 0x65, 0x59, 0xdb, 0x48, 0xbb, 0x39,
-	0xaf, 0x9f, 0xe7, 0xfd, 0x39, 0xaf, 0x1f, 0x19, 0x35, 0x29, 0x27, 0x51, 0x06, 0x7e, 0x02, 0x39,
-	0x88, 0x54, 0x78, 0x8c, 0x53, 0x49, 0xf1, 0xd3, 0x2f, 0x69, 0x1a, 0x0d, 0x48, 0x9a, 0x7b, 0xd3,
-	0x45, 0xd7, 0xd3, 0xc2, 0x56, 0x33, 0xa1, 0x09, 0x55, 0x2a, 0xbf, 0x58, 0x69, 0x43, 0x6b, 0xc7,
-	0xb4, 0x61, 0x84, 0x93, 0x0b, 0xd3, 0xa5, 0xfd, 0x73, 0x1d, 0x3d, 0xfa, 0xa0, 0xfb, 0x9e, 0x4a,
-	0x22, 0x01, 0xbf, 0x41, 0x75, 0x2d, 0x70, 0xac, 0x3d, 0xab, 0xb3, 0x71, 0xf8, 0xdc, 0x5b, 0xca,
-	0xf1, 0x4e, 0x94, 0xb0, 0xb7, 0x76, 0xfd, 0x7b, 0xb7, 0x16, 0x18, 0x1b, 0xa6, 0xc8, 0x86, 0xcb,
-	0x68, 0x40, 0xf2, 0x04, 0x42, 0x4e, 0x24, 0x08, 0x67, 0x65, 0x6f, 0xb5, 0xb3, 0x71, 0x78, 0x50,
-	0xd1, 0xe8, 0xbd, 0x31, 0x04, 0x44, 0xc2, 0xc7, 0x21, 0xcb, 0xa0, 0xd7, 0x2a, 0x7a, 0xfe, 0xf8,
-	0xb3, 0x8b, 0xe7, 0xb6, 0x44, 0xb0, 0x09, 0xb7, 0x6a, 0x02, 0x7f, 0x46, 0xb8, 0x0f, 0x10, 0x03,
-	0x0f, 0x63, 0xc8, 0x20, 0x21, 0x32, 0xa5, 0xb9, 0x70, 0x56, 0x15, 0x74, 0xbf, 0x02, 0x7a, 0xac,
-	0x4c, 0xef, 0x66, 0x1e, 0xf3, 0x1f, 0xdb, 0xfd, 0x3b, 0x75, 0x81, 0x13, 0xf4, 0x78, 0x44, 0x25,
-	0x84, 0x0c, 0x72, 0x92, 0xc9, 0xab, 0x30, 0xa2, 0xc3, 0x5c, 0x02, 0x17, 0xce, 0x9a, 0x82, 0xbc,
-	0xaa, 0x80, 0x7c, 0xa2, 0x12, 0x4e, 0xb4, 0xed, 0xad, 0x76, 0x19, 0xcc, 0xce, 0x68, 0x6e, 0x47,
-	0xe0, 0xaf, 0xe8, 0x19, 0x49, 0x12, 0x5e, 0x80, 0x21, 0x2c, 0x4d, 0x31, 0x2c, 0xe4, 0xc2, 0x59,
-	0x57, 0xc0, 0xd7, 0x15, 0xc0, 0xa3, 0xa9, 0xff, 0xf6, 0xe0, 0x8a, 0x53, 0x18, 0x6e, 0x8b, 0x2c,
-	0x13, 0x08, 0x9c, 0xa2, 0x2d, 0xc6, 0xd3, 0x08, 0x42, 0x91, 0x13, 0x26, 0x06, 0x54, 0x0a, 0xa7,
-	0xae, 0x80, 0x9d, 0xaa, 0x10, 0x14, 0x8e, 0x53, 0x63, 0xe8, 0x3d, 0x31, 0xf7, 0x66, 0x97, 0xca,
-	0x22, 0xb0, 0x59, 0xe9, 0x1b, 0x9f, 0xa1, 0xc6, 0xdc, 0x34, 0x1f, 0x28, 0xd6, 0xcb, 0x2a, 0xd6,
-	0xa2, 0x49, 0x6e, 0xb1, 0xf2, 0x14, 0xdb, 0x7d, 0xd4, 0xb8, 0x7b, 0xb7, 0xf8, 0x05, 0xb2, 0x4d,
-	0x48, 0x48, 0x1c, 0x73, 0x10, 0x3a, 0xde, 0x0f, 0x83, 0x4d, 0x5d, 0x3d, 0xd2, 0x45, 0xbc, 0x8f,
-	0xb6, 0x47, 0x24, 0x4b, 0x63, 0x22, 0xe9, 0x7f, 0xe5, 0x8a, 0x52, 0x36, 0x66, 0x1b, 0x46, 0xdc,
-	0xfe, 0x6e, 0x21, 0xbb, 0x7c, 0xa2, 0xc5, 0x7e, 0x6b, 0xb1, 0x1f, 0x87, 0xa8, 0xb9, 0x28, 0x56,
-	0x8a, 0x77, 0xdf, 0x54, 0x05, 0x78, 0x3e, 0x4f, 0xbd, 0xe3, 0xeb, 0xb1, 0x6b, 0xdd, 0x8c, 0x5d,
-	0xeb, 0xef, 0xd8, 0xb5, 0xbe, 0x4d, 0xdc, 0xda, 0xcd, 0xc4, 0xad, 0xfd, 0x9a, 0xb8, 0xb5, 0xb3,
-	0x83, 0x24, 0x95, 0x83, 0xe1, 0xb9, 0x17, 0xd1, 0x0b, 0x7f, 0xda, 0x7d, 0xb6, 0xe8, 0xfa, 0x97,
-	0xbe, 0x79, 0x2b, 0xe4, 0x15, 0x03, 0x71, 0x5e, 0x57, 0x6f, 0x45, 0xf7, 0x5f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x0e, 0x1c, 0xd8, 0x5d, 0x89, 0x04, 0x00, 0x00,
+	// 704 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x53, 0xd3, 0x40,
+	0x14, 0x6f, 0xf8, 0xa7, 0x6c, 0x69, 0x29, 0x4b, 0x65, 0x62, 0x67, 0x28, 0xb5, 0xea, 0x4c, 0x1d,
+	0xb4, 0x1d, 0x41, 0xc7, 0xa3, 0x43, 0x05, 0x3d, 0x70, 0xb0, 0x13, 0xd4, 0x03, 0x97, 0xb8, 0x34,
+	0x8f, 0x34, 0x63, 0xc8, 0xc6, 0xbc, 0x6d, 0x07, 0x0e, 0x5e, 0x3d, 0x7b, 0xf2, 0xe8, 0x07, 0xf0,
+	0x93, 0x70, 0xe4, 0xe8, 0x49, 0x1d, 0x38, 0xf9, 0x2d, 0x9c, 0xec, 0x6e, 0x0a, 0xa5, 0x6d, 0xb4,
+	0xb7, 0xcd, 0xdb, 0xdf, 0x9f, 0x97, 0xf7, 0x76, 0xdf, 0x92, 0x22, 0x8f, 0x58, 0xdb, 0x87, 0x86,
+	0x0b, 0x01, 0xa0, 0x87, 0xf5, 0x30, 0xe2, 0x82, 0xd3, 0xdb, 0x1f, 0x3c, 0xaf, 0xdd, 0x61, 0x5e,
+	0x50, 0x4f, 0x16, 0x9b, 0x75, 0x05, 0x2c, 0x15, 0x5d, 0xee, 0x72, 0x89, 0x6a, 0xc4, 0x2b, 0x45,
+	0x28, 0x2d, 0x6b, 0x99, 0x90, 0x45, 0xec, 0x48, 0xab, 0x54, 0xff, 0x10, 0xb2, 0xf0, 0x4a, 0xe9,
+	0xee, 0x09, 0x26, 0x80, 0x3e, 0x27, 0x73, 0x0a, 0x60, 0x1a, 0x15, 0xa3, 0x96, 0xdd, 0xb8, 0x53,
+	0x1f, 0xeb, 0x53, 0x6f, 0x49, 0x60, 0x73, 0xe6, 0xf4, 0xe7, 0x5a, 0xc6, 0xd2, 0x34, 0xca, 0x49,
+	0x1e, 0x8e, 0xdb, 0x1d, 0x16, 0xb8, 0x60, 0x47, 0x4c, 0x00, 0x9a, 0x53, 0x95, 0xe9, 0x5a, 0x76,
+	0xe3, 0x61, 0x8a, 0xd0, 0x8e, 0x26, 0x58, 0x4c, 0xc0, 0x9b, 0x6e, 0xe8, 0x43, 0xb3, 0x14, 0x6b,
+	0x7e, 0xff, 0xb5, 0x46, 0x87, 0xb6, 0xd0, 0xca, 0xc1, 0x95, 0x18, 0xd2, 0xf7, 0x84, 0x1e, 0x02,
+	0x38, 0x10, 0xd9, 0x0e, 0xf8, 0xe0, 0x32, 0xe1, 0xf1, 0x00, 0xcd, 0x69, 0x69, 0xba, 0x9e, 0x62,
+	0xfa, 0x52, 0x92, 0xb6, 0xfb, 0x1c, 0xfd, 0x1f, 0x4b, 0x87, 0xd7, 0xe2, 0x48, 0x5d, 0x72, 0xab,
+	0xc7, 0x05, 0xd8, 0x21, 0x04, 0xcc, 0x17, 0x27, 0x76, 0x9b, 0x77, 0x03, 0x01, 0x11, 0x9a, 0x33,
+	0xd2, 0xe4, 0x51, 0x8a, 0xc9, 0x3b, 0x2e, 0xa0, 0xa5, 0x68, 0x2f, 0x14, 0x4b, 0xdb, 0x2c, 0xf7,
+	0x86, 0x76, 0x90, 0x7e, 0x22, 0xab, 0xcc, 0x75, 0xa3, 0xd8, 0x18, 0xec, 0x81, 0x2a, 0xda, 0x31,
+	0x1c, 0xcd, 0x59, 0x69, 0xf8, 0x24, 0xc5, 0x70, 0x2b, 0xe1, 0x5f, 0x2d, 0x5c, 0x9c, 0x85, 0xf6,
+	0x2d, 0xb1, 0x71, 0x00, 0xa4, 0x1e, 0x59, 0x0c, 0x23, 0xaf, 0x0d, 0x36, 0x06, 0x2c, 0xc4, 0x0e,
+	0x17, 0x68, 0xce, 0x49, 0xc3, 0x5a, 0xda, 0x21, 0x88, 0x19, 0x7b, 0x9a, 0xd0, 0x5c, 0xd1, 0x7d,
+	0xcb, 0x0f, 0x84, 0xd1, 0xca, 0x87, 0x03, 0xdf, 0x74, 0x9f, 0x14, 0x86, 0xaa, 0x79, 0x43, 0x7a,
+	0x3d, 0x48, 0xf3, 0x1a, 0x55, 0xc9, 0xc5, 0xf0, 0x5a, 0x15, 0x3f, 0x1b, 0xa4, 0x32, 0xae, 0x8c,
+	0x61, 0x04, 0xaa, 0x92, 0x37, 0xa5, 0xd9, 0xb3, 0x49, 0x2b, 0xd9, 0x52, 0x7c, 0x6d, 0xbd, 0xca,
+	0x52, 0x30, 0x48, 0xef, 0x92, 0x5c, 0x87, 0xf9, 0x02, 0x1c, 0xdb, 0x81, 0x80, 0x1f, 0xa1, 0x39,
+	0x5f, 0x99, 0xae, 0xcd, 0x5b, 0x0b, 0x2a, 0xb8, 0x2d, 0x63, 0xf4, 0x23, 0x59, 0xe9, 0x31, 0xdf,
+	0x73, 0x98, 0xe0, 0x91, 0xad, 0xac, 0x6d, 0x14, 0x4c, 0xa0, 0x49, 0x64, 0x8a, 0x4f, 0xd3, 0x4e,
+	0x57, 0x42, 0x7c, 0x2d, 0xbf, 0xe3, 0x1b, 0x8c, 0x16, 0xb4, 0x79, 0xe4, 0xe8, 0x04, 0x8b, 0xbd,
+	0x11, 0x08, 0xda, 0x25, 0xa5, 0x21, 0x4b, 0x55, 0x44, 0x0f, 0xd0, 0xcc, 0x4a, 0xdb, 0xc7, 0xff,
+	0x6f, 0xab, 0xbb, 0xa2, 0x2d, 0xcd, 0xde, 0xa8, 0x5d, 0x0f, 0x54, 0xcf, 0xe5, 0xf1, 0x0a, 0xbb,
+	0x07, 0xbe, 0x87, 0x9d, 0xb8, 0xe7, 0x0b, 0xff, 0xee, 0x79, 0x4c, 0x69, 0x25, 0x8c, 0x7e, 0xcf,
+	0x07, 0xa2, 0x48, 0x77, 0x49, 0x36, 0xec, 0xfa, 0xbe, 0x2d, 0xe3, 0x68, 0xe6, 0xa4, 0xec, 0xbd,
+	0x34, 0xd9, 0xae, 0xef, 0x4b, 0x69, 0xad, 0x48, 0xc2, 0x24, 0x80, 0xf4, 0x2d, 0x29, 0xf4, 0x53,
+	0x4c, 0x14, 0xf3, 0x13, 0x2b, 0x2e, 0xf6, 0x35, 0x94, 0x6c, 0xf5, 0x90, 0x14, 0xae, 0xcf, 0x1c,
+	0x7a, 0x9f, 0xe4, 0xf5, 0xf0, 0x62, 0x8e, 0x13, 0x01, 0xaa, 0xb1, 0x3b, 0x6f, 0xe5, 0x54, 0x74,
+	0x4b, 0x05, 0xe9, 0x3a, 0x59, 0xba, 0xec, 0x58, 0x82, 0x9c, 0x92, 0xc8, 0x42, 0x7f, 0x43, 0x83,
+	0xab, 0xdf, 0x0c, 0x92, 0x1f, 0xbc, 0x29, 0xa3, 0xf9, 0xc6, 0x68, 0x3e, 0xb5, 0x49, 0x71, 0xd4,
+	0xb8, 0x93, 0x7e, 0x93, 0x4e, 0x3b, 0x8b, 0x0e, 0xcf, 0xb9, 0xea, 0x57, 0x83, 0x94, 0xc6, 0x1f,
+	0xdd, 0xc9, 0x92, 0xdd, 0x25, 0xb3, 0xea, 0xb6, 0xa8, 0xec, 0x1a, 0x13, 0xde, 0x16, 0xdd, 0x2b,
+	0xa5, 0xd1, 0xdc, 0x39, 0x3d, 0x2f, 0x1b, 0x67, 0xe7, 0x65, 0xe3, 0xf7, 0x79, 0xd9, 0xf8, 0x72,
+	0x51, 0xce, 0x9c, 0x5d, 0x94, 0x33, 0x3f, 0x2e, 0xca, 0x99, 0xfd, 0x75, 0xd7, 0x13, 0x9d, 0xee,
+	0x41, 0xbd, 0xcd, 0x8f, 0x1a, 0x89, 0xf0, 0xe5, 0xe2, 0xb8, 0xa1, 0xdf, 0x56, 0x71, 0x12, 0x02,
+	0x1e, 0xcc, 0xc9, 0xb7, 0x75, 0xf3, 0xef, 0x00, 0xfb, 0xdf, 0xdc, 0xa1, 0xb9, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -294,6 +423,99 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PublisherPrices) > 0 {
+		for iNdEx := len(m.PublisherPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PublisherPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.PullPrices) > 0 {
+		for iNdEx := len(m.PullPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PullPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PricePublishers) > 0 {
+		for iNdEx := len(m.PricePublishers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PricePublishers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ValidatorOraclePenalties) > 0 {
+		for iNdEx := len(m.ValidatorOraclePenalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorOraclePenalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ValidatorOracleStats) > 0 {
+		for iNdEx := len(m.ValidatorOracleStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorOracleStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.HaltedDenoms) > 0 {
+		for iNdEx := len(m.HaltedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HaltedDenoms[iNdEx])
+			copy(dAtA[i:], m.HaltedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.HaltedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AggregateExchangeRatePrevotes) > 0 {
+		for iNdEx := len(m.AggregateExchangeRatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregateExchangeRatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.PenaltyCounters) > 0 {
 		for iNdEx := len(m.PenaltyCounters) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -470,6 +692,46 @@ func (m *PenaltyCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorOracleStatsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorOracleStatsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorOracleStatsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -525,6 +787,48 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AggregateExchangeRatePrevotes) > 0 {
+		for _, e := range m.AggregateExchangeRatePrevotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HaltedDenoms) > 0 {
+		for _, s := range m.HaltedDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorOracleStats) > 0 {
+		for _, e := range m.ValidatorOracleStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorOraclePenalties) > 0 {
+		for _, e := range m.ValidatorOraclePenalties {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PricePublishers) > 0 {
+		for _, e := range m.PricePublishers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PullPrices) > 0 {
+		for _, e := range m.PullPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PublisherPrices) > 0 {
+		for _, e := range m.PublisherPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -562,6 +866,21 @@ func (m *PenaltyCounter) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorOracleStatsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Stats.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -834,39 +1153,275 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateExchangeRatePrevotes", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FeederDelegation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			m.AggregateExchangeRatePrevotes = append(m.AggregateExchangeRatePrevotes, AggregateExchangeRatePrevote{})
+			if err := m.AggregateExchangeRatePrevotes[len(m.AggregateExchangeRatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HaltedDenoms = append(m.HaltedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorOracleStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorOracleStats = append(m.ValidatorOracleStats, ValidatorOracleStatsRecord{})
+			if err := m.ValidatorOracleStats[len(m.ValidatorOracleStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorOraclePenalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorOraclePenalties = append(m.ValidatorOraclePenalties, ValidatorOraclePenalty{})
+			if err := m.ValidatorOraclePenalties[len(m.ValidatorOraclePenalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePublishers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PricePublishers = append(m.PricePublishers, PricePublisher{})
+			if err := m.PricePublishers[len(m.PricePublishers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PullPrices = append(m.PullPrices, PullPrice{})
+			if err := m.PullPrices[len(m.PullPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublisherPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublisherPrices = append(m.PublisherPrices, PullPrice{})
+			if err := m.PublisherPrices[len(m.PublisherPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeederDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
@@ -1087,6 +1642,121 @@ func (m *PenaltyCounter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorOracleStatsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorOracleStatsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorOracleStatsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
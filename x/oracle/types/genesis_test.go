@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -15,8 +16,15 @@ func TestNewGenesisState(t *testing.T) {
 	aggregateExchangeRateVote := []AggregateExchangeRateVote{}
 	priceSnapshot := PriceSnapshots{}
 	votePenaltyCounters := []VotePenaltyCounter{}
+	aggregateExchangeRatePrevotes := []AggregateExchangeRatePrevote{}
+	haltedDenoms := []string{}
+	validatorOracleStats := []ValidatorOracleStatsRecord{}
+	validatorOraclePenalties := []ValidatorOraclePenalty{}
+	pricePublishers := []PricePublisher{}
+	pullPrices := []PullPrice{}
+	publisherPrices := []PullPrice{}
 
-	newGenesis := NewGenesisState(params, exchangeRateTuple, feederDelegation, penaltyCounters, aggregateExchangeRateVote, priceSnapshot, votePenaltyCounters)
+	newGenesis := NewGenesisState(params, exchangeRateTuple, feederDelegation, penaltyCounters, aggregateExchangeRateVote, priceSnapshot, votePenaltyCounters, aggregateExchangeRatePrevotes, haltedDenoms, validatorOracleStats, validatorOraclePenalties, pricePublishers, pullPrices, publisherPrices)
 
 	// expected result
 	expected := &GenesisState{
@@ -27,6 +35,14 @@ func TestNewGenesisState(t *testing.T) {
 		PriceSnapshots:             priceSnapshot,
 		VotePenaltyCounters:        votePenaltyCounters,
 		PenaltyCounters:            penaltyCounters,
+
+		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevotes,
+		HaltedDenoms:                  haltedDenoms,
+		ValidatorOracleStats:          validatorOracleStats,
+		ValidatorOraclePenalties:      validatorOraclePenalties,
+		PricePublishers:               pricePublishers,
+		PullPrices:                    pullPrices,
+		PublisherPrices:               publisherPrices,
 	}
 
 	// validation
@@ -42,6 +58,13 @@ func TestDefaultGenesisState(t *testing.T) {
 	aggregateExchangeRateVote := []AggregateExchangeRateVote{}
 	priceSnapshot := PriceSnapshots{}
 	votePenaltyCounters := []VotePenaltyCounter{}
+	aggregateExchangeRatePrevotes := []AggregateExchangeRatePrevote{}
+	haltedDenoms := []string{}
+	validatorOracleStats := []ValidatorOracleStatsRecord{}
+	validatorOraclePenalties := []ValidatorOraclePenalty{}
+	pricePublishers := []PricePublisher{}
+	pullPrices := []PullPrice{}
+	publisherPrices := []PullPrice{}
 
 	expected := &GenesisState{
 		Params:                     params,
@@ -51,6 +74,14 @@ func TestDefaultGenesisState(t *testing.T) {
 		PriceSnapshots:             priceSnapshot,
 		VotePenaltyCounters:        votePenaltyCounters,
 		PenaltyCounters:            penaltyCounters,
+
+		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevotes,
+		HaltedDenoms:                  haltedDenoms,
+		ValidatorOracleStats:          validatorOracleStats,
+		ValidatorOraclePenalties:      validatorOraclePenalties,
+		PricePublishers:               pricePublishers,
+		PullPrices:                    pullPrices,
+		PublisherPrices:               publisherPrices,
 	}
 
 	// Create default genesis
@@ -64,6 +95,14 @@ func TestValidateGenesis(t *testing.T) {
 	genState := DefaultGenesisState()
 	require.NoError(t, ValidateGenesis(genState))
 
+	genState.PricePublishers = []PricePublisher{{Name: "kii", KeyType: KeyTypeEd25519, PubKey: []byte{0x01}}}
+	require.Error(t, ValidateGenesis(genState))
+
+	genState = DefaultGenesisState()
+	genState.PublisherPrices = []PullPrice{{Denom: "ubtc", Price: sdk.NewDec(1), Publishers: []string{"kii", "pyth"}}}
+	require.Error(t, ValidateGenesis(genState))
+
+	genState = DefaultGenesisState()
 	genState.Params.VotePeriod = 0
 	require.Error(t, ValidateGenesis(genState))
 }
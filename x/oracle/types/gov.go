@@ -0,0 +1,167 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeAddOracleDenom    = "AddOracleDenom"
+	ProposalTypeRemoveOracleDenom = "RemoveOracleDenom"
+
+	ProposalTypeAddPricePublisher    = "AddPricePublisher"
+	ProposalTypeRemovePricePublisher = "RemovePricePublisher"
+)
+
+func init() {
+	// for routing
+	govtypes.RegisterProposalType(ProposalTypeAddOracleDenom)
+	govtypes.RegisterProposalType(ProposalTypeRemoveOracleDenom)
+	govtypes.RegisterProposalType(ProposalTypeAddPricePublisher)
+	govtypes.RegisterProposalType(ProposalTypeRemovePricePublisher)
+	// for marshal and unmarshal
+	govtypes.RegisterProposalTypeCodec(&AddOracleDenomProposal{}, "oracle/AddOracleDenomProposal")
+	govtypes.RegisterProposalTypeCodec(&RemoveOracleDenomProposal{}, "oracle/RemoveOracleDenomProposal")
+	govtypes.RegisterProposalTypeCodec(&AddPricePublisherProposal{}, "oracle/AddPricePublisherProposal")
+	govtypes.RegisterProposalTypeCodec(&RemovePricePublisherProposal{}, "oracle/RemovePricePublisherProposal")
+}
+
+var (
+	_ govtypes.Content = &AddOracleDenomProposal{}
+	_ govtypes.Content = &RemoveOracleDenomProposal{}
+	_ govtypes.Content = &AddPricePublisherProposal{}
+	_ govtypes.Content = &RemovePricePublisherProposal{}
+)
+
+// NewAddOracleDenomProposal creates a new AddOracleDenomProposal instance
+func NewAddOracleDenomProposal(title, description string, denom Denom) *AddOracleDenomProposal {
+	return &AddOracleDenomProposal{Title: title, Description: description, Denom: denom}
+}
+
+func (p *AddOracleDenomProposal) GetTitle() string { return p.Title }
+
+func (p *AddOracleDenomProposal) GetDescription() string { return p.Description }
+
+func (p *AddOracleDenomProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AddOracleDenomProposal) ProposalType() string { return ProposalTypeAddOracleDenom }
+
+// ValidateBasic validates the proposal content and the denom overrides
+func (p *AddOracleDenomProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return p.Denom.Validate()
+}
+
+func (p AddOracleDenomProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Add Oracle Denom Proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+`, p.Title, p.Description, strings.TrimSpace(p.Denom.String())))
+	return b.String()
+}
+
+// NewRemoveOracleDenomProposal creates a new RemoveOracleDenomProposal instance
+func NewRemoveOracleDenomProposal(title, description, denom string) *RemoveOracleDenomProposal {
+	return &RemoveOracleDenomProposal{Title: title, Description: description, Denom: denom}
+}
+
+func (p *RemoveOracleDenomProposal) GetTitle() string { return p.Title }
+
+func (p *RemoveOracleDenomProposal) GetDescription() string { return p.Description }
+
+func (p *RemoveOracleDenomProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveOracleDenomProposal) ProposalType() string { return ProposalTypeRemoveOracleDenom }
+
+// ValidateBasic validates the proposal content and the denom name
+func (p *RemoveOracleDenomProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if len(p.Denom) == 0 {
+		return ErrInvalidDenom.Wrap("denom must have name")
+	}
+	return nil
+}
+
+func (p RemoveOracleDenomProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Remove Oracle Denom Proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+`, p.Title, p.Description, p.Denom))
+	return b.String()
+}
+
+// NewAddPricePublisherProposal creates a new AddPricePublisherProposal instance
+func NewAddPricePublisherProposal(title, description string, publisher PricePublisher) *AddPricePublisherProposal {
+	return &AddPricePublisherProposal{Title: title, Description: description, Publisher: publisher}
+}
+
+func (p *AddPricePublisherProposal) GetTitle() string { return p.Title }
+
+func (p *AddPricePublisherProposal) GetDescription() string { return p.Description }
+
+func (p *AddPricePublisherProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AddPricePublisherProposal) ProposalType() string { return ProposalTypeAddPricePublisher }
+
+// ValidateBasic validates the proposal content and the publisher key
+func (p *AddPricePublisherProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return p.Publisher.Validate()
+}
+
+func (p AddPricePublisherProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Add Price Publisher Proposal:
+  Title:       %s
+  Description: %s
+  Publisher:   %s
+  Key Type:    %s
+`, p.Title, p.Description, p.Publisher.Name, p.Publisher.KeyType))
+	return b.String()
+}
+
+// NewRemovePricePublisherProposal creates a new RemovePricePublisherProposal instance
+func NewRemovePricePublisherProposal(title, description, name string) *RemovePricePublisherProposal {
+	return &RemovePricePublisherProposal{Title: title, Description: description, Name: name}
+}
+
+func (p *RemovePricePublisherProposal) GetTitle() string { return p.Title }
+
+func (p *RemovePricePublisherProposal) GetDescription() string { return p.Description }
+
+func (p *RemovePricePublisherProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemovePricePublisherProposal) ProposalType() string { return ProposalTypeRemovePricePublisher }
+
+// ValidateBasic validates the proposal content and the publisher name
+func (p *RemovePricePublisherProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if len(p.Name) == 0 {
+		return ErrInvalidPricePublisher.Wrap("publisher must have name")
+	}
+	return nil
+}
+
+func (p RemovePricePublisherProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Remove Price Publisher Proposal:
+  Title:       %s
+  Description: %s
+  Publisher:   %s
+`, p.Title, p.Description, p.Name))
+	return b.String()
+}
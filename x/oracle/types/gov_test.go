@@ -0,0 +1,39 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddOracleDenomProposalValidateBasic(t *testing.T) {
+	invalidBand := sdk.NewDec(2)
+
+	require.NoError(t, NewAddOracleDenomProposal("title", "description", Denom{Name: "uatom"}).ValidateBasic())
+	require.Error(t, NewAddOracleDenomProposal("", "description", Denom{Name: "uatom"}).ValidateBasic())
+	require.Error(t, NewAddOracleDenomProposal("title", "description", Denom{}).ValidateBasic())
+	require.Error(t, NewAddOracleDenomProposal("title", "description", Denom{Name: "uatom", RewardBand: &invalidBand}).ValidateBasic())
+}
+
+func TestRemoveOracleDenomProposalValidateBasic(t *testing.T) {
+	require.NoError(t, NewRemoveOracleDenomProposal("title", "description", "uatom").ValidateBasic())
+	require.Error(t, NewRemoveOracleDenomProposal("title", "", "uatom").ValidateBasic())
+	require.Error(t, NewRemoveOracleDenomProposal("title", "description", "").ValidateBasic())
+}
+
+func TestAddPricePublisherProposalValidateBasic(t *testing.T) {
+	publisher, err := NewPricePublisher("kii", ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	require.NoError(t, NewAddPricePublisherProposal("title", "description", publisher).ValidateBasic())
+	require.Error(t, NewAddPricePublisherProposal("", "description", publisher).ValidateBasic())
+	require.Error(t, NewAddPricePublisherProposal("title", "description", PricePublisher{Name: "kii", KeyType: KeyTypeEd25519}).ValidateBasic())
+}
+
+func TestRemovePricePublisherProposalValidateBasic(t *testing.T) {
+	require.NoError(t, NewRemovePricePublisherProposal("title", "description", "kii").ValidateBasic())
+	require.Error(t, NewRemovePricePublisherProposal("title", "", "kii").ValidateBasic())
+	require.Error(t, NewRemovePricePublisherProposal("title", "description", "").ValidateBasic())
+}
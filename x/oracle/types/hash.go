@@ -0,0 +1,93 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"gopkg.in/yaml.v2"
+)
+
+var _ yaml.Marshaler = AggregateVoteHash{}
+
+// AggregateVoteHash is the hash value submitted on the prevote, it is built from the
+// salt, the exchange rates string and the validator address
+type AggregateVoteHash []byte
+
+// GetAggregateVoteHash computes the hash value to be sent on the prevote
+// The hash is computed as SHA256("{salt}:{exchange rates}:{voter}") truncated to 20 bytes
+func GetAggregateVoteHash(salt string, exchangeRatesStr string, voter sdk.ValAddress) AggregateVoteHash {
+	sourceStr := fmt.Sprintf("%s:%s:%s", salt, exchangeRatesStr, voter.String())
+	bz := tmhash.Sum([]byte(sourceStr))
+	return bz[:tmhash.TruncatedSize]
+}
+
+// AggregateVoteHashFromHexString converts the hex string to an AggregateVoteHash
+func AggregateVoteHashFromHexString(s string) (AggregateVoteHash, error) {
+	h, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// String implements stringify
+func (h AggregateVoteHash) String() string {
+	return hex.EncodeToString(h)
+}
+
+// Equal does the bytes comparison between two hashes
+func (h AggregateVoteHash) Equal(h2 AggregateVoteHash) bool {
+	return bytes.Equal(h, h2)
+}
+
+// Empty checks if the hash has no content
+func (h AggregateVoteHash) Empty() bool {
+	return len(h) == 0
+}
+
+// Format implements the fmt.Formatter interface
+func (h AggregateVoteHash) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		_, _ = s.Write([]byte(h.String()))
+	default:
+		_, _ = s.Write([]byte(fmt.Sprintf("%X", []byte(h))))
+	}
+}
+
+// Marshal returns the raw bytes of the hash
+func (h AggregateVoteHash) Marshal() ([]byte, error) {
+	return h, nil
+}
+
+// MarshalJSON marshals the hash as an hex string
+func (h AggregateVoteHash) MarshalJSON() ([]byte, error) {
+	return json.Marshal(h.String())
+}
+
+// MarshalYAML marshals the hash as an hex string
+func (h AggregateVoteHash) MarshalYAML() (interface{}, error) {
+	return h.String(), nil
+}
+
+// UnmarshalJSON decodes the hash from an hex string
+func (h *AggregateVoteHash) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	h2, err := AggregateVoteHashFromHexString(s)
+	if err != nil {
+		return err
+	}
+
+	*h = h2
+	return nil
+}
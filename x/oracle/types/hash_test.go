@@ -0,0 +1,49 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"gopkg.in/yaml.v2"
+)
+
+func TestAggregateVoteHash(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+	}
+
+	aggregateVoteHash := GetAggregateVoteHash("salt", "100ukii,200uatom", sdk.ValAddress(addrs[0]))
+	hexStr := hex.EncodeToString(aggregateVoteHash)
+	aggregateVoteHashRes, err := AggregateVoteHashFromHexString(hexStr)
+	require.NoError(t, err)
+	require.Equal(t, aggregateVoteHash, aggregateVoteHashRes)
+	require.True(t, aggregateVoteHash.Equal(aggregateVoteHash))
+	require.True(t, AggregateVoteHash([]byte{}).Empty())
+
+	// a different salt produces a different hash
+	otherHash := GetAggregateVoteHash("other", "100ukii,200uatom", sdk.ValAddress(addrs[0]))
+	require.False(t, aggregateVoteHash.Equal(otherHash))
+
+	// a different voter produces a different hash
+	otherVoter := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+	otherHash = GetAggregateVoteHash("salt", "100ukii,200uatom", otherVoter)
+	require.False(t, aggregateVoteHash.Equal(otherHash))
+
+	got, _ := yaml.Marshal(&aggregateVoteHash)
+	require.Equal(t, aggregateVoteHash.String()+"\n", string(got))
+
+	res := AggregateVoteHash{}
+	testMarshal(t, &aggregateVoteHash, &res, json.Marshal, (&res).UnmarshalJSON)
+}
+
+func testMarshal(t *testing.T, original, res interface{}, marshal func(v interface{}) ([]byte, error), unmarshal func(data []byte) error) {
+	bz, err := marshal(original)
+	require.NoError(t, err)
+	err = unmarshal(bz)
+	require.NoError(t, err)
+	require.Equal(t, original, res)
+}
@@ -33,6 +33,14 @@ var (
 	VoteTargetKey                = []byte{0x05} // Stores the list of assets that validators must submit votes for
 	PriceSnapshotKey             = []byte{0x06} // Stores historical price snapshots at specific timestamps
 	SpamPreventionCounter        = []byte{0x07} // Stores repeated submissions by validator.
+
+	AggregateExchangeRatePrevoteKey = []byte{0x08} // Stores the hashed exchange rate prevotes submitted by validators
+	HaltedDenomKey                  = []byte{0x09} // Stores the denoms halted by the price deviation circuit breaker
+	ValidatorOracleStatsKey         = []byte{0x0A} // Stores the rolling voting performance by validator
+	ValidatorOraclePenaltyKey       = []byte{0x0B} // Stores the last oracle penalty applied by validator
+	PricePublisherKey               = []byte{0x0C} // Stores the governance registered price publishers by name
+	PullPriceKey                    = []byte{0x0D} // Stores the latest price attested by publishers by denom
+	PublisherPriceKey               = []byte{0x0E} // Stores the latest price signed by each publisher by denom
 )
 
 // GetExchangeRateKey returns the key to search the latest exchange rate by denom
@@ -51,11 +59,26 @@ func GetVotePenaltyCounterKey(valAddr sdk.ValAddress) []byte {
 	return append(VotePenaltyCounterKey, address.MustLengthPrefix(valAddr)...)
 }
 
+// GetValidatorOracleStatsKey returns the key to search the rolling voting performance by validator address
+func GetValidatorOracleStatsKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorOracleStatsKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetValidatorOraclePenaltyKey returns the key to search the last oracle penalty by validator address
+func GetValidatorOraclePenaltyKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorOraclePenaltyKey, address.MustLengthPrefix(valAddr)...)
+}
+
 // GetAggregateExchangeRateVoteKey returns the key to search the exchange rate votes submitted by validator address
 func GetAggregateExchangeRateVoteKey(valAddr sdk.ValAddress) []byte {
 	return append(AggregateExchangeRateVoteKey, address.MustLengthPrefix(valAddr)...)
 }
 
+// GetAggregateExchangeRatePrevoteKey returns the key to search the exchange rate prevote submitted by validator address
+func GetAggregateExchangeRatePrevoteKey(valAddr sdk.ValAddress) []byte {
+	return append(AggregateExchangeRatePrevoteKey, address.MustLengthPrefix(valAddr)...)
+}
+
 // GetSpamPreventionCounterKey returns the key to search the spam prevention counter by validator address
 func GetSpamPreventionCounterKey(valAddr sdk.ValAddress) []byte {
 	return append(SpamPreventionCounter, address.MustLengthPrefix(valAddr)...)
@@ -73,6 +96,35 @@ func ExtractDenomFromVoteTargetKey(key []byte) string {
 	return denom
 }
 
+// GetHaltedDenomKey returns the key to search if a denom is halted
+// e.g = "BTC/USD" -> GetHaltedDenomKey -> [0x09]["BTC/USD"]
+func GetHaltedDenomKey(denom string) []byte {
+	return append(HaltedDenomKey, []byte(denom)...)
+}
+
+// GetPricePublisherKey returns the key to search a price publisher by name
+func GetPricePublisherKey(name string) []byte {
+	return append(PricePublisherKey, []byte(name)...)
+}
+
+// GetPullPriceKey returns the key to search the latest attested price by denom
+// e.g = "ubtc" -> GetPullPriceKey -> [0x0D]["ubtc"]
+func GetPullPriceKey(denom string) []byte {
+	return append(PullPriceKey, []byte(denom)...)
+}
+
+// GetPublisherPricesKey returns the prefix to iterate the prices signed by the publishers of a denom
+// e.g = "ubtc" -> GetPublisherPricesKey -> [0x0E][4]["ubtc"]
+func GetPublisherPricesKey(denom string) []byte {
+	return append(PublisherPriceKey, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// GetPublisherPriceKey returns the key to search the latest price of a denom signed by a publisher
+// e.g = "ubtc", "pyth" -> GetPublisherPriceKey -> [0x0E][4]["ubtc"]["pyth"]
+func GetPublisherPriceKey(denom, publisher string) []byte {
+	return append(GetPublisherPricesKey(denom), []byte(publisher)...)
+}
+
 // GetPriceSnapshotKey returns the key to search the price snapshot by timestamp
 func GetPriceSnapshotKey(timestamp uint64) []byte {
 	timestampKey := make([]byte, 8)
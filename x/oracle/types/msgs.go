@@ -1,19 +1,74 @@
 package types
 
 import (
+	"encoding/hex"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 )
 
 // ensure Msg interface be implemented at compile time
 var (
 	_ sdk.Msg = &MsgDelegateFeedConsent{}
 	_ sdk.Msg = &MsgAggregateExchangeRateVote{}
+	_ sdk.Msg = &MsgAggregateExchangeRatePrevote{}
+	_ sdk.Msg = &MsgSubmitPriceAttestation{}
 )
 
+// NewMsgAggregateExchangeRatePrevote creates a MsgAggregateExchangeRatePrevote instance
+func NewMsgAggregateExchangeRatePrevote(hash AggregateVoteHash, feeder sdk.AccAddress, validator sdk.ValAddress) *MsgAggregateExchangeRatePrevote {
+	return &MsgAggregateExchangeRatePrevote{
+		Hash:      hash.String(),
+		Feeder:    feeder.String(),
+		Validator: validator.String(),
+	}
+}
+
+// GetSigners implements sdk.Msg interface
+// Returns the signer of the transaction which is the feeder
+func (msg MsgAggregateExchangeRatePrevote) GetSigners() []sdk.AccAddress {
+	feeder, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{feeder}
+}
+
+// ValidateBasic implements sdk.Msg interface
+// ValidateBasic validates the message content (valid addresses and valid hash)
+func (msg MsgAggregateExchangeRatePrevote) ValidateBasic() error {
+	// Check valid hash
+	_, err := AggregateVoteHashFromHexString(msg.Hash)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidHash, "Invalid vote hash (%s)", err)
+	}
+
+	// Check hash size, must be the truncated size
+	if len(msg.Hash) != tmhash.TruncatedSize*2 {
+		return ErrInvalidHashLength
+	}
+
+	// Check valid feeder address
+	_, err = sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid feeder address (%s)", err)
+	}
+
+	// Check valid validator address
+	_, err = sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid operator address (%s)", err)
+	}
+
+	return nil
+}
+
 // NewMsgAggregateExchangeRateVote creates a MsgAggregateExchangeRateVote instance
-func NewMsgAggregateExchangeRateVote(exchangeRate string, feeder sdk.AccAddress, validator sdk.ValAddress) *MsgAggregateExchangeRateVote {
+func NewMsgAggregateExchangeRateVote(salt string, exchangeRate string, feeder sdk.AccAddress, validator sdk.ValAddress) *MsgAggregateExchangeRateVote {
 	return &MsgAggregateExchangeRateVote{
+		Salt:          salt,
 		ExchangeRates: exchangeRate,
 		Feeder:        feeder.String(),
 		Validator:     validator.String(),
@@ -67,6 +122,16 @@ func (msg MsgAggregateExchangeRateVote) ValidateBasic() error {
 			return sdkerrors.Wrap(ErrInvalidExchangeRate, "overflow exchange rate")
 		}
 	}
+
+	// Check the salt used to build the prevote hash
+	if len(msg.Salt) > 64 || len(msg.Salt) < 1 {
+		return ErrInvalidSaltLength
+	}
+
+	_, err = hex.DecodeString(msg.Salt)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidSaltFormat, "salt must be a valid hex input")
+	}
 	return nil
 }
 
@@ -106,3 +171,44 @@ func (msg MsgDelegateFeedConsent) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgSubmitPriceAttestation creates a MsgSubmitPriceAttestation instance
+func NewMsgSubmitPriceAttestation(sender sdk.AccAddress, attestation PriceAttestation, signature []byte) *MsgSubmitPriceAttestation {
+	return &MsgSubmitPriceAttestation{
+		Sender:      sender.String(),
+		Attestation: attestation,
+		Signature:   signature,
+	}
+}
+
+// GetSigners implements sdk.Msg interface
+// Returns the signer of the transaction which is the sender, any account can relay an attestation
+func (msg MsgSubmitPriceAttestation) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic implements sdk.Msg interface
+// ValidateBasic validates the message content (valid sender, attestation content and signature)
+func (msg MsgSubmitPriceAttestation) ValidateBasic() error {
+	// Validate sender address
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	// Validate the attestation content
+	if err := msg.Attestation.Validate(); err != nil {
+		return err
+	}
+
+	if len(msg.Signature) == 0 {
+		return sdkerrors.Wrap(ErrInvalidAttestationSig, "signature must not be empty")
+	}
+
+	return nil
+}
@@ -7,10 +7,49 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestMsgAggregateExchangeRatePrevote(t *testing.T) {
+	type test struct {
+		hash       AggregateVoteHash
+		voter      sdk.AccAddress
+		expectPass bool
+	}
+
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1___________")),
+	}
+
+	exchangeRates := "12.00atom,1234.12eth"
+	hash := GetAggregateVoteHash("1f2e3d4c", exchangeRates, sdk.ValAddress(addrs[0]))
+
+	tests := []test{
+		{hash, addrs[0], true},
+		{hash[:10], addrs[0], false},
+		{AggregateVoteHash{}, addrs[0], false},
+		{hash, sdk.AccAddress{}, false},
+	}
+
+	// validation
+	for i, test := range tests {
+		msg := NewMsgAggregateExchangeRatePrevote(test.hash, test.voter, sdk.ValAddress(test.voter))
+		if test.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+			continue
+		}
+
+		require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+	}
+
+	// must fail because the hash is not an hex string
+	msg := NewMsgAggregateExchangeRatePrevote(hash, addrs[0], sdk.ValAddress(addrs[0]))
+	msg.Hash = "zz" + msg.Hash[2:]
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidHash)
+}
+
 func TestMsgAggregateExchangeRateVote(t *testing.T) {
 
 	type test struct {
 		voter         sdk.AccAddress
+		salt          string
 		exchangeRates string
 		expectPass    bool
 	}
@@ -24,17 +63,23 @@ func TestMsgAggregateExchangeRateVote(t *testing.T) {
 	abstainExchangeRates := "0.0atom,123.12eth"
 	overFlowExchangeRates := "1000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000.0atom,123.13eth"
 
+	salt := "1f2e3d4c"
+	longSalt := "1f2e3d4c1f2e3d4c1f2e3d4c1f2e3d4c1f2e3d4c1f2e3d4c1f2e3d4c1f2e3d4c1f"
+
 	tests := []test{
-		{addrs[0], exchangeRates, true},
-		{addrs[0], invalidExchangeRates, false},
-		{addrs[0], abstainExchangeRates, true},
-		{addrs[0], overFlowExchangeRates, false},
-		{sdk.AccAddress{}, exchangeRates, false},
+		{addrs[0], salt, exchangeRates, true},
+		{addrs[0], salt, invalidExchangeRates, false},
+		{addrs[0], salt, abstainExchangeRates, true},
+		{addrs[0], salt, overFlowExchangeRates, false},
+		{addrs[0], "", exchangeRates, false},
+		{addrs[0], longSalt, exchangeRates, false},
+		{addrs[0], "salt", exchangeRates, false},
+		{sdk.AccAddress{}, salt, exchangeRates, false},
 	}
 
 	// validation
 	for i, test := range tests {
-		msg := NewMsgAggregateExchangeRateVote(test.exchangeRates, test.voter, sdk.ValAddress(test.voter))
+		msg := NewMsgAggregateExchangeRateVote(test.salt, test.exchangeRates, test.voter, sdk.ValAddress(test.voter))
 		if test.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
 
@@ -85,3 +130,27 @@ func TestMsgDelegateFeedConsent(t *testing.T) {
 	}
 
 }
+
+func TestMsgSubmitPriceAttestation(t *testing.T) {
+	sender := sdk.AccAddress([]byte("addr1___________"))
+	attestation := NewPriceAttestation("kii", "kiichain", 100, ExchangeRateTuples{{Denom: "ubtc", ExchangeRate: sdk.NewDec(100000)}})
+	signature := []byte("signature")
+
+	msg := NewMsgSubmitPriceAttestation(sender, attestation, signature)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{sender}, msg.GetSigners())
+
+	// invalid sender
+	msg = NewMsgSubmitPriceAttestation(sdk.AccAddress{}, attestation, signature)
+	require.Error(t, msg.ValidateBasic())
+
+	// invalid attestation
+	invalidAttestation := attestation
+	invalidAttestation.Timestamp = 0
+	msg = NewMsgSubmitPriceAttestation(sender, invalidAttestation, signature)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidAttestation)
+
+	// no signature
+	msg = NewMsgSubmitPriceAttestation(sender, attestation, nil)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidAttestationSig)
+}
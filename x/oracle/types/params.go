@@ -19,6 +19,19 @@ var (
 	KeySlashWindow       = []byte("SlashWindow")
 	KeyMinValidPerWindow = []byte("MinValidPerWindow")
 	KeyLookbackDuration  = []byte("LookbackDuration")
+
+	KeyRewardDistributionWindow = []byte("RewardDistributionWindow")
+	KeyRewardPoolShare          = []byte("RewardPoolShare")
+	KeyMaxMissedVotePeriods     = []byte("MaxMissedVotePeriods")
+	KeyMaxPriceDeviation        = []byte("MaxPriceDeviation")
+	KeyJailDuration             = []byte("JailDuration")
+	KeyPenaltyEscalationWindows = []byte("PenaltyEscalationWindows")
+	KeyPenaltyEscalationFactor  = []byte("PenaltyEscalationFactor")
+	KeyMaxAttestationAge        = []byte("MaxAttestationAge")
+
+	KeyMaxPenaltyEscalationLevel = []byte("MaxPenaltyEscalationLevel")
+	KeyMinPricePublishers        = []byte("MinPricePublishers")
+	KeyHaltRecoveryPeriods       = []byte("HaltRecoveryPeriods")
 )
 
 // Default parameter value
@@ -39,6 +52,19 @@ var (
 	DefaultSlashFraction     = sdk.NewDecWithPrec(0, 4) // 0.00 | 0%
 	DefaultMinValidPerWindow = sdk.NewDecWithPrec(5, 2) // 0.05 | 5%
 	DefaultLookbackDuration  = uint64(3600)
+
+	DefaultRewardDistributionWindow = utils.BlocksPerWeek // pool paid out over a week
+	DefaultRewardPoolShare          = sdk.ZeroDec()       // 0.00 | 0%, enabled by governance
+	DefaultMaxMissedVotePeriods     = uint64(0)           // stale check disabled
+	DefaultMaxPriceDeviation        = sdk.ZeroDec()       // circuit breaker disabled
+	DefaultJailDuration             = uint64(0)           // jailed without a minimum duration
+	DefaultPenaltyEscalationWindows = uint64(0)           // penalty escalation disabled
+	DefaultPenaltyEscalationFactor  = sdk.NewDec(2)       // penalties doubled on each escalation level
+	DefaultMaxAttestationAge        = uint64(0)           // pull oracle disabled
+
+	DefaultMaxPenaltyEscalationLevel = uint64(10) // penalties multiplied up to 10 times
+	DefaultMinPricePublishers        = uint64(3)  // median of at least 3 publishers
+	DefaultHaltRecoveryPeriods       = uint64(3)  // halted denoms resume after 3 agreeing medians
 )
 
 // Implement the interface ParamSet
@@ -55,6 +81,19 @@ func DefaultParams() Params {
 		SlashWindow:       DefaultSlashWindow,
 		MinValidPerWindow: DefaultMinValidPerWindow,
 		LookbackDuration:  DefaultLookbackDuration,
+
+		RewardDistributionWindow: DefaultRewardDistributionWindow,
+		RewardPoolShare:          DefaultRewardPoolShare,
+		MaxMissedVotePeriods:     DefaultMaxMissedVotePeriods,
+		MaxPriceDeviation:        DefaultMaxPriceDeviation,
+		JailDuration:             DefaultJailDuration,
+		PenaltyEscalationWindows: DefaultPenaltyEscalationWindows,
+		PenaltyEscalationFactor:  DefaultPenaltyEscalationFactor,
+		MaxAttestationAge:        DefaultMaxAttestationAge,
+
+		MaxPenaltyEscalationLevel: DefaultMaxPenaltyEscalationLevel,
+		MinPricePublishers:        DefaultMinPricePublishers,
+		HaltRecoveryPeriods:       DefaultHaltRecoveryPeriods,
 	}
 }
 
@@ -75,6 +114,17 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeySlashWindow, &p.SlashWindow, validateSlashWindow),
 		paramstypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
 		paramstypes.NewParamSetPair(KeyLookbackDuration, &p.LookbackDuration, validateLookbackDuration),
+		paramstypes.NewParamSetPair(KeyRewardDistributionWindow, &p.RewardDistributionWindow, validateRewardDistributionWindow),
+		paramstypes.NewParamSetPair(KeyRewardPoolShare, &p.RewardPoolShare, validateRewardPoolShare),
+		paramstypes.NewParamSetPair(KeyMaxMissedVotePeriods, &p.MaxMissedVotePeriods, validateMaxMissedVotePeriods),
+		paramstypes.NewParamSetPair(KeyMaxPriceDeviation, &p.MaxPriceDeviation, validateMaxPriceDeviation),
+		paramstypes.NewParamSetPair(KeyJailDuration, &p.JailDuration, validateJailDuration),
+		paramstypes.NewParamSetPair(KeyPenaltyEscalationWindows, &p.PenaltyEscalationWindows, validatePenaltyEscalationWindows),
+		paramstypes.NewParamSetPair(KeyPenaltyEscalationFactor, &p.PenaltyEscalationFactor, validatePenaltyEscalationFactor),
+		paramstypes.NewParamSetPair(KeyMaxAttestationAge, &p.MaxAttestationAge, validateMaxAttestationAge),
+		paramstypes.NewParamSetPair(KeyMaxPenaltyEscalationLevel, &p.MaxPenaltyEscalationLevel, validateMaxPenaltyEscalationLevel),
+		paramstypes.NewParamSetPair(KeyMinPricePublishers, &p.MinPricePublishers, validateMinPricePublishers),
+		paramstypes.NewParamSetPair(KeyHaltRecoveryPeriods, &p.HaltRecoveryPeriods, validateHaltRecoveryPeriods),
 	}
 }
 
@@ -113,14 +163,49 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter MinValidPerWindow must be between [0, 1]")
 	}
 
+	if p.RewardDistributionWindow < p.VotePeriod {
+		return fmt.Errorf("oracle parameter RewardDistributionWindow must be greater than or equal with VotePeriod")
+	}
+
+	if p.RewardPoolShare.GT(sdk.OneDec()) || p.RewardPoolShare.IsNegative() {
+		return fmt.Errorf("oracle parameter RewardPoolShare must be between [0, 1]")
+	}
+
+	if p.MaxPriceDeviation.IsNegative() {
+		return fmt.Errorf("oracle parameter MaxPriceDeviation must be positive")
+	}
+
+	if p.PenaltyEscalationFactor.LT(sdk.OneDec()) {
+		return fmt.Errorf("oracle parameter PenaltyEscalationFactor must be greater than or equal to 1")
+	}
+
+	if p.MinPricePublishers == 0 {
+		return fmt.Errorf("oracle parameter MinPricePublishers must be > 0")
+	}
+
+	if p.HaltRecoveryPeriods == 0 {
+		return fmt.Errorf("oracle parameter HaltRecoveryPeriods must be > 0")
+	}
+
 	for _, denom := range p.Whitelist {
 		if len(denom.Name) == 0 {
 			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
 		}
+
+		if err := denom.Validate(); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+// DenomRewardBand returns the reward band of the whitelisted denom, if the denom does not
+// override it the global RewardBand is returned
+func (p Params) DenomRewardBand(denom string) sdk.Dec {
+	whitelistDenom, _ := p.Whitelist.Get(denom)
+	return whitelistDenom.RewardBandOrDefault(p.RewardBand)
+}
+
 func validateVotePeriod(i interface{}) error {
 	v, ok := i.(uint64) // Data type must be uint64
 	if !ok {
@@ -177,6 +262,10 @@ func validateWhitelist(i interface{}) error {
 		if len(denom.Name) == 0 {
 			return fmt.Errorf("oracle parameter Whitelist Denom must have elements")
 		}
+
+		if err := denom.Validate(); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -237,3 +326,130 @@ func validateLookbackDuration(i interface{}) error {
 
 	return nil
 }
+
+func validateRewardDistributionWindow(i interface{}) error {
+	v, ok := i.(uint64) // Data type must be uint64
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("reward distribution window must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateRewardPoolShare(i interface{}) error {
+	v, ok := i.(sdk.Dec) // Data type must be Decimal from cosmos sdk
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("reward pool share must be positive: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) { // Parameter cannot be greater than 1.00
+		return fmt.Errorf("reward pool share is too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxMissedVotePeriods(i interface{}) error {
+	_, ok := i.(uint64) // Data type must be uint64
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxPriceDeviation(i interface{}) error {
+	v, ok := i.(sdk.Dec) // Data type must be Decimal from cosmos sdk
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max price deviation must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateJailDuration(i interface{}) error {
+	_, ok := i.(uint64) // Data type must be uint64
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePenaltyEscalationWindows(i interface{}) error {
+	_, ok := i.(uint64) // Data type must be uint64
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePenaltyEscalationFactor(i interface{}) error {
+	v, ok := i.(sdk.Dec) // Data type must be Decimal from cosmos sdk
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.LT(sdk.OneDec()) { // Parameter cannot reduce the penalties
+		return fmt.Errorf("penalty escalation factor must be greater than or equal to 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxAttestationAge(i interface{}) error {
+	_, ok := i.(uint64) // Data type must be uint64
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxPenaltyEscalationLevel(i interface{}) error {
+	_, ok := i.(uint64) // Data type must be uint64
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMinPricePublishers(i interface{}) error {
+	v, ok := i.(uint64) // Data type must be uint64
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 { // At least one publisher price is aggregated
+		return fmt.Errorf("min price publishers must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateHaltRecoveryPeriods(i interface{}) error {
+	v, ok := i.(uint64) // Data type must be uint64
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 { // The halted denoms must be able to resume
+		return fmt.Errorf("halt recovery periods must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -24,6 +24,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ExchangeRateStatus defines if an exchange rate is safe to be used
+type ExchangeRateStatus int32
+
+const (
+	// the exchange rate is updated on every vote period
+	ExchangeRateStatusActive ExchangeRateStatus = 0
+	// the exchange rate missed more vote periods than allowed
+	ExchangeRateStatusStale ExchangeRateStatus = 1
+	// the last median moved more than the max price deviation from the previous exchange rate
+	ExchangeRateStatusHalted ExchangeRateStatus = 2
+)
+
+var ExchangeRateStatus_name = map[int32]string{
+	0: "EXCHANGE_RATE_STATUS_ACTIVE",
+	1: "EXCHANGE_RATE_STATUS_STALE",
+	2: "EXCHANGE_RATE_STATUS_HALTED",
+}
+
+var ExchangeRateStatus_value = map[string]int32{
+	"EXCHANGE_RATE_STATUS_ACTIVE": 0,
+	"EXCHANGE_RATE_STATUS_STALE":  1,
+	"EXCHANGE_RATE_STATUS_HALTED": 2,
+}
+
+func (x ExchangeRateStatus) String() string {
+	return proto.EnumName(ExchangeRateStatus_name, int32(x))
+}
+
+func (ExchangeRateStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2db12dafa3fbe2a3, []int{0}
+}
+
 // Params defines the parameters for the module
 type Params struct {
 	// The number of blocks per voting
@@ -47,6 +79,42 @@ type Params struct {
 	MinValidPerWindow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_valid_per_window" yaml:"min_valid_per_window"`
 	// How far back (in blocks) the module can compute historical price metrics
 	LookbackDuration uint64 `protobuf:"varint,9,opt,name=lookback_duration,json=lookbackDuration,proto3" json:"lookback_duration,omitempty" yaml:"lookback_duration"`
+	// Number of blocks over which the reward pool is paid out to the ballot winners. On each
+	// vote period the pool pays out vote_period / reward_distribution_window of its balance
+	RewardDistributionWindow uint64 `protobuf:"varint,10,opt,name=reward_distribution_window,json=rewardDistributionWindow,proto3" json:"reward_distribution_window,omitempty" yaml:"reward_distribution_window"`
+	// Share of the fee collector balance (transaction fees plus minted inflation) moved into
+	// the oracle reward pool on each block. For instance, if reward_pool_share = 0.05 then 5%
+	// of the collected fees funds the oracle voters
+	// "github.com/cosmos/cosmos-sdk/types.Dec" = Cosmos SDK decimal data type
+	RewardPoolShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=reward_pool_share,json=rewardPoolShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_pool_share" yaml:"reward_pool_share"`
+	// Number of vote periods a denom can miss before its exchange rate is marked as stale. Zero disables the check
+	MaxMissedVotePeriods uint64 `protobuf:"varint,12,opt,name=max_missed_vote_periods,json=maxMissedVotePeriods,proto3" json:"max_missed_vote_periods,omitempty" yaml:"max_missed_vote_periods"`
+	// Maximum change allowed between the new median and the previous exchange rate. For instance, if max_price_deviation = 0.2
+	// a denom whose price moves more than 20% in one vote period is marked as halted. Zero disables the circuit breaker
+	// "github.com/cosmos/cosmos-sdk/types.Dec" = Cosmos SDK decimal data type
+	MaxPriceDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=max_price_deviation,json=maxPriceDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_deviation" yaml:"max_price_deviation"`
+	// Time (in seconds) a validator penalized by the oracle must stay jailed before it can unjail. Zero jails
+	// the validator without a minimum duration
+	JailDuration uint64 `protobuf:"varint,14,opt,name=jail_duration,json=jailDuration,proto3" json:"jail_duration,omitempty" yaml:"jail_duration"`
+	// Number of consecutive slash windows below min_valid_per_window before the penalties escalate. For instance, if
+	// penalty_escalation_windows = 3 the slash fraction and jail duration are multiplied by penalty_escalation_factor
+	// on the third consecutive bad window, and again on each multiple of 3. Zero disables the escalation
+	PenaltyEscalationWindows uint64 `protobuf:"varint,15,opt,name=penalty_escalation_windows,json=penaltyEscalationWindows,proto3" json:"penalty_escalation_windows,omitempty" yaml:"penalty_escalation_windows"`
+	// Multiplier applied to the slash fraction and jail duration on each escalation level
+	// "github.com/cosmos/cosmos-sdk/types.Dec" = Cosmos SDK decimal data type
+	PenaltyEscalationFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=penalty_escalation_factor,json=penaltyEscalationFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"penalty_escalation_factor" yaml:"penalty_escalation_factor"`
+	// Maximum age (in seconds) of a signed price attestation compared with the block time, older or future attestations
+	// are rejected. Zero disables the pull oracle
+	MaxAttestationAge uint64 `protobuf:"varint,17,opt,name=max_attestation_age,json=maxAttestationAge,proto3" json:"max_attestation_age,omitempty" yaml:"max_attestation_age"`
+	// Maximum escalation level applied to the penalties. The consecutive bad windows beyond it keep the penalties of
+	// the max level, the jail duration is also capped at the max time.Duration
+	MaxPenaltyEscalationLevel uint64 `protobuf:"varint,18,opt,name=max_penalty_escalation_level,json=maxPenaltyEscalationLevel,proto3" json:"max_penalty_escalation_level,omitempty" yaml:"max_penalty_escalation_level"`
+	// Minimum number of publishers with a fresh price of a denom required to update its pull oracle price, the
+	// pull oracle price is the median of the fresh publisher prices
+	MinPricePublishers uint64 `protobuf:"varint,19,opt,name=min_price_publishers,json=minPricePublishers,proto3" json:"min_price_publishers,omitempty" yaml:"min_price_publishers"`
+	// Number of consecutive vote periods whose medians must agree with each other, within the max_price_deviation,
+	// before a halted denom resumes with the new median as its exchange rate
+	HaltRecoveryPeriods uint64 `protobuf:"varint,20,opt,name=halt_recovery_periods,json=haltRecoveryPeriods,proto3" json:"halt_recovery_periods,omitempty" yaml:"halt_recovery_periods"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -109,10 +177,73 @@ func (m *Params) GetLookbackDuration() uint64 {
 	return 0
 }
 
+func (m *Params) GetRewardDistributionWindow() uint64 {
+	if m != nil {
+		return m.RewardDistributionWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMissedVotePeriods() uint64 {
+	if m != nil {
+		return m.MaxMissedVotePeriods
+	}
+	return 0
+}
+
+func (m *Params) GetJailDuration() uint64 {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetPenaltyEscalationWindows() uint64 {
+	if m != nil {
+		return m.PenaltyEscalationWindows
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAttestationAge() uint64 {
+	if m != nil {
+		return m.MaxAttestationAge
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPenaltyEscalationLevel() uint64 {
+	if m != nil {
+		return m.MaxPenaltyEscalationLevel
+	}
+	return 0
+}
+
+func (m *Params) GetMinPricePublishers() uint64 {
+	if m != nil {
+		return m.MinPricePublishers
+	}
+	return 0
+}
+
+func (m *Params) GetHaltRecoveryPeriods() uint64 {
+	if m != nil {
+		return m.HaltRecoveryPeriods
+	}
+	return 0
+}
+
 // Data type which has the name of the currency
 type Denom struct {
 	// Stores the name of a token pair, e.g: "BTC/USD"
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// Acceptable desviation from the media price for this denom, overrides Params.reward_band when set
+	// "github.com/cosmos/cosmos-sdk/types.Dec" = Cosmos SDK decimal data type
+	RewardBand *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=reward_band,json=rewardBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_band,omitempty" yaml:"reward_band,omitempty"`
+	// Minimum number of distinct validators that must vote this denom to pass the ballot. Zero disables the check
+	MinVoters uint64 `protobuf:"varint,3,opt,name=min_voters,json=minVoters,proto3" json:"min_voters,omitempty" yaml:"min_voters,omitempty"`
+	// Maximum age (in blocks) of the exchange rate before it is considered stale. Zero disables the check
+	MaxStaleness uint64 `protobuf:"varint,4,opt,name=max_staleness,json=maxStaleness,proto3" json:"max_staleness,omitempty" yaml:"max_staleness,omitempty"`
 }
 
 func (m *Denom) Reset()      { *m = Denom{} }
@@ -186,6 +317,46 @@ func (m *AggregateExchangeRateVote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AggregateExchangeRateVote proto.InternalMessageInfo
 
+// Data type that stores the salted hash of an exchange rate vote, submitted
+// one vote period before the vote itself is revealed
+type AggregateExchangeRatePrevote struct {
+	Hash        string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty" yaml:"hash"`
+	Voter       string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
+	SubmitBlock uint64 `protobuf:"varint,3,opt,name=submit_block,json=submitBlock,proto3" json:"submit_block,omitempty" yaml:"submit_block"`
+}
+
+func (m *AggregateExchangeRatePrevote) Reset()      { *m = AggregateExchangeRatePrevote{} }
+func (*AggregateExchangeRatePrevote) ProtoMessage() {}
+func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2db12dafa3fbe2a3, []int{3}
+}
+func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregateExchangeRatePrevote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateExchangeRatePrevote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregateExchangeRatePrevote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateExchangeRatePrevote.Merge(m, src)
+}
+func (m *AggregateExchangeRatePrevote) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateExchangeRatePrevote) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateExchangeRatePrevote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateExchangeRatePrevote proto.InternalMessageInfo
+
 // Data type that represet a signle exchange rate vote inside AggregateExchangeRateVote
 type ExchangeRateTuple struct {
 	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
@@ -195,7 +366,7 @@ type ExchangeRateTuple struct {
 func (m *ExchangeRateTuple) Reset()      { *m = ExchangeRateTuple{} }
 func (*ExchangeRateTuple) ProtoMessage() {}
 func (*ExchangeRateTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2db12dafa3fbe2a3, []int{3}
+	return fileDescriptor_2db12dafa3fbe2a3, []int{4}
 }
 func (m *ExchangeRateTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +406,7 @@ type OracleExchangeRate struct {
 func (m *OracleExchangeRate) Reset()      { *m = OracleExchangeRate{} }
 func (*OracleExchangeRate) ProtoMessage() {}
 func (*OracleExchangeRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2db12dafa3fbe2a3, []int{4}
+	return fileDescriptor_2db12dafa3fbe2a3, []int{5}
 }
 func (m *OracleExchangeRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,6 +435,48 @@ func (m *OracleExchangeRate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OracleExchangeRate proto.InternalMessageInfo
 
+// Data type that stores the recovery of a denom halted by the circuit breaker, the median of every vote period
+// is compared with the median of the previous one until enough consecutive medians agree
+type DenomHalt struct {
+	// median of the last vote period of the halted denom
+	LastExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=last_exchange_rate,json=lastExchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_exchange_rate" yaml:"last_exchange_rate"`
+	// number of consecutive vote periods whose median agreed with the previous median
+	AgreeingPeriods uint64 `protobuf:"varint,2,opt,name=agreeing_periods,json=agreeingPeriods,proto3" json:"agreeing_periods,omitempty" yaml:"agreeing_periods"`
+}
+
+func (m *DenomHalt) Reset()         { *m = DenomHalt{} }
+func (m *DenomHalt) String() string { return proto.CompactTextString(m) }
+func (*DenomHalt) ProtoMessage()    {}
+func (*DenomHalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2db12dafa3fbe2a3, []int{6}
+}
+func (m *DenomHalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomHalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomHalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomHalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomHalt.Merge(m, src)
+}
+func (m *DenomHalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomHalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomHalt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomHalt proto.InternalMessageInfo
+
 // Data type represents one historical price record for a single exchange rate
 type PriceSnapshotItem struct {
 	Denom              string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -274,7 +487,7 @@ func (m *PriceSnapshotItem) Reset()         { *m = PriceSnapshotItem{} }
 func (m *PriceSnapshotItem) String() string { return proto.CompactTextString(m) }
 func (*PriceSnapshotItem) ProtoMessage()    {}
 func (*PriceSnapshotItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2db12dafa3fbe2a3, []int{5}
+	return fileDescriptor_2db12dafa3fbe2a3, []int{7}
 }
 func (m *PriceSnapshotItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,7 +541,7 @@ func (m *PriceSnapshot) Reset()         { *m = PriceSnapshot{} }
 func (m *PriceSnapshot) String() string { return proto.CompactTextString(m) }
 func (*PriceSnapshot) ProtoMessage()    {}
 func (*PriceSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2db12dafa3fbe2a3, []int{6}
+	return fileDescriptor_2db12dafa3fbe2a3, []int{8}
 }
 func (m *PriceSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -383,7 +596,7 @@ func (m *OracleTwap) Reset()         { *m = OracleTwap{} }
 func (m *OracleTwap) String() string { return proto.CompactTextString(m) }
 func (*OracleTwap) ProtoMessage()    {}
 func (*OracleTwap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2db12dafa3fbe2a3, []int{7}
+	return fileDescriptor_2db12dafa3fbe2a3, []int{9}
 }
 func (m *OracleTwap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -426,6 +639,136 @@ func (m *OracleTwap) GetLookbackSeconds() int64 {
 	return 0
 }
 
+// Ema = Exponential moving average
+// Data type that computes the exponential moving average of the price over an specific period of time
+type OracleEma struct {
+	Denom           string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Ema             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=ema,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ema" yaml:"ema"`
+	LookbackSeconds int64                                  `protobuf:"varint,3,opt,name=lookback_seconds,json=lookbackSeconds,proto3" json:"lookback_seconds,omitempty"`
+	HalfLifeSeconds uint64                                 `protobuf:"varint,4,opt,name=half_life_seconds,json=halfLifeSeconds,proto3" json:"half_life_seconds,omitempty"`
+}
+
+func (m *OracleEma) Reset()         { *m = OracleEma{} }
+func (m *OracleEma) String() string { return proto.CompactTextString(m) }
+func (*OracleEma) ProtoMessage()    {}
+func (*OracleEma) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2db12dafa3fbe2a3, []int{10}
+}
+func (m *OracleEma) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleEma) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleEma.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleEma) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleEma.Merge(m, src)
+}
+func (m *OracleEma) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleEma) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleEma.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleEma proto.InternalMessageInfo
+
+func (m *OracleEma) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *OracleEma) GetLookbackSeconds() int64 {
+	if m != nil {
+		return m.LookbackSeconds
+	}
+	return 0
+}
+
+func (m *OracleEma) GetHalfLifeSeconds() uint64 {
+	if m != nil {
+		return m.HalfLifeSeconds
+	}
+	return 0
+}
+
+// Data type with the statistics of the price over an specific period of time
+type OraclePriceStats struct {
+	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Median github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=median,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"median" yaml:"median"`
+	Min    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min" yaml:"min"`
+	Max    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max" yaml:"max"`
+	// standard deviation of the prices
+	Volatility github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=volatility,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volatility" yaml:"volatility"`
+	// number of snapshots used to compute the statistics
+	SampleCount     uint64 `protobuf:"varint,6,opt,name=sample_count,json=sampleCount,proto3" json:"sample_count,omitempty"`
+	LookbackSeconds int64  `protobuf:"varint,7,opt,name=lookback_seconds,json=lookbackSeconds,proto3" json:"lookback_seconds,omitempty"`
+}
+
+func (m *OraclePriceStats) Reset()         { *m = OraclePriceStats{} }
+func (m *OraclePriceStats) String() string { return proto.CompactTextString(m) }
+func (*OraclePriceStats) ProtoMessage()    {}
+func (*OraclePriceStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2db12dafa3fbe2a3, []int{11}
+}
+func (m *OraclePriceStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OraclePriceStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OraclePriceStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OraclePriceStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OraclePriceStats.Merge(m, src)
+}
+func (m *OraclePriceStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *OraclePriceStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_OraclePriceStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OraclePriceStats proto.InternalMessageInfo
+
+func (m *OraclePriceStats) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *OraclePriceStats) GetSampleCount() uint64 {
+	if m != nil {
+		return m.SampleCount
+	}
+	return 0
+}
+
+func (m *OraclePriceStats) GetLookbackSeconds() int64 {
+	if m != nil {
+		return m.LookbackSeconds
+	}
+	return 0
+}
+
 // Data type that tracks the voting behavior per validator
 type VotePenaltyCounter struct {
 	MissCount    uint64 `protobuf:"varint,1,opt,name=miss_count,json=missCount,proto3" json:"miss_count,omitempty"`
@@ -437,7 +780,7 @@ func (m *VotePenaltyCounter) Reset()         { *m = VotePenaltyCounter{} }
 func (m *VotePenaltyCounter) String() string { return proto.CompactTextString(m) }
 func (*VotePenaltyCounter) ProtoMessage()    {}
 func (*VotePenaltyCounter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2db12dafa3fbe2a3, []int{8}
+	return fileDescriptor_2db12dafa3fbe2a3, []int{12}
 }
 func (m *VotePenaltyCounter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,109 +830,573 @@ func (m *VotePenaltyCounter) GetSuccessCount() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "kiichain.kiichain3.oracle.Params")
-	proto.RegisterType((*Denom)(nil), "kiichain.kiichain3.oracle.Denom")
-	proto.RegisterType((*AggregateExchangeRateVote)(nil), "kiichain.kiichain3.oracle.AggregateExchangeRateVote")
-	proto.RegisterType((*ExchangeRateTuple)(nil), "kiichain.kiichain3.oracle.ExchangeRateTuple")
-	proto.RegisterType((*OracleExchangeRate)(nil), "kiichain.kiichain3.oracle.OracleExchangeRate")
-	proto.RegisterType((*PriceSnapshotItem)(nil), "kiichain.kiichain3.oracle.PriceSnapshotItem")
-	proto.RegisterType((*PriceSnapshot)(nil), "kiichain.kiichain3.oracle.PriceSnapshot")
-	proto.RegisterType((*OracleTwap)(nil), "kiichain.kiichain3.oracle.OracleTwap")
-	proto.RegisterType((*VotePenaltyCounter)(nil), "kiichain.kiichain3.oracle.VotePenaltyCounter")
+// Data type that tracks the voting performance of a validator on a closed slash window
+type OracleWindowStats struct {
+	SuccessCount uint64 `protobuf:"varint,1,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	AbstainCount uint64 `protobuf:"varint,2,opt,name=abstain_count,json=abstainCount,proto3" json:"abstain_count,omitempty"`
+	MissCount    uint64 `protobuf:"varint,3,opt,name=miss_count,json=missCount,proto3" json:"miss_count,omitempty"`
+	// sum of the relative deviations of the votes from the final weighted median
+	DeviationSum   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=deviation_sum,json=deviationSum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"deviation_sum" yaml:"deviation_sum"`
+	DeviationCount uint64                                 `protobuf:"varint,5,opt,name=deviation_count,json=deviationCount,proto3" json:"deviation_count,omitempty"`
+	// true if the validator was slashed at the end of the window
+	Slashed bool `protobuf:"varint,6,opt,name=slashed,proto3" json:"slashed,omitempty"`
+	// block height where the window was closed
+	EndHeight int64 `protobuf:"varint,7,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
 }
 
-func init() { proto.RegisterFile("oracle/params.proto", fileDescriptor_2db12dafa3fbe2a3) }
+func (m *OracleWindowStats) Reset()         { *m = OracleWindowStats{} }
+func (m *OracleWindowStats) String() string { return proto.CompactTextString(m) }
+func (*OracleWindowStats) ProtoMessage()    {}
+func (*OracleWindowStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2db12dafa3fbe2a3, []int{13}
+}
+func (m *OracleWindowStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleWindowStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleWindowStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleWindowStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleWindowStats.Merge(m, src)
+}
+func (m *OracleWindowStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleWindowStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleWindowStats.DiscardUnknown(m)
+}
 
-var fileDescriptor_2db12dafa3fbe2a3 = []byte{
-	// 968 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xbf, 0x8f, 0x1b, 0x45,
-	0x14, 0xf6, 0xde, 0x2f, 0xf0, 0xd8, 0x26, 0xe7, 0x39, 0x07, 0x7c, 0x90, 0x78, 0xad, 0x89, 0x88,
-	0x0e, 0x29, 0xb1, 0xa5, 0x5c, 0x81, 0xb0, 0x44, 0x81, 0x39, 0x0e, 0x9d, 0x14, 0xc4, 0x31, 0x31,
-	0x87, 0x44, 0xb3, 0x1a, 0xef, 0x0e, 0xf6, 0xc8, 0xbb, 0x3b, 0xab, 0x9d, 0x71, 0x9c, 0x2b, 0x40,
-	0x94, 0x94, 0x29, 0xa1, 0xbb, 0x9a, 0x3f, 0x80, 0xbf, 0x21, 0x05, 0x45, 0x4a, 0x44, 0xb1, 0xa0,
-	0xbb, 0x86, 0x86, 0xc6, 0x1d, 0x1d, 0x9a, 0xd9, 0x59, 0x7b, 0xef, 0xf6, 0x12, 0x64, 0xa1, 0x54,
-	0xbb, 0xef, 0x7b, 0x6f, 0xbe, 0xf7, 0xe6, 0x9b, 0xf7, 0x46, 0x03, 0x76, 0x78, 0x4c, 0x5c, 0x9f,
-	0x76, 0x23, 0x12, 0x93, 0x40, 0x74, 0xa2, 0x98, 0x4b, 0x0e, 0x77, 0x27, 0x8c, 0xb9, 0x63, 0xc2,
-	0xc2, 0x4e, 0xf6, 0xb3, 0xdf, 0x49, 0xe3, 0xde, 0x6e, 0x8c, 0xf8, 0x88, 0xeb, 0xa8, 0xae, 0xfa,
-	0x4b, 0x17, 0xa0, 0xef, 0xb7, 0xc0, 0xd6, 0xb1, 0x66, 0x80, 0xef, 0x83, 0xca, 0x63, 0x2e, 0xa9,
-	0x13, 0xd1, 0x98, 0x71, 0xaf, 0x69, 0xb5, 0xad, 0xbd, 0x8d, 0xfe, 0x9b, 0xf3, 0xc4, 0x86, 0xa7,
-	0x24, 0xf0, 0x7b, 0x28, 0xe7, 0x44, 0x18, 0x28, 0xeb, 0x58, 0x1b, 0x30, 0x04, 0x6f, 0x68, 0x9f,
-	0x1c, 0xc7, 0x54, 0x8c, 0xb9, 0xef, 0x35, 0xd7, 0xda, 0xd6, 0x5e, 0xb9, 0xff, 0xe9, 0xb3, 0xc4,
-	0x2e, 0xfd, 0x9e, 0xd8, 0x77, 0x47, 0x4c, 0x8e, 0xa7, 0xc3, 0x8e, 0xcb, 0x83, 0xae, 0xcb, 0x45,
-	0xc0, 0x85, 0xf9, 0xdc, 0x17, 0xde, 0xa4, 0x2b, 0x4f, 0x23, 0x2a, 0x3a, 0x07, 0xd4, 0x9d, 0x27,
-	0xf6, 0xcd, 0x5c, 0xa6, 0x05, 0x1b, 0xc2, 0x35, 0x05, 0x0c, 0x32, 0x1b, 0x52, 0x50, 0x89, 0xe9,
-	0x8c, 0xc4, 0x9e, 0x33, 0x24, 0xa1, 0xd7, 0x5c, 0xd7, 0xc9, 0x0e, 0x56, 0x4e, 0x66, 0xb6, 0x95,
-	0xa3, 0x42, 0x18, 0xa4, 0x56, 0x9f, 0x84, 0x2a, 0x4d, 0x79, 0x36, 0x66, 0x92, 0xfa, 0x4c, 0xc8,
-	0xe6, 0x46, 0x7b, 0x7d, 0xaf, 0xf2, 0xa0, 0xdd, 0x79, 0xa1, 0xbe, 0x9d, 0x03, 0x1a, 0xf2, 0xa0,
-	0xff, 0xae, 0x2a, 0x63, 0x9e, 0xd8, 0xdb, 0x29, 0xf9, 0x82, 0x00, 0xfd, 0xfc, 0x87, 0x5d, 0xd6,
-	0x21, 0x0f, 0x99, 0x90, 0x78, 0xc9, 0xac, 0xd4, 0x13, 0x3e, 0x11, 0x63, 0xe7, 0x9b, 0x98, 0xb8,
-	0x92, 0xf1, 0xb0, 0xb9, 0xf9, 0xff, 0xd4, 0xbb, 0xcc, 0x86, 0x70, 0x4d, 0x03, 0x87, 0xc6, 0x86,
-	0x3d, 0x50, 0x4d, 0x23, 0x66, 0x2c, 0xf4, 0xf8, 0xac, 0xb9, 0xa5, 0xcf, 0xf9, 0xad, 0x79, 0x62,
-	0xef, 0xe4, 0xd7, 0xa7, 0x5e, 0x84, 0x2b, 0xda, 0xfc, 0x4a, 0x5b, 0xf0, 0x3b, 0xd0, 0x08, 0x58,
-	0xe8, 0x3c, 0x26, 0x3e, 0xf3, 0x54, 0x2b, 0x64, 0x1c, 0xaf, 0xe9, 0x8a, 0x3f, 0x5b, 0xb9, 0xe2,
-	0x77, 0xd2, 0x8c, 0xd7, 0x71, 0x22, 0x5c, 0x0f, 0x58, 0x78, 0xa2, 0xd0, 0x63, 0x1a, 0x9b, 0xfc,
-	0x47, 0xa0, 0xee, 0x73, 0x3e, 0x19, 0x12, 0x77, 0xe2, 0x78, 0xd3, 0x98, 0x68, 0xb9, 0xca, 0x7a,
-	0x03, 0xb7, 0xe6, 0x89, 0xdd, 0x4c, 0xe9, 0x0a, 0x21, 0x08, 0x6f, 0x67, 0xd8, 0x81, 0x81, 0x7a,
-	0xaf, 0xff, 0x78, 0x66, 0x97, 0xfe, 0x3a, 0xb3, 0x2d, 0xd4, 0x03, 0x9b, 0xfa, 0x60, 0xe0, 0x1d,
-	0xb0, 0x11, 0x92, 0x80, 0xea, 0xce, 0x2f, 0xf7, 0x6f, 0xcc, 0x13, 0xbb, 0x92, 0x12, 0x2a, 0x14,
-	0x61, 0xed, 0xec, 0x55, 0x7f, 0x38, 0xb3, 0x4b, 0x66, 0x6d, 0x09, 0xfd, 0x6d, 0x81, 0xdd, 0x8f,
-	0x46, 0xa3, 0x98, 0x8e, 0x88, 0xa4, 0x9f, 0x3c, 0x71, 0xc7, 0x24, 0x1c, 0x51, 0x4c, 0x24, 0x3d,
-	0xe1, 0x92, 0xc2, 0x9f, 0x2c, 0xd0, 0xa0, 0x06, 0x74, 0x62, 0xa2, 0x9a, 0x7a, 0x1a, 0xf9, 0x54,
-	0x34, 0x2d, 0xdd, 0x4d, 0xf7, 0x5e, 0xd2, 0x4d, 0x79, 0xae, 0x81, 0x5a, 0xd4, 0xff, 0xc0, 0x74,
-	0x96, 0xd1, 0xec, 0x3a, 0x5e, 0xd5, 0x64, 0xb0, 0xb0, 0x52, 0x60, 0x48, 0x0b, 0x18, 0xbc, 0x0b,
-	0x36, 0xd5, 0x54, 0xc5, 0x66, 0x56, 0xb7, 0xe7, 0x89, 0x5d, 0x5d, 0x4e, 0x5f, 0x8c, 0x70, 0xea,
-	0xbe, 0xb2, 0xdf, 0x5f, 0x2c, 0x50, 0x2f, 0x24, 0x50, 0x5c, 0x9e, 0x52, 0xd0, 0x28, 0x97, 0xe3,
-	0xd2, 0x30, 0xc2, 0xa9, 0x1b, 0x4e, 0x40, 0xed, 0x52, 0xd9, 0x26, 0xf7, 0xe1, 0xca, 0x7d, 0xd3,
-	0xb8, 0x46, 0x03, 0x84, 0xab, 0xf9, 0x6d, 0x5e, 0x29, 0xfc, 0xd7, 0x35, 0x00, 0x3f, 0xd7, 0xd2,
-	0xe6, 0xcb, 0x2f, 0x56, 0x64, 0xbd, 0xba, 0x8a, 0xd4, 0xbd, 0xe5, 0x13, 0x21, 0x9d, 0x69, 0xe4,
-	0x2d, 0x37, 0xbf, 0xca, 0xbd, 0x75, 0x14, 0xca, 0xe5, 0xbd, 0x95, 0xa3, 0x42, 0x18, 0x28, 0xeb,
-	0x4b, 0x6d, 0xc0, 0x01, 0xb8, 0x99, 0xf3, 0x39, 0x92, 0x05, 0x54, 0x48, 0x12, 0x44, 0xfa, 0xa2,
-	0x5c, 0xef, 0xb7, 0xe7, 0x89, 0x7d, 0xab, 0x40, 0xb1, 0x0c, 0x43, 0x78, 0x67, 0x49, 0x36, 0xc8,
-	0xd0, 0x2b, 0x72, 0x3e, 0xb5, 0x40, 0xfd, 0x38, 0x66, 0x2e, 0x7d, 0x14, 0x92, 0x48, 0x8c, 0xb9,
-	0x3c, 0x92, 0x34, 0x80, 0x8d, 0x4b, 0x7d, 0x90, 0x9d, 0x3a, 0x05, 0x8d, 0xb4, 0xa9, 0x9d, 0xe2,
-	0xe1, 0x57, 0x1e, 0xdc, 0x7f, 0xc9, 0x10, 0x14, 0x0f, 0xac, 0xbf, 0xa1, 0xe4, 0xc2, 0x90, 0x17,
-	0x3c, 0xe8, 0x1f, 0x0b, 0xd4, 0x2e, 0x95, 0x04, 0x1f, 0x02, 0x28, 0xcc, 0x7f, 0x4e, 0x05, 0x4b,
-	0xab, 0x70, 0x7b, 0x9e, 0xd8, 0xbb, 0xe6, 0xbe, 0x2b, 0xc4, 0x20, 0x5c, 0xcf, 0xc0, 0x85, 0x00,
-	0x7a, 0x98, 0x23, 0xc5, 0xef, 0x2c, 0x16, 0x30, 0x49, 0x03, 0xd1, 0x5c, 0xfb, 0xcf, 0x61, 0x2e,
-	0x28, 0x75, 0x75, 0x98, 0xaf, 0xe3, 0xd5, 0xc3, 0x5c, 0x58, 0x29, 0x30, 0x8c, 0x0a, 0x18, 0x3a,
-	0xb3, 0x00, 0x48, 0xc5, 0x1a, 0xcc, 0x48, 0xf4, 0x82, 0x73, 0xf8, 0x02, 0x6c, 0xc8, 0x19, 0x89,
-	0x4c, 0xdf, 0x7d, 0xb8, 0x72, 0x8b, 0x9b, 0xcb, 0x50, 0x71, 0x20, 0xac, 0xa9, 0xe0, 0x7b, 0x60,
-	0x71, 0xb1, 0x3a, 0x82, 0xba, 0x3c, 0xf4, 0x44, 0xda, 0x65, 0xf8, 0x46, 0x86, 0x3f, 0x4a, 0x61,
-	0xf4, 0x2d, 0x80, 0x27, 0xfa, 0xc9, 0x10, 0x12, 0x5f, 0x9e, 0x7e, 0xcc, 0xa7, 0xa1, 0xa4, 0x31,
-	0xbc, 0x0d, 0x40, 0xc0, 0x84, 0x70, 0x5c, 0x65, 0xa7, 0x4f, 0x0e, 0x5c, 0x56, 0x88, 0x0e, 0x80,
-	0x77, 0x40, 0x8d, 0x0c, 0x85, 0x24, 0x2c, 0x34, 0x11, 0x6b, 0x3a, 0xa2, 0x6a, 0xc0, 0x45, 0x90,
-	0x98, 0xba, 0x2e, 0x5d, 0xd0, 0xac, 0xa7, 0x41, 0x06, 0xd4, 0x41, 0xfd, 0xc3, 0x67, 0xe7, 0x2d,
-	0xeb, 0xf9, 0x79, 0xcb, 0xfa, 0xf3, 0xbc, 0x65, 0x3d, 0xbd, 0x68, 0x95, 0x9e, 0x5f, 0xb4, 0x4a,
-	0xbf, 0x5d, 0xb4, 0x4a, 0x5f, 0xdf, 0xcb, 0x09, 0x90, 0x9d, 0xdc, 0xe2, 0x67, 0xbf, 0xfb, 0xa4,
-	0x6b, 0xde, 0x59, 0x5a, 0x8a, 0xe1, 0x96, 0x7e, 0x36, 0xed, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0x84, 0xfe, 0xca, 0x18, 0x7e, 0x09, 0x00, 0x00,
+var xxx_messageInfo_OracleWindowStats proto.InternalMessageInfo
+
+func (m *OracleWindowStats) GetSuccessCount() uint64 {
+	if m != nil {
+		return m.SuccessCount
+	}
+	return 0
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *OracleWindowStats) GetAbstainCount() uint64 {
+	if m != nil {
+		return m.AbstainCount
 	}
+	return 0
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *OracleWindowStats) GetMissCount() uint64 {
+	if m != nil {
+		return m.MissCount
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *OracleWindowStats) GetDeviationCount() uint64 {
+	if m != nil {
+		return m.DeviationCount
 	}
-	if this.VotePeriod != that1.VotePeriod {
-		return false
+	return 0
+}
+
+func (m *OracleWindowStats) GetSlashed() bool {
+	if m != nil {
+		return m.Slashed
 	}
-	if !this.VoteThreshold.Equal(that1.VoteThreshold) {
-		return false
+	return false
+}
+
+func (m *OracleWindowStats) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// Data type that keeps the rolling voting performance of a validator across the past slash windows
+type ValidatorOracleStats struct {
+	// deviation of the votes on the slash window in progress
+	CurrentDeviationSum   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=current_deviation_sum,json=currentDeviationSum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_deviation_sum" yaml:"current_deviation_sum"`
+	CurrentDeviationCount uint64                                 `protobuf:"varint,2,opt,name=current_deviation_count,json=currentDeviationCount,proto3" json:"current_deviation_count,omitempty"`
+	// closed slash windows, from the oldest to the most recent
+	Windows []OracleWindowStats `protobuf:"bytes,3,rep,name=windows,proto3" json:"windows"`
+	// number of consecutive closed windows below the min valid per window
+	ConsecutiveBadWindows uint64 `protobuf:"varint,4,opt,name=consecutive_bad_windows,json=consecutiveBadWindows,proto3" json:"consecutive_bad_windows,omitempty"`
+}
+
+func (m *ValidatorOracleStats) Reset()         { *m = ValidatorOracleStats{} }
+func (m *ValidatorOracleStats) String() string { return proto.CompactTextString(m) }
+func (*ValidatorOracleStats) ProtoMessage()    {}
+func (*ValidatorOracleStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2db12dafa3fbe2a3, []int{14}
+}
+func (m *ValidatorOracleStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorOracleStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorOracleStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorOracleStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorOracleStats.Merge(m, src)
+}
+func (m *ValidatorOracleStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorOracleStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorOracleStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorOracleStats proto.InternalMessageInfo
+
+func (m *ValidatorOracleStats) GetCurrentDeviationCount() uint64 {
+	if m != nil {
+		return m.CurrentDeviationCount
+	}
+	return 0
+}
+
+func (m *ValidatorOracleStats) GetWindows() []OracleWindowStats {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+func (m *ValidatorOracleStats) GetConsecutiveBadWindows() uint64 {
+	if m != nil {
+		return m.ConsecutiveBadWindows
+	}
+	return 0
+}
+
+// Data type that stores the last oracle penalty applied to a validator
+type ValidatorOraclePenalty struct {
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	// number of consecutive windows below the min valid per window when the penalty was applied
+	ConsecutiveBadWindows uint64 `protobuf:"varint,2,opt,name=consecutive_bad_windows,json=consecutiveBadWindows,proto3" json:"consecutive_bad_windows,omitempty"`
+	// escalation level of the penalty, zero means the base slash fraction and jail duration
+	EscalationLevel uint64 `protobuf:"varint,3,opt,name=escalation_level,json=escalationLevel,proto3" json:"escalation_level,omitempty"`
+	// slash fraction applied to the validator
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+	// unix time (in seconds) until the validator can not unjail
+	JailedUntil int64 `protobuf:"varint,5,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+	// block height where the penalty was applied
+	PenaltyHeight int64 `protobuf:"varint,6,opt,name=penalty_height,json=penaltyHeight,proto3" json:"penalty_height,omitempty"`
+}
+
+func (m *ValidatorOraclePenalty) Reset()         { *m = ValidatorOraclePenalty{} }
+func (m *ValidatorOraclePenalty) String() string { return proto.CompactTextString(m) }
+func (*ValidatorOraclePenalty) ProtoMessage()    {}
+func (*ValidatorOraclePenalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2db12dafa3fbe2a3, []int{15}
+}
+func (m *ValidatorOraclePenalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorOraclePenalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorOraclePenalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorOraclePenalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorOraclePenalty.Merge(m, src)
+}
+func (m *ValidatorOraclePenalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorOraclePenalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorOraclePenalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorOraclePenalty proto.InternalMessageInfo
+
+func (m *ValidatorOraclePenalty) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+func (m *ValidatorOraclePenalty) GetConsecutiveBadWindows() uint64 {
+	if m != nil {
+		return m.ConsecutiveBadWindows
+	}
+	return 0
+}
+
+func (m *ValidatorOraclePenalty) GetEscalationLevel() uint64 {
+	if m != nil {
+		return m.EscalationLevel
+	}
+	return 0
+}
+
+func (m *ValidatorOraclePenalty) GetJailedUntil() int64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+func (m *ValidatorOraclePenalty) GetPenaltyHeight() int64 {
+	if m != nil {
+		return m.PenaltyHeight
+	}
+	return 0
+}
+
+// Data type that represents an off-chain price publisher registered by governance, the publisher
+// signs the price attestations submitted to the pull oracle
+type PricePublisher struct {
+	// Unique name of the publisher
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// Type of the publisher key, ed25519 or secp256k1
+	KeyType string `protobuf:"bytes,2,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty" yaml:"key_type"`
+	// Raw bytes of the publisher public key (32 bytes ed25519 or 33 bytes compressed secp256k1)
+	PubKey []byte `protobuf:"bytes,3,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty" yaml:"pub_key"`
+}
+
+func (m *PricePublisher) Reset()         { *m = PricePublisher{} }
+func (m *PricePublisher) String() string { return proto.CompactTextString(m) }
+func (*PricePublisher) ProtoMessage()    {}
+func (*PricePublisher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2db12dafa3fbe2a3, []int{16}
+}
+func (m *PricePublisher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PricePublisher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PricePublisher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PricePublisher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PricePublisher.Merge(m, src)
+}
+func (m *PricePublisher) XXX_Size() int {
+	return m.Size()
+}
+func (m *PricePublisher) XXX_DiscardUnknown() {
+	xxx_messageInfo_PricePublisher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PricePublisher proto.InternalMessageInfo
+
+// Data type that represents a batch of prices signed by a price publisher, the signature
+// is done over the protobuf encoding of this message
+type PriceAttestation struct {
+	// Name of the publisher that signed the prices
+	Publisher string `protobuf:"bytes,1,opt,name=publisher,proto3" json:"publisher,omitempty" yaml:"publisher"`
+	// Chain where the attestation is valid, avoids replaying attestations on other chains
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	// Unix time (in seconds) when the prices were observed
+	Timestamp int64              `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty" yaml:"timestamp"`
+	Prices    ExchangeRateTuples `protobuf:"bytes,4,rep,name=prices,proto3,castrepeated=ExchangeRateTuples" json:"prices" yaml:"prices"`
+}
+
+func (m *PriceAttestation) Reset()         { *m = PriceAttestation{} }
+func (m *PriceAttestation) String() string { return proto.CompactTextString(m) }
+func (*PriceAttestation) ProtoMessage()    {}
+func (*PriceAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2db12dafa3fbe2a3, []int{17}
+}
+func (m *PriceAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceAttestation.Merge(m, src)
+}
+func (m *PriceAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceAttestation proto.InternalMessageInfo
+
+// Data type that stores the latest price of a denom on the high frequency pull oracle feed, it is also used
+// to store the latest price signed by each publisher
+type PullPrice struct {
+	Denom string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// Unix time (in seconds) of the attestation, the oldest one of the aggregated prices
+	PublishTime int64 `protobuf:"varint,3,opt,name=publish_time,json=publishTime,proto3" json:"publish_time,omitempty"`
+	// Names of the publishers whose prices were aggregated
+	Publishers []string `protobuf:"bytes,4,rep,name=publishers,proto3" json:"publishers,omitempty"`
+	// Block height where the price was submitted
+	UpdateHeight int64 `protobuf:"varint,5,opt,name=update_height,json=updateHeight,proto3" json:"update_height,omitempty"`
+}
+
+func (m *PullPrice) Reset()         { *m = PullPrice{} }
+func (m *PullPrice) String() string { return proto.CompactTextString(m) }
+func (*PullPrice) ProtoMessage()    {}
+func (*PullPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2db12dafa3fbe2a3, []int{18}
+}
+func (m *PullPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PullPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PullPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PullPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullPrice.Merge(m, src)
+}
+func (m *PullPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *PullPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PullPrice proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("kiichain.kiichain3.oracle.ExchangeRateStatus", ExchangeRateStatus_name, ExchangeRateStatus_value)
+	proto.RegisterType((*Params)(nil), "kiichain.kiichain3.oracle.Params")
+	proto.RegisterType((*Denom)(nil), "kiichain.kiichain3.oracle.Denom")
+	proto.RegisterType((*AggregateExchangeRateVote)(nil), "kiichain.kiichain3.oracle.AggregateExchangeRateVote")
+	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "kiichain.kiichain3.oracle.AggregateExchangeRatePrevote")
+	proto.RegisterType((*ExchangeRateTuple)(nil), "kiichain.kiichain3.oracle.ExchangeRateTuple")
+	proto.RegisterType((*OracleExchangeRate)(nil), "kiichain.kiichain3.oracle.OracleExchangeRate")
+	proto.RegisterType((*DenomHalt)(nil), "kiichain.kiichain3.oracle.DenomHalt")
+	proto.RegisterType((*PriceSnapshotItem)(nil), "kiichain.kiichain3.oracle.PriceSnapshotItem")
+	proto.RegisterType((*PriceSnapshot)(nil), "kiichain.kiichain3.oracle.PriceSnapshot")
+	proto.RegisterType((*OracleTwap)(nil), "kiichain.kiichain3.oracle.OracleTwap")
+	proto.RegisterType((*OracleEma)(nil), "kiichain.kiichain3.oracle.OracleEma")
+	proto.RegisterType((*OraclePriceStats)(nil), "kiichain.kiichain3.oracle.OraclePriceStats")
+	proto.RegisterType((*VotePenaltyCounter)(nil), "kiichain.kiichain3.oracle.VotePenaltyCounter")
+	proto.RegisterType((*OracleWindowStats)(nil), "kiichain.kiichain3.oracle.OracleWindowStats")
+	proto.RegisterType((*ValidatorOracleStats)(nil), "kiichain.kiichain3.oracle.ValidatorOracleStats")
+	proto.RegisterType((*ValidatorOraclePenalty)(nil), "kiichain.kiichain3.oracle.ValidatorOraclePenalty")
+	proto.RegisterType((*PricePublisher)(nil), "kiichain.kiichain3.oracle.PricePublisher")
+	proto.RegisterType((*PriceAttestation)(nil), "kiichain.kiichain3.oracle.PriceAttestation")
+	proto.RegisterType((*PullPrice)(nil), "kiichain.kiichain3.oracle.PullPrice")
+}
+
+func init() { proto.RegisterFile("oracle/params.proto", fileDescriptor_2db12dafa3fbe2a3) }
+
+var fileDescriptor_2db12dafa3fbe2a3 = []byte{
+	// 2266 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x92, 0xb4, 0x64, 0x8e, 0x48, 0x89, 0x1a, 0xd1, 0xd1, 0x5a, 0x56, 0xb4, 0xf2, 0x18,
+	0x4e, 0xfc, 0x8d, 0x13, 0x19, 0x70, 0x80, 0x6f, 0x51, 0xb7, 0x6e, 0x41, 0x5a, 0xf2, 0x8f, 0x46,
+	0x71, 0x95, 0x11, 0xed, 0xb4, 0xbd, 0x2c, 0x86, 0xbb, 0x63, 0x72, 0xcb, 0xfd, 0xc1, 0xee, 0x2c,
+	0x25, 0x11, 0x68, 0x7b, 0xe8, 0xc9, 0xc8, 0xa1, 0xc8, 0xb1, 0x05, 0x1a, 0xc0, 0x40, 0x0f, 0x05,
+	0x7a, 0x6e, 0xfb, 0x0f, 0xf4, 0x62, 0xa0, 0x45, 0x91, 0x43, 0x0b, 0x24, 0x3d, 0xb0, 0x85, 0x7d,
+	0xe9, 0xa5, 0x17, 0xde, 0x7a, 0x2b, 0xe6, 0xc7, 0x2e, 0x97, 0x5c, 0xd2, 0x36, 0x63, 0xf4, 0x24,
+	0xce, 0x7b, 0x6f, 0x3e, 0xf3, 0x7e, 0xed, 0x7b, 0x6f, 0x46, 0x60, 0x3d, 0x08, 0x89, 0xe5, 0xd2,
+	0x6b, 0x5d, 0x12, 0x12, 0x8f, 0xed, 0x76, 0xc3, 0x20, 0x0a, 0xe0, 0xf9, 0x8e, 0xe3, 0x58, 0x6d,
+	0xe2, 0xf8, 0xbb, 0xf1, 0x8f, 0xf7, 0x77, 0xa5, 0xdc, 0x66, 0xb5, 0x15, 0xb4, 0x02, 0x21, 0x75,
+	0x8d, 0xff, 0x92, 0x1b, 0xd0, 0x5f, 0x56, 0xc0, 0xe2, 0xa1, 0x40, 0x80, 0x5f, 0x03, 0xcb, 0xc7,
+	0x41, 0x44, 0xcd, 0x2e, 0x0d, 0x9d, 0xc0, 0xd6, 0xb5, 0x1d, 0xed, 0x4a, 0xa1, 0xfe, 0xc6, 0x70,
+	0x60, 0xc0, 0x3e, 0xf1, 0xdc, 0x1b, 0x28, 0xc5, 0x44, 0x18, 0xf0, 0xd5, 0xa1, 0x58, 0x40, 0x1f,
+	0xac, 0x08, 0x5e, 0xd4, 0x0e, 0x29, 0x6b, 0x07, 0xae, 0xad, 0xe7, 0x76, 0xb4, 0x2b, 0xc5, 0xfa,
+	0x9d, 0xa7, 0x03, 0x63, 0xe1, 0xef, 0x03, 0xe3, 0xad, 0x96, 0x13, 0xb5, 0x7b, 0xcd, 0x5d, 0x2b,
+	0xf0, 0xae, 0x59, 0x01, 0xf3, 0x02, 0xa6, 0xfe, 0xbc, 0xc7, 0xec, 0xce, 0xb5, 0xa8, 0xdf, 0xa5,
+	0x6c, 0x77, 0x8f, 0x5a, 0xc3, 0x81, 0x71, 0x2e, 0x75, 0x52, 0x82, 0x86, 0x70, 0x99, 0x13, 0x1a,
+	0xf1, 0x1a, 0x52, 0xb0, 0x1c, 0xd2, 0x13, 0x12, 0xda, 0x66, 0x93, 0xf8, 0xb6, 0x9e, 0x17, 0x87,
+	0xed, 0xcd, 0x7d, 0x98, 0x32, 0x2b, 0x05, 0x85, 0x30, 0x90, 0xab, 0x3a, 0xf1, 0xf9, 0x31, 0xc5,
+	0x93, 0xb6, 0x13, 0x51, 0xd7, 0x61, 0x91, 0x5e, 0xd8, 0xc9, 0x5f, 0x59, 0xbe, 0xbe, 0xb3, 0x3b,
+	0xd3, 0xbf, 0xbb, 0x7b, 0xd4, 0x0f, 0xbc, 0xfa, 0x65, 0xae, 0xc6, 0x70, 0x60, 0x54, 0x24, 0x78,
+	0x02, 0x80, 0x7e, 0xfb, 0x0f, 0xa3, 0x28, 0x44, 0x0e, 0x1c, 0x16, 0xe1, 0x11, 0x32, 0xf7, 0x1e,
+	0x73, 0x09, 0x6b, 0x9b, 0x8f, 0x42, 0x62, 0x45, 0x4e, 0xe0, 0xeb, 0x67, 0x5e, 0xcf, 0x7b, 0xe3,
+	0x68, 0x08, 0x97, 0x05, 0xe1, 0xb6, 0x5a, 0xc3, 0x1b, 0xa0, 0x24, 0x25, 0x4e, 0x1c, 0xdf, 0x0e,
+	0x4e, 0xf4, 0x45, 0x11, 0xe7, 0x8d, 0xe1, 0xc0, 0x58, 0x4f, 0xef, 0x97, 0x5c, 0x84, 0x97, 0xc5,
+	0xf2, 0x63, 0xb1, 0x82, 0x3f, 0x05, 0x55, 0xcf, 0xf1, 0xcd, 0x63, 0xe2, 0x3a, 0x36, 0x4f, 0x85,
+	0x18, 0x63, 0x49, 0x68, 0xfc, 0xe1, 0xdc, 0x1a, 0x5f, 0x90, 0x27, 0x4e, 0xc3, 0x44, 0x78, 0xcd,
+	0x73, 0xfc, 0x87, 0x9c, 0x7a, 0x48, 0x43, 0x75, 0xfe, 0x3d, 0xb0, 0xe6, 0x06, 0x41, 0xa7, 0x49,
+	0xac, 0x8e, 0x69, 0xf7, 0x42, 0x22, 0xdc, 0x55, 0x14, 0x06, 0x6c, 0x0d, 0x07, 0x86, 0x2e, 0xe1,
+	0x32, 0x22, 0x08, 0x57, 0x62, 0xda, 0x9e, 0x22, 0x41, 0x0b, 0x6c, 0xaa, 0xc8, 0xdb, 0x0e, 0x8b,
+	0x42, 0xa7, 0xd9, 0xe3, 0xe4, 0xd8, 0x20, 0x20, 0x30, 0x2f, 0x0f, 0x07, 0xc6, 0xc5, 0xb1, 0x2c,
+	0x99, 0x22, 0x8b, 0xb0, 0x2e, 0x99, 0x7b, 0x29, 0x9e, 0xd2, 0xf7, 0x18, 0xac, 0xa9, 0x8d, 0xdd,
+	0x20, 0x70, 0x4d, 0xd6, 0x26, 0x21, 0xd5, 0x97, 0x85, 0xb3, 0xbe, 0x33, 0xb7, 0xb3, 0xf4, 0x31,
+	0x4d, 0x46, 0x80, 0x08, 0xaf, 0x4a, 0xda, 0x61, 0x10, 0xb8, 0x47, 0x9c, 0x02, 0xbf, 0x0f, 0x36,
+	0x3c, 0x72, 0x6a, 0x7a, 0x0e, 0x63, 0xd4, 0x36, 0x53, 0x1f, 0x2e, 0xd3, 0x4b, 0xc2, 0x32, 0x34,
+	0x1c, 0x18, 0xdb, 0xca, 0xf9, 0xd3, 0x05, 0x11, 0xae, 0x7a, 0xe4, 0xf4, 0x43, 0xc1, 0x78, 0x98,
+	0x7c, 0xeb, 0x0c, 0xfe, 0x18, 0xac, 0xf3, 0x1d, 0xdd, 0xd0, 0xb1, 0xa8, 0x69, 0xd3, 0x63, 0x47,
+	0x06, 0xa1, 0x2c, 0x8c, 0x3a, 0x98, 0xdb, 0xa8, 0xcd, 0x91, 0x12, 0x13, 0x90, 0x3c, 0x01, 0xc8,
+	0xe9, 0x21, 0x27, 0xee, 0xc5, 0x34, 0x78, 0x13, 0x94, 0x7f, 0x48, 0x1c, 0x77, 0x14, 0xfc, 0x15,
+	0x61, 0x8e, 0x3e, 0x1c, 0x18, 0x55, 0x89, 0x34, 0xc6, 0x46, 0xb8, 0xc4, 0xd7, 0xe9, 0xa0, 0x77,
+	0xa9, 0x4f, 0xdc, 0xa8, 0x6f, 0x52, 0x66, 0x11, 0x97, 0xa4, 0xe2, 0xc8, 0xf4, 0xd5, 0xc9, 0xa0,
+	0xcf, 0x96, 0x45, 0x58, 0x57, 0xcc, 0xfd, 0x84, 0x27, 0x63, 0xce, 0xe0, 0xcf, 0x35, 0x70, 0x7e,
+	0xca, 0xce, 0x47, 0xc4, 0x8a, 0x82, 0x50, 0xaf, 0x08, 0x47, 0xe1, 0xb9, 0x1d, 0xb5, 0x33, 0x53,
+	0x25, 0x09, 0x8c, 0xf0, 0x46, 0x46, 0xa3, 0xdb, 0x82, 0x03, 0xef, 0xcb, 0x90, 0x91, 0x28, 0xa2,
+	0x2c, 0x92, 0x7b, 0x48, 0x8b, 0xea, 0x6b, 0xc2, 0xdc, 0xed, 0xf1, 0x20, 0x4c, 0x08, 0xc9, 0x20,
+	0xd4, 0x46, 0xc4, 0x5a, 0x8b, 0xc2, 0x36, 0xd8, 0x12, 0xf1, 0xca, 0xaa, 0xe2, 0xd2, 0x63, 0xea,
+	0xea, 0x50, 0x00, 0xbf, 0x3d, 0x1c, 0x18, 0x97, 0x52, 0xd1, 0x9d, 0x21, 0x8d, 0xf0, 0x79, 0x1e,
+	0xe6, 0x49, 0xd5, 0x0f, 0x38, 0x0f, 0x7e, 0x24, 0xeb, 0x8d, 0xcc, 0x8c, 0x6e, 0xaf, 0xe9, 0x3a,
+	0xac, 0x4d, 0x43, 0xa6, 0xaf, 0x8b, 0x13, 0x8c, 0xf1, 0x0a, 0x32, 0x29, 0x85, 0x30, 0xf4, 0x1c,
+	0x5f, 0x24, 0xd0, 0x61, 0x42, 0x84, 0x0d, 0x70, 0xae, 0x4d, 0xdc, 0xc8, 0x0c, 0xa9, 0x15, 0x1c,
+	0xd3, 0xb0, 0x9f, 0x7c, 0x18, 0x55, 0x81, 0xb9, 0x33, 0x1c, 0x18, 0x5b, 0x12, 0x73, 0xaa, 0x18,
+	0xc2, 0xeb, 0x9c, 0x8e, 0x15, 0x59, 0x7d, 0x15, 0x37, 0xce, 0xfe, 0xe2, 0x89, 0xb1, 0xf0, 0xaf,
+	0x27, 0x86, 0x86, 0x7e, 0x97, 0x03, 0x67, 0x44, 0x9d, 0x87, 0x97, 0x40, 0xc1, 0x27, 0x1e, 0x15,
+	0x8d, 0xb4, 0x58, 0x5f, 0x1d, 0x0e, 0x8c, 0x65, 0x09, 0xcc, 0xa9, 0x08, 0x0b, 0x26, 0x0c, 0xc6,
+	0x7b, 0x99, 0x6c, 0x9c, 0xf7, 0x9f, 0x0e, 0x0c, 0x6d, 0xae, 0xec, 0xd8, 0xca, 0xf4, 0xb2, 0x77,
+	0x03, 0xcf, 0x89, 0xa8, 0xd7, 0x8d, 0xfa, 0xe3, 0x5d, 0xed, 0x5b, 0x00, 0x88, 0x72, 0x1b, 0x44,
+	0xdc, 0x91, 0xf9, 0x69, 0x8e, 0x94, 0xbc, 0x34, 0x40, 0x91, 0x97, 0x62, 0x41, 0x85, 0x77, 0x40,
+	0x99, 0x87, 0x93, 0x45, 0xc4, 0xa5, 0x3e, 0x65, 0x4c, 0x2f, 0x4c, 0x2b, 0x28, 0x09, 0x3b, 0x8d,
+	0x52, 0xf2, 0xc8, 0xe9, 0x51, 0xcc, 0xb8, 0x51, 0x7a, 0xfc, 0xc4, 0x58, 0x50, 0x6e, 0x5b, 0x40,
+	0xff, 0xd6, 0xc0, 0xf9, 0x5a, 0xab, 0x15, 0xd2, 0x16, 0x89, 0xe8, 0xfe, 0xa9, 0xd5, 0x26, 0x7e,
+	0x8b, 0x62, 0x12, 0x51, 0x7e, 0x2c, 0xfc, 0xa5, 0x06, 0xaa, 0x54, 0x11, 0xcd, 0x90, 0xf0, 0xe9,
+	0xa0, 0xd7, 0x75, 0x29, 0xd3, 0x35, 0xd1, 0x96, 0xdf, 0x7d, 0x41, 0x5b, 0x4e, 0x63, 0x35, 0xf8,
+	0xa6, 0xfa, 0xd7, 0x55, 0x8b, 0x56, 0x16, 0x4f, 0xc3, 0xe5, 0xdd, 0x1a, 0x66, 0x76, 0x32, 0x0c,
+	0x69, 0x86, 0x06, 0xdf, 0x02, 0x67, 0x84, 0xc3, 0x54, 0xec, 0x2a, 0xc3, 0x81, 0x51, 0x1a, 0x8d,
+	0x31, 0x21, 0xc2, 0x92, 0x3d, 0x61, 0xef, 0xef, 0x35, 0xb0, 0x35, 0xd5, 0xde, 0xc3, 0x90, 0x72,
+	0x79, 0x9e, 0x3d, 0x6d, 0xc2, 0xda, 0xd9, 0xec, 0xe1, 0x54, 0x84, 0x05, 0xf3, 0x55, 0xcf, 0x16,
+	0x3d, 0xbf, 0xd7, 0xf4, 0x9c, 0xc8, 0x6c, 0xba, 0x81, 0xd5, 0xd1, 0xf3, 0x99, 0x9e, 0x9f, 0xe2,
+	0xf2, 0x9e, 0x2f, 0x96, 0x75, 0xbe, 0x9a, 0xd0, 0xfb, 0x0f, 0x1a, 0x58, 0xcb, 0x38, 0x86, 0xeb,
+	0x61, 0xf3, 0x9c, 0xd7, 0xb5, 0x49, 0x3d, 0x04, 0x19, 0x61, 0xc9, 0x86, 0x1d, 0x50, 0x1e, 0x73,
+	0xb7, 0xd2, 0xfb, 0xf6, 0xdc, 0xd5, 0xb0, 0x3a, 0x25, 0x76, 0x08, 0x97, 0xd2, 0xe1, 0x99, 0x50,
+	0xfc, 0xcf, 0x39, 0x00, 0xbf, 0x2b, 0x52, 0x22, 0xad, 0x7e, 0x56, 0x23, 0xed, 0x7f, 0xa7, 0x11,
+	0x1f, 0x5c, 0x5d, 0xc2, 0x22, 0xb3, 0xd7, 0xb5, 0x47, 0xc6, 0xcf, 0x33, 0xb8, 0xde, 0xf3, 0xa3,
+	0xd1, 0xe0, 0x9a, 0x82, 0x42, 0x18, 0xf0, 0xd5, 0x03, 0xb1, 0xe0, 0x25, 0x2e, 0xc5, 0x33, 0x23,
+	0xc7, 0xa3, 0x2c, 0x22, 0x5e, 0x57, 0x84, 0x3d, 0x9f, 0x2e, 0x71, 0x53, 0xc5, 0x10, 0x5e, 0x1f,
+	0x81, 0x35, 0x62, 0xea, 0x84, 0x3b, 0xbf, 0xd0, 0x80, 0x1c, 0x67, 0xef, 0x12, 0x37, 0x82, 0x7d,
+	0x00, 0x05, 0xd4, 0x34, 0x57, 0x7e, 0x30, 0xb7, 0x2b, 0xcf, 0xa7, 0x94, 0x9b, 0xf0, 0x67, 0x85,
+	0x13, 0xc7, 0x02, 0x78, 0x1b, 0x54, 0x48, 0x2b, 0xa4, 0xd4, 0xf1, 0x5b, 0x49, 0x29, 0xcf, 0x89,
+	0xf4, 0xbe, 0x30, 0x1c, 0x18, 0x1b, 0x12, 0x6a, 0x52, 0x02, 0xe1, 0xd5, 0x98, 0x94, 0x54, 0xf0,
+	0xc7, 0xb1, 0x69, 0x9f, 0x6a, 0x60, 0x4d, 0x74, 0x8d, 0x23, 0x9f, 0x74, 0x59, 0x3b, 0x88, 0xee,
+	0x45, 0xd4, 0x83, 0xd5, 0xb1, 0x14, 0x8f, 0x13, 0x9a, 0x82, 0xaa, 0xac, 0x33, 0x66, 0x36, 0xaf,
+	0x97, 0xaf, 0xbf, 0xf7, 0x82, 0xba, 0x94, 0xcd, 0xc5, 0x7a, 0x81, 0x7b, 0x0a, 0xc3, 0x20, 0xc3,
+	0x41, 0xff, 0xd1, 0x40, 0x79, 0x4c, 0x25, 0x78, 0x00, 0x20, 0x53, 0xbf, 0x53, 0x01, 0xd6, 0x44,
+	0x80, 0xdf, 0x1c, 0xf9, 0x30, 0x2b, 0x83, 0xf0, 0x5a, 0x4c, 0x4c, 0x62, 0x2b, 0xea, 0xab, 0x6c,
+	0x9f, 0xc9, 0x06, 0x5e, 0xb5, 0xb9, 0x27, 0x5f, 0x56, 0x5f, 0x33, 0x9e, 0x9a, 0xac, 0xaf, 0xd3,
+	0x70, 0x45, 0x7d, 0xcd, 0xec, 0x64, 0x18, 0x76, 0x33, 0x34, 0xf4, 0x44, 0x03, 0x40, 0x3a, 0xab,
+	0x71, 0x42, 0xba, 0x33, 0xe2, 0xf0, 0x11, 0x28, 0x44, 0x27, 0xa4, 0xab, 0x3e, 0xa9, 0x9b, 0x73,
+	0xa7, 0x9c, 0xaa, 0xad, 0x1c, 0x03, 0x61, 0x01, 0x05, 0xff, 0x0f, 0x24, 0x97, 0x06, 0x93, 0x51,
+	0x2b, 0xf0, 0x6d, 0xd9, 0x2e, 0xf3, 0x78, 0x35, 0xa6, 0x1f, 0x49, 0x32, 0x7a, 0xaa, 0x81, 0xa2,
+	0x8a, 0xa7, 0x47, 0x66, 0x68, 0x78, 0x1f, 0xe4, 0xa9, 0x47, 0x94, 0x82, 0xdf, 0x9c, 0x5b, 0x41,
+	0xa0, 0xca, 0x8b, 0x47, 0x10, 0xe6, 0x40, 0x73, 0xa8, 0x07, 0xdf, 0x01, 0x6b, 0x6d, 0xe2, 0x3e,
+	0x32, 0x5d, 0xe7, 0x11, 0x4d, 0x64, 0x45, 0xdb, 0xc6, 0xab, 0x9c, 0x71, 0xe0, 0x3c, 0xa2, 0xb1,
+	0x29, 0x7f, 0xcd, 0x83, 0x8a, 0x34, 0x45, 0x86, 0x27, 0x22, 0x11, 0x9b, 0x61, 0xd1, 0xc7, 0x60,
+	0xd1, 0xa3, 0xb6, 0x43, 0x7c, 0x65, 0xd4, 0xb7, 0xe7, 0x36, 0xaa, 0xac, 0x06, 0x06, 0x81, 0x82,
+	0xb0, 0x82, 0xe3, 0xae, 0xf2, 0x1c, 0x5f, 0xcf, 0xbf, 0x9e, 0xab, 0x3c, 0xc7, 0x47, 0x98, 0x03,
+	0x09, 0x3c, 0x72, 0xaa, 0x17, 0x5e, 0x13, 0x8f, 0x9c, 0x72, 0x3c, 0x72, 0x0a, 0x2d, 0x00, 0x8e,
+	0x03, 0x3e, 0xa6, 0xba, 0x4e, 0xd4, 0x57, 0xb7, 0xf5, 0x5b, 0x73, 0xc3, 0xae, 0xc5, 0x8d, 0x3a,
+	0x46, 0x12, 0x8f, 0x2a, 0xf1, 0x02, 0x5e, 0x04, 0x25, 0x46, 0xbc, 0xae, 0x4b, 0x4d, 0x2b, 0xe8,
+	0xf9, 0x91, 0xbc, 0xa6, 0xe3, 0x65, 0x49, 0xbb, 0xc5, 0x49, 0x53, 0x53, 0x60, 0x69, 0x7a, 0x86,
+	0xfe, 0x04, 0x40, 0x79, 0x89, 0x13, 0x63, 0xb6, 0xd8, 0x4e, 0x43, 0xf8, 0x26, 0x9f, 0x05, 0x19,
+	0x53, 0x27, 0x88, 0x07, 0x1f, 0x3e, 0xea, 0x31, 0x26, 0xf1, 0x2f, 0x81, 0x32, 0x69, 0xb2, 0x88,
+	0x38, 0xbe, 0x92, 0x10, 0x75, 0x15, 0x97, 0x14, 0x31, 0x11, 0x62, 0x3d, 0xcb, 0xa2, 0x09, 0x4c,
+	0x5e, 0x0a, 0x29, 0xa2, 0x10, 0x42, 0x7f, 0xca, 0x81, 0x35, 0x99, 0x55, 0xf2, 0x92, 0x24, 0xd3,
+	0x2a, 0xb3, 0x55, 0xcb, 0x6e, 0x7d, 0x35, 0x25, 0xc6, 0x0d, 0xc9, 0x4f, 0x1a, 0xd2, 0x01, 0xe5,
+	0xe4, 0x5a, 0x69, 0xb2, 0x9e, 0xa7, 0x17, 0x5e, 0xaf, 0xc9, 0x8f, 0x81, 0x21, 0x5c, 0x4a, 0xd6,
+	0x47, 0x3d, 0x0f, 0xbe, 0x0d, 0x56, 0x47, 0x7c, 0xa9, 0xd0, 0x19, 0xa1, 0xd0, 0x4a, 0x42, 0x96,
+	0x5a, 0xe9, 0x60, 0x49, 0xbc, 0xad, 0x50, 0x5b, 0x04, 0xf7, 0x2c, 0x8e, 0x97, 0xdc, 0x1c, 0xea,
+	0xdb, 0x66, 0x9b, 0x3a, 0xad, 0x76, 0xa4, 0x42, 0x5a, 0xa4, 0xbe, 0x7d, 0x57, 0x10, 0xd0, 0x97,
+	0x39, 0x50, 0x15, 0x0f, 0x23, 0x24, 0x0a, 0x42, 0xe9, 0x56, 0xe9, 0xd0, 0x9f, 0x69, 0xe0, 0x9c,
+	0xd5, 0x0b, 0x43, 0xea, 0x47, 0xe6, 0xb8, 0xc1, 0x5a, 0x72, 0xaf, 0x58, 0xf8, 0x2a, 0xf7, 0x8a,
+	0xa9, 0xa0, 0x08, 0xaf, 0x2b, 0xfa, 0x5e, 0xda, 0xfe, 0xff, 0x07, 0x1b, 0x59, 0xf1, 0x74, 0xe8,
+	0xce, 0x4d, 0xee, 0x92, 0xee, 0x38, 0x00, 0x4b, 0xf1, 0x45, 0x3c, 0xff, 0xd2, 0xae, 0x93, 0x49,
+	0x26, 0xd5, 0x3c, 0x63, 0x08, 0xa1, 0x45, 0xe0, 0x33, 0x6a, 0xf5, 0x22, 0xe7, 0x98, 0x9a, 0x4d,
+	0x62, 0x27, 0xd7, 0xfc, 0x82, 0xd2, 0x62, 0xc4, 0xae, 0x13, 0x5b, 0x42, 0x31, 0xf4, 0xc7, 0x1c,
+	0x78, 0x63, 0xc2, 0xb7, 0xea, 0xa3, 0x81, 0x97, 0xc1, 0xca, 0x71, 0xcc, 0x31, 0x89, 0x6d, 0x87,
+	0xaa, 0x1c, 0x96, 0x13, 0x6a, 0xcd, 0xb6, 0xc3, 0x17, 0x9d, 0x9c, 0x7b, 0xc1, 0xc9, 0xfc, 0x6b,
+	0xce, 0xdc, 0xa4, 0x65, 0x26, 0xaf, 0xd2, 0x89, 0x6b, 0xf1, 0x83, 0xcc, 0x93, 0xa1, 0x4c, 0xe8,
+	0xdd, 0xf9, 0xe2, 0x3b, 0xf9, 0x32, 0x78, 0x11, 0x88, 0xd7, 0x12, 0x6a, 0x9b, 0x3d, 0x3f, 0x72,
+	0x5c, 0x91, 0xb6, 0x79, 0xbc, 0x2c, 0x69, 0x0f, 0x38, 0x89, 0xfb, 0x20, 0xbe, 0xc8, 0xab, 0xec,
+	0x5c, 0x14, 0x42, 0x65, 0x45, 0x55, 0x19, 0xfa, 0x2b, 0x0d, 0xac, 0x8c, 0x5f, 0xbc, 0x5f, 0xed,
+	0x36, 0xbc, 0x0b, 0xce, 0x76, 0x68, 0xdf, 0xe4, 0x1a, 0xaa, 0xa6, 0xb2, 0x3e, 0x1c, 0x18, 0xab,
+	0x52, 0x30, 0xe6, 0x20, 0xbc, 0xd4, 0xa1, 0xfd, 0x46, 0xbf, 0x4b, 0xe1, 0x55, 0xb0, 0xd4, 0xed,
+	0x35, 0xcd, 0x0e, 0xed, 0x0b, 0x57, 0x95, 0xea, 0x70, 0x38, 0x30, 0x56, 0xa4, 0xb8, 0x62, 0x20,
+	0xbc, 0xd8, 0xed, 0x35, 0x3f, 0xa0, 0xfd, 0xd4, 0x84, 0xf7, 0x9b, 0x1c, 0xa8, 0x08, 0xf5, 0x52,
+	0x0f, 0x1b, 0xf0, 0x3a, 0x28, 0x26, 0x6f, 0x07, 0x4a, 0xcb, 0xea, 0xe8, 0x21, 0x37, 0x61, 0x21,
+	0x3c, 0x12, 0xe3, 0xfa, 0x8a, 0xbc, 0x34, 0x1d, 0x3b, 0xab, 0x6f, 0xcc, 0x41, 0x78, 0x49, 0xfc,
+	0xbc, 0x67, 0xf3, 0x33, 0x26, 0xa7, 0xf1, 0xd4, 0x19, 0xa9, 0x19, 0x6d, 0x24, 0x06, 0x7f, 0x04,
+	0x16, 0xc5, 0x54, 0xc4, 0xf4, 0xc2, 0x4b, 0x3f, 0x8b, 0xec, 0x65, 0xf7, 0xaa, 0x1a, 0xc6, 0xca,
+	0xa9, 0x61, 0x6c, 0xe6, 0xf5, 0x56, 0x1d, 0x94, 0xf2, 0xd4, 0x97, 0x1a, 0x28, 0x1e, 0xf6, 0x5c,
+	0x57, 0x78, 0x6b, 0xc6, 0x1c, 0xb0, 0x07, 0xce, 0x88, 0x7d, 0x7a, 0xee, 0x2b, 0x25, 0xa1, 0xdc,
+	0xcc, 0x93, 0x4f, 0xf9, 0x55, 0xcc, 0xaa, 0x6a, 0x96, 0x59, 0x56, 0x34, 0x3e, 0xaa, 0xc2, 0x6d,
+	0x00, 0x52, 0x6f, 0x40, 0xdc, 0x1b, 0x45, 0x9c, 0xa2, 0xf0, 0x56, 0xa1, 0xee, 0x32, 0x2a, 0x37,
+	0x65, 0x02, 0x97, 0x24, 0x51, 0xa6, 0xe6, 0xc8, 0xb6, 0x77, 0xfe, 0xa6, 0x81, 0x31, 0x27, 0xf0,
+	0x3a, 0xd2, 0x63, 0xf0, 0x26, 0xb8, 0xb0, 0xff, 0xbd, 0x5b, 0x77, 0x6b, 0xf7, 0xef, 0xec, 0x9b,
+	0xb8, 0xd6, 0xd8, 0x37, 0x8f, 0x1a, 0xb5, 0xc6, 0x83, 0x23, 0xb3, 0x76, 0xab, 0x71, 0xef, 0xe1,
+	0x7e, 0x65, 0x61, 0x73, 0xeb, 0x93, 0xcf, 0x76, 0xf4, 0xec, 0xc6, 0x9a, 0xc5, 0x3f, 0x68, 0xf8,
+	0x0d, 0xb0, 0x39, 0x75, 0xfb, 0x51, 0xa3, 0x76, 0xb0, 0x5f, 0xd1, 0x36, 0x2f, 0x7c, 0xf2, 0xd9,
+	0xce, 0x46, 0x76, 0xb7, 0x78, 0x17, 0x99, 0x79, 0xf6, 0xdd, 0xda, 0x41, 0x63, 0x7f, 0xaf, 0x92,
+	0x9b, 0x75, 0x36, 0xbf, 0x84, 0x51, 0x7b, 0xb3, 0xf0, 0xf8, 0xd7, 0xdb, 0x0b, 0xf5, 0xfd, 0xa7,
+	0xcf, 0xb6, 0xb5, 0xcf, 0x9f, 0x6d, 0x6b, 0xff, 0x7c, 0xb6, 0xad, 0x7d, 0xfa, 0x7c, 0x7b, 0xe1,
+	0xf3, 0xe7, 0xdb, 0x0b, 0x5f, 0x3c, 0xdf, 0x5e, 0xf8, 0xc1, 0xd5, 0x54, 0x48, 0xe2, 0xdc, 0x19,
+	0xfd, 0x38, 0xbd, 0xa6, 0xfe, 0xa3, 0x24, 0x62, 0xd3, 0x5c, 0x14, 0xff, 0x20, 0x7a, 0xff, 0xbf,
+	0x03, 0x00, 0xac, 0x31, 0x3a, 0x75, 0x68, 0x1a, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.VotePeriod != that1.VotePeriod {
+		return false
+	}
+	if !this.VoteThreshold.Equal(that1.VoteThreshold) {
+		return false
 	}
 	if !this.RewardBand.Equal(that1.RewardBand) {
 		return false
@@ -614,6 +1421,39 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.LookbackDuration != that1.LookbackDuration {
 		return false
 	}
+	if this.RewardDistributionWindow != that1.RewardDistributionWindow {
+		return false
+	}
+	if !this.RewardPoolShare.Equal(that1.RewardPoolShare) {
+		return false
+	}
+	if this.MaxMissedVotePeriods != that1.MaxMissedVotePeriods {
+		return false
+	}
+	if !this.MaxPriceDeviation.Equal(that1.MaxPriceDeviation) {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
+	if this.PenaltyEscalationWindows != that1.PenaltyEscalationWindows {
+		return false
+	}
+	if !this.PenaltyEscalationFactor.Equal(that1.PenaltyEscalationFactor) {
+		return false
+	}
+	if this.MaxAttestationAge != that1.MaxAttestationAge {
+		return false
+	}
+	if this.MaxPenaltyEscalationLevel != that1.MaxPenaltyEscalationLevel {
+		return false
+	}
+	if this.MinPricePublishers != that1.MinPricePublishers {
+		return false
+	}
+	if this.HaltRecoveryPeriods != that1.HaltRecoveryPeriods {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -636,52 +1476,132 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.LookbackDuration != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.LookbackDuration))
+	if m.HaltRecoveryPeriods != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HaltRecoveryPeriods))
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.MinPricePublishers != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinPricePublishers))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MaxPenaltyEscalationLevel != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPenaltyEscalationLevel))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxAttestationAge != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAttestationAge))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
 	{
-		size := m.MinValidPerWindow.Size()
+		size := m.PenaltyEscalationFactor.Size()
 		i -= size
-		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.PenaltyEscalationFactor.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x3a
-	if m.SlashWindow != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.SlashWindow))
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.PenaltyEscalationWindows != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PenaltyEscalationWindows))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x78
+	}
+	if m.JailDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.JailDuration))
+		i--
+		dAtA[i] = 0x70
 	}
 	{
-		size := m.SlashFraction.Size()
+		size := m.MaxPriceDeviation.Size()
 		i -= size
-		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if len(m.Whitelist) > 0 {
-		for iNdEx := len(m.Whitelist) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Whitelist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintParams(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	dAtA[i] = 0x6a
+	if m.MaxMissedVotePeriods != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMissedVotePeriods))
+		i--
+		dAtA[i] = 0x60
 	}
 	{
-		size := m.RewardBand.Size()
+		size := m.RewardPoolShare.Size()
+		i -= size
+		if _, err := m.RewardPoolShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.RewardDistributionWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RewardDistributionWindow))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LookbackDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LookbackDuration))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.MinValidPerWindow.Size()
+		i -= size
+		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.SlashWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SlashWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Whitelist) > 0 {
+		for iNdEx := len(m.Whitelist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Whitelist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.RewardBand.Size()
 		i -= size
 		if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
@@ -728,6 +1648,28 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxStaleness != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxStaleness))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinVoters != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinVoters))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RewardBand != nil {
+		{
+			size := m.RewardBand.Size()
+			i -= size
+			if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
@@ -782,6 +1724,48 @@ func (m *AggregateExchangeRateVote) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateExchangeRatePrevote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateExchangeRatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubmitBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SubmitBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ExchangeRateTuple) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -870,6 +1854,44 @@ func (m *OracleExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomHalt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomHalt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomHalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AgreeingPeriods != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AgreeingPeriods))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.LastExchangeRate.Size()
+		i -= size
+		if _, err := m.LastExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PriceSnapshotItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -997,6 +2019,136 @@ func (m *OracleTwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OracleEma) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleEma) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleEma) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HalfLifeSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HalfLifeSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LookbackSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LookbackSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Ema.Size()
+		i -= size
+		if _, err := m.Ema.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OraclePriceStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OraclePriceStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OraclePriceStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LookbackSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LookbackSeconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SampleCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SampleCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Volatility.Size()
+		i -= size
+		if _, err := m.Volatility.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Max.Size()
+		i -= size
+		if _, err := m.Max.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Min.Size()
+		i -= size
+		if _, err := m.Min.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Median.Size()
+		i -= size
+		if _, err := m.Median.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *VotePenaltyCounter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1035,188 +2187,2862 @@ func (m *VotePenaltyCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *OracleWindowStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.VotePeriod != 0 {
-		n += 1 + sovParams(uint64(m.VotePeriod))
+
+func (m *OracleWindowStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleWindowStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = m.VoteThreshold.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.RewardBand.Size()
-	n += 1 + l + sovParams(uint64(l))
-	if len(m.Whitelist) > 0 {
-		for _, e := range m.Whitelist {
-			l = e.Size()
-			n += 1 + l + sovParams(uint64(l))
+	if m.Slashed {
+		i--
+		if m.Slashed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x30
 	}
-	l = m.SlashFraction.Size()
-	n += 1 + l + sovParams(uint64(l))
-	if m.SlashWindow != 0 {
-		n += 1 + sovParams(uint64(m.SlashWindow))
+	if m.DeviationCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DeviationCount))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = m.MinValidPerWindow.Size()
-	n += 1 + l + sovParams(uint64(l))
-	if m.LookbackDuration != 0 {
-		n += 1 + sovParams(uint64(m.LookbackDuration))
+	{
+		size := m.DeviationSum.Size()
+		i -= size
+		if _, err := m.DeviationSum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *Denom) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x22
+	if m.MissCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MissCount))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	if m.AbstainCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AbstainCount))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.SuccessCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SuccessCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AggregateExchangeRateVote) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorOracleStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorOracleStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorOracleStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ExchangeRateTuples) > 0 {
-		for _, e := range m.ExchangeRateTuples {
-			l = e.Size()
-			n += 1 + l + sovParams(uint64(l))
+	if m.ConsecutiveBadWindows != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ConsecutiveBadWindows))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	if m.CurrentDeviationCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CurrentDeviationCount))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	{
+		size := m.CurrentDeviationSum.Size()
+		i -= size
+		if _, err := m.CurrentDeviationSum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ExchangeRateTuple) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorOraclePenalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorOraclePenalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorOraclePenalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	if m.PenaltyHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PenaltyHeight))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = m.ExchangeRate.Size()
-	n += 1 + l + sovParams(uint64(l))
-	return n
+	if m.JailedUntil != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.EscalationLevel != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EscalationLevel))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConsecutiveBadWindows != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ConsecutiveBadWindows))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OracleExchangeRate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PricePublisher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PricePublisher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PricePublisher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ExchangeRate.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.LastUpdate.Size()
-	n += 1 + l + sovParams(uint64(l))
-	if m.LastUpdateTimestamp != 0 {
-		n += 1 + sovParams(uint64(m.LastUpdateTimestamp))
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.KeyType) > 0 {
+		i -= len(m.KeyType)
+		copy(dAtA[i:], m.KeyType)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.KeyType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PriceSnapshotItem) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PriceAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = m.OracleExchangeRate.Size()
-	n += 1 + l + sovParams(uint64(l))
-	return n
+	if m.Timestamp != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Publisher) > 0 {
+		i -= len(m.Publisher)
+		copy(dAtA[i:], m.Publisher)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Publisher)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PriceSnapshot) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PullPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PullPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SnapshotTimestamp != 0 {
-		n += 1 + sovParams(uint64(m.SnapshotTimestamp))
+	if m.UpdateHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UpdateHeight))
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.PriceSnapshotItems) > 0 {
+	if len(m.Publishers) > 0 {
+		for iNdEx := len(m.Publishers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Publishers[iNdEx])
+			copy(dAtA[i:], m.Publishers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Publishers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PublishTime != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PublishTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		n += 1 + sovParams(uint64(m.VotePeriod))
+	}
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.RewardBand.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.Whitelist) > 0 {
+		for _, e := range m.Whitelist {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.SlashWindow != 0 {
+		n += 1 + sovParams(uint64(m.SlashWindow))
+	}
+	l = m.MinValidPerWindow.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.LookbackDuration != 0 {
+		n += 1 + sovParams(uint64(m.LookbackDuration))
+	}
+	if m.RewardDistributionWindow != 0 {
+		n += 1 + sovParams(uint64(m.RewardDistributionWindow))
+	}
+	l = m.RewardPoolShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxMissedVotePeriods != 0 {
+		n += 1 + sovParams(uint64(m.MaxMissedVotePeriods))
+	}
+	l = m.MaxPriceDeviation.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.JailDuration != 0 {
+		n += 1 + sovParams(uint64(m.JailDuration))
+	}
+	if m.PenaltyEscalationWindows != 0 {
+		n += 1 + sovParams(uint64(m.PenaltyEscalationWindows))
+	}
+	l = m.PenaltyEscalationFactor.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxAttestationAge != 0 {
+		n += 2 + sovParams(uint64(m.MaxAttestationAge))
+	}
+	if m.MaxPenaltyEscalationLevel != 0 {
+		n += 2 + sovParams(uint64(m.MaxPenaltyEscalationLevel))
+	}
+	if m.MinPricePublishers != 0 {
+		n += 2 + sovParams(uint64(m.MinPricePublishers))
+	}
+	if m.HaltRecoveryPeriods != 0 {
+		n += 2 + sovParams(uint64(m.HaltRecoveryPeriods))
+	}
+	return n
+}
+
+func (m *Denom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.RewardBand != nil {
+		l = m.RewardBand.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MinVoters != 0 {
+		n += 1 + sovParams(uint64(m.MinVoters))
+	}
+	if m.MaxStaleness != 0 {
+		n += 1 + sovParams(uint64(m.MaxStaleness))
+	}
+	return n
+}
+
+func (m *AggregateExchangeRateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeRateTuples) > 0 {
+		for _, e := range m.ExchangeRateTuples {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *AggregateExchangeRatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.SubmitBlock != 0 {
+		n += 1 + sovParams(uint64(m.SubmitBlock))
+	}
+	return n
+}
+
+func (m *ExchangeRateTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *OracleExchangeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.LastUpdate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.LastUpdateTimestamp != 0 {
+		n += 1 + sovParams(uint64(m.LastUpdateTimestamp))
+	}
+	return n
+}
+
+func (m *DenomHalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LastExchangeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.AgreeingPeriods != 0 {
+		n += 1 + sovParams(uint64(m.AgreeingPeriods))
+	}
+	return n
+}
+
+func (m *PriceSnapshotItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.OracleExchangeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *PriceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SnapshotTimestamp != 0 {
+		n += 1 + sovParams(uint64(m.SnapshotTimestamp))
+	}
+	if len(m.PriceSnapshotItems) > 0 {
 		for _, e := range m.PriceSnapshotItems {
 			l = e.Size()
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *OracleTwap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
-	}
-	l = m.Twap.Size()
-	n += 1 + l + sovParams(uint64(l))
-	if m.LookbackSeconds != 0 {
-		n += 1 + sovParams(uint64(m.LookbackSeconds))
-	}
-	return n
-}
+	return n
+}
+
+func (m *OracleTwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Twap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.LookbackSeconds != 0 {
+		n += 1 + sovParams(uint64(m.LookbackSeconds))
+	}
+	return n
+}
+
+func (m *OracleEma) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Ema.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.LookbackSeconds != 0 {
+		n += 1 + sovParams(uint64(m.LookbackSeconds))
+	}
+	if m.HalfLifeSeconds != 0 {
+		n += 1 + sovParams(uint64(m.HalfLifeSeconds))
+	}
+	return n
+}
+
+func (m *OraclePriceStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Median.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Min.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Max.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Volatility.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.SampleCount != 0 {
+		n += 1 + sovParams(uint64(m.SampleCount))
+	}
+	if m.LookbackSeconds != 0 {
+		n += 1 + sovParams(uint64(m.LookbackSeconds))
+	}
+	return n
+}
+
+func (m *VotePenaltyCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MissCount != 0 {
+		n += 1 + sovParams(uint64(m.MissCount))
+	}
+	if m.AbstainCount != 0 {
+		n += 1 + sovParams(uint64(m.AbstainCount))
+	}
+	if m.SuccessCount != 0 {
+		n += 1 + sovParams(uint64(m.SuccessCount))
+	}
+	return n
+}
+
+func (m *OracleWindowStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SuccessCount != 0 {
+		n += 1 + sovParams(uint64(m.SuccessCount))
+	}
+	if m.AbstainCount != 0 {
+		n += 1 + sovParams(uint64(m.AbstainCount))
+	}
+	if m.MissCount != 0 {
+		n += 1 + sovParams(uint64(m.MissCount))
+	}
+	l = m.DeviationSum.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.DeviationCount != 0 {
+		n += 1 + sovParams(uint64(m.DeviationCount))
+	}
+	if m.Slashed {
+		n += 2
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovParams(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *ValidatorOracleStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrentDeviationSum.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.CurrentDeviationCount != 0 {
+		n += 1 + sovParams(uint64(m.CurrentDeviationCount))
+	}
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.ConsecutiveBadWindows != 0 {
+		n += 1 + sovParams(uint64(m.ConsecutiveBadWindows))
+	}
+	return n
+}
+
+func (m *ValidatorOraclePenalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.ConsecutiveBadWindows != 0 {
+		n += 1 + sovParams(uint64(m.ConsecutiveBadWindows))
+	}
+	if m.EscalationLevel != 0 {
+		n += 1 + sovParams(uint64(m.EscalationLevel))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.JailedUntil != 0 {
+		n += 1 + sovParams(uint64(m.JailedUntil))
+	}
+	if m.PenaltyHeight != 0 {
+		n += 1 + sovParams(uint64(m.PenaltyHeight))
+	}
+	return n
+}
+
+func (m *PricePublisher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.KeyType)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *PriceAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Publisher)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovParams(uint64(m.Timestamp))
+	}
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PullPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.PublishTime != 0 {
+		n += 1 + sovParams(uint64(m.PublishTime))
+	}
+	if len(m.Publishers) > 0 {
+		for _, s := range m.Publishers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.UpdateHeight != 0 {
+		n += 1 + sovParams(uint64(m.UpdateHeight))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Whitelist = append(m.Whitelist, Denom{})
+			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+			}
+			m.SlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackDuration", wireType)
+			}
+			m.LookbackDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+			}
+			m.RewardDistributionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPoolShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPoolShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedVotePeriods", wireType)
+			}
+			m.MaxMissedVotePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedVotePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			m.JailDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyEscalationWindows", wireType)
+			}
+			m.PenaltyEscalationWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PenaltyEscalationWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyEscalationFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PenaltyEscalationFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttestationAge", wireType)
+			}
+			m.MaxAttestationAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttestationAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPenaltyEscalationLevel", wireType)
+			}
+			m.MaxPenaltyEscalationLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPenaltyEscalationLevel |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPricePublishers", wireType)
+			}
+			m.MinPricePublishers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPricePublishers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltRecoveryPeriods", wireType)
+			}
+			m.HaltRecoveryPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltRecoveryPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Denom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.RewardBand = &v
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
+			}
+			m.MinVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStaleness", wireType)
+			}
+			m.MaxStaleness = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStaleness |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
+			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+			}
+			m.SubmitBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleExchangeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
+			}
+			m.LastUpdateTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomHalt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomHalt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomHalt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgreeingPeriods", wireType)
+			}
+			m.AgreeingPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgreeingPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceSnapshotItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceSnapshotItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTimestamp", wireType)
+			}
+			m.SnapshotTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshotItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSnapshotItems = append(m.PriceSnapshotItems, PriceSnapshotItem{})
+			if err := m.PriceSnapshotItems[len(m.PriceSnapshotItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleTwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleTwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleTwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			}
+			m.LookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleEma) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleEma: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleEma: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			}
+			m.LookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalfLifeSeconds", wireType)
+			}
+			m.HalfLifeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalfLifeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OraclePriceStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OraclePriceStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OraclePriceStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Median", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Median.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volatility", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleCount", wireType)
+			}
+			m.SampleCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SampleCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			}
+			m.LookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *VotePenaltyCounter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MissCount != 0 {
-		n += 1 + sovParams(uint64(m.MissCount))
-	}
-	if m.AbstainCount != 0 {
-		n += 1 + sovParams(uint64(m.AbstainCount))
-	}
-	if m.SuccessCount != 0 {
-		n += 1 + sovParams(uint64(m.SuccessCount))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *VotePenaltyCounter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1239,17 +5065,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotePenaltyCounter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotePenaltyCounter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCount", wireType)
 			}
-			m.VotePeriod = 0
+			m.MissCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1259,16 +5085,104 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotePeriod |= uint64(b&0x7F) << shift
+				m.MissCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+			}
+			m.AbstainCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AbstainCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCount", wireType)
+			}
+			m.SuccessCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuccessCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleWindowStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleWindowStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleWindowStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCount", wireType)
 			}
-			var stringLen uint64
+			m.SuccessCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1278,31 +5192,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SuccessCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
 			}
-			var stringLen uint64
+			m.AbstainCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1312,31 +5211,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AbstainCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCount", wireType)
 			}
-			var msglen int
+			m.MissCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1346,29 +5230,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MissCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Whitelist = append(m.Whitelist, Denom{})
-			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationSum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1396,15 +5265,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeviationSum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationCount", wireType)
 			}
-			m.SlashWindow = 0
+			m.DeviationCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1414,16 +5283,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashWindow |= uint64(b&0x7F) << shift
+				m.DeviationCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1433,31 +5302,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+			m.Slashed = bool(v != 0)
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LookbackDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			m.LookbackDuration = 0
+			m.EndHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1467,7 +5322,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LookbackDuration |= uint64(b&0x7F) << shift
+				m.EndHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1493,7 +5348,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Denom) Unmarshal(dAtA []byte) error {
+func (m *ValidatorOracleStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1516,15 +5371,15 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorOracleStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorOracleStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentDeviationSum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1552,61 +5407,32 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipParams(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.CurrentDeviationSum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthParams
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParams
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentDeviationCount", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CurrentDeviationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentDeviationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1633,16 +5459,16 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
-			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Windows = append(m.Windows, OracleWindowStats{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveBadWindows", wireType)
 			}
-			var stringLen uint64
+			m.ConsecutiveBadWindows = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1652,24 +5478,11 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ConsecutiveBadWindows |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1691,7 +5504,7 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
+func (m *ValidatorOraclePenalty) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1714,15 +5527,85 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorOraclePenalty: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorOraclePenalty: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveBadWindows", wireType)
+			}
+			m.ConsecutiveBadWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveBadWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscalationLevel", wireType)
+			}
+			m.EscalationLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscalationLevel |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1750,13 +5633,15 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
 			}
-			var stringLen uint64
+			m.JailedUntil = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1766,26 +5651,30 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.JailedUntil |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyHeight", wireType)
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PenaltyHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PenaltyHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1807,7 +5696,7 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
+func (m *PricePublisher) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1830,15 +5719,15 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleExchangeRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: PricePublisher: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PricePublisher: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1866,13 +5755,11 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1900,15 +5787,13 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KeyType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
-			m.LastUpdateTimestamp = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1918,11 +5803,26 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdateTimestamp |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1944,7 +5844,7 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
+func (m *PriceAttestation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1967,15 +5867,15 @@ func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceSnapshotItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceAttestation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceSnapshotItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Publisher", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2003,13 +5903,13 @@ func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Publisher = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -2019,80 +5919,29 @@ func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipParams(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthParams
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParams
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.SnapshotTimestamp = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -2102,14 +5951,14 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SnapshotTimestamp |= int64(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshotItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2136,8 +5985,8 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceSnapshotItems = append(m.PriceSnapshotItems, PriceSnapshotItem{})
-			if err := m.PriceSnapshotItems[len(m.PriceSnapshotItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prices = append(m.Prices, ExchangeRateTuple{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2162,7 +6011,7 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleTwap) Unmarshal(dAtA []byte) error {
+func (m *PullPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2185,10 +6034,10 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleTwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleTwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2225,7 +6074,7 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2253,15 +6102,15 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishTime", wireType)
 			}
-			m.LookbackSeconds = 0
+			m.PublishTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -2271,66 +6120,16 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LookbackSeconds |= int64(b&0x7F) << shift
+				m.PublishTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipParams(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthParams
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VotePenaltyCounter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParams
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VotePenaltyCounter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotePenaltyCounter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissCount", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Publishers", wireType)
 			}
-			m.MissCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -2340,35 +6139,29 @@ func (m *VotePenaltyCounter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
 			}
-			m.AbstainCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowParams
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AbstainCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Publishers = append(m.Publishers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateHeight", wireType)
 			}
-			m.SuccessCount = 0
+			m.UpdateHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -2378,7 +6171,7 @@ func (m *VotePenaltyCounter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SuccessCount |= uint64(b&0x7F) << shift
+				m.UpdateHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -60,6 +60,44 @@ func TestParamsValid(t *testing.T) {
 	err = p8.Validate()
 	require.Error(t, err)
 
+	// distribution window smaller than vote period
+	p10 := DefaultParams()
+	p10.RewardDistributionWindow = p10.VotePeriod - 1
+	err = p10.Validate()
+	require.Error(t, err)
+
+	// reward pool share out of range
+	p11 := DefaultParams()
+	p11.RewardPoolShare = sdk.NewDecWithPrec(11, 1)
+	err = p11.Validate()
+	require.Error(t, err)
+
+	// whitelist reward band override out of range
+	p12 := DefaultParams()
+	invalidBand := sdk.NewDec(2)
+	p12.Whitelist[0].RewardBand = &invalidBand
+	err = p12.Validate()
+	require.Error(t, err)
+	p12.Whitelist[0].RewardBand = nil
+
+	// penalty escalation factor reducing the penalties
+	p13 := DefaultParams()
+	p13.PenaltyEscalationFactor = sdk.NewDecWithPrec(5, 1)
+	err = p13.Validate()
+	require.Error(t, err)
+
+	// pull oracle prices without publishers
+	p14 := DefaultParams()
+	p14.MinPricePublishers = 0
+	err = p14.Validate()
+	require.Error(t, err)
+
+	// halted denoms which can't resume
+	p15 := DefaultParams()
+	p15.HaltRecoveryPeriods = 0
+	err = p15.Validate()
+	require.Error(t, err)
+
 	p9 := DefaultParams()
 	require.NotNil(t, p9.ParamSetPairs())
 	require.NotNil(t, p9.String())
@@ -70,3 +108,13 @@ func TestDefaultParams(t *testing.T) {
 	require.Equal(t, DefaultSlashFraction, params.SlashFraction)
 	require.Equal(t, DefaultLookbackDuration, params.LookbackDuration)
 }
+
+func TestDenomRewardBand(t *testing.T) {
+	params := DefaultParams()
+	band := sdk.NewDecWithPrec(5, 2)
+	params.Whitelist = DenomList{{Name: "ubtc"}, {Name: "usol", RewardBand: &band}}
+
+	require.Equal(t, params.RewardBand, params.DenomRewardBand("ubtc"))
+	require.Equal(t, band, params.DenomRewardBand("usol"))
+	require.Equal(t, params.RewardBand, params.DenomRewardBand("unknown"))
+}
@@ -0,0 +1,174 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Supported price publisher key types
+const (
+	KeyTypeEd25519   = "ed25519"
+	KeyTypeSecp256k1 = "secp256k1"
+)
+
+// MaxAttestationPrices is the maximum amount of prices on a single price attestation
+const MaxAttestationPrices = 100
+
+// NewPricePublisher creates a new instance of PricePublisher from a public key
+func NewPricePublisher(name string, pubKey cryptotypes.PubKey) (PricePublisher, error) {
+	switch pubKey.(type) {
+	case *ed25519.PubKey:
+		return PricePublisher{Name: name, KeyType: KeyTypeEd25519, PubKey: pubKey.Bytes()}, nil
+	case *secp256k1.PubKey:
+		return PricePublisher{Name: name, KeyType: KeyTypeSecp256k1, PubKey: pubKey.Bytes()}, nil
+	default:
+		return PricePublisher{}, ErrInvalidPricePublisher.Wrapf("unsupported key type %s", pubKey.Type())
+	}
+}
+
+// GetPubKey returns the publisher public key based on its key type
+func (p PricePublisher) GetPubKey() (cryptotypes.PubKey, error) {
+	switch p.KeyType {
+	case KeyTypeEd25519:
+		if len(p.PubKey) != ed25519.PubKeySize {
+			return nil, ErrInvalidPricePublisher.Wrapf("ed25519 public key must have %d bytes", ed25519.PubKeySize)
+		}
+		return &ed25519.PubKey{Key: p.PubKey}, nil
+	case KeyTypeSecp256k1:
+		if len(p.PubKey) != secp256k1.PubKeySize {
+			return nil, ErrInvalidPricePublisher.Wrapf("secp256k1 public key must have %d bytes", secp256k1.PubKeySize)
+		}
+		return &secp256k1.PubKey{Key: p.PubKey}, nil
+	default:
+		return nil, ErrInvalidPricePublisher.Wrapf("unsupported key type %s", p.KeyType)
+	}
+}
+
+// Validate checks the publisher has name and a valid public key
+func (p PricePublisher) Validate() error {
+	if len(p.Name) == 0 {
+		return ErrInvalidPricePublisher.Wrap("publisher must have name")
+	}
+
+	_, err := p.GetPubKey()
+	return err
+}
+
+// NewPriceAttestation creates a new instance of PriceAttestation
+func NewPriceAttestation(publisher, chainID string, timestamp int64, prices ExchangeRateTuples) PriceAttestation {
+	return PriceAttestation{
+		Publisher: publisher,
+		ChainId:   chainID,
+		Timestamp: timestamp,
+		Prices:    prices,
+	}
+}
+
+// GetSignBytes returns the bytes signed by the publisher, the protobuf encoding of the attestation
+func (a PriceAttestation) GetSignBytes() []byte {
+	bz, err := a.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// Validate checks the attestation content (publisher, timestamp and positive prices without duplicated denoms)
+func (a PriceAttestation) Validate() error {
+	if len(a.Publisher) == 0 {
+		return ErrInvalidAttestation.Wrap("attestation must have publisher")
+	}
+
+	if len(a.ChainId) == 0 {
+		return ErrInvalidAttestation.Wrap("attestation must have chain id")
+	}
+
+	if a.Timestamp <= 0 {
+		return ErrInvalidAttestation.Wrap("attestation timestamp must be positive")
+	}
+
+	if len(a.Prices) == 0 || len(a.Prices) > MaxAttestationPrices {
+		return ErrInvalidAttestation.Wrapf("attestation must have between 1 and %d prices", MaxAttestationPrices)
+	}
+
+	denoms := make(map[string]struct{}, len(a.Prices))
+	for _, price := range a.Prices {
+		if err := sdk.ValidateDenom(price.Denom); err != nil {
+			return ErrInvalidAttestation.Wrap(err.Error())
+		}
+
+		if _, ok := denoms[price.Denom]; ok {
+			return ErrInvalidAttestation.Wrapf("duplicated denom %s", price.Denom)
+		}
+		denoms[price.Denom] = struct{}{}
+
+		if price.ExchangeRate.IsNil() || !price.ExchangeRate.IsPositive() {
+			return ErrInvalidAttestation.Wrapf("price of %s must be positive", price.Denom)
+		}
+
+		// Check overflow on price values
+		if price.ExchangeRate.BigInt().BitLen() > 255+sdk.DecimalPrecisionBits {
+			return ErrInvalidAttestation.Wrapf("overflow price of %s", price.Denom)
+		}
+	}
+
+	return nil
+}
+
+// SignPriceAttestation signs the attestation with the publisher private key, it is used by
+// local signers and tests to stand in for the off-chain publishers
+func SignPriceAttestation(privKey cryptotypes.PrivKey, attestation PriceAttestation) ([]byte, error) {
+	return privKey.Sign(attestation.GetSignBytes())
+}
+
+// VerifyPriceAttestation checks the signature of the attestation was done by the publisher
+func VerifyPriceAttestation(publisher PricePublisher, attestation PriceAttestation, signature []byte) error {
+	pubKey, err := publisher.GetPubKey()
+	if err != nil {
+		return err
+	}
+
+	if !pubKey.VerifySignature(attestation.GetSignBytes(), signature) {
+		return ErrInvalidAttestationSig.Wrap(fmt.Sprintf("signature does not match publisher %s", publisher.Name))
+	}
+
+	return nil
+}
+
+// AggregatePullPrices returns the pull oracle price of a denom with the median of the publisher prices,
+// the oldest publish time and the sorted names of the publishers
+func AggregatePullPrices(denom string, prices []PullPrice, height int64) PullPrice {
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].Price.LT(prices[j].Price)
+	})
+
+	// median = middle price, or the average of the two middle prices
+	middle := len(prices) / 2
+	median := prices[middle].Price
+	if len(prices)%2 == 0 {
+		median = prices[middle-1].Price.Add(median).QuoInt64(2)
+	}
+
+	publishTime := prices[0].PublishTime
+	publishers := make([]string, 0, len(prices))
+	for _, price := range prices {
+		if price.PublishTime < publishTime {
+			publishTime = price.PublishTime
+		}
+		publishers = append(publishers, price.Publishers...)
+	}
+	sort.Strings(publishers)
+
+	return PullPrice{
+		Denom:        denom,
+		Price:        median,
+		PublishTime:  publishTime,
+		Publishers:   publishers,
+		UpdateHeight: height,
+	}
+}
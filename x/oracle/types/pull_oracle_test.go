@@ -0,0 +1,101 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/sr25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPricePublisher(t *testing.T) {
+	// ed25519 and secp256k1 keys are supported
+	edKey := ed25519.GenPrivKey().PubKey()
+	publisher, err := NewPricePublisher("ed", edKey)
+	require.NoError(t, err)
+	require.Equal(t, KeyTypeEd25519, publisher.KeyType)
+	require.NoError(t, publisher.Validate())
+	pubKey, err := publisher.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, edKey.Equals(pubKey))
+
+	secpKey := secp256k1.GenPrivKey().PubKey()
+	publisher, err = NewPricePublisher("secp", secpKey)
+	require.NoError(t, err)
+	require.Equal(t, KeyTypeSecp256k1, publisher.KeyType)
+	require.NoError(t, publisher.Validate())
+	pubKey, err = publisher.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, secpKey.Equals(pubKey))
+
+	// other key types are rejected
+	_, err = NewPricePublisher("sr", sr25519.GenPrivKey().PubKey())
+	require.ErrorIs(t, err, ErrInvalidPricePublisher)
+
+	// invalid publishers
+	require.ErrorIs(t, PricePublisher{KeyType: KeyTypeEd25519, PubKey: edKey.Bytes()}.Validate(), ErrInvalidPricePublisher)
+	require.ErrorIs(t, PricePublisher{Name: "ed", KeyType: KeyTypeEd25519, PubKey: secpKey.Bytes()}.Validate(), ErrInvalidPricePublisher)
+	require.ErrorIs(t, PricePublisher{Name: "secp", KeyType: KeyTypeSecp256k1, PubKey: edKey.Bytes()}.Validate(), ErrInvalidPricePublisher)
+	require.ErrorIs(t, PricePublisher{Name: "rsa", KeyType: "rsa", PubKey: edKey.Bytes()}.Validate(), ErrInvalidPricePublisher)
+}
+
+func TestPriceAttestationValidate(t *testing.T) {
+	prices := ExchangeRateTuples{
+		{Denom: "ubtc", ExchangeRate: sdk.NewDec(100000)},
+		{Denom: "ueth", ExchangeRate: sdk.NewDec(3000)},
+	}
+
+	tests := []struct {
+		name        string
+		attestation PriceAttestation
+		expectPass  bool
+	}{
+		{"valid attestation", NewPriceAttestation("kii", "kiichain", 100, prices), true},
+		{"no publisher", NewPriceAttestation("", "kiichain", 100, prices), false},
+		{"no chain id", NewPriceAttestation("kii", "", 100, prices), false},
+		{"no timestamp", NewPriceAttestation("kii", "kiichain", 0, prices), false},
+		{"no prices", NewPriceAttestation("kii", "kiichain", 100, ExchangeRateTuples{}), false},
+		{"duplicated denom", NewPriceAttestation("kii", "kiichain", 100, append(prices, prices[0])), false},
+		{"zero price", NewPriceAttestation("kii", "kiichain", 100, ExchangeRateTuples{{Denom: "ubtc", ExchangeRate: sdk.ZeroDec()}}), false},
+		{"invalid denom", NewPriceAttestation("kii", "kiichain", 100, ExchangeRateTuples{{Denom: "1", ExchangeRate: sdk.OneDec()}}), false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.attestation.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrInvalidAttestation)
+		})
+	}
+}
+
+func TestVerifyPriceAttestation(t *testing.T) {
+	attestation := NewPriceAttestation("kii", "kiichain", 100, ExchangeRateTuples{{Denom: "ubtc", ExchangeRate: sdk.NewDec(100000)}})
+
+	for _, privKey := range []cryptotypes.PrivKey{ed25519.GenPrivKey(), secp256k1.GenPrivKey()} {
+		publisher, err := NewPricePublisher("kii", privKey.PubKey())
+		require.NoError(t, err)
+
+		signature, err := SignPriceAttestation(privKey, attestation)
+		require.NoError(t, err)
+		require.NoError(t, VerifyPriceAttestation(publisher, attestation, signature))
+
+		// any change on the attestation invalidates the signature
+		tampered := attestation
+		tampered.Timestamp = 101
+		require.ErrorIs(t, VerifyPriceAttestation(publisher, tampered, signature), ErrInvalidAttestationSig)
+	}
+
+	// signed by another key
+	publisher, err := NewPricePublisher("kii", ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	signature, err := SignPriceAttestation(ed25519.GenPrivKey(), attestation)
+	require.NoError(t, err)
+	require.ErrorIs(t, VerifyPriceAttestation(publisher, attestation, signature), ErrInvalidAttestationSig)
+}
@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -71,6 +74,8 @@ var xxx_messageInfo_QueryExchangeRateRequest proto.InternalMessageInfo
 // QueryExchangeRateResponse is the response for the Query/ExchangeRate rpc method
 type QueryExchangeRateResponse struct {
 	OracleExchangeRate *OracleExchangeRate `protobuf:"bytes,1,opt,name=oracle_exchange_rate,json=oracleExchangeRate,proto3" json:"oracle_exchange_rate,omitempty"`
+	// status of the exchange rate, consumers must not use stale or halted prices
+	Status ExchangeRateStatus `protobuf:"varint,2,opt,name=status,proto3,enum=kiichain.kiichain3.oracle.ExchangeRateStatus" json:"status,omitempty"`
 }
 
 func (m *QueryExchangeRateResponse) Reset()         { *m = QueryExchangeRateResponse{} }
@@ -268,6 +273,8 @@ func (m *QueryActivesResponse) GetActives() []string {
 type DenomOracleExchangeRate struct {
 	Denom              string              `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	OracleExchangeRate *OracleExchangeRate `protobuf:"bytes,2,opt,name=oracle_exchange_rate,json=oracleExchangeRate,proto3" json:"oracle_exchange_rate,omitempty"`
+	// status of the exchange rate, consumers must not use stale or halted prices
+	Status ExchangeRateStatus `protobuf:"varint,3,opt,name=status,proto3,enum=kiichain.kiichain3.oracle.ExchangeRateStatus" json:"status,omitempty"`
 }
 
 func (m *DenomOracleExchangeRate) Reset()         { *m = DenomOracleExchangeRate{} }
@@ -317,6 +324,13 @@ func (m *DenomOracleExchangeRate) GetOracleExchangeRate() *OracleExchangeRate {
 	return nil
 }
 
+func (m *DenomOracleExchangeRate) GetStatus() ExchangeRateStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ExchangeRateStatusActive
+}
+
 // QueryVoteTargetsRequest is the request for the Query/VoteTargets rpc method
 type QueryVoteTargetsRequest struct {
 }
@@ -482,24 +496,25 @@ func (m *QueryPriceSnapshotHistoryResponse) GetPriceSnapshot() PriceSnapshots {
 	return nil
 }
 
-// QueryTwapsRequest is the request for the Query/Twaps rpc method
-type QueryTwapsRequest struct {
-	// time to lookback on the snapshots array
-	LookbackSeconds uint64 `protobuf:"varint,1,opt,name=lookback_seconds,json=lookbackSeconds,proto3" json:"lookback_seconds,omitempty"`
+// QueryPriceAtRequest is the request for the Query/PriceAt rpc method
+type QueryPriceAtRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// unix timestamp (seconds) to search the price at
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (m *QueryTwapsRequest) Reset()         { *m = QueryTwapsRequest{} }
-func (m *QueryTwapsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTwapsRequest) ProtoMessage()    {}
-func (*QueryTwapsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPriceAtRequest) Reset()         { *m = QueryPriceAtRequest{} }
+func (m *QueryPriceAtRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceAtRequest) ProtoMessage()    {}
+func (*QueryPriceAtRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_562b782cb9ac197e, []int{11}
 }
-func (m *QueryTwapsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPriceAtRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTwapsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceAtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTwapsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceAtRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -509,45 +524,35 @@ func (m *QueryTwapsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *QueryTwapsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTwapsRequest.Merge(m, src)
+func (m *QueryPriceAtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceAtRequest.Merge(m, src)
 }
-func (m *QueryTwapsRequest) XXX_Size() int {
+func (m *QueryPriceAtRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTwapsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTwapsRequest.DiscardUnknown(m)
+func (m *QueryPriceAtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceAtRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTwapsRequest proto.InternalMessageInfo
-
-func (m *QueryTwapsRequest) GetLookbackSeconds() uint64 {
-	if m != nil {
-		return m.LookbackSeconds
-	}
-	return 0
-}
+var xxx_messageInfo_QueryPriceAtRequest proto.InternalMessageInfo
 
-// QueryTwapsRequest is the response for the Query/Twaps rpc method
-// OracleTwaps is the alias of the oracle_twap array element
-type QueryTwapsResponse struct {
-	// Twap = Time-weighted average price
-	// oracle_twap is the average price over an specific period of time and denom
-	OracleTwap OracleTwaps `protobuf:"bytes,1,rep,name=oracle_twap,json=oracleTwap,proto3,castrepeated=OracleTwaps" json:"oracle_twap"`
+// QueryPriceAtResponse is the response for the Query/PriceAt rpc method
+type QueryPriceAtResponse struct {
+	Price DenomPriceSnapshot `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
 }
 
-func (m *QueryTwapsResponse) Reset()         { *m = QueryTwapsResponse{} }
-func (m *QueryTwapsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTwapsResponse) ProtoMessage()    {}
-func (*QueryTwapsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPriceAtResponse) Reset()         { *m = QueryPriceAtResponse{} }
+func (m *QueryPriceAtResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceAtResponse) ProtoMessage()    {}
+func (*QueryPriceAtResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_562b782cb9ac197e, []int{12}
 }
-func (m *QueryTwapsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPriceAtResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTwapsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceAtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTwapsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceAtResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -557,43 +562,47 @@ func (m *QueryTwapsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryTwapsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTwapsResponse.Merge(m, src)
+func (m *QueryPriceAtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceAtResponse.Merge(m, src)
 }
-func (m *QueryTwapsResponse) XXX_Size() int {
+func (m *QueryPriceAtResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTwapsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTwapsResponse.DiscardUnknown(m)
+func (m *QueryPriceAtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceAtResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTwapsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPriceAtResponse proto.InternalMessageInfo
 
-func (m *QueryTwapsResponse) GetOracleTwap() OracleTwaps {
+func (m *QueryPriceAtResponse) GetPrice() DenomPriceSnapshot {
 	if m != nil {
-		return m.OracleTwap
+		return m.Price
 	}
-	return nil
+	return DenomPriceSnapshot{}
 }
 
-// QueryFeederDelegationResponse is the request for the Query/FeederDelegation rpc method
-type QueryFeederDelegationRequest struct {
-	// validator address to query for
-	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+// QueryPriceSnapshotRangeRequest is the request for the Query/PriceSnapshotRange rpc method
+type QueryPriceSnapshotRangeRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// unix timestamps (seconds) of the range, both included
+	FromTimestamp int64 `protobuf:"varint,2,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`
+	ToTimestamp   int64 `protobuf:"varint,3,opt,name=to_timestamp,json=toTimestamp,proto3" json:"to_timestamp,omitempty"`
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFeederDelegationRequest) Reset()         { *m = QueryFeederDelegationRequest{} }
-func (m *QueryFeederDelegationRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryFeederDelegationRequest) ProtoMessage()    {}
-func (*QueryFeederDelegationRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPriceSnapshotRangeRequest) Reset()         { *m = QueryPriceSnapshotRangeRequest{} }
+func (m *QueryPriceSnapshotRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceSnapshotRangeRequest) ProtoMessage()    {}
+func (*QueryPriceSnapshotRangeRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_562b782cb9ac197e, []int{13}
 }
-func (m *QueryFeederDelegationRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPriceSnapshotRangeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFeederDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceSnapshotRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFeederDelegationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceSnapshotRangeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -603,36 +612,37 @@ func (m *QueryFeederDelegationRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryFeederDelegationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFeederDelegationRequest.Merge(m, src)
+func (m *QueryPriceSnapshotRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceSnapshotRangeRequest.Merge(m, src)
 }
-func (m *QueryFeederDelegationRequest) XXX_Size() int {
+func (m *QueryPriceSnapshotRangeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFeederDelegationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFeederDelegationRequest.DiscardUnknown(m)
+func (m *QueryPriceSnapshotRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceSnapshotRangeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFeederDelegationRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPriceSnapshotRangeRequest proto.InternalMessageInfo
 
-// QueryFeederDelegationResponse is the response for the Query/FeederDelegation rpc method
-type QueryFeederDelegationResponse struct {
-	// delegated address
-	FeedAddr string `protobuf:"bytes,1,opt,name=feed_addr,json=feedAddr,proto3" json:"feed_addr,omitempty"`
+// QueryPriceSnapshotRangeResponse is the response for the Query/PriceSnapshotRange rpc method
+type QueryPriceSnapshotRangeResponse struct {
+	Prices []DenomPriceSnapshot `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
+	// pagination defines the pagination for the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFeederDelegationResponse) Reset()         { *m = QueryFeederDelegationResponse{} }
-func (m *QueryFeederDelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryFeederDelegationResponse) ProtoMessage()    {}
-func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPriceSnapshotRangeResponse) Reset()         { *m = QueryPriceSnapshotRangeResponse{} }
+func (m *QueryPriceSnapshotRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceSnapshotRangeResponse) ProtoMessage()    {}
+func (*QueryPriceSnapshotRangeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_562b782cb9ac197e, []int{14}
 }
-func (m *QueryFeederDelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPriceSnapshotRangeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFeederDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceSnapshotRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFeederDelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceSnapshotRangeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -642,42 +652,50 @@ func (m *QueryFeederDelegationResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryFeederDelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFeederDelegationResponse.Merge(m, src)
+func (m *QueryPriceSnapshotRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceSnapshotRangeResponse.Merge(m, src)
 }
-func (m *QueryFeederDelegationResponse) XXX_Size() int {
+func (m *QueryPriceSnapshotRangeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFeederDelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFeederDelegationResponse.DiscardUnknown(m)
+func (m *QueryPriceSnapshotRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceSnapshotRangeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFeederDelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPriceSnapshotRangeResponse proto.InternalMessageInfo
 
-func (m *QueryFeederDelegationResponse) GetFeedAddr() string {
+func (m *QueryPriceSnapshotRangeResponse) GetPrices() []DenomPriceSnapshot {
 	if m != nil {
-		return m.FeedAddr
+		return m.Prices
 	}
-	return ""
+	return nil
 }
 
-// QueryVotePenaltyCounterRequest is the request for the Query/VotePenaltyCounter rpc
-type QueryVotePenaltyCounterRequest struct {
-	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+func (m *QueryPriceSnapshotRangeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryVotePenaltyCounterRequest) Reset()         { *m = QueryVotePenaltyCounterRequest{} }
-func (m *QueryVotePenaltyCounterRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryVotePenaltyCounterRequest) ProtoMessage()    {}
-func (*QueryVotePenaltyCounterRequest) Descriptor() ([]byte, []int) {
+// DenomPriceSnapshot is the exchange rate of a denom on a snapshot
+type DenomPriceSnapshot struct {
+	SnapshotTimestamp  int64              `protobuf:"varint,1,opt,name=snapshot_timestamp,json=snapshotTimestamp,proto3" json:"snapshot_timestamp,omitempty"`
+	OracleExchangeRate OracleExchangeRate `protobuf:"bytes,2,opt,name=oracle_exchange_rate,json=oracleExchangeRate,proto3" json:"oracle_exchange_rate"`
+}
+
+func (m *DenomPriceSnapshot) Reset()         { *m = DenomPriceSnapshot{} }
+func (m *DenomPriceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*DenomPriceSnapshot) ProtoMessage()    {}
+func (*DenomPriceSnapshot) Descriptor() ([]byte, []int) {
 	return fileDescriptor_562b782cb9ac197e, []int{15}
 }
-func (m *QueryVotePenaltyCounterRequest) XXX_Unmarshal(b []byte) error {
+func (m *DenomPriceSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVotePenaltyCounterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DenomPriceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVotePenaltyCounterRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DenomPriceSnapshot.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -687,36 +705,50 @@ func (m *QueryVotePenaltyCounterRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryVotePenaltyCounterRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVotePenaltyCounterRequest.Merge(m, src)
+func (m *DenomPriceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPriceSnapshot.Merge(m, src)
 }
-func (m *QueryVotePenaltyCounterRequest) XXX_Size() int {
+func (m *DenomPriceSnapshot) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVotePenaltyCounterRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVotePenaltyCounterRequest.DiscardUnknown(m)
+func (m *DenomPriceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPriceSnapshot.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVotePenaltyCounterRequest proto.InternalMessageInfo
+var xxx_messageInfo_DenomPriceSnapshot proto.InternalMessageInfo
 
-// QueryVotePenaltyCounterResponse is the response for the Query/VotePenaltyCounter rpc
-type QueryVotePenaltyCounterResponse struct {
-	// Returns the voting behavior per validator
-	VotePenaltyCounter *VotePenaltyCounter `protobuf:"bytes,1,opt,name=vote_penalty_counter,json=votePenaltyCounter,proto3" json:"vote_penalty_counter,omitempty"`
+func (m *DenomPriceSnapshot) GetSnapshotTimestamp() int64 {
+	if m != nil {
+		return m.SnapshotTimestamp
+	}
+	return 0
 }
 
-func (m *QueryVotePenaltyCounterResponse) Reset()         { *m = QueryVotePenaltyCounterResponse{} }
-func (m *QueryVotePenaltyCounterResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryVotePenaltyCounterResponse) ProtoMessage()    {}
-func (*QueryVotePenaltyCounterResponse) Descriptor() ([]byte, []int) {
+func (m *DenomPriceSnapshot) GetOracleExchangeRate() OracleExchangeRate {
+	if m != nil {
+		return m.OracleExchangeRate
+	}
+	return OracleExchangeRate{}
+}
+
+// QueryTwapsRequest is the request for the Query/Twaps rpc method
+type QueryTwapsRequest struct {
+	// time to lookback on the snapshots array
+	LookbackSeconds uint64 `protobuf:"varint,1,opt,name=lookback_seconds,json=lookbackSeconds,proto3" json:"lookback_seconds,omitempty"`
+}
+
+func (m *QueryTwapsRequest) Reset()         { *m = QueryTwapsRequest{} }
+func (m *QueryTwapsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTwapsRequest) ProtoMessage()    {}
+func (*QueryTwapsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_562b782cb9ac197e, []int{16}
 }
-func (m *QueryVotePenaltyCounterResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTwapsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVotePenaltyCounterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTwapsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVotePenaltyCounterResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTwapsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -726,41 +758,45 @@ func (m *QueryVotePenaltyCounterResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryVotePenaltyCounterResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVotePenaltyCounterResponse.Merge(m, src)
+func (m *QueryTwapsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTwapsRequest.Merge(m, src)
 }
-func (m *QueryVotePenaltyCounterResponse) XXX_Size() int {
+func (m *QueryTwapsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVotePenaltyCounterResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVotePenaltyCounterResponse.DiscardUnknown(m)
+func (m *QueryTwapsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTwapsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVotePenaltyCounterResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTwapsRequest proto.InternalMessageInfo
 
-func (m *QueryVotePenaltyCounterResponse) GetVotePenaltyCounter() *VotePenaltyCounter {
+func (m *QueryTwapsRequest) GetLookbackSeconds() uint64 {
 	if m != nil {
-		return m.VotePenaltyCounter
+		return m.LookbackSeconds
 	}
-	return nil
+	return 0
 }
 
-// QuerySlashWindowRequest is the request for the Query/SlashWindow rpc
-type QuerySlashWindowRequest struct {
+// QueryTwapsRequest is the response for the Query/Twaps rpc method
+// OracleTwaps is the alias of the oracle_twap array element
+type QueryTwapsResponse struct {
+	// Twap = Time-weighted average price
+	// oracle_twap is the average price over an specific period of time and denom
+	OracleTwap OracleTwaps `protobuf:"bytes,1,rep,name=oracle_twap,json=oracleTwap,proto3,castrepeated=OracleTwaps" json:"oracle_twap"`
 }
 
-func (m *QuerySlashWindowRequest) Reset()         { *m = QuerySlashWindowRequest{} }
-func (m *QuerySlashWindowRequest) String() string { return proto.CompactTextString(m) }
-func (*QuerySlashWindowRequest) ProtoMessage()    {}
-func (*QuerySlashWindowRequest) Descriptor() ([]byte, []int) {
+func (m *QueryTwapsResponse) Reset()         { *m = QueryTwapsResponse{} }
+func (m *QueryTwapsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTwapsResponse) ProtoMessage()    {}
+func (*QueryTwapsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_562b782cb9ac197e, []int{17}
 }
-func (m *QuerySlashWindowRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTwapsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySlashWindowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTwapsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySlashWindowRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTwapsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -770,36 +806,45 @@ func (m *QuerySlashWindowRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QuerySlashWindowRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySlashWindowRequest.Merge(m, src)
+func (m *QueryTwapsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTwapsResponse.Merge(m, src)
 }
-func (m *QuerySlashWindowRequest) XXX_Size() int {
+func (m *QueryTwapsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySlashWindowRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySlashWindowRequest.DiscardUnknown(m)
+func (m *QueryTwapsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTwapsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySlashWindowRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTwapsResponse proto.InternalMessageInfo
 
-// QuerySlashWindowResponse is the response for the Query/SlashWindow rpc
-type QuerySlashWindowResponse struct {
-	// window_progress defines the number of voting periods
-	WindowProgress uint64 `protobuf:"varint,1,opt,name=window_progress,json=windowProgress,proto3" json:"window_progress,omitempty"`
+func (m *QueryTwapsResponse) GetOracleTwap() OracleTwaps {
+	if m != nil {
+		return m.OracleTwap
+	}
+	return nil
 }
 
-func (m *QuerySlashWindowResponse) Reset()         { *m = QuerySlashWindowResponse{} }
-func (m *QuerySlashWindowResponse) String() string { return proto.CompactTextString(m) }
-func (*QuerySlashWindowResponse) ProtoMessage()    {}
-func (*QuerySlashWindowResponse) Descriptor() ([]byte, []int) {
+// QueryEmasRequest is the request for the Query/Emas rpc method
+type QueryEmasRequest struct {
+	// time to lookback on the snapshots array
+	LookbackSeconds uint64 `protobuf:"varint,1,opt,name=lookback_seconds,json=lookbackSeconds,proto3" json:"lookback_seconds,omitempty"`
+	// time it takes for the weight of a price to decay by half
+	HalfLifeSeconds uint64 `protobuf:"varint,2,opt,name=half_life_seconds,json=halfLifeSeconds,proto3" json:"half_life_seconds,omitempty"`
+}
+
+func (m *QueryEmasRequest) Reset()         { *m = QueryEmasRequest{} }
+func (m *QueryEmasRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmasRequest) ProtoMessage()    {}
+func (*QueryEmasRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_562b782cb9ac197e, []int{18}
 }
-func (m *QuerySlashWindowResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryEmasRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySlashWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEmasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySlashWindowResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEmasRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -809,41 +854,50 @@ func (m *QuerySlashWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QuerySlashWindowResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySlashWindowResponse.Merge(m, src)
+func (m *QueryEmasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmasRequest.Merge(m, src)
 }
-func (m *QuerySlashWindowResponse) XXX_Size() int {
+func (m *QueryEmasRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySlashWindowResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySlashWindowResponse.DiscardUnknown(m)
+func (m *QueryEmasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmasRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySlashWindowResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryEmasRequest proto.InternalMessageInfo
 
-func (m *QuerySlashWindowResponse) GetWindowProgress() uint64 {
+func (m *QueryEmasRequest) GetLookbackSeconds() uint64 {
 	if m != nil {
-		return m.WindowProgress
+		return m.LookbackSeconds
 	}
 	return 0
 }
 
-// QueryParamsResponse is the request for the Query/Params rpc method
-type QueryParamsRequest struct {
+func (m *QueryEmasRequest) GetHalfLifeSeconds() uint64 {
+	if m != nil {
+		return m.HalfLifeSeconds
+	}
+	return 0
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+// QueryEmasResponse is the response for the Query/Emas rpc method
+// OracleEmas is the alias of the oracle_ema array element
+type QueryEmasResponse struct {
+	OracleEma OracleEmas `protobuf:"bytes,1,rep,name=oracle_ema,json=oracleEma,proto3,castrepeated=OracleEmas" json:"oracle_ema"`
+}
+
+func (m *QueryEmasResponse) Reset()         { *m = QueryEmasResponse{} }
+func (m *QueryEmasResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmasResponse) ProtoMessage()    {}
+func (*QueryEmasResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_562b782cb9ac197e, []int{19}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryEmasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEmasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEmasResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -853,36 +907,43 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryEmasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmasResponse.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryEmasResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryEmasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmasResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryEmasResponse proto.InternalMessageInfo
 
-// QueryParamsResponse is the response for the Query/Params rpc method
-type QueryParamsResponse struct {
-	// return the params module's params
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+func (m *QueryEmasResponse) GetOracleEma() OracleEmas {
+	if m != nil {
+		return m.OracleEma
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{20}
+// QueryPriceStatsRequest is the request for the Query/PriceStats rpc method
+type QueryPriceStatsRequest struct {
+	// time to lookback on the snapshots array
+	LookbackSeconds uint64 `protobuf:"varint,1,opt,name=lookback_seconds,json=lookbackSeconds,proto3" json:"lookback_seconds,omitempty"`
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryPriceStatsRequest) Reset()         { *m = QueryPriceStatsRequest{} }
+func (m *QueryPriceStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceStatsRequest) ProtoMessage()    {}
+func (*QueryPriceStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{20}
+}
+func (m *QueryPriceStatsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceStatsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -892,1465 +953,6363 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryPriceStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceStatsRequest.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryPriceStatsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryPriceStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceStatsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPriceStatsRequest proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() *Params {
+func (m *QueryPriceStatsRequest) GetLookbackSeconds() uint64 {
 	if m != nil {
-		return m.Params
+		return m.LookbackSeconds
 	}
-	return nil
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryExchangeRateRequest)(nil), "kiichain.kiichain3.oracle.QueryExchangeRateRequest")
-	proto.RegisterType((*QueryExchangeRateResponse)(nil), "kiichain.kiichain3.oracle.QueryExchangeRateResponse")
-	proto.RegisterType((*QueryExchangeRatesRequest)(nil), "kiichain.kiichain3.oracle.QueryExchangeRatesRequest")
-	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "kiichain.kiichain3.oracle.QueryExchangeRatesResponse")
-	proto.RegisterType((*QueryActivesRequest)(nil), "kiichain.kiichain3.oracle.QueryActivesRequest")
-	proto.RegisterType((*QueryActivesResponse)(nil), "kiichain.kiichain3.oracle.QueryActivesResponse")
-	proto.RegisterType((*DenomOracleExchangeRate)(nil), "kiichain.kiichain3.oracle.DenomOracleExchangeRate")
-	proto.RegisterType((*QueryVoteTargetsRequest)(nil), "kiichain.kiichain3.oracle.QueryVoteTargetsRequest")
-	proto.RegisterType((*QueryVoteTargetsResponse)(nil), "kiichain.kiichain3.oracle.QueryVoteTargetsResponse")
-	proto.RegisterType((*QueryPriceSnapshotHistoryRequest)(nil), "kiichain.kiichain3.oracle.QueryPriceSnapshotHistoryRequest")
-	proto.RegisterType((*QueryPriceSnapshotHistoryResponse)(nil), "kiichain.kiichain3.oracle.QueryPriceSnapshotHistoryResponse")
-	proto.RegisterType((*QueryTwapsRequest)(nil), "kiichain.kiichain3.oracle.QueryTwapsRequest")
-	proto.RegisterType((*QueryTwapsResponse)(nil), "kiichain.kiichain3.oracle.QueryTwapsResponse")
-	proto.RegisterType((*QueryFeederDelegationRequest)(nil), "kiichain.kiichain3.oracle.QueryFeederDelegationRequest")
-	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "kiichain.kiichain3.oracle.QueryFeederDelegationResponse")
-	proto.RegisterType((*QueryVotePenaltyCounterRequest)(nil), "kiichain.kiichain3.oracle.QueryVotePenaltyCounterRequest")
-	proto.RegisterType((*QueryVotePenaltyCounterResponse)(nil), "kiichain.kiichain3.oracle.QueryVotePenaltyCounterResponse")
-	proto.RegisterType((*QuerySlashWindowRequest)(nil), "kiichain.kiichain3.oracle.QuerySlashWindowRequest")
-	proto.RegisterType((*QuerySlashWindowResponse)(nil), "kiichain.kiichain3.oracle.QuerySlashWindowResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "kiichain.kiichain3.oracle.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "kiichain.kiichain3.oracle.QueryParamsResponse")
+// QueryPriceStatsResponse is the response for the Query/PriceStats rpc method
+// OraclePriceStatsList is the alias of the oracle_price_stats array element
+type QueryPriceStatsResponse struct {
+	OraclePriceStats OraclePriceStatsList `protobuf:"bytes,1,rep,name=oracle_price_stats,json=oraclePriceStats,proto3,castrepeated=OraclePriceStatsList" json:"oracle_price_stats"`
 }
 
-func init() { proto.RegisterFile("oracle/query.proto", fileDescriptor_562b782cb9ac197e) }
-
-var fileDescriptor_562b782cb9ac197e = []byte{
-	// 1092 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x94, 0x26, 0x6d, 0x9e, 0x9b, 0xb4, 0x4c, 0x0c, 0x49, 0xb6, 0xa9, 0xdd, 0xac, 0x9a,
-	0x36, 0x48, 0x89, 0x37, 0x72, 0x08, 0xb4, 0x69, 0x41, 0x4a, 0x52, 0x2a, 0x38, 0xd5, 0x75, 0x2a,
-	0x90, 0xe0, 0xb0, 0x9a, 0x78, 0xa7, 0xf6, 0x2a, 0xce, 0xce, 0x66, 0x67, 0x93, 0x34, 0x8a, 0x72,
-	0xe9, 0xa9, 0x87, 0x1e, 0x90, 0x2a, 0xb8, 0x81, 0x7a, 0xe0, 0x84, 0x38, 0xf0, 0x17, 0x70, 0xe0,
-	0x14, 0x6e, 0x95, 0x90, 0x10, 0x07, 0x54, 0x50, 0xc2, 0x81, 0x3f, 0x03, 0x79, 0xf6, 0xad, 0xb3,
-	0x5b, 0xef, 0xfa, 0x47, 0xd4, 0x93, 0x77, 0xdf, 0xcf, 0xef, 0xdb, 0x79, 0xf3, 0x3e, 0x19, 0xa8,
-	0xf0, 0x58, 0xb5, 0xc1, 0x8d, 0xad, 0x6d, 0xee, 0xed, 0x15, 0x5d, 0x4f, 0xf8, 0x82, 0x4e, 0x6c,
-	0xd8, 0x76, 0xb5, 0xce, 0x6c, 0xa7, 0x18, 0x3e, 0x2c, 0x14, 0x83, 0x30, 0x2d, 0x57, 0x13, 0x35,
-	0xa1, 0xa2, 0x8c, 0xe6, 0x53, 0x90, 0xa0, 0x4d, 0xd6, 0x84, 0xa8, 0x35, 0xb8, 0xc1, 0x5c, 0xdb,
-	0x60, 0x8e, 0x23, 0x7c, 0xe6, 0xdb, 0xc2, 0x91, 0xe8, 0x1d, 0xc5, 0x16, 0x2e, 0xf3, 0xd8, 0x26,
-	0x1a, 0xf5, 0x25, 0x18, 0x7f, 0xd0, 0x6c, 0xf9, 0xc9, 0xe3, 0x6a, 0x9d, 0x39, 0x35, 0x5e, 0x61,
-	0x3e, 0xaf, 0xf0, 0xad, 0x6d, 0x2e, 0x7d, 0x9a, 0x83, 0x01, 0x8b, 0x3b, 0x62, 0x73, 0x9c, 0x5c,
-	0x25, 0x33, 0x43, 0x95, 0xe0, 0x65, 0xe9, 0xfc, 0xd3, 0x17, 0x85, 0xcc, 0x7f, 0x2f, 0x0a, 0x19,
-	0xfd, 0x19, 0x81, 0x89, 0x84, 0x64, 0xe9, 0x0a, 0x47, 0x72, 0xca, 0x21, 0x17, 0x34, 0x34, 0x39,
-	0xba, 0x4d, 0x8f, 0xf9, 0x5c, 0x15, 0xcb, 0x96, 0xe6, 0x8a, 0xa9, 0xe4, 0x8a, 0xf7, 0xd5, 0x4f,
-	0xb4, 0xe8, 0xca, 0xd9, 0xc3, 0x57, 0x05, 0x52, 0xc1, 0x8f, 0x14, 0xf5, 0x44, 0xe0, 0x5c, 0x4e,
-	0x40, 0x23, 0x91, 0x8b, 0xfe, 0x0b, 0x01, 0x2d, 0xc9, 0x8b, 0x60, 0xbf, 0x21, 0xa0, 0x29, 0x7a,
-	0x66, 0x0a, 0xe6, 0xb7, 0x66, 0xb2, 0xa5, 0x52, 0x07, 0xcc, 0x77, 0x9b, 0xc9, 0x09, 0xc0, 0xaf,
-	0x1d, 0xbe, 0x2a, 0x64, 0x7e, 0xfc, 0xbb, 0x30, 0x99, 0x12, 0x50, 0x66, 0xb6, 0x27, 0x2b, 0x63,
-	0x56, 0xb2, 0x37, 0xc2, 0xee, 0x1d, 0x18, 0x55, 0xf8, 0x97, 0xab, 0xbe, 0xbd, 0x73, 0xc2, 0x6b,
-	0x1e, 0x72, 0x71, 0x33, 0x12, 0x1a, 0x87, 0x73, 0x2c, 0x30, 0x29, 0xf0, 0x43, 0x95, 0xf0, 0x55,
-	0xff, 0x96, 0xc0, 0x58, 0x0a, 0x98, 0xe4, 0x13, 0x4f, 0x3d, 0xc9, 0x33, 0x6f, 0xf4, 0x24, 0xf5,
-	0x09, 0x18, 0x53, 0x54, 0x3e, 0x17, 0x3e, 0x7f, 0xc8, 0xbc, 0x1a, 0xf7, 0x5b, 0x2c, 0x3f, 0xc2,
-	0x29, 0x8d, 0xb9, 0x90, 0xe9, 0x14, 0x5c, 0xd8, 0x11, 0x3e, 0x37, 0xfd, 0xc0, 0x8e, 0x74, 0xb3,
-	0x3b, 0x27, 0xa1, 0xba, 0x0e, 0x57, 0x55, 0x7a, 0xd9, 0xb3, 0xab, 0x7c, 0xcd, 0x61, 0xae, 0xac,
-	0x0b, 0xff, 0x53, 0x5b, 0xfa, 0xc2, 0xdb, 0x0b, 0x5b, 0x3c, 0x23, 0x30, 0xd5, 0x21, 0x08, 0x9b,
-	0xd5, 0x60, 0xc4, 0x6d, 0xfa, 0x4d, 0x89, 0x01, 0x38, 0x1a, 0x33, 0x1d, 0x3e, 0x42, 0xac, 0xe0,
-	0xca, 0xbb, 0x38, 0x10, 0x23, 0x31, 0xb3, 0xac, 0x0c, 0xbb, 0xd1, 0x77, 0xfd, 0x63, 0x78, 0x5b,
-	0xa1, 0x79, 0xb8, 0xcb, 0xdc, 0xf0, 0x33, 0xd0, 0xf7, 0xe0, 0x52, 0x43, 0x88, 0x8d, 0x75, 0x56,
-	0xdd, 0x30, 0x25, 0xaf, 0x0a, 0xc7, 0x92, 0xea, 0xa4, 0xce, 0x56, 0x2e, 0x86, 0xf6, 0xb5, 0xc0,
-	0xac, 0x6f, 0x01, 0x8d, 0xe6, 0x23, 0xfc, 0xaf, 0x20, 0x8b, 0x27, 0xe9, 0xef, 0x32, 0x17, 0xb1,
-	0x4f, 0x77, 0x3d, 0xc0, 0x66, 0x91, 0x95, 0x51, 0x04, 0x9e, 0x3d, 0xb1, 0xc9, 0x0a, 0x88, 0xd6,
-	0x8b, 0x7e, 0x1f, 0x26, 0x55, 0xcb, 0x7b, 0x9c, 0x5b, 0xdc, 0xbb, 0xcb, 0x1b, 0xbc, 0xa6, 0xf6,
-	0x4f, 0x88, 0x7e, 0x1a, 0x46, 0x76, 0x58, 0xc3, 0xb6, 0x98, 0x2f, 0x3c, 0x93, 0x59, 0x96, 0x87,
-	0x53, 0x36, 0xdc, 0xb2, 0x2e, 0x5b, 0x96, 0x17, 0x19, 0xf9, 0x3b, 0x70, 0x25, 0xa5, 0x20, 0xd2,
-	0xb9, 0x0c, 0x43, 0x8f, 0x38, 0xb7, 0xa2, 0xc5, 0xce, 0x37, 0x0d, 0xcd, 0x3a, 0xfa, 0x03, 0xc8,
-	0xb7, 0x66, 0xa6, 0xcc, 0x1d, 0xd6, 0xf0, 0xf7, 0x56, 0xc5, 0xb6, 0xe3, 0x73, 0xef, 0xd4, 0x80,
-	0x9e, 0x10, 0x28, 0xa4, 0xd6, 0x44, 0x4c, 0x26, 0xe4, 0xd4, 0x38, 0xba, 0x81, 0xdb, 0xac, 0x06,
-	0xfe, 0x1e, 0xd6, 0x5e, 0x42, 0x51, 0xba, 0xd3, 0x66, 0x6b, 0x5d, 0x93, 0xb5, 0x06, 0x93, 0xf5,
-	0x2f, 0x6c, 0xc7, 0x12, 0xbb, 0xe1, 0x0c, 0xaf, 0xe2, 0x35, 0x89, 0xb9, 0x10, 0xd7, 0x0d, 0xb8,
-	0xb8, 0xab, 0x2c, 0xa6, 0xeb, 0x89, 0x9a, 0xc7, 0x65, 0x38, 0x3a, 0x23, 0x81, 0xb9, 0x8c, 0x56,
-	0x3d, 0x87, 0x93, 0x53, 0x56, 0x32, 0x11, 0x96, 0x2e, 0xe3, 0xfa, 0x09, 0xad, 0x58, 0xf5, 0x16,
-	0x0c, 0x06, 0x72, 0x82, 0xfc, 0xa6, 0x3a, 0xdd, 0x83, 0x20, 0x15, 0x13, 0x4a, 0x7f, 0x0d, 0xc3,
-	0x80, 0x2a, 0x49, 0x7f, 0x26, 0x70, 0x21, 0xb6, 0x86, 0x16, 0x3a, 0x54, 0x49, 0x53, 0x2b, 0xed,
-	0xfd, 0xfe, 0x92, 0x02, 0x02, 0xfa, 0xe2, 0x93, 0xdf, 0xff, 0x7d, 0x7e, 0xc6, 0xa0, 0x73, 0x46,
-	0x98, 0x64, 0xa0, 0x4c, 0xaa, 0xdd, 0x27, 0x8d, 0x7d, 0xf5, 0x7b, 0x60, 0xc4, 0x56, 0x1f, 0xfd,
-	0x89, 0xc0, 0x70, 0x4c, 0x49, 0x68, 0x5f, 0xed, 0xc3, 0xcf, 0xaa, 0x2d, 0xf6, 0x99, 0x85, 0xa8,
-	0x8b, 0x0a, 0xf5, 0x0c, 0xbd, 0x9e, 0x86, 0x3a, 0x86, 0x56, 0xd2, 0xe7, 0x04, 0xce, 0xa1, 0x42,
-	0xd0, 0x62, 0xb7, 0x96, 0x71, 0x85, 0xd1, 0x8c, 0x9e, 0xe3, 0x11, 0xdc, 0x0d, 0x05, 0x6e, 0x8a,
-	0x16, 0xd2, 0xc0, 0xa1, 0x12, 0xd1, 0x1f, 0x08, 0x64, 0x23, 0x1b, 0x9d, 0x96, 0xba, 0x75, 0x6a,
-	0x57, 0x06, 0x6d, 0xa1, 0xaf, 0x1c, 0x44, 0x38, 0xab, 0x10, 0x5e, 0xa7, 0xd7, 0xd2, 0x10, 0x46,
-	0x05, 0x85, 0xfe, 0x46, 0x20, 0x97, 0x24, 0x0a, 0xf4, 0x76, 0xb7, 0xde, 0x1d, 0xf4, 0x46, 0xbb,
-	0x73, 0xba, 0x64, 0x64, 0xf0, 0x81, 0x62, 0x30, 0x4f, 0x8b, 0x69, 0x0c, 0xe2, 0x2a, 0x65, 0xd6,
-	0x11, 0xf2, 0xf7, 0x04, 0x06, 0xd4, 0xe6, 0xa6, 0xb3, 0xdd, 0xfa, 0x47, 0x95, 0x47, 0x9b, 0xeb,
-	0x31, 0x1a, 0xe1, 0xdd, 0x54, 0xf0, 0x4a, 0x74, 0x3e, 0x0d, 0x5e, 0x53, 0x7e, 0xa4, 0xb1, 0xff,
-	0xba, 0x9a, 0x1d, 0xd0, 0x5f, 0x09, 0x5c, 0x7a, 0x7d, 0xdf, 0xd3, 0x0f, 0xbb, 0x75, 0x4f, 0x91,
-	0x1c, 0xed, 0x66, 0xff, 0x89, 0xc8, 0xe0, 0xb6, 0x62, 0xb0, 0x48, 0x17, 0xda, 0x18, 0xb4, 0xc4,
-	0x41, 0x1a, 0xfb, 0x71, 0xf9, 0x38, 0x30, 0x1e, 0xa9, 0x72, 0xf4, 0x0f, 0x02, 0xb4, 0x7d, 0x9b,
-	0xd3, 0x5b, 0xbd, 0xcc, 0x6a, 0xa2, 0x54, 0x69, 0x4b, 0xa7, 0x49, 0x45, 0x2a, 0x9f, 0x29, 0x2a,
-	0xab, 0x74, 0xb9, 0x2f, 0x2a, 0x49, 0x22, 0x46, 0xbf, 0x23, 0x90, 0x8d, 0x88, 0x4b, 0xf7, 0x1b,
-	0xdb, 0x2e, 0x52, 0xdd, 0x6f, 0x6c, 0x82, 0x7a, 0xe9, 0xd3, 0x8a, 0x43, 0x81, 0x5e, 0x69, 0xe3,
-	0x20, 0x9b, 0xd1, 0x66, 0xa0, 0x61, 0xf4, 0x29, 0x81, 0xc1, 0x40, 0x66, 0x68, 0xd7, 0x89, 0x8d,
-	0xe9, 0x9b, 0x56, 0xec, 0x35, 0x1c, 0x01, 0x15, 0x14, 0xa0, 0x09, 0x3a, 0xd6, 0x06, 0x28, 0x90,
-	0xb7, 0x95, 0x7b, 0x87, 0x47, 0x79, 0xf2, 0xf2, 0x28, 0x4f, 0xfe, 0x39, 0xca, 0x93, 0xaf, 0x8f,
-	0xf3, 0x99, 0x97, 0xc7, 0xf9, 0xcc, 0x9f, 0xc7, 0xf9, 0xcc, 0x97, 0xb3, 0x35, 0xdb, 0xaf, 0x6f,
-	0xaf, 0x17, 0xab, 0x62, 0xf3, 0x24, 0xb9, 0xd5, 0xd4, 0x78, 0x1c, 0x16, 0xf2, 0xf7, 0x5c, 0x2e,
-	0xd7, 0x07, 0xd5, 0xff, 0xb4, 0x85, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x27, 0xd2, 0x49, 0xc8,
-	0x21, 0x0e, 0x00, 0x00,
+func (m *QueryPriceStatsResponse) Reset()         { *m = QueryPriceStatsResponse{} }
+func (m *QueryPriceStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceStatsResponse) ProtoMessage()    {}
+func (*QueryPriceStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{21}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ExchangeRate returns exchange rate of a specific denom
-	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
-	// ExchangeRates returns the exchange rate for all denoms
-	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
-	// Actives returns all actives denoms on the module's KVStore
-	Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error)
-	// VoteTargets returns all vote targets denoms
-	VoteTargets(ctx context.Context, in *QueryVoteTargetsRequest, opts ...grpc.CallOption) (*QueryVoteTargetsResponse, error)
-	// PriceSnapshotHistory returns the history of price snapshots for all assets
-	PriceSnapshotHistory(ctx context.Context, in *QueryPriceSnapshotHistoryRequest, opts ...grpc.CallOption) (*QueryPriceSnapshotHistoryResponse, error)
-	// Twap = Time-weighted average price
-	// Twaps returns the list of the average price over an specific period of time and denom
-	Twaps(ctx context.Context, in *QueryTwapsRequest, opts ...grpc.CallOption) (*QueryTwapsResponse, error)
-	// FeederDelegation returns the delegator by the validator address
-	FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
-	// VotePenaltyCounter returns the voting behavior by an specific validator
-	VotePenaltyCounter(ctx context.Context, in *QueryVotePenaltyCounterRequest, opts ...grpc.CallOption) (*QueryVotePenaltyCounterResponse, error)
-	// SlashWindow returns slash window informacion
-	SlashWindow(ctx context.Context, in *QuerySlashWindowRequest, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error)
-	// Params returns the Oracle module's params
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryPriceStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPriceStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPriceStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceStatsResponse.Merge(m, src)
 }
-
-func (c *queryClient) ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error) {
-	out := new(QueryExchangeRateResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/ExchangeRate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPriceStatsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
-	out := new(QueryExchangeRatesResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/ExchangeRates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPriceStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceStatsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error) {
-	out := new(QueryActivesResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/Actives", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPriceStatsResponse proto.InternalMessageInfo
+
+func (m *QueryPriceStatsResponse) GetOraclePriceStats() OraclePriceStatsList {
+	if m != nil {
+		return m.OraclePriceStats
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) VoteTargets(ctx context.Context, in *QueryVoteTargetsRequest, opts ...grpc.CallOption) (*QueryVoteTargetsResponse, error) {
-	out := new(QueryVoteTargetsResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/VoteTargets", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryFeederDelegationResponse is the request for the Query/FeederDelegation rpc method
+type QueryFeederDelegationRequest struct {
+	// validator address to query for
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func (c *queryClient) PriceSnapshotHistory(ctx context.Context, in *QueryPriceSnapshotHistoryRequest, opts ...grpc.CallOption) (*QueryPriceSnapshotHistoryResponse, error) {
-	out := new(QueryPriceSnapshotHistoryResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/PriceSnapshotHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFeederDelegationRequest) Reset()         { *m = QueryFeederDelegationRequest{} }
+func (m *QueryFeederDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeederDelegationRequest) ProtoMessage()    {}
+func (*QueryFeederDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{22}
+}
+func (m *QueryFeederDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeederDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeederDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFeederDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeederDelegationRequest.Merge(m, src)
+}
+func (m *QueryFeederDelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeederDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeederDelegationRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Twaps(ctx context.Context, in *QueryTwapsRequest, opts ...grpc.CallOption) (*QueryTwapsResponse, error) {
-	out := new(QueryTwapsResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/Twaps", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryFeederDelegationRequest proto.InternalMessageInfo
+
+// QueryFeederDelegationResponse is the response for the Query/FeederDelegation rpc method
+type QueryFeederDelegationResponse struct {
+	// delegated address
+	FeedAddr string `protobuf:"bytes,1,opt,name=feed_addr,json=feedAddr,proto3" json:"feed_addr,omitempty"`
 }
 
-func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
-	out := new(QueryFeederDelegationResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/FeederDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFeederDelegationResponse) Reset()         { *m = QueryFeederDelegationResponse{} }
+func (m *QueryFeederDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeederDelegationResponse) ProtoMessage()    {}
+func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{23}
+}
+func (m *QueryFeederDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeederDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeederDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFeederDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeederDelegationResponse.Merge(m, src)
+}
+func (m *QueryFeederDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeederDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeederDelegationResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) VotePenaltyCounter(ctx context.Context, in *QueryVotePenaltyCounterRequest, opts ...grpc.CallOption) (*QueryVotePenaltyCounterResponse, error) {
-	out := new(QueryVotePenaltyCounterResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/VotePenaltyCounter", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeederDelegationResponse proto.InternalMessageInfo
+
+func (m *QueryFeederDelegationResponse) GetFeedAddr() string {
+	if m != nil {
+		return m.FeedAddr
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) SlashWindow(ctx context.Context, in *QuerySlashWindowRequest, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error) {
-	out := new(QuerySlashWindowResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/SlashWindow", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryVotePenaltyCounterRequest is the request for the Query/VotePenaltyCounter rpc
+type QueryVotePenaltyCounterRequest struct {
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVotePenaltyCounterRequest) Reset()         { *m = QueryVotePenaltyCounterRequest{} }
+func (m *QueryVotePenaltyCounterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVotePenaltyCounterRequest) ProtoMessage()    {}
+func (*QueryVotePenaltyCounterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{24}
+}
+func (m *QueryVotePenaltyCounterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotePenaltyCounterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotePenaltyCounterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ExchangeRate returns exchange rate of a specific denom
-	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
-	// ExchangeRates returns the exchange rate for all denoms
-	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
-	// Actives returns all actives denoms on the module's KVStore
-	Actives(context.Context, *QueryActivesRequest) (*QueryActivesResponse, error)
-	// VoteTargets returns all vote targets denoms
-	VoteTargets(context.Context, *QueryVoteTargetsRequest) (*QueryVoteTargetsResponse, error)
-	// PriceSnapshotHistory returns the history of price snapshots for all assets
-	PriceSnapshotHistory(context.Context, *QueryPriceSnapshotHistoryRequest) (*QueryPriceSnapshotHistoryResponse, error)
-	// Twap = Time-weighted average price
-	// Twaps returns the list of the average price over an specific period of time and denom
-	Twaps(context.Context, *QueryTwapsRequest) (*QueryTwapsResponse, error)
-	// FeederDelegation returns the delegator by the validator address
-	FeederDelegation(context.Context, *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error)
-	// VotePenaltyCounter returns the voting behavior by an specific validator
-	VotePenaltyCounter(context.Context, *QueryVotePenaltyCounterRequest) (*QueryVotePenaltyCounterResponse, error)
-	// SlashWindow returns slash window informacion
-	SlashWindow(context.Context, *QuerySlashWindowRequest) (*QuerySlashWindowResponse, error)
-	// Params returns the Oracle module's params
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (m *QueryVotePenaltyCounterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotePenaltyCounterRequest.Merge(m, src)
+}
+func (m *QueryVotePenaltyCounterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotePenaltyCounterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotePenaltyCounterRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryVotePenaltyCounterRequest proto.InternalMessageInfo
+
+// QueryVotePenaltyCounterResponse is the response for the Query/VotePenaltyCounter rpc
+type QueryVotePenaltyCounterResponse struct {
+	// Returns the voting behavior per validator
+	VotePenaltyCounter *VotePenaltyCounter `protobuf:"bytes,1,opt,name=vote_penalty_counter,json=votePenaltyCounter,proto3" json:"vote_penalty_counter,omitempty"`
 }
 
-func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRate not implemented")
+func (m *QueryVotePenaltyCounterResponse) Reset()         { *m = QueryVotePenaltyCounterResponse{} }
+func (m *QueryVotePenaltyCounterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVotePenaltyCounterResponse) ProtoMessage()    {}
+func (*QueryVotePenaltyCounterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{25}
 }
-func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
+func (m *QueryVotePenaltyCounterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Actives(ctx context.Context, req *QueryActivesRequest) (*QueryActivesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Actives not implemented")
+func (m *QueryVotePenaltyCounterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotePenaltyCounterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) VoteTargets(ctx context.Context, req *QueryVoteTargetsRequest) (*QueryVoteTargetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VoteTargets not implemented")
+func (m *QueryVotePenaltyCounterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotePenaltyCounterResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) PriceSnapshotHistory(ctx context.Context, req *QueryPriceSnapshotHistoryRequest) (*QueryPriceSnapshotHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PriceSnapshotHistory not implemented")
+func (m *QueryVotePenaltyCounterResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Twaps(ctx context.Context, req *QueryTwapsRequest) (*QueryTwapsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Twaps not implemented")
+func (m *QueryVotePenaltyCounterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotePenaltyCounterResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeederDelegation not implemented")
+
+var xxx_messageInfo_QueryVotePenaltyCounterResponse proto.InternalMessageInfo
+
+func (m *QueryVotePenaltyCounterResponse) GetVotePenaltyCounter() *VotePenaltyCounter {
+	if m != nil {
+		return m.VotePenaltyCounter
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) VotePenaltyCounter(ctx context.Context, req *QueryVotePenaltyCounterRequest) (*QueryVotePenaltyCounterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VotePenaltyCounter not implemented")
+
+// QueryValidatorOracleStatsRequest is the request for the Query/ValidatorOracleStats rpc
+type QueryValidatorOracleStatsRequest struct {
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
-func (*UnimplementedQueryServer) SlashWindow(ctx context.Context, req *QuerySlashWindowRequest) (*QuerySlashWindowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SlashWindow not implemented")
+
+func (m *QueryValidatorOracleStatsRequest) Reset()         { *m = QueryValidatorOracleStatsRequest{} }
+func (m *QueryValidatorOracleStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorOracleStatsRequest) ProtoMessage()    {}
+func (*QueryValidatorOracleStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{26}
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryValidatorOracleStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryValidatorOracleStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorOracleStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorOracleStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorOracleStatsRequest.Merge(m, src)
+}
+func (m *QueryValidatorOracleStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorOracleStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorOracleStatsRequest.DiscardUnknown(m)
 }
 
-func _Query_ExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExchangeRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExchangeRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.kiichain3.oracle.Query/ExchangeRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExchangeRate(ctx, req.(*QueryExchangeRateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryValidatorOracleStatsRequest proto.InternalMessageInfo
+
+// QueryValidatorOracleStatsResponse is the response for the Query/ValidatorOracleStats rpc
+// the rates are calculated over the stored windows and the slash window in progress
+type QueryValidatorOracleStatsResponse struct {
+	// vote periods where the validator voted / total vote periods
+	ParticipationRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=participation_rate,json=participationRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"participation_rate"`
+	// vote periods where all the validator votes were inside the reward band / total vote periods
+	SuccessRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=success_rate,json=successRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"success_rate"`
+	// average relative deviation of the validator votes from the final weighted median
+	AverageDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=average_deviation,json=averageDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average_deviation"`
+	// number of stored windows where the validator was slashed
+	SlashCount uint64 `protobuf:"varint,4,opt,name=slash_count,json=slashCount,proto3" json:"slash_count,omitempty"`
+	// raw rolling stats of the validator
+	Stats ValidatorOracleStats `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats"`
 }
 
-func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExchangeRatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExchangeRates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.kiichain3.oracle.Query/ExchangeRates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExchangeRates(ctx, req.(*QueryExchangeRatesRequest))
+func (m *QueryValidatorOracleStatsResponse) Reset()         { *m = QueryValidatorOracleStatsResponse{} }
+func (m *QueryValidatorOracleStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorOracleStatsResponse) ProtoMessage()    {}
+func (*QueryValidatorOracleStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{27}
+}
+func (m *QueryValidatorOracleStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorOracleStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorOracleStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryValidatorOracleStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorOracleStatsResponse.Merge(m, src)
+}
+func (m *QueryValidatorOracleStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorOracleStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorOracleStatsResponse.DiscardUnknown(m)
 }
 
-func _Query_Actives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActivesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Actives(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.kiichain3.oracle.Query/Actives",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Actives(ctx, req.(*QueryActivesRequest))
+var xxx_messageInfo_QueryValidatorOracleStatsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorOracleStatsResponse) GetSlashCount() uint64 {
+	if m != nil {
+		return m.SlashCount
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_VoteTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVoteTargetsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VoteTargets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.kiichain3.oracle.Query/VoteTargets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VoteTargets(ctx, req.(*QueryVoteTargetsRequest))
+func (m *QueryValidatorOracleStatsResponse) GetStats() ValidatorOracleStats {
+	if m != nil {
+		return m.Stats
 	}
-	return interceptor(ctx, in, info, handler)
+	return ValidatorOracleStats{}
 }
 
-func _Query_PriceSnapshotHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPriceSnapshotHistoryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PriceSnapshotHistory(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.kiichain3.oracle.Query/PriceSnapshotHistory",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PriceSnapshotHistory(ctx, req.(*QueryPriceSnapshotHistoryRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryPenalizedValidatorsRequest is the request for the Query/PenalizedValidators rpc
+type QueryPenalizedValidatorsRequest struct {
 }
 
-func _Query_Twaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTwapsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Twaps(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.kiichain3.oracle.Query/Twaps",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Twaps(ctx, req.(*QueryTwapsRequest))
+func (m *QueryPenalizedValidatorsRequest) Reset()         { *m = QueryPenalizedValidatorsRequest{} }
+func (m *QueryPenalizedValidatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPenalizedValidatorsRequest) ProtoMessage()    {}
+func (*QueryPenalizedValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{28}
+}
+func (m *QueryPenalizedValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPenalizedValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPenalizedValidatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPenalizedValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPenalizedValidatorsRequest.Merge(m, src)
+}
+func (m *QueryPenalizedValidatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPenalizedValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPenalizedValidatorsRequest.DiscardUnknown(m)
 }
 
-func _Query_FeederDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFeederDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FeederDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.kiichain3.oracle.Query/FeederDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FeederDelegation(ctx, req.(*QueryFeederDelegationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryPenalizedValidatorsRequest proto.InternalMessageInfo
+
+// QueryPenalizedValidatorsResponse is the response for the Query/PenalizedValidators rpc
+type QueryPenalizedValidatorsResponse struct {
+	Penalties []ValidatorOraclePenalty `protobuf:"bytes,1,rep,name=penalties,proto3" json:"penalties"`
 }
 
-func _Query_VotePenaltyCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVotePenaltyCounterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VotePenaltyCounter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.kiichain3.oracle.Query/VotePenaltyCounter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VotePenaltyCounter(ctx, req.(*QueryVotePenaltyCounterRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryPenalizedValidatorsResponse) Reset()         { *m = QueryPenalizedValidatorsResponse{} }
+func (m *QueryPenalizedValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPenalizedValidatorsResponse) ProtoMessage()    {}
+func (*QueryPenalizedValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{29}
 }
-
-func _Query_SlashWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySlashWindowRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SlashWindow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.kiichain3.oracle.Query/SlashWindow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SlashWindow(ctx, req.(*QuerySlashWindowRequest))
+func (m *QueryPenalizedValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPenalizedValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPenalizedValidatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPenalizedValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPenalizedValidatorsResponse.Merge(m, src)
+}
+func (m *QueryPenalizedValidatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPenalizedValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPenalizedValidatorsResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.kiichain3.oracle.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryPenalizedValidatorsResponse proto.InternalMessageInfo
+
+func (m *QueryPenalizedValidatorsResponse) GetPenalties() []ValidatorOraclePenalty {
+	if m != nil {
+		return m.Penalties
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kiichain.kiichain3.oracle.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ExchangeRate",
-			Handler:    _Query_ExchangeRate_Handler,
-		},
-		{
-			MethodName: "ExchangeRates",
-			Handler:    _Query_ExchangeRates_Handler,
-		},
-		{
-			MethodName: "Actives",
-			Handler:    _Query_Actives_Handler,
-		},
-		{
-			MethodName: "VoteTargets",
-			Handler:    _Query_VoteTargets_Handler,
-		},
-		{
-			MethodName: "PriceSnapshotHistory",
-			Handler:    _Query_PriceSnapshotHistory_Handler,
-		},
-		{
-			MethodName: "Twaps",
-			Handler:    _Query_Twaps_Handler,
-		},
-		{
-			MethodName: "FeederDelegation",
-			Handler:    _Query_FeederDelegation_Handler,
-		},
-		{
-			MethodName: "VotePenaltyCounter",
-			Handler:    _Query_VotePenaltyCounter_Handler,
-		},
-		{
-			MethodName: "SlashWindow",
-			Handler:    _Query_SlashWindow_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "oracle/query.proto",
+// QueryPullPricesRequest is the request for the Query/PullPrices rpc
+type QueryPullPricesRequest struct {
 }
 
-func (m *QueryExchangeRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPullPricesRequest) Reset()         { *m = QueryPullPricesRequest{} }
+func (m *QueryPullPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPullPricesRequest) ProtoMessage()    {}
+func (*QueryPullPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{30}
+}
+func (m *QueryPullPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPullPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPullPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryPullPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPullPricesRequest.Merge(m, src)
+}
+func (m *QueryPullPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPullPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPullPricesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPullPricesRequest proto.InternalMessageInfo
+
+// QueryPullPricesResponse is the response for the Query/PullPrices rpc
+type QueryPullPricesResponse struct {
+	PullPrices []PullPrice `protobuf:"bytes,1,rep,name=pull_prices,json=pullPrices,proto3" json:"pull_prices"`
 }
 
-func (m *QueryExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryPullPricesResponse) Reset()         { *m = QueryPullPricesResponse{} }
+func (m *QueryPullPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPullPricesResponse) ProtoMessage()    {}
+func (*QueryPullPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{31}
+}
+func (m *QueryPullPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPullPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPullPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPullPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPullPricesResponse.Merge(m, src)
+}
+func (m *QueryPullPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPullPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPullPricesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPullPricesResponse proto.InternalMessageInfo
+
+func (m *QueryPullPricesResponse) GetPullPrices() []PullPrice {
+	if m != nil {
+		return m.PullPrices
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPullPriceRequest is the request for the Query/PullPrice rpc
+type QueryPullPriceRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OracleExchangeRate != nil {
-		{
-			size, err := m.OracleExchangeRate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPullPriceRequest) Reset()         { *m = QueryPullPriceRequest{} }
+func (m *QueryPullPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPullPriceRequest) ProtoMessage()    {}
+func (*QueryPullPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{32}
+}
+func (m *QueryPullPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPullPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPullPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPullPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPullPriceRequest.Merge(m, src)
+}
+func (m *QueryPullPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPullPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPullPriceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPullPriceRequest proto.InternalMessageInfo
+
+// QueryPullPriceResponse is the response for the Query/PullPrice rpc
+type QueryPullPriceResponse struct {
+	PullPrice PullPrice `protobuf:"bytes,1,opt,name=pull_price,json=pullPrice,proto3" json:"pull_price"`
 }
 
-func (m *QueryExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPullPriceResponse) Reset()         { *m = QueryPullPriceResponse{} }
+func (m *QueryPullPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPullPriceResponse) ProtoMessage()    {}
+func (*QueryPullPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{33}
+}
+func (m *QueryPullPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPullPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPullPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPullPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPullPriceResponse.Merge(m, src)
+}
+func (m *QueryPullPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPullPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPullPriceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPullPriceResponse proto.InternalMessageInfo
+
+func (m *QueryPullPriceResponse) GetPullPrice() PullPrice {
+	if m != nil {
+		return m.PullPrice
 	}
-	return dAtA[:n], nil
+	return PullPrice{}
 }
 
-func (m *QueryExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPricePublishersRequest is the request for the Query/PricePublishers rpc
+type QueryPricePublishersRequest struct {
 }
 
-func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DenomOracleExchangeRate) > 0 {
-		for iNdEx := len(m.DenomOracleExchangeRate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DenomOracleExchangeRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryPricePublishersRequest) Reset()         { *m = QueryPricePublishersRequest{} }
+func (m *QueryPricePublishersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPricePublishersRequest) ProtoMessage()    {}
+func (*QueryPricePublishersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{34}
+}
+func (m *QueryPricePublishersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPricePublishersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPricePublishersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryActivesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPricePublishersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPricePublishersRequest.Merge(m, src)
+}
+func (m *QueryPricePublishersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPricePublishersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPricePublishersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryActivesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPricePublishersRequest proto.InternalMessageInfo
+
+// QueryPricePublishersResponse is the response for the Query/PricePublishers rpc
+type QueryPricePublishersResponse struct {
+	PricePublishers []PricePublisher `protobuf:"bytes,1,rep,name=price_publishers,json=pricePublishers,proto3" json:"price_publishers"`
 }
 
-func (m *QueryActivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPricePublishersResponse) Reset()         { *m = QueryPricePublishersResponse{} }
+func (m *QueryPricePublishersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPricePublishersResponse) ProtoMessage()    {}
+func (*QueryPricePublishersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{35}
+}
+func (m *QueryPricePublishersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPricePublishersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPricePublishersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPricePublishersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPricePublishersResponse.Merge(m, src)
+}
+func (m *QueryPricePublishersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPricePublishersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPricePublishersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryActivesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPricePublishersResponse proto.InternalMessageInfo
+
+func (m *QueryPricePublishersResponse) GetPricePublishers() []PricePublisher {
+	if m != nil {
+		return m.PricePublishers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryActivesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAggregatePrevoteRequest is the request for the Query/AggregatePrevote rpc
+type QueryAggregatePrevoteRequest struct {
+	// validator address to query for
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func (m *QueryActivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Actives) > 0 {
-		for iNdEx := len(m.Actives) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Actives[iNdEx])
-			copy(dAtA[i:], m.Actives[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Actives[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAggregatePrevoteRequest) Reset()         { *m = QueryAggregatePrevoteRequest{} }
+func (m *QueryAggregatePrevoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevoteRequest) ProtoMessage()    {}
+func (*QueryAggregatePrevoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{36}
+}
+func (m *QueryAggregatePrevoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatePrevoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatePrevoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *DenomOracleExchangeRate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAggregatePrevoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevoteRequest.Merge(m, src)
+}
+func (m *QueryAggregatePrevoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregatePrevoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevoteRequest.DiscardUnknown(m)
 }
 
-func (m *DenomOracleExchangeRate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAggregatePrevoteRequest proto.InternalMessageInfo
+
+// QueryAggregatePrevoteResponse is the response for the Query/AggregatePrevote rpc
+type QueryAggregatePrevoteResponse struct {
+	// pending prevote of the validator
+	AggregatePrevote AggregateExchangeRatePrevote `protobuf:"bytes,1,opt,name=aggregate_prevote,json=aggregatePrevote,proto3" json:"aggregate_prevote"`
 }
 
-func (m *DenomOracleExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OracleExchangeRate != nil {
-		{
-			size, err := m.OracleExchangeRate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAggregatePrevoteResponse) Reset()         { *m = QueryAggregatePrevoteResponse{} }
+func (m *QueryAggregatePrevoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevoteResponse) ProtoMessage()    {}
+func (*QueryAggregatePrevoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{37}
+}
+func (m *QueryAggregatePrevoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatePrevoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatePrevoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAggregatePrevoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevoteResponse.Merge(m, src)
+}
+func (m *QueryAggregatePrevoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregatePrevoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevoteResponse.DiscardUnknown(m)
 }
 
-func (m *QueryVoteTargetsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAggregatePrevoteResponse proto.InternalMessageInfo
+
+func (m *QueryAggregatePrevoteResponse) GetAggregatePrevote() AggregateExchangeRatePrevote {
+	if m != nil {
+		return m.AggregatePrevote
 	}
-	return dAtA[:n], nil
+	return AggregateExchangeRatePrevote{}
 }
 
-func (m *QueryVoteTargetsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAggregatePrevotesRequest is the request for the Query/AggregatePrevotes rpc
+type QueryAggregatePrevotesRequest struct {
 }
 
-func (m *QueryVoteTargetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAggregatePrevotesRequest) Reset()         { *m = QueryAggregatePrevotesRequest{} }
+func (m *QueryAggregatePrevotesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevotesRequest) ProtoMessage()    {}
+func (*QueryAggregatePrevotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{38}
 }
-
-func (m *QueryVoteTargetsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAggregatePrevotesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatePrevotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatePrevotesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryAggregatePrevotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevotesRequest.Merge(m, src)
+}
+func (m *QueryAggregatePrevotesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregatePrevotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevotesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryVoteTargetsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAggregatePrevotesRequest proto.InternalMessageInfo
+
+// QueryAggregatePrevotesResponse is the response for the Query/AggregatePrevotes rpc
+type QueryAggregatePrevotesResponse struct {
+	// pending prevotes of all validators
+	AggregatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,1,rep,name=aggregate_prevotes,json=aggregatePrevotes,proto3" json:"aggregate_prevotes"`
 }
 
-func (m *QueryVoteTargetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VoteTargets) > 0 {
-		for iNdEx := len(m.VoteTargets) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.VoteTargets[iNdEx])
-			copy(dAtA[i:], m.VoteTargets[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.VoteTargets[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAggregatePrevotesResponse) Reset()         { *m = QueryAggregatePrevotesResponse{} }
+func (m *QueryAggregatePrevotesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevotesResponse) ProtoMessage()    {}
+func (*QueryAggregatePrevotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{39}
+}
+func (m *QueryAggregatePrevotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatePrevotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatePrevotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryPriceSnapshotHistoryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAggregatePrevotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevotesResponse.Merge(m, src)
 }
-
-func (m *QueryPriceSnapshotHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAggregatePrevotesResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryPriceSnapshotHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAggregatePrevotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevotesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPriceSnapshotHistoryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAggregatePrevotesResponse proto.InternalMessageInfo
+
+func (m *QueryAggregatePrevotesResponse) GetAggregatePrevotes() []AggregateExchangeRatePrevote {
+	if m != nil {
+		return m.AggregatePrevotes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPriceSnapshotHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+// QuerySlashWindowRequest is the request for the Query/SlashWindow rpc
+type QuerySlashWindowRequest struct {
+}
 
-func (m *QueryPriceSnapshotHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PriceSnapshot) > 0 {
-		for iNdEx := len(m.PriceSnapshot) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PriceSnapshot[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QuerySlashWindowRequest) Reset()         { *m = QuerySlashWindowRequest{} }
+func (m *QuerySlashWindowRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashWindowRequest) ProtoMessage()    {}
+func (*QuerySlashWindowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{40}
+}
+func (m *QuerySlashWindowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashWindowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashWindowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryTwapsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySlashWindowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashWindowRequest.Merge(m, src)
 }
-
-func (m *QueryTwapsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySlashWindowRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryTwapsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LookbackSeconds != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LookbackSeconds))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QuerySlashWindowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashWindowRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTwapsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QuerySlashWindowRequest proto.InternalMessageInfo
 
-func (m *QueryTwapsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySlashWindowResponse is the response for the Query/SlashWindow rpc
+type QuerySlashWindowResponse struct {
+	// window_progress defines the number of voting periods
+	WindowProgress uint64 `protobuf:"varint,1,opt,name=window_progress,json=windowProgress,proto3" json:"window_progress,omitempty"`
 }
 
-func (m *QueryTwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OracleTwap) > 0 {
-		for iNdEx := len(m.OracleTwap) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OracleTwap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QuerySlashWindowResponse) Reset()         { *m = QuerySlashWindowResponse{} }
+func (m *QuerySlashWindowResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashWindowResponse) ProtoMessage()    {}
+func (*QuerySlashWindowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{41}
+}
+func (m *QuerySlashWindowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashWindowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryFeederDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySlashWindowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashWindowResponse.Merge(m, src)
 }
-
-func (m *QueryFeederDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySlashWindowResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryFeederDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QuerySlashWindowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashWindowResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFeederDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySlashWindowResponse proto.InternalMessageInfo
+
+func (m *QuerySlashWindowResponse) GetWindowProgress() uint64 {
+	if m != nil {
+		return m.WindowProgress
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryFeederDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardPoolRequest is the request for the Query/RewardPool rpc
+type QueryRewardPoolRequest struct {
 }
 
-func (m *QueryFeederDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeedAddr) > 0 {
-		i -= len(m.FeedAddr)
-		copy(dAtA[i:], m.FeedAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryRewardPoolRequest) Reset()         { *m = QueryRewardPoolRequest{} }
+func (m *QueryRewardPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolRequest) ProtoMessage()    {}
+func (*QueryRewardPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{42}
 }
-
-func (m *QueryVotePenaltyCounterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryRewardPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolRequest.Merge(m, src)
+}
+func (m *QueryRewardPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolRequest.DiscardUnknown(m)
 }
 
-func (m *QueryVotePenaltyCounterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryRewardPoolRequest proto.InternalMessageInfo
+
+// QueryRewardPoolResponse is the response for the Query/RewardPool rpc
+type QueryRewardPoolResponse struct {
+	// coins held by the oracle module account waiting to be paid to the voters
+	Pool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool"`
 }
 
-func (m *QueryVotePenaltyCounterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryRewardPoolResponse) Reset()         { *m = QueryRewardPoolResponse{} }
+func (m *QueryRewardPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolResponse) ProtoMessage()    {}
+func (*QueryRewardPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{43}
+}
+func (m *QueryRewardPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolResponse.Merge(m, src)
+}
+func (m *QueryRewardPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolResponse.DiscardUnknown(m)
 }
 
-func (m *QueryVotePenaltyCounterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardPoolResponse proto.InternalMessageInfo
+
+func (m *QueryRewardPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Pool
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryVotePenaltyCounterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryParamsResponse is the request for the Query/Params rpc method
+type QueryParamsRequest struct {
 }
 
-func (m *QueryVotePenaltyCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VotePenaltyCounter != nil {
-		{
-			size, err := m.VotePenaltyCounter.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{44}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySlashWindowRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response for the Query/Params rpc method
+type QueryParamsResponse struct {
+	// return the params module's params
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{45}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySlashWindowRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
 }
 
-func (m *QuerySlashWindowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*QueryExchangeRateRequest)(nil), "kiichain.kiichain3.oracle.QueryExchangeRateRequest")
+	proto.RegisterType((*QueryExchangeRateResponse)(nil), "kiichain.kiichain3.oracle.QueryExchangeRateResponse")
+	proto.RegisterType((*QueryExchangeRatesRequest)(nil), "kiichain.kiichain3.oracle.QueryExchangeRatesRequest")
+	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "kiichain.kiichain3.oracle.QueryExchangeRatesResponse")
+	proto.RegisterType((*QueryActivesRequest)(nil), "kiichain.kiichain3.oracle.QueryActivesRequest")
+	proto.RegisterType((*QueryActivesResponse)(nil), "kiichain.kiichain3.oracle.QueryActivesResponse")
+	proto.RegisterType((*DenomOracleExchangeRate)(nil), "kiichain.kiichain3.oracle.DenomOracleExchangeRate")
+	proto.RegisterType((*QueryVoteTargetsRequest)(nil), "kiichain.kiichain3.oracle.QueryVoteTargetsRequest")
+	proto.RegisterType((*QueryVoteTargetsResponse)(nil), "kiichain.kiichain3.oracle.QueryVoteTargetsResponse")
+	proto.RegisterType((*QueryPriceSnapshotHistoryRequest)(nil), "kiichain.kiichain3.oracle.QueryPriceSnapshotHistoryRequest")
+	proto.RegisterType((*QueryPriceSnapshotHistoryResponse)(nil), "kiichain.kiichain3.oracle.QueryPriceSnapshotHistoryResponse")
+	proto.RegisterType((*QueryPriceAtRequest)(nil), "kiichain.kiichain3.oracle.QueryPriceAtRequest")
+	proto.RegisterType((*QueryPriceAtResponse)(nil), "kiichain.kiichain3.oracle.QueryPriceAtResponse")
+	proto.RegisterType((*QueryPriceSnapshotRangeRequest)(nil), "kiichain.kiichain3.oracle.QueryPriceSnapshotRangeRequest")
+	proto.RegisterType((*QueryPriceSnapshotRangeResponse)(nil), "kiichain.kiichain3.oracle.QueryPriceSnapshotRangeResponse")
+	proto.RegisterType((*DenomPriceSnapshot)(nil), "kiichain.kiichain3.oracle.DenomPriceSnapshot")
+	proto.RegisterType((*QueryTwapsRequest)(nil), "kiichain.kiichain3.oracle.QueryTwapsRequest")
+	proto.RegisterType((*QueryTwapsResponse)(nil), "kiichain.kiichain3.oracle.QueryTwapsResponse")
+	proto.RegisterType((*QueryEmasRequest)(nil), "kiichain.kiichain3.oracle.QueryEmasRequest")
+	proto.RegisterType((*QueryEmasResponse)(nil), "kiichain.kiichain3.oracle.QueryEmasResponse")
+	proto.RegisterType((*QueryPriceStatsRequest)(nil), "kiichain.kiichain3.oracle.QueryPriceStatsRequest")
+	proto.RegisterType((*QueryPriceStatsResponse)(nil), "kiichain.kiichain3.oracle.QueryPriceStatsResponse")
+	proto.RegisterType((*QueryFeederDelegationRequest)(nil), "kiichain.kiichain3.oracle.QueryFeederDelegationRequest")
+	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "kiichain.kiichain3.oracle.QueryFeederDelegationResponse")
+	proto.RegisterType((*QueryVotePenaltyCounterRequest)(nil), "kiichain.kiichain3.oracle.QueryVotePenaltyCounterRequest")
+	proto.RegisterType((*QueryVotePenaltyCounterResponse)(nil), "kiichain.kiichain3.oracle.QueryVotePenaltyCounterResponse")
+	proto.RegisterType((*QueryValidatorOracleStatsRequest)(nil), "kiichain.kiichain3.oracle.QueryValidatorOracleStatsRequest")
+	proto.RegisterType((*QueryValidatorOracleStatsResponse)(nil), "kiichain.kiichain3.oracle.QueryValidatorOracleStatsResponse")
+	proto.RegisterType((*QueryPenalizedValidatorsRequest)(nil), "kiichain.kiichain3.oracle.QueryPenalizedValidatorsRequest")
+	proto.RegisterType((*QueryPenalizedValidatorsResponse)(nil), "kiichain.kiichain3.oracle.QueryPenalizedValidatorsResponse")
+	proto.RegisterType((*QueryPullPricesRequest)(nil), "kiichain.kiichain3.oracle.QueryPullPricesRequest")
+	proto.RegisterType((*QueryPullPricesResponse)(nil), "kiichain.kiichain3.oracle.QueryPullPricesResponse")
+	proto.RegisterType((*QueryPullPriceRequest)(nil), "kiichain.kiichain3.oracle.QueryPullPriceRequest")
+	proto.RegisterType((*QueryPullPriceResponse)(nil), "kiichain.kiichain3.oracle.QueryPullPriceResponse")
+	proto.RegisterType((*QueryPricePublishersRequest)(nil), "kiichain.kiichain3.oracle.QueryPricePublishersRequest")
+	proto.RegisterType((*QueryPricePublishersResponse)(nil), "kiichain.kiichain3.oracle.QueryPricePublishersResponse")
+	proto.RegisterType((*QueryAggregatePrevoteRequest)(nil), "kiichain.kiichain3.oracle.QueryAggregatePrevoteRequest")
+	proto.RegisterType((*QueryAggregatePrevoteResponse)(nil), "kiichain.kiichain3.oracle.QueryAggregatePrevoteResponse")
+	proto.RegisterType((*QueryAggregatePrevotesRequest)(nil), "kiichain.kiichain3.oracle.QueryAggregatePrevotesRequest")
+	proto.RegisterType((*QueryAggregatePrevotesResponse)(nil), "kiichain.kiichain3.oracle.QueryAggregatePrevotesResponse")
+	proto.RegisterType((*QuerySlashWindowRequest)(nil), "kiichain.kiichain3.oracle.QuerySlashWindowRequest")
+	proto.RegisterType((*QuerySlashWindowResponse)(nil), "kiichain.kiichain3.oracle.QuerySlashWindowResponse")
+	proto.RegisterType((*QueryRewardPoolRequest)(nil), "kiichain.kiichain3.oracle.QueryRewardPoolRequest")
+	proto.RegisterType((*QueryRewardPoolResponse)(nil), "kiichain.kiichain3.oracle.QueryRewardPoolResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "kiichain.kiichain3.oracle.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "kiichain.kiichain3.oracle.QueryParamsResponse")
 }
 
-func (m *QuerySlashWindowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("oracle/query.proto", fileDescriptor_562b782cb9ac197e) }
+
+var fileDescriptor_562b782cb9ac197e = []byte{
+	// 2225 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x77, 0xc7, 0x1f, 0x59, 0xbf, 0x49, 0x1c, 0xbb, 0x3c, 0xbb, 0xb6, 0x3b, 0xce, 0x4c, 0xdc,
+	0xc4, 0x89, 0xb3, 0x89, 0xa7, 0xfd, 0xb1, 0xf9, 0x0e, 0x11, 0xb6, 0x93, 0xc0, 0x2a, 0x2b, 0xed,
+	0xa4, 0x1d, 0x76, 0xa5, 0x5d, 0xa1, 0x51, 0x79, 0xa6, 0x3c, 0x6e, 0x32, 0x33, 0xdd, 0xe9, 0x6a,
+	0xdb, 0xeb, 0xb5, 0x7c, 0xd9, 0xd3, 0x4a, 0x20, 0x84, 0x58, 0x71, 0xe0, 0x00, 0xca, 0x81, 0x03,
+	0x5a, 0x01, 0x42, 0xe2, 0xc0, 0x09, 0x84, 0x38, 0x85, 0x03, 0xd2, 0x8a, 0x45, 0x08, 0x21, 0x11,
+	0x50, 0xc2, 0x81, 0x0b, 0xff, 0x03, 0xea, 0xea, 0xd7, 0x5f, 0xd3, 0xd3, 0xd3, 0xd3, 0xb3, 0xec,
+	0xc9, 0xd3, 0x55, 0xef, 0xbd, 0xfa, 0xfd, 0x5e, 0xbd, 0x7a, 0x55, 0xef, 0xc9, 0x40, 0x0c, 0x8b,
+	0x56, 0x1b, 0x4c, 0x7d, 0xb2, 0xcb, 0xac, 0x83, 0x92, 0x69, 0x19, 0xb6, 0x41, 0x66, 0x1e, 0xeb,
+	0x7a, 0x75, 0x87, 0xea, 0xad, 0x92, 0xf7, 0x63, 0xb5, 0xe4, 0x8a, 0xc9, 0xf9, 0xba, 0x51, 0x37,
+	0x84, 0x94, 0xea, 0xfc, 0x72, 0x15, 0xe4, 0xd9, 0xba, 0x61, 0xd4, 0x1b, 0x4c, 0xa5, 0xa6, 0xae,
+	0xd2, 0x56, 0xcb, 0xb0, 0xa9, 0xad, 0x1b, 0x2d, 0x8e, 0xb3, 0x85, 0xaa, 0xc1, 0x9b, 0x06, 0x57,
+	0xb7, 0x28, 0x67, 0xea, 0xde, 0xf2, 0x16, 0xb3, 0xe9, 0xb2, 0x5a, 0x35, 0xf4, 0x16, 0xce, 0xbf,
+	0x1e, 0x9e, 0x17, 0x38, 0x7c, 0x29, 0x93, 0xd6, 0xf5, 0x96, 0x30, 0x86, 0xb2, 0x93, 0x08, 0xd7,
+	0xa4, 0x16, 0x6d, 0xe2, 0x02, 0xca, 0x4d, 0x98, 0x7e, 0xe8, 0xa8, 0xdd, 0xfb, 0xa0, 0xba, 0x43,
+	0x5b, 0x75, 0xa6, 0x51, 0x9b, 0x69, 0xec, 0xc9, 0x2e, 0xe3, 0x36, 0xc9, 0xc3, 0x70, 0x8d, 0xb5,
+	0x8c, 0xe6, 0xb4, 0x74, 0x56, 0x5a, 0x18, 0xd5, 0xdc, 0x8f, 0x9b, 0xaf, 0x7c, 0xfc, 0xb4, 0x38,
+	0xf0, 0x9f, 0xa7, 0xc5, 0x01, 0xe5, 0x73, 0x09, 0x66, 0x3a, 0x28, 0x73, 0xd3, 0x68, 0x71, 0x46,
+	0x18, 0xe4, 0xdd, 0x05, 0x2b, 0x0c, 0xa7, 0x2b, 0x16, 0xb5, 0x99, 0x30, 0x96, 0x5b, 0x59, 0x2c,
+	0x25, 0x3a, 0xaa, 0xf4, 0xb6, 0xf8, 0x13, 0x36, 0xba, 0x3e, 0xf4, 0xec, 0x79, 0x51, 0xd2, 0x88,
+	0x11, 0x9b, 0x21, 0xf7, 0x60, 0x84, 0xdb, 0xd4, 0xde, 0xe5, 0xd3, 0xc7, 0xce, 0x4a, 0x0b, 0x63,
+	0x5d, 0x0d, 0x87, 0x15, 0x37, 0x85, 0x92, 0x86, 0xca, 0x21, 0x56, 0xa7, 0x3b, 0x90, 0xe2, 0xe8,
+	0x12, 0xe5, 0x77, 0x12, 0xc8, 0x9d, 0x66, 0x91, 0xf3, 0x0f, 0x25, 0x90, 0x85, 0x97, 0x2a, 0x09,
+	0xd4, 0x07, 0x17, 0x72, 0x2b, 0x2b, 0x5d, 0x10, 0xde, 0x75, 0x94, 0x3b, 0xf0, 0x3f, 0xf7, 0xec,
+	0x79, 0x71, 0xe0, 0xd3, 0x7f, 0x16, 0x67, 0x13, 0x04, 0xca, 0x54, 0xb7, 0xb8, 0x36, 0x55, 0xeb,
+	0x3c, 0x1b, 0x62, 0xf7, 0x2a, 0x4c, 0x0a, 0xfc, 0x6b, 0x55, 0x5b, 0xdf, 0x0b, 0x78, 0x2d, 0x41,
+	0x3e, 0x3a, 0x8c, 0x84, 0xa6, 0xe1, 0x38, 0x75, 0x87, 0x04, 0xf8, 0x51, 0xcd, 0xfb, 0x54, 0xfe,
+	0x21, 0xc1, 0x54, 0x02, 0x98, 0xce, 0x81, 0x93, 0x18, 0x10, 0xc7, 0xbe, 0xac, 0x80, 0x18, 0xfc,
+	0x02, 0x01, 0xa1, 0xcc, 0xc0, 0x94, 0xf0, 0xc8, 0x3b, 0x86, 0xcd, 0x1e, 0x51, 0xab, 0xce, 0x6c,
+	0xdf, 0x59, 0x5f, 0x85, 0xe9, 0xf8, 0x14, 0x3a, 0x6c, 0x0e, 0x4e, 0xec, 0x19, 0x36, 0xab, 0xd8,
+	0xee, 0x38, 0x7a, 0x2d, 0xb7, 0x17, 0x88, 0x2a, 0x0a, 0x9c, 0x15, 0xea, 0x65, 0x4b, 0xaf, 0xb2,
+	0xcd, 0x16, 0x35, 0xf9, 0x8e, 0x61, 0x7f, 0x43, 0xe7, 0xb6, 0x61, 0x1d, 0x78, 0x4b, 0x7c, 0x57,
+	0x82, 0xb9, 0x2e, 0x42, 0xb8, 0x58, 0x1d, 0xc6, 0x4c, 0x67, 0xbe, 0xc2, 0x51, 0x00, 0x23, 0x6c,
+	0xa1, 0x0b, 0xe5, 0x88, 0xc1, 0xf5, 0xd7, 0x30, 0xae, 0xc6, 0x22, 0xc3, 0x5c, 0x3b, 0x69, 0x86,
+	0xbf, 0x95, 0x4d, 0x8c, 0x1a, 0x21, 0xb5, 0x66, 0x77, 0x4d, 0x10, 0x64, 0x16, 0x46, 0x6d, 0xbd,
+	0xc9, 0xb8, 0x4d, 0x9b, 0xa6, 0xd8, 0xdc, 0x41, 0x2d, 0x18, 0x08, 0x85, 0x22, 0x85, 0x7c, 0xd4,
+	0x28, 0xb2, 0x7a, 0x13, 0x86, 0xc5, 0xea, 0x3d, 0x64, 0x0a, 0x11, 0x80, 0x51, 0x46, 0x4e, 0x60,
+	0x0c, 0x68, 0xae, 0x05, 0xe5, 0xcf, 0x12, 0x14, 0xe2, 0x6e, 0xd4, 0xc4, 0x96, 0x77, 0xe5, 0x30,
+	0x0f, 0x63, 0xdb, 0x96, 0xd1, 0xac, 0xb4, 0x13, 0x39, 0xe9, 0x8c, 0x3e, 0xf2, 0x06, 0x9d, 0xdd,
+	0xb6, 0x8d, 0x90, 0xd0, 0xa0, 0x10, 0xca, 0xd9, 0x46, 0x20, 0x72, 0x1f, 0x20, 0xc8, 0xc4, 0xd3,
+	0x43, 0x82, 0xd2, 0xf9, 0x92, 0x9b, 0xb6, 0x4b, 0x4e, 0xda, 0x2e, 0xb9, 0xd7, 0x07, 0xa6, 0xed,
+	0x52, 0x99, 0xfa, 0xd8, 0xb4, 0x90, 0x66, 0xc8, 0x6f, 0xbf, 0x91, 0xa0, 0x98, 0x48, 0x0a, 0x7d,
+	0xf8, 0x00, 0x46, 0x84, 0x07, 0x38, 0x46, 0x44, 0x5f, 0x4e, 0x44, 0x13, 0xe4, 0xeb, 0x11, 0x0a,
+	0xee, 0x71, 0xbd, 0x90, 0x4a, 0xc1, 0x45, 0x12, 0xe6, 0xa0, 0x7c, 0x2a, 0x01, 0x89, 0xaf, 0x46,
+	0x16, 0x81, 0x78, 0x01, 0x1c, 0xf2, 0xa5, 0x24, 0x7c, 0x39, 0xe1, 0xcd, 0x04, 0x1e, 0xfd, 0xbf,
+	0xe7, 0x91, 0x81, 0x4e, 0x79, 0x44, 0xb9, 0x03, 0x13, 0xc2, 0xcb, 0x8f, 0xf6, 0xa9, 0xe9, 0x1d,
+	0x7d, 0x72, 0x11, 0xc6, 0x1b, 0x86, 0xf1, 0x78, 0x8b, 0x56, 0x1f, 0x57, 0x38, 0xab, 0x1a, 0xad,
+	0x1a, 0x17, 0x40, 0x87, 0xb4, 0x53, 0xde, 0xf8, 0xa6, 0x3b, 0xac, 0x3c, 0x01, 0x12, 0xd6, 0xc7,
+	0x8d, 0x79, 0x1f, 0x72, 0x08, 0xde, 0xde, 0xa7, 0x26, 0xee, 0xce, 0x7c, 0x2a, 0x66, 0xc7, 0xc8,
+	0xfa, 0x24, 0x1e, 0xd6, 0x5c, 0x30, 0xc6, 0x35, 0x30, 0xfc, 0x0f, 0x45, 0x87, 0x71, 0xf7, 0x72,
+	0x6a, 0xd2, 0x3e, 0x10, 0x93, 0xd7, 0x61, 0x62, 0x87, 0x36, 0xb6, 0x2b, 0x0d, 0x7d, 0x9b, 0xf9,
+	0xb2, 0xc7, 0x5c, 0x59, 0x67, 0xe2, 0x2d, 0x7d, 0x9b, 0x79, 0xec, 0x1a, 0x30, 0x11, 0x5a, 0x0a,
+	0xc9, 0xbd, 0x0b, 0xe0, 0xed, 0x4c, 0x93, 0x22, 0xb7, 0x73, 0xe9, 0xfb, 0xd1, 0xa4, 0xeb, 0x04,
+	0xa9, 0x81, 0x3f, 0xc4, 0xb5, 0x51, 0xc3, 0xfb, 0xad, 0x6c, 0xc0, 0x6b, 0xa1, 0x88, 0xb7, 0xa9,
+	0xdd, 0xcf, 0x86, 0xfc, 0x40, 0x82, 0xa9, 0x98, 0x15, 0x44, 0xbe, 0xef, 0x3d, 0xe6, 0x2a, 0x98,
+	0x50, 0x9d, 0x59, 0x64, 0x70, 0x29, 0x95, 0x41, 0x60, 0x70, 0x7d, 0x16, 0x89, 0xe4, 0xdb, 0x67,
+	0xde, 0xd2, 0xb9, 0xad, 0x8d, 0x1b, 0x6d, 0xa3, 0xca, 0xdb, 0x30, 0x2b, 0x30, 0xdd, 0x67, 0xac,
+	0xc6, 0xac, 0xbb, 0xac, 0xc1, 0xea, 0xe2, 0xac, 0x78, 0xfc, 0xe6, 0x61, 0x6c, 0x8f, 0x36, 0xf4,
+	0x1a, 0xb5, 0x0d, 0xab, 0x42, 0x6b, 0x35, 0x0b, 0xf3, 0xd4, 0x49, 0x7f, 0x74, 0xad, 0x56, 0xb3,
+	0x42, 0xd9, 0xe1, 0x36, 0x9c, 0x49, 0x30, 0x88, 0x54, 0x4f, 0xc3, 0xe8, 0x36, 0x63, 0xb5, 0xb0,
+	0xb1, 0x57, 0x9c, 0x01, 0xc7, 0x8e, 0xf2, 0x10, 0x0a, 0xfe, 0xd5, 0x56, 0x66, 0x2d, 0xda, 0xb0,
+	0x0f, 0x36, 0x8c, 0xdd, 0x96, 0xcd, 0xac, 0xbe, 0x01, 0x7d, 0xe4, 0xa5, 0xab, 0x4e, 0x36, 0x11,
+	0x53, 0x05, 0xf2, 0xe2, 0xd6, 0x34, 0xdd, 0xe9, 0x4a, 0xd5, 0x9d, 0xef, 0xe1, 0x06, 0xe8, 0x60,
+	0x94, 0xec, 0xc5, 0xc6, 0x94, 0x4d, 0xbc, 0x73, 0xdf, 0xf1, 0x40, 0xba, 0xdb, 0x13, 0x09, 0xa5,
+	0xcc, 0xcc, 0x9e, 0x0e, 0xc2, 0x5c, 0x17, 0xab, 0xc8, 0xed, 0x5b, 0x40, 0x4c, 0x6a, 0xd9, 0x7a,
+	0x55, 0x37, 0xc5, 0x46, 0x04, 0xaf, 0xe0, 0xd1, 0xf5, 0x92, 0x13, 0x2d, 0x7f, 0x7f, 0x5e, 0x3c,
+	0x5f, 0xd7, 0xed, 0x9d, 0xdd, 0xad, 0x52, 0xd5, 0x68, 0xaa, 0xf8, 0xa2, 0x77, 0xff, 0x2c, 0xf2,
+	0xda, 0x63, 0xd5, 0x3e, 0x30, 0x19, 0x2f, 0xdd, 0x65, 0x55, 0x6d, 0x22, 0x62, 0x49, 0x3c, 0x77,
+	0x1e, 0xc2, 0x09, 0xbe, 0x5b, 0xad, 0x32, 0xce, 0x83, 0x2c, 0x98, 0xdd, 0x70, 0x0e, 0x6d, 0x08,
+	0x93, 0xef, 0xc3, 0x04, 0xdd, 0x63, 0x16, 0xad, 0xb3, 0x4a, 0x8d, 0xed, 0xe9, 0x6e, 0xda, 0x1f,
+	0xec, 0xcb, 0xee, 0x38, 0x1a, 0xba, 0xeb, 0xd9, 0x21, 0x45, 0xc8, 0xf1, 0x06, 0xe5, 0x3b, 0xee,
+	0x1e, 0x8b, 0x0b, 0x71, 0x48, 0x03, 0x31, 0x24, 0x36, 0x8b, 0x3c, 0x80, 0x61, 0xf7, 0xf4, 0x0d,
+	0x8b, 0xcd, 0x57, 0xbb, 0x6d, 0x7e, 0x07, 0xbf, 0x7b, 0x0f, 0x00, 0x61, 0x43, 0x99, 0xf3, 0xae,
+	0x4a, 0x27, 0x1c, 0xf4, 0x0f, 0x59, 0xcd, 0x57, 0xf1, 0x5f, 0x73, 0x07, 0x70, 0x36, 0x59, 0x04,
+	0xf7, 0xf0, 0x9b, 0x30, 0xea, 0x86, 0xa6, 0xee, 0xdf, 0xa8, 0xcb, 0xbd, 0xe3, 0xc2, 0x58, 0x44,
+	0x64, 0x81, 0x25, 0x65, 0xda, 0x4b, 0x6b, 0xbb, 0x8d, 0x86, 0xc8, 0x09, 0x3e, 0xa8, 0x6d, 0x98,
+	0x8a, 0xcd, 0xf8, 0x57, 0x7b, 0xce, 0xdc, 0x6d, 0x34, 0x2a, 0x91, 0xfb, 0xbd, 0x5b, 0x96, 0xf5,
+	0x6d, 0x20, 0x00, 0x30, 0x7d, 0xa3, 0xca, 0x35, 0x78, 0x35, 0xba, 0x4e, 0xaf, 0xb5, 0x5f, 0xb5,
+	0x1d, 0x7a, 0xe8, 0xf9, 0x06, 0x01, 0x3e, 0x3c, 0xc1, 0x59, 0xe0, 0x8d, 0xfa, 0xf0, 0x94, 0x33,
+	0x70, 0x3a, 0x48, 0xd8, 0xe5, 0xdd, 0xad, 0x86, 0xce, 0x77, 0x58, 0xb0, 0x73, 0x1f, 0xc2, 0x6c,
+	0xe7, 0x69, 0x44, 0xf2, 0x1e, 0x8c, 0xbb, 0xd9, 0xdc, 0xf4, 0xe7, 0xd0, 0x5d, 0x17, 0xd3, 0x1e,
+	0xc8, 0xbe, 0x35, 0x04, 0x75, 0xca, 0x8c, 0xae, 0xe1, 0xe7, 0xed, 0xb5, 0x7a, 0xdd, 0x72, 0x32,
+	0x2c, 0x2b, 0x5b, 0xcc, 0xc9, 0x3b, 0x7d, 0x27, 0x93, 0xef, 0x48, 0x70, 0x26, 0xc1, 0x22, 0xd2,
+	0xf9, 0x36, 0x4c, 0x50, 0x6f, 0xae, 0x62, 0xba, 0x93, 0xe8, 0xdf, 0x6b, 0x5d, 0xf8, 0xf8, 0xf6,
+	0x22, 0xf5, 0xa2, 0xab, 0x8e, 0xec, 0xc6, 0x69, 0xdb, 0x9a, 0x4a, 0x31, 0x01, 0x8c, 0xef, 0xfb,
+	0xef, 0x79, 0x2f, 0xeb, 0x0e, 0x12, 0x88, 0xb7, 0x01, 0x24, 0x86, 0xd7, 0xdb, 0x80, 0x2f, 0x08,
+	0x78, 0xa2, 0x1d, 0x70, 0x50, 0xaf, 0x6d, 0x3a, 0x99, 0xe4, 0x5d, 0xbd, 0x55, 0x33, 0xf6, 0x3d,
+	0xac, 0x1b, 0x30, 0x1d, 0x9f, 0x42, 0x90, 0x17, 0xe0, 0xd4, 0xbe, 0x18, 0xa9, 0x98, 0x96, 0x51,
+	0xb7, 0x18, 0xf7, 0x9e, 0x0f, 0x63, 0xee, 0x70, 0x19, 0x47, 0xfd, 0xb3, 0xaa, 0xb1, 0x7d, 0x6a,
+	0xd5, 0xca, 0x86, 0xd1, 0x08, 0xc2, 0x70, 0x2a, 0x36, 0xe3, 0xdf, 0x6b, 0x43, 0xa6, 0x61, 0x34,
+	0x90, 0xf4, 0x4c, 0xe4, 0xcd, 0xec, 0xbd, 0x96, 0x37, 0x0c, 0xbd, 0xb5, 0xbe, 0x84, 0xcf, 0x86,
+	0x85, 0x1e, 0xf2, 0xaa, 0xa3, 0xc0, 0x35, 0x61, 0x58, 0xc9, 0xe3, 0x23, 0xb3, 0x2c, 0x7a, 0x3a,
+	0x1e, 0xa2, 0x32, 0x4c, 0x46, 0x46, 0x11, 0xcd, 0x0d, 0x18, 0x71, 0x7b, 0x3f, 0x18, 0x35, 0x73,
+	0xdd, 0x4e, 0x81, 0xab, 0x8a, 0x0a, 0x2b, 0xff, 0x2d, 0xc0, 0xb0, 0x30, 0x49, 0x7e, 0x25, 0xc1,
+	0x89, 0x48, 0xbd, 0xbd, 0xda, 0xc5, 0x4a, 0x52, 0x6b, 0x49, 0x7e, 0x23, 0x9b, 0x92, 0x4b, 0x40,
+	0xb9, 0xf2, 0xd1, 0xe7, 0xff, 0xfe, 0xe4, 0x98, 0x4a, 0x16, 0x55, 0x4f, 0x49, 0x75, 0x75, 0x54,
+	0x91, 0x9e, 0xb8, 0x7a, 0x28, 0xfe, 0x1e, 0xa9, 0x91, 0xc2, 0x80, 0xfc, 0x5c, 0x82, 0x93, 0x61,
+	0x7b, 0x9c, 0x64, 0x5a, 0xde, 0x73, 0xab, 0x7c, 0x25, 0xa3, 0x16, 0xa2, 0x2e, 0x09, 0xd4, 0x0b,
+	0xe4, 0x7c, 0x12, 0xea, 0x08, 0x5a, 0x4e, 0x3e, 0x91, 0xe0, 0x38, 0xf6, 0x61, 0x48, 0x29, 0x6d,
+	0xc9, 0x68, 0x1f, 0x47, 0x56, 0x7b, 0x96, 0x47, 0x70, 0x17, 0x04, 0xb8, 0x39, 0x52, 0x4c, 0x02,
+	0x87, 0xfd, 0x1e, 0xf2, 0x53, 0x09, 0x72, 0xa1, 0x86, 0x07, 0x59, 0x49, 0x5b, 0x29, 0xde, 0x38,
+	0x91, 0x57, 0x33, 0xe9, 0x20, 0xc2, 0xcb, 0x02, 0xe1, 0x79, 0x72, 0x2e, 0x09, 0x61, 0xb8, 0xdf,
+	0x42, 0xfe, 0x28, 0x41, 0xbe, 0x53, 0xcf, 0x84, 0xdc, 0x4a, 0x5b, 0xbb, 0x4b, 0x3b, 0x46, 0xbe,
+	0xdd, 0x9f, 0x32, 0x32, 0xb8, 0x2a, 0x18, 0x2c, 0x91, 0x52, 0x12, 0x83, 0x68, 0x13, 0xa7, 0xb2,
+	0x83, 0x90, 0x7f, 0x26, 0xc1, 0x71, 0x6c, 0x8e, 0xa4, 0x07, 0x42, 0xb4, 0x35, 0x23, 0xab, 0x3d,
+	0xcb, 0x23, 0xc8, 0xdb, 0x02, 0xe4, 0x55, 0xf2, 0x46, 0xda, 0xd9, 0x72, 0xc1, 0x52, 0x5b, 0x3d,
+	0xf4, 0x6b, 0xf5, 0x23, 0xc7, 0xed, 0x24, 0xde, 0x8e, 0x20, 0x37, 0x32, 0xf9, 0x2d, 0xdc, 0x97,
+	0x91, 0x6f, 0xf6, 0xa3, 0xda, 0x1f, 0x17, 0xdf, 0xf1, 0x96, 0x00, 0xfd, 0x13, 0x09, 0x86, 0x45,
+	0x6d, 0x4d, 0x2e, 0xa7, 0x61, 0x08, 0xf7, 0x06, 0xe4, 0xc5, 0x1e, 0xa5, 0x11, 0xe4, 0x75, 0x01,
+	0x72, 0x85, 0x2c, 0x25, 0x81, 0x74, 0x1a, 0x04, 0x5c, 0x3d, 0x6c, 0x2f, 0x6f, 0x8f, 0xc8, 0x2f,
+	0x25, 0x18, 0x72, 0x2a, 0x64, 0x72, 0x29, 0x35, 0x21, 0x05, 0x8d, 0x00, 0xf9, 0x72, 0x6f, 0xc2,
+	0x88, 0xee, 0x81, 0x40, 0x77, 0x8f, 0x6c, 0x24, 0xa1, 0x63, 0x4d, 0xda, 0x09, 0x9c, 0x7a, 0x18,
+	0x6b, 0x21, 0x1c, 0x91, 0x5f, 0x4b, 0x00, 0x41, 0xcd, 0x4b, 0x96, 0x7b, 0xdb, 0xda, 0x50, 0x6d,
+	0x26, 0xaf, 0x64, 0x51, 0x41, 0x0a, 0x77, 0x04, 0x85, 0xeb, 0xe4, 0x6a, 0xca, 0xb1, 0x73, 0x94,
+	0x3a, 0xb9, 0xf9, 0x0f, 0x12, 0x8c, 0xb7, 0x57, 0xd1, 0xe4, 0x5a, 0x1a, 0x90, 0x84, 0x42, 0x5e,
+	0xbe, 0x9e, 0x5d, 0x11, 0x79, 0xdc, 0x12, 0x3c, 0xae, 0x90, 0xd5, 0x18, 0x0f, 0xff, 0x2d, 0xc9,
+	0xd5, 0xc3, 0xe8, 0x6b, 0xf3, 0x48, 0xdd, 0x16, 0xe6, 0xc8, 0x5f, 0x25, 0x20, 0xf1, 0x1a, 0x39,
+	0xfd, 0x60, 0x26, 0x36, 0x00, 0xe4, 0x9b, 0xfd, 0xa8, 0x22, 0x95, 0x37, 0x05, 0x95, 0x0d, 0xb2,
+	0x96, 0x89, 0x4a, 0xa7, 0xd6, 0x00, 0xf9, 0x8b, 0x04, 0xf9, 0x4e, 0xf5, 0x5f, 0x7a, 0xa2, 0xef,
+	0xd2, 0x03, 0x90, 0x6f, 0xf7, 0xa7, 0x8c, 0xf4, 0xd6, 0x04, 0xbd, 0x5b, 0xe4, 0x46, 0x26, 0x7a,
+	0xae, 0x88, 0x1b, 0x87, 0xe4, 0xb7, 0x12, 0x4c, 0x76, 0xa8, 0x44, 0x49, 0x7a, 0x3a, 0x4c, 0xac,
+	0x70, 0xe5, 0x5b, 0x7d, 0xe9, 0x22, 0xa7, 0x45, 0xc1, 0xe9, 0x02, 0x99, 0xef, 0xc6, 0xc9, 0xf4,
+	0x0c, 0x90, 0x1f, 0x39, 0x47, 0xdd, 0xaf, 0x2f, 0x7b, 0x38, 0xea, 0xed, 0xa5, 0xaf, 0xbc, 0x92,
+	0x45, 0x05, 0x41, 0x9e, 0x13, 0x20, 0x0b, 0x64, 0x36, 0x06, 0x32, 0x54, 0x2a, 0x3b, 0x89, 0x7d,
+	0xd4, 0x57, 0x26, 0x4b, 0x3d, 0xaf, 0xe3, 0x21, 0x5b, 0xce, 0xa0, 0x91, 0xfa, 0x78, 0x09, 0x01,
+	0xf3, 0xae, 0x23, 0xf2, 0x0b, 0x09, 0x4e, 0xb5, 0x15, 0xb3, 0xe4, 0x6a, 0x4f, 0x99, 0x2f, 0x56,
+	0x1c, 0xcb, 0xd7, 0x32, 0xeb, 0x21, 0xe4, 0x8b, 0x02, 0xf2, 0x57, 0xc8, 0x5c, 0x1c, 0x72, 0x5b,
+	0x31, 0x4d, 0xfe, 0x24, 0xc1, 0x78, 0x7b, 0xfd, 0x97, 0x9e, 0x21, 0x13, 0x4a, 0x66, 0xf9, 0x7a,
+	0x76, 0x45, 0x84, 0x7c, 0x5f, 0x40, 0xfe, 0x1a, 0xb9, 0x93, 0xe9, 0xdc, 0xc5, 0x8a, 0x53, 0xf2,
+	0x7b, 0x09, 0x26, 0xda, 0x17, 0xe1, 0x24, 0x33, 0x2e, 0x7f, 0x0f, 0x6e, 0xf4, 0xa1, 0x99, 0xfa,
+	0x66, 0x0c, 0x51, 0x8a, 0x97, 0xd7, 0xe4, 0xc7, 0x12, 0xe4, 0x42, 0x75, 0x6e, 0xfa, 0x33, 0x3d,
+	0x5e, 0x2f, 0xcb, 0xab, 0x99, 0x74, 0x10, 0xf0, 0xbc, 0x00, 0x5c, 0x24, 0x67, 0x62, 0x80, 0xdd,
+	0x76, 0x9f, 0x5b, 0x4e, 0x8b, 0xfc, 0x10, 0x14, 0xca, 0xe9, 0xf9, 0x21, 0x56, 0x6e, 0xcb, 0x2b,
+	0x59, 0x54, 0x52, 0xf3, 0x83, 0x25, 0x84, 0x2b, 0x4e, 0x31, 0x4d, 0x3e, 0x96, 0x60, 0xc4, 0xad,
+	0x7b, 0x49, 0xea, 0x5b, 0x2e, 0x52, 0x70, 0xcb, 0xa5, 0x5e, 0xc5, 0x11, 0x4f, 0x51, 0xe0, 0x99,
+	0x21, 0x53, 0xf1, 0x33, 0x26, 0x04, 0xd7, 0xef, 0x3d, 0x7b, 0x51, 0x90, 0x3e, 0x7b, 0x51, 0x90,
+	0xfe, 0xf5, 0xa2, 0x20, 0x7d, 0xff, 0x65, 0x61, 0xe0, 0xb3, 0x97, 0x85, 0x81, 0xbf, 0xbd, 0x2c,
+	0x0c, 0xbc, 0x77, 0x29, 0xd4, 0x21, 0xf0, 0x95, 0xfd, 0x1f, 0x1f, 0x78, 0x76, 0x44, 0xab, 0x60,
+	0x6b, 0x44, 0xfc, 0x93, 0xc7, 0xea, 0xff, 0x06, 0x00, 0xa9, 0x05, 0x22, 0xe4, 0xaa, 0x22, 0x00,
+	0x00,
 }
 
-func (m *QuerySlashWindowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ExchangeRate returns exchange rate of a specific denom
+	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
+	// ExchangeRates returns the exchange rate for all denoms
+	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
+	// Actives returns all actives denoms on the module's KVStore
+	Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error)
+	// VoteTargets returns all vote targets denoms
+	VoteTargets(ctx context.Context, in *QueryVoteTargetsRequest, opts ...grpc.CallOption) (*QueryVoteTargetsResponse, error)
+	// PriceSnapshotHistory returns the history of price snapshots for all assets
+	PriceSnapshotHistory(ctx context.Context, in *QueryPriceSnapshotHistoryRequest, opts ...grpc.CallOption) (*QueryPriceSnapshotHistoryResponse, error)
+	// PriceAt returns the price of a denom on the most recent snapshot at or before the given timestamp
+	PriceAt(ctx context.Context, in *QueryPriceAtRequest, opts ...grpc.CallOption) (*QueryPriceAtResponse, error)
+	// PriceSnapshotRange returns the paginated prices of a denom on the snapshots between two timestamps
+	PriceSnapshotRange(ctx context.Context, in *QueryPriceSnapshotRangeRequest, opts ...grpc.CallOption) (*QueryPriceSnapshotRangeResponse, error)
+	// Twap = Time-weighted average price
+	// Twaps returns the list of the average price over an specific period of time and denom
+	Twaps(ctx context.Context, in *QueryTwapsRequest, opts ...grpc.CallOption) (*QueryTwapsResponse, error)
+	// Ema = Exponential moving average
+	// Emas returns the list of the exponential moving average price with the given half-life over an specific period of time and denom
+	Emas(ctx context.Context, in *QueryEmasRequest, opts ...grpc.CallOption) (*QueryEmasResponse, error)
+	// PriceStats returns the median, min, max and volatility of the price over an specific period of time and denom
+	PriceStats(ctx context.Context, in *QueryPriceStatsRequest, opts ...grpc.CallOption) (*QueryPriceStatsResponse, error)
+	// FeederDelegation returns the delegator by the validator address
+	FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
+	// VotePenaltyCounter returns the voting behavior by an specific validator
+	VotePenaltyCounter(ctx context.Context, in *QueryVotePenaltyCounterRequest, opts ...grpc.CallOption) (*QueryVotePenaltyCounterResponse, error)
+	// ValidatorOracleStats returns the voting performance of an specific validator across the past slash windows
+	ValidatorOracleStats(ctx context.Context, in *QueryValidatorOracleStatsRequest, opts ...grpc.CallOption) (*QueryValidatorOracleStatsResponse, error)
+	// PenalizedValidators returns the validators currently under oracle penalty
+	PenalizedValidators(ctx context.Context, in *QueryPenalizedValidatorsRequest, opts ...grpc.CallOption) (*QueryPenalizedValidatorsResponse, error)
+	// PullPrices returns the latest prices on the pull oracle feed
+	PullPrices(ctx context.Context, in *QueryPullPricesRequest, opts ...grpc.CallOption) (*QueryPullPricesResponse, error)
+	// PullPrice returns the latest price of a denom on the pull oracle feed
+	PullPrice(ctx context.Context, in *QueryPullPriceRequest, opts ...grpc.CallOption) (*QueryPullPriceResponse, error)
+	// PricePublishers returns the publishers allowed to sign the pull oracle prices
+	PricePublishers(ctx context.Context, in *QueryPricePublishersRequest, opts ...grpc.CallOption) (*QueryPricePublishersResponse, error)
+	// AggregatePrevote returns the pending prevote of an specific validator
+	AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error)
+	// AggregatePrevotes returns the pending prevotes of all validators
+	AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotesRequest, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error)
+	// SlashWindow returns slash window informacion
+	SlashWindow(ctx context.Context, in *QuerySlashWindowRequest, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error)
+	// RewardPool returns the current balance of the oracle reward pool
+	RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error)
+	// Params returns the Oracle module's params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
-func (m *QuerySlashWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WindowProgress != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.WindowProgress))
-		i--
-		dAtA[i] = 0x8
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error) {
+	out := new(QueryExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/ExchangeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
+	out := new(QueryExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/ExchangeRates", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error) {
+	out := new(QueryActivesResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/Actives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) VoteTargets(ctx context.Context, in *QueryVoteTargetsRequest, opts ...grpc.CallOption) (*QueryVoteTargetsResponse, error) {
+	out := new(QueryVoteTargetsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/VoteTargets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PriceSnapshotHistory(ctx context.Context, in *QueryPriceSnapshotHistoryRequest, opts ...grpc.CallOption) (*QueryPriceSnapshotHistoryResponse, error) {
+	out := new(QueryPriceSnapshotHistoryResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/PriceSnapshotHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PriceAt(ctx context.Context, in *QueryPriceAtRequest, opts ...grpc.CallOption) (*QueryPriceAtResponse, error) {
+	out := new(QueryPriceAtResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/PriceAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PriceSnapshotRange(ctx context.Context, in *QueryPriceSnapshotRangeRequest, opts ...grpc.CallOption) (*QueryPriceSnapshotRangeResponse, error) {
+	out := new(QueryPriceSnapshotRangeResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/PriceSnapshotRange", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) Twaps(ctx context.Context, in *QueryTwapsRequest, opts ...grpc.CallOption) (*QueryTwapsResponse, error) {
+	out := new(QueryTwapsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/Twaps", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryExchangeRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+func (c *queryClient) Emas(ctx context.Context, in *QueryEmasRequest, opts ...grpc.CallOption) (*QueryEmasResponse, error) {
+	out := new(QueryEmasResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/Emas", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryExchangeRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OracleExchangeRate != nil {
-		l = m.OracleExchangeRate.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) PriceStats(ctx context.Context, in *QueryPriceStatsRequest, opts ...grpc.CallOption) (*QueryPriceStatsResponse, error) {
+	out := new(QueryPriceStatsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/PriceStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryExchangeRatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
+	out := new(QueryFeederDelegationResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/FeederDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryExchangeRatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.DenomOracleExchangeRate) > 0 {
-		for _, e := range m.DenomOracleExchangeRate {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) VotePenaltyCounter(ctx context.Context, in *QueryVotePenaltyCounterRequest, opts ...grpc.CallOption) (*QueryVotePenaltyCounterResponse, error) {
+	out := new(QueryVotePenaltyCounterResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/VotePenaltyCounter", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryActivesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ValidatorOracleStats(ctx context.Context, in *QueryValidatorOracleStatsRequest, opts ...grpc.CallOption) (*QueryValidatorOracleStatsResponse, error) {
+	out := new(QueryValidatorOracleStatsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/ValidatorOracleStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryActivesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Actives) > 0 {
-		for _, s := range m.Actives {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) PenalizedValidators(ctx context.Context, in *QueryPenalizedValidatorsRequest, opts ...grpc.CallOption) (*QueryPenalizedValidatorsResponse, error) {
+	out := new(QueryPenalizedValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/PenalizedValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *DenomOracleExchangeRate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.OracleExchangeRate != nil {
-		l = m.OracleExchangeRate.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) PullPrices(ctx context.Context, in *QueryPullPricesRequest, opts ...grpc.CallOption) (*QueryPullPricesResponse, error) {
+	out := new(QueryPullPricesResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/PullPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryVoteTargetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) PullPrice(ctx context.Context, in *QueryPullPriceRequest, opts ...grpc.CallOption) (*QueryPullPriceResponse, error) {
+	out := new(QueryPullPriceResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/PullPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryVoteTargetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.VoteTargets) > 0 {
-		for _, s := range m.VoteTargets {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) PricePublishers(ctx context.Context, in *QueryPricePublishersRequest, opts ...grpc.CallOption) (*QueryPricePublishersResponse, error) {
+	out := new(QueryPricePublishersResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/PricePublishers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryPriceSnapshotHistoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error) {
+	out := new(QueryAggregatePrevoteResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/AggregatePrevote", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryPriceSnapshotHistoryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PriceSnapshot) > 0 {
-		for _, e := range m.PriceSnapshot {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotesRequest, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error) {
+	out := new(QueryAggregatePrevotesResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/AggregatePrevotes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryTwapsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LookbackSeconds != 0 {
-		n += 1 + sovQuery(uint64(m.LookbackSeconds))
+func (c *queryClient) SlashWindow(ctx context.Context, in *QuerySlashWindowRequest, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error) {
+	out := new(QuerySlashWindowResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/SlashWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryTwapsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.OracleTwap) > 0 {
-		for _, e := range m.OracleTwap {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error) {
+	out := new(QueryRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/RewardPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFeederDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFeederDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeedAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ExchangeRate returns exchange rate of a specific denom
+	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
+	// ExchangeRates returns the exchange rate for all denoms
+	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
+	// Actives returns all actives denoms on the module's KVStore
+	Actives(context.Context, *QueryActivesRequest) (*QueryActivesResponse, error)
+	// VoteTargets returns all vote targets denoms
+	VoteTargets(context.Context, *QueryVoteTargetsRequest) (*QueryVoteTargetsResponse, error)
+	// PriceSnapshotHistory returns the history of price snapshots for all assets
+	PriceSnapshotHistory(context.Context, *QueryPriceSnapshotHistoryRequest) (*QueryPriceSnapshotHistoryResponse, error)
+	// PriceAt returns the price of a denom on the most recent snapshot at or before the given timestamp
+	PriceAt(context.Context, *QueryPriceAtRequest) (*QueryPriceAtResponse, error)
+	// PriceSnapshotRange returns the paginated prices of a denom on the snapshots between two timestamps
+	PriceSnapshotRange(context.Context, *QueryPriceSnapshotRangeRequest) (*QueryPriceSnapshotRangeResponse, error)
+	// Twap = Time-weighted average price
+	// Twaps returns the list of the average price over an specific period of time and denom
+	Twaps(context.Context, *QueryTwapsRequest) (*QueryTwapsResponse, error)
+	// Ema = Exponential moving average
+	// Emas returns the list of the exponential moving average price with the given half-life over an specific period of time and denom
+	Emas(context.Context, *QueryEmasRequest) (*QueryEmasResponse, error)
+	// PriceStats returns the median, min, max and volatility of the price over an specific period of time and denom
+	PriceStats(context.Context, *QueryPriceStatsRequest) (*QueryPriceStatsResponse, error)
+	// FeederDelegation returns the delegator by the validator address
+	FeederDelegation(context.Context, *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error)
+	// VotePenaltyCounter returns the voting behavior by an specific validator
+	VotePenaltyCounter(context.Context, *QueryVotePenaltyCounterRequest) (*QueryVotePenaltyCounterResponse, error)
+	// ValidatorOracleStats returns the voting performance of an specific validator across the past slash windows
+	ValidatorOracleStats(context.Context, *QueryValidatorOracleStatsRequest) (*QueryValidatorOracleStatsResponse, error)
+	// PenalizedValidators returns the validators currently under oracle penalty
+	PenalizedValidators(context.Context, *QueryPenalizedValidatorsRequest) (*QueryPenalizedValidatorsResponse, error)
+	// PullPrices returns the latest prices on the pull oracle feed
+	PullPrices(context.Context, *QueryPullPricesRequest) (*QueryPullPricesResponse, error)
+	// PullPrice returns the latest price of a denom on the pull oracle feed
+	PullPrice(context.Context, *QueryPullPriceRequest) (*QueryPullPriceResponse, error)
+	// PricePublishers returns the publishers allowed to sign the pull oracle prices
+	PricePublishers(context.Context, *QueryPricePublishersRequest) (*QueryPricePublishersResponse, error)
+	// AggregatePrevote returns the pending prevote of an specific validator
+	AggregatePrevote(context.Context, *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error)
+	// AggregatePrevotes returns the pending prevotes of all validators
+	AggregatePrevotes(context.Context, *QueryAggregatePrevotesRequest) (*QueryAggregatePrevotesResponse, error)
+	// SlashWindow returns slash window informacion
+	SlashWindow(context.Context, *QuerySlashWindowRequest) (*QuerySlashWindowResponse, error)
+	// RewardPool returns the current balance of the oracle reward pool
+	RewardPool(context.Context, *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error)
+	// Params returns the Oracle module's params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
-func (m *QueryVotePenaltyCounterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryVotePenaltyCounterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRate not implemented")
+}
+func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
+}
+func (*UnimplementedQueryServer) Actives(ctx context.Context, req *QueryActivesRequest) (*QueryActivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Actives not implemented")
+}
+func (*UnimplementedQueryServer) VoteTargets(ctx context.Context, req *QueryVoteTargetsRequest) (*QueryVoteTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteTargets not implemented")
+}
+func (*UnimplementedQueryServer) PriceSnapshotHistory(ctx context.Context, req *QueryPriceSnapshotHistoryRequest) (*QueryPriceSnapshotHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceSnapshotHistory not implemented")
+}
+func (*UnimplementedQueryServer) PriceAt(ctx context.Context, req *QueryPriceAtRequest) (*QueryPriceAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceAt not implemented")
+}
+func (*UnimplementedQueryServer) PriceSnapshotRange(ctx context.Context, req *QueryPriceSnapshotRangeRequest) (*QueryPriceSnapshotRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceSnapshotRange not implemented")
+}
+func (*UnimplementedQueryServer) Twaps(ctx context.Context, req *QueryTwapsRequest) (*QueryTwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Twaps not implemented")
+}
+func (*UnimplementedQueryServer) Emas(ctx context.Context, req *QueryEmasRequest) (*QueryEmasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Emas not implemented")
+}
+func (*UnimplementedQueryServer) PriceStats(ctx context.Context, req *QueryPriceStatsRequest) (*QueryPriceStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceStats not implemented")
+}
+func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeederDelegation not implemented")
+}
+func (*UnimplementedQueryServer) VotePenaltyCounter(ctx context.Context, req *QueryVotePenaltyCounterRequest) (*QueryVotePenaltyCounterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotePenaltyCounter not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorOracleStats(ctx context.Context, req *QueryValidatorOracleStatsRequest) (*QueryValidatorOracleStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOracleStats not implemented")
+}
+func (*UnimplementedQueryServer) PenalizedValidators(ctx context.Context, req *QueryPenalizedValidatorsRequest) (*QueryPenalizedValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PenalizedValidators not implemented")
+}
+func (*UnimplementedQueryServer) PullPrices(ctx context.Context, req *QueryPullPricesRequest) (*QueryPullPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullPrices not implemented")
+}
+func (*UnimplementedQueryServer) PullPrice(ctx context.Context, req *QueryPullPriceRequest) (*QueryPullPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullPrice not implemented")
+}
+func (*UnimplementedQueryServer) PricePublishers(ctx context.Context, req *QueryPricePublishersRequest) (*QueryPricePublishersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PricePublishers not implemented")
+}
+func (*UnimplementedQueryServer) AggregatePrevote(ctx context.Context, req *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevote not implemented")
+}
+func (*UnimplementedQueryServer) AggregatePrevotes(ctx context.Context, req *QueryAggregatePrevotesRequest) (*QueryAggregatePrevotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevotes not implemented")
+}
+func (*UnimplementedQueryServer) SlashWindow(ctx context.Context, req *QuerySlashWindowRequest) (*QuerySlashWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashWindow not implemented")
+}
+func (*UnimplementedQueryServer) RewardPool(ctx context.Context, req *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPool not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.VotePenaltyCounter != nil {
-		l = m.VotePenaltyCounter.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRate(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/ExchangeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRate(ctx, req.(*QueryExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySlashWindowRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/ExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRates(ctx, req.(*QueryExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySlashWindowResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Actives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.WindowProgress != 0 {
-		n += 1 + sovQuery(uint64(m.WindowProgress))
+	if interceptor == nil {
+		return srv.(QueryServer).Actives(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/Actives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Actives(ctx, req.(*QueryActivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_VoteTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).VoteTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/VoteTargets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteTargets(ctx, req.(*QueryVoteTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PriceSnapshotHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceSnapshotHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).PriceSnapshotHistory(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/PriceSnapshotHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceSnapshotHistory(ctx, req.(*QueryPriceSnapshotHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func _Query_PriceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/PriceAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceAt(ctx, req.(*QueryPriceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func _Query_PriceSnapshotRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceSnapshotRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceSnapshotRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/PriceSnapshotRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceSnapshotRange(ctx, req.(*QueryPriceSnapshotRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Twaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Twaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/Twaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Twaps(ctx, req.(*QueryTwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Emas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Emas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/Emas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Emas(ctx, req.(*QueryEmasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/PriceStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceStats(ctx, req.(*QueryPriceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeederDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeederDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeederDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/FeederDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeederDelegation(ctx, req.(*QueryFeederDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotePenaltyCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotePenaltyCounterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotePenaltyCounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/VotePenaltyCounter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotePenaltyCounter(ctx, req.(*QueryVotePenaltyCounterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorOracleStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorOracleStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorOracleStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/ValidatorOracleStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorOracleStats(ctx, req.(*QueryValidatorOracleStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PenalizedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPenalizedValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PenalizedValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/PenalizedValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PenalizedValidators(ctx, req.(*QueryPenalizedValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PullPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPullPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PullPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/PullPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PullPrices(ctx, req.(*QueryPullPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PullPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPullPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PullPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/PullPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PullPrice(ctx, req.(*QueryPullPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PricePublishers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPricePublishersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PricePublishers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/PricePublishers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PricePublishers(ctx, req.(*QueryPricePublishersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregatePrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregatePrevoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatePrevote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/AggregatePrevote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatePrevote(ctx, req.(*QueryAggregatePrevoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregatePrevotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregatePrevotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatePrevotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/AggregatePrevotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatePrevotes(ctx, req.(*QueryAggregatePrevotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/SlashWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashWindow(ctx, req.(*QuerySlashWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/RewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPool(ctx, req.(*QueryRewardPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kiichain.kiichain3.oracle.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExchangeRate",
+			Handler:    _Query_ExchangeRate_Handler,
+		},
+		{
+			MethodName: "ExchangeRates",
+			Handler:    _Query_ExchangeRates_Handler,
+		},
+		{
+			MethodName: "Actives",
+			Handler:    _Query_Actives_Handler,
+		},
+		{
+			MethodName: "VoteTargets",
+			Handler:    _Query_VoteTargets_Handler,
+		},
+		{
+			MethodName: "PriceSnapshotHistory",
+			Handler:    _Query_PriceSnapshotHistory_Handler,
+		},
+		{
+			MethodName: "PriceAt",
+			Handler:    _Query_PriceAt_Handler,
+		},
+		{
+			MethodName: "PriceSnapshotRange",
+			Handler:    _Query_PriceSnapshotRange_Handler,
+		},
+		{
+			MethodName: "Twaps",
+			Handler:    _Query_Twaps_Handler,
+		},
+		{
+			MethodName: "Emas",
+			Handler:    _Query_Emas_Handler,
+		},
+		{
+			MethodName: "PriceStats",
+			Handler:    _Query_PriceStats_Handler,
+		},
+		{
+			MethodName: "FeederDelegation",
+			Handler:    _Query_FeederDelegation_Handler,
+		},
+		{
+			MethodName: "VotePenaltyCounter",
+			Handler:    _Query_VotePenaltyCounter_Handler,
+		},
+		{
+			MethodName: "ValidatorOracleStats",
+			Handler:    _Query_ValidatorOracleStats_Handler,
+		},
+		{
+			MethodName: "PenalizedValidators",
+			Handler:    _Query_PenalizedValidators_Handler,
+		},
+		{
+			MethodName: "PullPrices",
+			Handler:    _Query_PullPrices_Handler,
+		},
+		{
+			MethodName: "PullPrice",
+			Handler:    _Query_PullPrice_Handler,
+		},
+		{
+			MethodName: "PricePublishers",
+			Handler:    _Query_PricePublishers_Handler,
+		},
+		{
+			MethodName: "AggregatePrevote",
+			Handler:    _Query_AggregatePrevote_Handler,
+		},
+		{
+			MethodName: "AggregatePrevotes",
+			Handler:    _Query_AggregatePrevotes_Handler,
+		},
+		{
+			MethodName: "SlashWindow",
+			Handler:    _Query_SlashWindow_Handler,
+		},
+		{
+			MethodName: "RewardPool",
+			Handler:    _Query_RewardPool_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "oracle/query.proto",
+}
+
+func (m *QueryExchangeRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OracleExchangeRate != nil {
+		{
+			size, err := m.OracleExchangeRate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomOracleExchangeRate) > 0 {
+		for iNdEx := len(m.DenomOracleExchangeRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomOracleExchangeRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActivesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActivesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actives) > 0 {
+		for iNdEx := len(m.Actives) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Actives[iNdEx])
+			copy(dAtA[i:], m.Actives[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Actives[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomOracleExchangeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomOracleExchangeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomOracleExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OracleExchangeRate != nil {
+		{
+			size, err := m.OracleExchangeRate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteTargetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteTargetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteTargetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteTargetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteTargetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteTargetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoteTargets) > 0 {
+		for iNdEx := len(m.VoteTargets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VoteTargets[iNdEx])
+			copy(dAtA[i:], m.VoteTargets[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.VoteTargets[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceSnapshotHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceSnapshotHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceSnapshotHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceSnapshotHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceSnapshotHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceSnapshotHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceSnapshot) > 0 {
+		for iNdEx := len(m.PriceSnapshot) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceSnapshot[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceAtRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceAtRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceAtRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceAtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceAtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceAtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceSnapshotRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceSnapshotRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceSnapshotRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ToTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceSnapshotRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceSnapshotRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceSnapshotRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPriceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPriceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.OracleExchangeRate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SnapshotTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SnapshotTimestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTwapsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTwapsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTwapsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LookbackSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LookbackSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTwapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTwapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OracleTwap) > 0 {
+		for iNdEx := len(m.OracleTwap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleTwap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HalfLifeSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HalfLifeSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LookbackSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LookbackSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OracleEma) > 0 {
+		for iNdEx := len(m.OracleEma) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LookbackSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LookbackSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OraclePriceStats) > 0 {
+		for iNdEx := len(m.OraclePriceStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OraclePriceStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeederDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeederDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeederDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeederDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeederDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeederDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeedAddr) > 0 {
+		i -= len(m.FeedAddr)
+		copy(dAtA[i:], m.FeedAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotePenaltyCounterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotePenaltyCounterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotePenaltyCounterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotePenaltyCounterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotePenaltyCounterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotePenaltyCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotePenaltyCounter != nil {
+		{
+			size, err := m.VotePenaltyCounter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOracleStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOracleStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOracleStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOracleStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOracleStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOracleStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.SlashCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.AverageDeviation.Size()
+		i -= size
+		if _, err := m.AverageDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SuccessRate.Size()
+		i -= size
+		if _, err := m.SuccessRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ParticipationRate.Size()
+		i -= size
+		if _, err := m.ParticipationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPenalizedValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPenalizedValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPenalizedValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPenalizedValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPenalizedValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPenalizedValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Penalties) > 0 {
+		for iNdEx := len(m.Penalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Penalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPullPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPullPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPullPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPullPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPullPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPullPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PullPrices) > 0 {
+		for iNdEx := len(m.PullPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PullPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPullPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPullPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPullPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPullPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPullPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPullPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PullPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPricePublishersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPricePublishersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPricePublishersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPricePublishersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPricePublishersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPricePublishersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PricePublishers) > 0 {
+		for iNdEx := len(m.PricePublishers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PricePublishers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AggregatePrevote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregatePrevotes) > 0 {
+		for iNdEx := len(m.AggregatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashWindowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashWindowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashWindowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashWindowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashWindowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowProgress != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowProgress))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryExchangeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleExchangeRate != nil {
+		l = m.OracleExchangeRate.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryExchangeRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomOracleExchangeRate) > 0 {
+		for _, e := range m.DenomOracleExchangeRate {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryActivesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Actives) > 0 {
+		for _, s := range m.Actives {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomOracleExchangeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OracleExchangeRate != nil {
+		l = m.OracleExchangeRate.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryVoteTargetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVoteTargetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VoteTargets) > 0 {
+		for _, s := range m.VoteTargets {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPriceSnapshotHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPriceSnapshotHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceSnapshot) > 0 {
+		for _, e := range m.PriceSnapshot {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPriceAtRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *QueryPriceAtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPriceSnapshotRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.FromTimestamp))
+	}
+	if m.ToTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.ToTimestamp))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceSnapshotRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomPriceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SnapshotTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.SnapshotTimestamp))
+	}
+	l = m.OracleExchangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTwapsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LookbackSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.LookbackSeconds))
+	}
+	return n
+}
+
+func (m *QueryTwapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OracleTwap) > 0 {
+		for _, e := range m.OracleTwap {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEmasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LookbackSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.LookbackSeconds))
+	}
+	if m.HalfLifeSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.HalfLifeSeconds))
+	}
+	return n
+}
+
+func (m *QueryEmasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OracleEma) > 0 {
+		for _, e := range m.OracleEma {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPriceStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LookbackSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.LookbackSeconds))
+	}
+	return n
+}
+
+func (m *QueryPriceStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OraclePriceStats) > 0 {
+		for _, e := range m.OraclePriceStats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeederDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeederDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotePenaltyCounterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotePenaltyCounterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePenaltyCounter != nil {
+		l = m.VotePenaltyCounter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOracleStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOracleStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ParticipationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SuccessRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AverageDeviation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SlashCount != 0 {
+		n += 1 + sovQuery(uint64(m.SlashCount))
+	}
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPenalizedValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPenalizedValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Penalties) > 0 {
+		for _, e := range m.Penalties {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPullPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPullPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PullPrices) > 0 {
+		for _, e := range m.PullPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPullPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPullPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PullPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPricePublishersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPricePublishersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PricePublishers) > 0 {
+		for _, e := range m.PricePublishers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAggregatePrevoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregatePrevoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregatePrevote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAggregatePrevotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAggregatePrevotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregatePrevotes) > 0 {
+		for _, e := range m.AggregatePrevotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySlashWindowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySlashWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowProgress != 0 {
+		n += 1 + sovQuery(uint64(m.WindowProgress))
+	}
+	return n
+}
+
+func (m *QueryRewardPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		for _, e := range m.Pool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OracleExchangeRate == nil {
+				m.OracleExchangeRate = &OracleExchangeRate{}
+			}
+			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ExchangeRateStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomOracleExchangeRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomOracleExchangeRate = append(m.DenomOracleExchangeRate, DenomOracleExchangeRate{})
+			if err := m.DenomOracleExchangeRate[len(m.DenomOracleExchangeRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actives = append(m.Actives, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomOracleExchangeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomOracleExchangeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomOracleExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OracleExchangeRate == nil {
+				m.OracleExchangeRate = &OracleExchangeRate{}
+			}
+			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ExchangeRateStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteTargetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteTargetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteTargets = append(m.VoteTargets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceSnapshotHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceSnapshotHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceSnapshotHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceSnapshotHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceSnapshotHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceSnapshotHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSnapshot = append(m.PriceSnapshot, PriceSnapshot{})
+			if err := m.PriceSnapshot[len(m.PriceSnapshot)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceAtRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceAtRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceAtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceAtResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceAtResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceAtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceSnapshotRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceSnapshotRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceSnapshotRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTimestamp", wireType)
+			}
+			m.FromTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTimestamp", wireType)
+			}
+			m.ToTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceSnapshotRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceSnapshotRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceSnapshotRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, DenomPriceSnapshot{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomPriceSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPriceSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTimestamp", wireType)
+			}
+			m.SnapshotTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTwapsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTwapsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTwapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			}
+			m.LookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTwapsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTwapsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTwapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleTwap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleTwap = append(m.OracleTwap, OracleTwap{})
+			if err := m.OracleTwap[len(m.OracleTwap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			}
+			m.LookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalfLifeSeconds", wireType)
+			}
+			m.HalfLifeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalfLifeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleEma", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleEma = append(m.OracleEma, OracleEma{})
+			if err := m.OracleEma[len(m.OracleEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			}
+			m.LookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePriceStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OraclePriceStats = append(m.OraclePriceStats, OraclePriceStats{})
+			if err := m.OraclePriceStats[len(m.OraclePriceStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVotePenaltyCounterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2373,15 +7332,15 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotePenaltyCounterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotePenaltyCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2409,7 +7368,7 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2432,7 +7391,7 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVotePenaltyCounterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2455,15 +7414,15 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotePenaltyCounterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotePenaltyCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePenaltyCounter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2490,10 +7449,10 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OracleExchangeRate == nil {
-				m.OracleExchangeRate = &OracleExchangeRate{}
+			if m.VotePenaltyCounter == nil {
+				m.VotePenaltyCounter = &VotePenaltyCounter{}
 			}
-			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VotePenaltyCounter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2518,7 +7477,7 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorOracleStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2541,12 +7500,44 @@ func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorOracleStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorOracleStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2568,7 +7559,7 @@ func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorOracleStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2591,15 +7582,136 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorOracleStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorOracleStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomOracleExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ParticipationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SuccessRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AverageDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashCount", wireType)
+			}
+			m.SlashCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2626,8 +7738,7 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomOracleExchangeRate = append(m.DenomOracleExchangeRate, DenomOracleExchangeRate{})
-			if err := m.DenomOracleExchangeRate[len(m.DenomOracleExchangeRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2652,7 +7763,7 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPenalizedValidatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2675,10 +7786,10 @@ func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPenalizedValidatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPenalizedValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2702,7 +7813,7 @@ func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPenalizedValidatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2725,17 +7836,17 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPenalizedValidatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPenalizedValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalties", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2745,23 +7856,25 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actives = append(m.Actives, string(dAtA[iNdEx:postIndex]))
+			m.Penalties = append(m.Penalties, ValidatorOraclePenalty{})
+			if err := m.Penalties[len(m.Penalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2784,7 +7897,7 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomOracleExchangeRate) Unmarshal(dAtA []byte) error {
+func (m *QueryPullPricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2807,47 +7920,65 @@ func (m *DenomOracleExchangeRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomOracleExchangeRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPullPricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomOracleExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPullPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPullPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPullPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPullPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPrices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2874,10 +8005,8 @@ func (m *DenomOracleExchangeRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OracleExchangeRate == nil {
-				m.OracleExchangeRate = &OracleExchangeRate{}
-			}
-			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PullPrices = append(m.PullPrices, PullPrice{})
+			if err := m.PullPrices[len(m.PullPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2902,7 +8031,7 @@ func (m *DenomOracleExchangeRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPullPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2925,12 +8054,44 @@ func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteTargetsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPullPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPullPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2952,7 +8113,7 @@ func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPullPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2975,17 +8136,17 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteTargetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPullPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPullPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPrice", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2995,23 +8156,24 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoteTargets = append(m.VoteTargets, string(dAtA[iNdEx:postIndex]))
+			if err := m.PullPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3034,7 +8196,7 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPriceSnapshotHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPricePublishersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3057,10 +8219,10 @@ func (m *QueryPriceSnapshotHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPriceSnapshotHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPricePublishersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPriceSnapshotHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPricePublishersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3084,7 +8246,7 @@ func (m *QueryPriceSnapshotHistoryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPriceSnapshotHistoryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPricePublishersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3107,15 +8269,15 @@ func (m *QueryPriceSnapshotHistoryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPriceSnapshotHistoryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPricePublishersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPriceSnapshotHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPricePublishersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePublishers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3142,8 +8304,8 @@ func (m *QueryPriceSnapshotHistoryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceSnapshot = append(m.PriceSnapshot, PriceSnapshot{})
-			if err := m.PriceSnapshot[len(m.PriceSnapshot)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PricePublishers = append(m.PricePublishers, PricePublisher{})
+			if err := m.PricePublishers[len(m.PricePublishers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3168,7 +8330,7 @@ func (m *QueryPriceSnapshotHistoryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTwapsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePrevoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3191,17 +8353,17 @@ func (m *QueryTwapsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTwapsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTwapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
-			m.LookbackSeconds = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3211,11 +8373,24 @@ func (m *QueryTwapsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LookbackSeconds |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3237,7 +8412,7 @@ func (m *QueryTwapsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTwapsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3260,15 +8435,15 @@ func (m *QueryTwapsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTwapsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTwapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleTwap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevote", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3295,8 +8470,7 @@ func (m *QueryTwapsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleTwap = append(m.OracleTwap, OracleTwap{})
-			if err := m.OracleTwap[len(m.OracleTwap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AggregatePrevote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3321,7 +8495,7 @@ func (m *QueryTwapsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePrevotesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3344,44 +8518,12 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePrevotesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePrevotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3403,7 +8545,7 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3426,17 +8568,17 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevotes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3446,23 +8588,25 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedAddr = string(dAtA[iNdEx:postIndex])
+			m.AggregatePrevotes = append(m.AggregatePrevotes, AggregateExchangeRatePrevote{})
+			if err := m.AggregatePrevotes[len(m.AggregatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3485,7 +8629,7 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotePenaltyCounterRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashWindowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3508,44 +8652,12 @@ func (m *QueryVotePenaltyCounterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotePenaltyCounterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashWindowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotePenaltyCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashWindowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3567,7 +8679,7 @@ func (m *QueryVotePenaltyCounterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotePenaltyCounterResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3590,17 +8702,17 @@ func (m *QueryVotePenaltyCounterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotePenaltyCounterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashWindowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotePenaltyCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotePenaltyCounter", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowProgress", wireType)
 			}
-			var msglen int
+			m.WindowProgress = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3610,28 +8722,11 @@ func (m *QueryVotePenaltyCounterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.WindowProgress |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.VotePenaltyCounter == nil {
-				m.VotePenaltyCounter = &VotePenaltyCounter{}
-			}
-			if err := m.VotePenaltyCounter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3653,7 +8748,7 @@ func (m *QueryVotePenaltyCounterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySlashWindowRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3676,10 +8771,10 @@ func (m *QuerySlashWindowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySlashWindowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySlashWindowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3703,7 +8798,7 @@ func (m *QuerySlashWindowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3726,17 +8821,17 @@ func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySlashWindowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySlashWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WindowProgress", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
-			m.WindowProgress = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3746,11 +8841,26 @@ func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WindowProgress |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = append(m.Pool, types.Coin{})
+			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
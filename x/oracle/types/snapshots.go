@@ -9,6 +9,12 @@ type PriceSnapshots []PriceSnapshot
 // OracleTwaps represents an array of OracleTwap on query.go
 type OracleTwaps []OracleTwap
 
+// OracleEmas represents an array of OracleEma on query.go
+type OracleEmas []OracleEma
+
+// OraclePriceStatsList represents an array of OraclePriceStats on query.go
+type OraclePriceStatsList []OraclePriceStats
+
 // Constructor functions
 // NewPriceSnapshot creates a new instance of PriceSnapshot
 func NewPriceSnapshot(snapshotTimestamp int64, priceSnapshotItems PriceSnapshotItems) PriceSnapshot {
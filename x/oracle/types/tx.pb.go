@@ -28,19 +28,101 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MsgAggregateExchangeRatePrevote represent the message to submit
+// the hash of an aggregate exchange rate vote
+type MsgAggregateExchangeRatePrevote struct {
+	Hash      string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty" yaml:"hash"`
+	Feeder    string `protobuf:"bytes,2,opt,name=feeder,proto3" json:"feeder,omitempty" yaml:"feeder"`
+	Validator string `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty" yaml:"validator"`
+}
+
+func (m *MsgAggregateExchangeRatePrevote) Reset()         { *m = MsgAggregateExchangeRatePrevote{} }
+func (m *MsgAggregateExchangeRatePrevote) String() string { return proto.CompactTextString(m) }
+func (*MsgAggregateExchangeRatePrevote) ProtoMessage()    {}
+func (*MsgAggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5390096518ffda, []int{0}
+}
+func (m *MsgAggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAggregateExchangeRatePrevote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAggregateExchangeRatePrevote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAggregateExchangeRatePrevote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAggregateExchangeRatePrevote.Merge(m, src)
+}
+func (m *MsgAggregateExchangeRatePrevote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAggregateExchangeRatePrevote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAggregateExchangeRatePrevote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAggregateExchangeRatePrevote proto.InternalMessageInfo
+
+// MsgAggregateExchangeRatePrevoteResponse defines the MsgAggregateExchangeRatePrevote response
+type MsgAggregateExchangeRatePrevoteResponse struct {
+}
+
+func (m *MsgAggregateExchangeRatePrevoteResponse) Reset() {
+	*m = MsgAggregateExchangeRatePrevoteResponse{}
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAggregateExchangeRatePrevoteResponse) ProtoMessage()    {}
+func (*MsgAggregateExchangeRatePrevoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5390096518ffda, []int{1}
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAggregateExchangeRatePrevoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAggregateExchangeRatePrevoteResponse.Merge(m, src)
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAggregateExchangeRatePrevoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAggregateExchangeRatePrevoteResponse proto.InternalMessageInfo
+
 // MsgAggregateExchangeRateVote represent the message to submit
 // an aggregate exchange rate vote
 type MsgAggregateExchangeRateVote struct {
 	ExchangeRates string `protobuf:"bytes,1,opt,name=exchange_rates,json=exchangeRates,proto3" json:"exchange_rates,omitempty" yaml:"exchange_rates"`
 	Feeder        string `protobuf:"bytes,2,opt,name=feeder,proto3" json:"feeder,omitempty" yaml:"feeder"`
 	Validator     string `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty" yaml:"validator"`
+	// salt used to build the hash submitted on the previous prevote
+	Salt string `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty" yaml:"salt"`
 }
 
 func (m *MsgAggregateExchangeRateVote) Reset()         { *m = MsgAggregateExchangeRateVote{} }
 func (m *MsgAggregateExchangeRateVote) String() string { return proto.CompactTextString(m) }
 func (*MsgAggregateExchangeRateVote) ProtoMessage()    {}
 func (*MsgAggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5390096518ffda, []int{0}
+	return fileDescriptor_cb5390096518ffda, []int{2}
 }
 func (m *MsgAggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -77,7 +159,7 @@ func (m *MsgAggregateExchangeRateVoteResponse) Reset()         { *m = MsgAggrega
 func (m *MsgAggregateExchangeRateVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAggregateExchangeRateVoteResponse) ProtoMessage()    {}
 func (*MsgAggregateExchangeRateVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5390096518ffda, []int{1}
+	return fileDescriptor_cb5390096518ffda, []int{3}
 }
 func (m *MsgAggregateExchangeRateVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -117,7 +199,7 @@ func (m *MsgDelegateFeedConsent) Reset()         { *m = MsgDelegateFeedConsent{}
 func (m *MsgDelegateFeedConsent) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateFeedConsent) ProtoMessage()    {}
 func (*MsgDelegateFeedConsent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5390096518ffda, []int{2}
+	return fileDescriptor_cb5390096518ffda, []int{4}
 }
 func (m *MsgDelegateFeedConsent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -154,7 +236,7 @@ func (m *MsgDelegateFeedConsentResponse) Reset()         { *m = MsgDelegateFeedC
 func (m *MsgDelegateFeedConsentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateFeedConsentResponse) ProtoMessage()    {}
 func (*MsgDelegateFeedConsentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5390096518ffda, []int{3}
+	return fileDescriptor_cb5390096518ffda, []int{5}
 }
 func (m *MsgDelegateFeedConsentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,43 +265,139 @@ func (m *MsgDelegateFeedConsentResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDelegateFeedConsentResponse proto.InternalMessageInfo
 
+// MsgSubmitPriceAttestation represents a message to submit a batch of prices signed
+// by a registered price publisher, any account can relay the attestation
+type MsgSubmitPriceAttestation struct {
+	Sender      string           `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Attestation PriceAttestation `protobuf:"bytes,2,opt,name=attestation,proto3" json:"attestation" yaml:"attestation"`
+	// signature of the publisher over the attestation
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty" yaml:"signature"`
+}
+
+func (m *MsgSubmitPriceAttestation) Reset()         { *m = MsgSubmitPriceAttestation{} }
+func (m *MsgSubmitPriceAttestation) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitPriceAttestation) ProtoMessage()    {}
+func (*MsgSubmitPriceAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5390096518ffda, []int{6}
+}
+func (m *MsgSubmitPriceAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitPriceAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitPriceAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitPriceAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitPriceAttestation.Merge(m, src)
+}
+func (m *MsgSubmitPriceAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitPriceAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitPriceAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitPriceAttestation proto.InternalMessageInfo
+
+// MsgSubmitPriceAttestationResponse defines the Msg MsgSubmitPriceAttestation response type
+type MsgSubmitPriceAttestationResponse struct {
+}
+
+func (m *MsgSubmitPriceAttestationResponse) Reset()         { *m = MsgSubmitPriceAttestationResponse{} }
+func (m *MsgSubmitPriceAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitPriceAttestationResponse) ProtoMessage()    {}
+func (*MsgSubmitPriceAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5390096518ffda, []int{7}
+}
+func (m *MsgSubmitPriceAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitPriceAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitPriceAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitPriceAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitPriceAttestationResponse.Merge(m, src)
+}
+func (m *MsgSubmitPriceAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitPriceAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitPriceAttestationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitPriceAttestationResponse proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterType((*MsgAggregateExchangeRatePrevote)(nil), "kiichain.kiichain3.oracle.MsgAggregateExchangeRatePrevote")
+	proto.RegisterType((*MsgAggregateExchangeRatePrevoteResponse)(nil), "kiichain.kiichain3.oracle.MsgAggregateExchangeRatePrevoteResponse")
 	proto.RegisterType((*MsgAggregateExchangeRateVote)(nil), "kiichain.kiichain3.oracle.MsgAggregateExchangeRateVote")
 	proto.RegisterType((*MsgAggregateExchangeRateVoteResponse)(nil), "kiichain.kiichain3.oracle.MsgAggregateExchangeRateVoteResponse")
 	proto.RegisterType((*MsgDelegateFeedConsent)(nil), "kiichain.kiichain3.oracle.MsgDelegateFeedConsent")
 	proto.RegisterType((*MsgDelegateFeedConsentResponse)(nil), "kiichain.kiichain3.oracle.MsgDelegateFeedConsentResponse")
+	proto.RegisterType((*MsgSubmitPriceAttestation)(nil), "kiichain.kiichain3.oracle.MsgSubmitPriceAttestation")
+	proto.RegisterType((*MsgSubmitPriceAttestationResponse)(nil), "kiichain.kiichain3.oracle.MsgSubmitPriceAttestationResponse")
 }
 
 func init() { proto.RegisterFile("oracle/tx.proto", fileDescriptor_cb5390096518ffda) }
 
 var fileDescriptor_cb5390096518ffda = []byte{
-	// 409 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xb1, 0xef, 0xd2, 0x40,
-	0x14, 0xc7, 0x7b, 0x90, 0x10, 0xb8, 0x04, 0xd1, 0x82, 0x06, 0x88, 0x69, 0xc9, 0xc5, 0x18, 0x4d,
-	0x4c, 0x1b, 0x61, 0x30, 0xb2, 0xa8, 0xa8, 0x6c, 0x2c, 0x1d, 0x1c, 0x5c, 0xcc, 0xd1, 0x3e, 0x8f,
-	0xc6, 0xd2, 0x6b, 0x7a, 0xa7, 0x81, 0x59, 0x07, 0x47, 0x17, 0x77, 0x12, 0xff, 0x19, 0xdd, 0x18,
-	0x9d, 0x1a, 0x03, 0x8b, 0x73, 0xff, 0x02, 0x43, 0xdb, 0xab, 0x98, 0x00, 0x89, 0xbf, 0xed, 0xe5,
-	0xbe, 0x9f, 0xef, 0xbb, 0xef, 0xbb, 0xcb, 0xc3, 0x2d, 0x1e, 0x53, 0x37, 0x00, 0x5b, 0xae, 0xac,
-	0x28, 0xe6, 0x92, 0xeb, 0xbd, 0x77, 0xbe, 0xef, 0x2e, 0xa8, 0x1f, 0x5a, 0xaa, 0x18, 0x59, 0x39,
-	0xd3, 0xef, 0x30, 0xce, 0x78, 0x46, 0xd9, 0x87, 0x2a, 0x37, 0x90, 0x1f, 0x08, 0xdf, 0x9e, 0x09,
-	0xf6, 0x8c, 0xb1, 0x18, 0x18, 0x95, 0xf0, 0x72, 0xe5, 0x2e, 0x68, 0xc8, 0xc0, 0xa1, 0x12, 0x5e,
-	0x71, 0x09, 0xfa, 0x53, 0x7c, 0x0d, 0x8a, 0xb3, 0x37, 0x31, 0x95, 0x20, 0xba, 0x68, 0x80, 0xee,
-	0x35, 0x26, 0xbd, 0x34, 0x31, 0x6f, 0xae, 0xe9, 0x32, 0x18, 0x93, 0x7f, 0x75, 0xe2, 0x34, 0xe1,
-	0xa8, 0x89, 0xd0, 0xef, 0xe3, 0xda, 0x5b, 0x00, 0x0f, 0xe2, 0x6e, 0x25, 0x73, 0xde, 0x48, 0x13,
-	0xb3, 0x99, 0x3b, 0xf3, 0x73, 0xe2, 0x14, 0x80, 0x3e, 0xc4, 0x8d, 0x0f, 0x34, 0xf0, 0x3d, 0x2a,
-	0x79, 0xdc, 0xad, 0x66, 0x74, 0x27, 0x4d, 0xcc, 0xeb, 0x39, 0x5d, 0x4a, 0xc4, 0xf9, 0x8b, 0x8d,
-	0xeb, 0x9f, 0x37, 0xa6, 0xf6, 0x7b, 0x63, 0x6a, 0xe4, 0x2e, 0xbe, 0x73, 0x69, 0x14, 0x07, 0x44,
-	0xc4, 0x43, 0x01, 0xe4, 0x13, 0xc2, 0xb7, 0x66, 0x82, 0xbd, 0x80, 0x20, 0xe3, 0xa6, 0x00, 0xde,
-	0xf3, 0x83, 0x10, 0x4a, 0xdd, 0xc6, 0x75, 0x1e, 0x41, 0x9c, 0xdd, 0x9f, 0xcf, 0xd9, 0x4e, 0x13,
-	0xb3, 0x95, 0xdf, 0xaf, 0x14, 0xe2, 0x94, 0xd0, 0xc1, 0xe0, 0x15, 0x7d, 0x8a, 0xf1, 0x8e, 0x0c,
-	0x4a, 0x21, 0x4e, 0x09, 0x1d, 0xc5, 0x1d, 0x60, 0xe3, 0x74, 0x0a, 0x15, 0x74, 0xf8, 0xad, 0x82,
-	0xab, 0x33, 0xc1, 0xf4, 0xaf, 0x08, 0xf7, 0xce, 0xff, 0xd0, 0x23, 0xeb, 0xec, 0xa7, 0x5b, 0x97,
-	0xde, 0xa3, 0xff, 0xe4, 0x8a, 0x46, 0x95, 0x4f, 0xff, 0x88, 0x70, 0xfb, 0xd4, 0x2b, 0x3e, 0xbc,
-	0xdc, 0xf8, 0x84, 0xa5, 0xff, 0xf8, 0xbf, 0x2d, 0x2a, 0xc5, 0x64, 0xfa, 0x7d, 0x67, 0xa0, 0xed,
-	0xce, 0x40, 0xbf, 0x76, 0x06, 0xfa, 0xb2, 0x37, 0xb4, 0xed, 0xde, 0xd0, 0x7e, 0xee, 0x0d, 0xed,
-	0xf5, 0x03, 0xe6, 0xcb, 0xc5, 0xfb, 0xb9, 0xe5, 0xf2, 0xa5, 0xad, 0xba, 0x96, 0xc5, 0xc8, 0x5e,
-	0xd9, 0x6a, 0x7d, 0xd6, 0x11, 0x88, 0x79, 0x2d, 0xdb, 0x88, 0xd1, 0x9f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x47, 0x29, 0xac, 0x53, 0x55, 0x03, 0x00, 0x00,
+	// 611 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xde, 0x69, 0x4b, 0x69, 0x27, 0xd6, 0xe8, 0xa6, 0x96, 0x64, 0x29, 0xbb, 0x75, 0x2a, 0x6a,
+	0x29, 0x6c, 0x30, 0x15, 0xc4, 0x20, 0x68, 0xa3, 0xf5, 0x16, 0x28, 0x23, 0x78, 0xf0, 0x22, 0x93,
+	0xe4, 0x39, 0x59, 0x4c, 0x76, 0xc3, 0xce, 0xa4, 0xa4, 0x67, 0x3d, 0x78, 0xf0, 0xe0, 0xc5, 0xab,
+	0xf4, 0x3f, 0xf0, 0xdf, 0xe8, 0xb1, 0xe0, 0xc5, 0xd3, 0x22, 0xc9, 0xc5, 0x93, 0xc2, 0xfe, 0x05,
+	0xb2, 0x3f, 0xb3, 0x86, 0x24, 0xc5, 0x80, 0xb7, 0xe1, 0xbd, 0xef, 0xfb, 0xe6, 0x7b, 0xdf, 0xec,
+	0x63, 0x71, 0xde, 0x71, 0x59, 0xb3, 0x03, 0x65, 0x39, 0x30, 0x7b, 0xae, 0x23, 0x1d, 0xb5, 0xf4,
+	0xd6, 0xb2, 0x9a, 0x6d, 0x66, 0xd9, 0x66, 0x72, 0x38, 0x30, 0x23, 0x8c, 0xb6, 0xc9, 0x1d, 0xee,
+	0x84, 0xa8, 0x72, 0x70, 0x8a, 0x08, 0x5a, 0x21, 0x56, 0xe8, 0x31, 0x97, 0x75, 0x45, 0x54, 0x24,
+	0x5f, 0x11, 0x36, 0xea, 0x82, 0x1f, 0x72, 0xee, 0x02, 0x67, 0x12, 0x8e, 0x06, 0xcd, 0x36, 0xb3,
+	0x39, 0x50, 0x26, 0xe1, 0xd8, 0x85, 0x13, 0x47, 0x82, 0xba, 0x8b, 0x57, 0xda, 0x4c, 0xb4, 0x8b,
+	0x68, 0x07, 0xdd, 0x5d, 0xaf, 0xe5, 0x7d, 0xcf, 0xc8, 0x9d, 0xb2, 0x6e, 0xa7, 0x4a, 0x82, 0x2a,
+	0xa1, 0x61, 0x53, 0xdd, 0xc3, 0xab, 0x6f, 0x00, 0x5a, 0xe0, 0x16, 0x97, 0x42, 0xd8, 0x75, 0xdf,
+	0x33, 0x36, 0x22, 0x58, 0x54, 0x27, 0x34, 0x06, 0xa8, 0x15, 0xbc, 0x7e, 0xc2, 0x3a, 0x56, 0x8b,
+	0x49, 0xc7, 0x2d, 0x2e, 0x87, 0xe8, 0x4d, 0xdf, 0x33, 0xae, 0x45, 0xe8, 0xb4, 0x45, 0xe8, 0x18,
+	0x56, 0x5d, 0xfb, 0x70, 0x66, 0x28, 0x3f, 0xcf, 0x0c, 0x85, 0xec, 0xe1, 0x3b, 0x97, 0x18, 0xa6,
+	0x20, 0x7a, 0x8e, 0x2d, 0x80, 0xfc, 0x42, 0x78, 0x7b, 0x16, 0xf6, 0x65, 0x30, 0xd9, 0x13, 0x7c,
+	0x15, 0xe2, 0xda, 0x6b, 0x97, 0x49, 0x10, 0xf1, 0x8c, 0x25, 0xdf, 0x33, 0x6e, 0x44, 0x76, 0xfe,
+	0xee, 0x13, 0xba, 0x01, 0x19, 0x11, 0xf1, 0x9f, 0xc7, 0x0e, 0xa2, 0x17, 0xac, 0x23, 0x8b, 0x2b,
+	0x93, 0xd1, 0x07, 0x55, 0x42, 0xc3, 0x66, 0x26, 0x9b, 0xdb, 0xf8, 0xd6, 0xbc, 0x79, 0xd3, 0x60,
+	0xde, 0x23, 0xbc, 0x55, 0x17, 0xfc, 0x19, 0x74, 0x42, 0xdc, 0x73, 0x80, 0xd6, 0xd3, 0xa0, 0x61,
+	0x4b, 0xb5, 0x8c, 0xd7, 0x9c, 0x1e, 0xb8, 0xa1, 0xc9, 0x28, 0x8c, 0x82, 0xef, 0x19, 0xf9, 0xe8,
+	0xd6, 0xa4, 0x43, 0x68, 0x0a, 0x0a, 0x08, 0xad, 0x58, 0xa7, 0xb8, 0x34, 0x49, 0x48, 0x3a, 0x84,
+	0xa6, 0xa0, 0x8c, 0xdd, 0x1d, 0xac, 0x4f, 0x77, 0x91, 0x1a, 0xfd, 0x8d, 0x70, 0xa9, 0x2e, 0xf8,
+	0x8b, 0x7e, 0xa3, 0x6b, 0xc9, 0x63, 0xd7, 0x6a, 0xc2, 0xa1, 0x94, 0x20, 0x24, 0x93, 0x96, 0x63,
+	0x07, 0xe1, 0x0b, 0xb0, 0x5b, 0x90, 0x38, 0xcd, 0x84, 0x1f, 0xd5, 0x09, 0x8d, 0x01, 0xaa, 0x85,
+	0x73, 0x6c, 0xcc, 0x0c, 0x8d, 0xe6, 0x2a, 0xfb, 0xe6, 0xcc, 0x1d, 0x32, 0x27, 0x2f, 0xab, 0x69,
+	0xe7, 0x9e, 0xa1, 0xf8, 0x9e, 0xa1, 0x46, 0x17, 0x64, 0xd4, 0x08, 0xcd, 0x6a, 0x07, 0xef, 0x2c,
+	0x2c, 0x6e, 0x33, 0xd9, 0x77, 0x21, 0x7c, 0xe7, 0x2b, 0xd9, 0x77, 0x4e, 0x5b, 0x84, 0x8e, 0x61,
+	0x99, 0x4c, 0x76, 0xf1, 0xcd, 0x99, 0x03, 0x27, 0xb1, 0x54, 0xbe, 0xad, 0xe0, 0xe5, 0xba, 0xe0,
+	0xea, 0x17, 0x84, 0xb7, 0xe7, 0xae, 0x6e, 0x75, 0xce, 0x84, 0x97, 0x6c, 0x91, 0x56, 0x5b, 0x9c,
+	0x9b, 0x18, 0x55, 0x3f, 0x23, 0x5c, 0x9a, 0xbd, 0x7e, 0x0f, 0x16, 0xb8, 0x21, 0x20, 0x6a, 0x8f,
+	0x17, 0x24, 0xa6, 0xbe, 0xde, 0x21, 0x5c, 0x98, 0xf6, 0xf5, 0xdf, 0x9b, 0x2f, 0x3c, 0x85, 0xa2,
+	0x3d, 0xfc, 0x67, 0x4a, 0xea, 0xe2, 0x23, 0xc2, 0x5b, 0x33, 0x3e, 0xed, 0xfb, 0xf3, 0x55, 0xa7,
+	0xb3, 0xb4, 0x47, 0x8b, 0xb0, 0x12, 0x3b, 0xb5, 0xa3, 0xf3, 0xa1, 0x8e, 0x2e, 0x86, 0x3a, 0xfa,
+	0x31, 0xd4, 0xd1, 0xa7, 0x91, 0xae, 0x5c, 0x8c, 0x74, 0xe5, 0xfb, 0x48, 0x57, 0x5e, 0xed, 0x73,
+	0x4b, 0xb6, 0xfb, 0x0d, 0xb3, 0xe9, 0x74, 0xcb, 0x89, 0xf0, 0xf8, 0x30, 0x28, 0x27, 0xff, 0xa6,
+	0xd3, 0x1e, 0x88, 0xc6, 0x6a, 0xf8, 0x67, 0x39, 0xf8, 0x33, 0x00, 0xa5, 0xb2, 0x5d, 0x30, 0xb2,
+	0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -234,11 +412,17 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
+	// AggregateExchangeRatePrevote defines the method for submitting the
+	// salted hash of an aggregate exchange rate vote
+	AggregateExchangeRatePrevote(ctx context.Context, in *MsgAggregateExchangeRatePrevote, opts ...grpc.CallOption) (*MsgAggregateExchangeRatePrevoteResponse, error)
 	// AggregateExchangeRateVote defines the method for submitting an
 	// aggregate exchange rate vote
 	AggregateExchangeRateVote(ctx context.Context, in *MsgAggregateExchangeRateVote, opts ...grpc.CallOption) (*MsgAggregateExchangeRateVoteResponse, error)
 	// DelegateFeedConsent defines the method for delegate the prive voting
 	DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeedConsent, opts ...grpc.CallOption) (*MsgDelegateFeedConsentResponse, error)
+	// SubmitPriceAttestation defines the method for submitting a batch of prices
+	// signed by a registered price publisher to the pull oracle
+	SubmitPriceAttestation(ctx context.Context, in *MsgSubmitPriceAttestation, opts ...grpc.CallOption) (*MsgSubmitPriceAttestationResponse, error)
 }
 
 type msgClient struct {
@@ -249,6 +433,15 @@ func NewMsgClient(cc grpc1.ClientConn) MsgClient {
 	return &msgClient{cc}
 }
 
+func (c *msgClient) AggregateExchangeRatePrevote(ctx context.Context, in *MsgAggregateExchangeRatePrevote, opts ...grpc.CallOption) (*MsgAggregateExchangeRatePrevoteResponse, error) {
+	out := new(MsgAggregateExchangeRatePrevoteResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Msg/AggregateExchangeRatePrevote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AggregateExchangeRateVote(ctx context.Context, in *MsgAggregateExchangeRateVote, opts ...grpc.CallOption) (*MsgAggregateExchangeRateVoteResponse, error) {
 	out := new(MsgAggregateExchangeRateVoteResponse)
 	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Msg/AggregateExchangeRateVote", in, out, opts...)
@@ -267,30 +460,69 @@ func (c *msgClient) DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeed
 	return out, nil
 }
 
+func (c *msgClient) SubmitPriceAttestation(ctx context.Context, in *MsgSubmitPriceAttestation, opts ...grpc.CallOption) (*MsgSubmitPriceAttestationResponse, error) {
+	out := new(MsgSubmitPriceAttestationResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Msg/SubmitPriceAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
+	// AggregateExchangeRatePrevote defines the method for submitting the
+	// salted hash of an aggregate exchange rate vote
+	AggregateExchangeRatePrevote(context.Context, *MsgAggregateExchangeRatePrevote) (*MsgAggregateExchangeRatePrevoteResponse, error)
 	// AggregateExchangeRateVote defines the method for submitting an
 	// aggregate exchange rate vote
 	AggregateExchangeRateVote(context.Context, *MsgAggregateExchangeRateVote) (*MsgAggregateExchangeRateVoteResponse, error)
 	// DelegateFeedConsent defines the method for delegate the prive voting
 	DelegateFeedConsent(context.Context, *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error)
+	// SubmitPriceAttestation defines the method for submitting a batch of prices
+	// signed by a registered price publisher to the pull oracle
+	SubmitPriceAttestation(context.Context, *MsgSubmitPriceAttestation) (*MsgSubmitPriceAttestationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
 type UnimplementedMsgServer struct {
 }
 
+func (*UnimplementedMsgServer) AggregateExchangeRatePrevote(ctx context.Context, req *MsgAggregateExchangeRatePrevote) (*MsgAggregateExchangeRatePrevoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateExchangeRatePrevote not implemented")
+}
 func (*UnimplementedMsgServer) AggregateExchangeRateVote(ctx context.Context, req *MsgAggregateExchangeRateVote) (*MsgAggregateExchangeRateVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AggregateExchangeRateVote not implemented")
 }
 func (*UnimplementedMsgServer) DelegateFeedConsent(ctx context.Context, req *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegateFeedConsent not implemented")
 }
+func (*UnimplementedMsgServer) SubmitPriceAttestation(ctx context.Context, req *MsgSubmitPriceAttestation) (*MsgSubmitPriceAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPriceAttestation not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
+func _Msg_AggregateExchangeRatePrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAggregateExchangeRatePrevote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AggregateExchangeRatePrevote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Msg/AggregateExchangeRatePrevote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AggregateExchangeRatePrevote(ctx, req.(*MsgAggregateExchangeRatePrevote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AggregateExchangeRateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAggregateExchangeRateVote)
 	if err := dec(in); err != nil {
@@ -327,10 +559,32 @@ func _Msg_DelegateFeedConsent_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitPriceAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitPriceAttestation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitPriceAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Msg/SubmitPriceAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitPriceAttestation(ctx, req.(*MsgSubmitPriceAttestation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kiichain.kiichain3.oracle.Msg",
 	HandlerType: (*MsgServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AggregateExchangeRatePrevote",
+			Handler:    _Msg_AggregateExchangeRatePrevote_Handler,
+		},
 		{
 			MethodName: "AggregateExchangeRateVote",
 			Handler:    _Msg_AggregateExchangeRateVote_Handler,
@@ -339,11 +593,82 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegateFeedConsent",
 			Handler:    _Msg_DelegateFeedConsent_Handler,
 		},
+		{
+			MethodName: "SubmitPriceAttestation",
+			Handler:    _Msg_SubmitPriceAttestation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "oracle/tx.proto",
 }
 
+func (m *MsgAggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAggregateExchangeRatePrevote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAggregateExchangeRatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Feeder) > 0 {
+		i -= len(m.Feeder)
+		copy(dAtA[i:], m.Feeder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Feeder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAggregateExchangeRatePrevoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAggregateExchangeRatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAggregateExchangeRatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgAggregateExchangeRateVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -364,6 +689,13 @@ func (m *MsgAggregateExchangeRateVote) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Validator) > 0 {
 		i -= len(m.Validator)
 		copy(dAtA[i:], m.Validator)
@@ -471,6 +803,76 @@ func (m *MsgDelegateFeedConsentResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSubmitPriceAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitPriceAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitPriceAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitPriceAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitPriceAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitPriceAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -482,13 +884,13 @@ func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
-func (m *MsgAggregateExchangeRateVote) Size() (n int) {
+func (m *MsgAggregateExchangeRatePrevote) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ExchangeRates)
+	l = len(m.Hash)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -503,7 +905,7 @@ func (m *MsgAggregateExchangeRateVote) Size() (n int) {
 	return n
 }
 
-func (m *MsgAggregateExchangeRateVoteResponse) Size() (n int) {
+func (m *MsgAggregateExchangeRatePrevoteResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -512,22 +914,56 @@ func (m *MsgAggregateExchangeRateVoteResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgDelegateFeedConsent) Size() (n int) {
+func (m *MsgAggregateExchangeRateVote) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Operator)
+	l = len(m.ExchangeRates)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Delegate)
+	l = len(m.Feeder)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAggregateExchangeRateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateFeedConsent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
 
 func (m *MsgDelegateFeedConsentResponse) Size() (n int) {
 	if m == nil {
@@ -538,12 +974,236 @@ func (m *MsgDelegateFeedConsentResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSubmitPriceAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Attestation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitPriceAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -669,6 +1329,38 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			}
 			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -904,6 +1596,205 @@ func (m *MsgDelegateFeedConsentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSubmitPriceAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitPriceAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitPriceAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitPriceAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitPriceAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitPriceAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
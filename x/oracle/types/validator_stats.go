@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxOracleStatsWindows is the amount of closed slash windows kept on the validator oracle stats
+const MaxOracleStatsWindows = 12
+
+// NewValidatorOracleStats creates a new instance of ValidatorOracleStats without history
+func NewValidatorOracleStats() ValidatorOracleStats {
+	return ValidatorOracleStats{
+		CurrentDeviationSum: sdk.ZeroDec(),
+		Windows:             []OracleWindowStats{},
+	}
+}
+
+// NewOracleWindowStats creates a new instance of OracleWindowStats
+func NewOracleWindowStats(counter VotePenaltyCounter, deviationSum sdk.Dec, deviationCount uint64, slashed bool, endHeight int64) OracleWindowStats {
+	return OracleWindowStats{
+		SuccessCount:   counter.SuccessCount,
+		AbstainCount:   counter.AbstainCount,
+		MissCount:      counter.MissCount,
+		DeviationSum:   deviationSum,
+		DeviationCount: deviationCount,
+		Slashed:        slashed,
+		EndHeight:      endHeight,
+	}
+}
+
+// CloseWindow moves the slash window in progress into the history and drops the oldest windows
+// when the history is longer than MaxOracleStatsWindows
+func (s *ValidatorOracleStats) CloseWindow(counter VotePenaltyCounter, slashed bool, endHeight int64) {
+	window := NewOracleWindowStats(counter, s.CurrentDeviationSum, s.CurrentDeviationCount, slashed, endHeight)
+	s.Windows = append(s.Windows, window)
+	if len(s.Windows) > MaxOracleStatsWindows {
+		s.Windows = s.Windows[len(s.Windows)-MaxOracleStatsWindows:]
+	}
+
+	// reset the window in progress
+	s.CurrentDeviationSum = sdk.ZeroDec()
+	s.CurrentDeviationCount = 0
+}
+
+// SlashCount returns the amount of stored windows where the validator was slashed
+func (s ValidatorOracleStats) SlashCount() uint64 {
+	slashCount := uint64(0)
+	for _, window := range s.Windows {
+		if window.Slashed {
+			slashCount++
+		}
+	}
+	return slashCount
+}
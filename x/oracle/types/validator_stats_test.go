@@ -0,0 +1,31 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorOracleStatsCloseWindow(t *testing.T) {
+	stats := NewValidatorOracleStats()
+	stats.CurrentDeviationSum = sdk.NewDecWithPrec(5, 2)
+	stats.CurrentDeviationCount = 5
+
+	// close the first window
+	stats.CloseWindow(VotePenaltyCounter{MissCount: 1, AbstainCount: 2, SuccessCount: 3}, true, 100)
+	require.Len(t, stats.Windows, 1)
+	require.Equal(t, NewOracleWindowStats(VotePenaltyCounter{MissCount: 1, AbstainCount: 2, SuccessCount: 3}, sdk.NewDecWithPrec(5, 2), 5, true, 100), stats.Windows[0])
+	require.Equal(t, sdk.ZeroDec(), stats.CurrentDeviationSum)
+	require.Zero(t, stats.CurrentDeviationCount)
+	require.Equal(t, uint64(1), stats.SlashCount())
+
+	// the history keeps only the most recent windows
+	for i := 0; i < MaxOracleStatsWindows; i++ {
+		stats.CloseWindow(VotePenaltyCounter{SuccessCount: 1}, false, int64(200+i))
+	}
+	require.Len(t, stats.Windows, MaxOracleStatsWindows)
+	require.Equal(t, int64(200), stats.Windows[0].EndHeight)
+	require.Equal(t, int64(200+MaxOracleStatsWindows-1), stats.Windows[MaxOracleStatsWindows-1].EndHeight)
+	require.Zero(t, stats.SlashCount())
+}
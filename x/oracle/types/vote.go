@@ -8,6 +8,21 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
+// NewAggregateExchangeRatePrevote creates a new AggregateExchangeRatePrevote instance
+func NewAggregateExchangeRatePrevote(hash AggregateVoteHash, voter sdk.ValAddress, submitBlock uint64) AggregateExchangeRatePrevote {
+	return AggregateExchangeRatePrevote{
+		Hash:        hash.String(),
+		Voter:       voter.String(),
+		SubmitBlock: submitBlock,
+	}
+}
+
+// String implements stringify for the AggregateExchangeRatePrevote
+func (a AggregateExchangeRatePrevote) String() string {
+	out, _ := yaml.Marshal(a)
+	return string(out)
+}
+
 // NewAggregateExchangeRateVote creates a new AggregateExchangeRateVote instance
 func NewAggregateExchangeRateVote(exchangeRateTuples ExchangeRateTuples, voter sdk.ValAddress) (AggregateExchangeRateVote, error) {
 	// Iterate over the exchangeRateTuples and validate all exchangeRate are higher than zero
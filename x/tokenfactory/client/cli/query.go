@@ -27,6 +27,10 @@ func GetQueryCmd() *cobra.Command {
 		GetParams(),
 		GetCmdDenomAuthorityMetadata(),
 		GetCmdDenomsFromCreator(),
+		GetCmdDenomRoles(),
+		GetCmdMinterAllowance(),
+		GetCmdDenomMaxSupply(),
+		GetCmdDenomPaused(),
 	)
 
 	return cmd
@@ -120,3 +124,129 @@ func GetCmdDenomsFromCreator() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdDenomRoles returns the roles an address was granted over a denom
+func GetCmdDenomRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-roles [denom] [address] [flags]",
+		Short: "Get the roles an address was granted over a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err := sdk.AccAddressFromBech32(args[1]); err != nil {
+				return err
+			}
+
+			res, err := queryClient.DenomRoles(cmd.Context(), &types.QueryDenomRolesRequest{
+				Denom:   args[0],
+				Address: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdMinterAllowance returns the remaining amount a minter can mint
+func GetCmdMinterAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "minter-allowance [denom] [minter address] [flags]",
+		Short: "Get the remaining amount a minter can mint of a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err := sdk.AccAddressFromBech32(args[1]); err != nil {
+				return err
+			}
+
+			res, err := queryClient.MinterAllowance(cmd.Context(), &types.QueryMinterAllowanceRequest{
+				Denom:  args[0],
+				Minter: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDenomMaxSupply returns the max supply of a denom
+func GetCmdDenomMaxSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-max-supply [denom] [flags]",
+		Short: "Get the max supply of a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomMaxSupply(cmd.Context(), &types.QueryDenomMaxSupplyRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDenomPaused returns whether the transfers of a denom are paused
+func GetCmdDenomPaused() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-paused [denom] [flags]",
+		Short: "Get whether the transfers of a specific denom are paused",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomPaused(cmd.Context(), &types.QueryDenomPausedRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -23,6 +23,10 @@ import (
 )
 
 const (
+	FlagMintToAddress   = "mint-to-address"
+	FlagBurnFromAddress = "burn-from-address"
+	FlagMaxSupply       = "max-supply"
+
 	FlagAllowList            = "allow-list"
 	FlagAllowListDescription = "Path to the allow list JSON file with an array of addresses " +
 		"that are allowed to send/receive the token. The file should have the following format: {\"addresses\": " +
@@ -46,6 +50,12 @@ func GetTxCmd() *cobra.Command {
 		NewBurnCmd(),
 		NewChangeAdminCmd(),
 		NewSetDenomMetadataCmd(),
+		NewForceTransferCmd(),
+		NewGrantRoleCmd(),
+		NewRevokeRoleCmd(),
+		NewSetMinterAllowanceCmd(),
+		NewPauseDenomCmd(),
+		NewUnpauseDenomCmd(),
 	)
 
 	return cmd
@@ -73,6 +83,11 @@ func NewCreateDenomCmd() *cobra.Command {
 				return err
 			}
 
+			maxSupplyStr, err := cmd.Flags().GetString(FlagMaxSupply)
+			if err != nil {
+				return err
+			}
+
 			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
 
 			msg := types.NewMsgCreateDenom(
@@ -80,6 +95,15 @@ func NewCreateDenomCmd() *cobra.Command {
 				args[0],
 			)
 
+			// only cap the supply if a max supply is provided
+			if maxSupplyStr != "" {
+				maxSupply, ok := sdk.NewIntFromString(maxSupplyStr)
+				if !ok {
+					return fmt.Errorf("invalid max supply %s", maxSupplyStr)
+				}
+				msg.MaxSupply = maxSupply
+			}
+
 			// only parse allow list if it is provided
 			if allowListFilePath != "" {
 				// Parse the allow list
@@ -96,6 +120,7 @@ func NewCreateDenomCmd() *cobra.Command {
 
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(FlagAllowList, "", FlagAllowListDescription)
+	cmd.Flags().String(FlagMaxSupply, "", "The maximum total supply of the denom, it can't be changed after the creation")
 	return cmd
 }
 
@@ -167,9 +192,15 @@ func NewMintCmd() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgMint(
+			mintToAddress, err := cmd.Flags().GetString(FlagMintToAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMintTo(
 				clientCtx.GetFromAddress().String(),
 				amount,
+				mintToAddress,
 			)
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
@@ -177,6 +208,7 @@ func NewMintCmd() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagMintToAddress, "", "Address to mint the tokens to, defaults to the sender")
 	return cmd
 }
 
@@ -199,9 +231,15 @@ func NewBurnCmd() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgBurn(
+			burnFromAddress, err := cmd.Flags().GetString(FlagBurnFromAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurnFrom(
 				clientCtx.GetFromAddress().String(),
 				amount,
+				burnFromAddress,
 			)
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
@@ -209,6 +247,7 @@ func NewBurnCmd() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagBurnFromAddress, "", "Address to burn the tokens from, defaults to the sender, only the admin can burn from other accounts")
 	return cmd
 }
 
@@ -240,6 +279,188 @@ func NewChangeAdminCmd() *cobra.Command {
 	return cmd
 }
 
+// NewForceTransferCmd broadcast MsgForceTransfer
+func NewForceTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "force-transfer [amount] [transfer-from-address] [transfer-to-address] [flags]",
+		Short: "Transfer tokens of a factory-created denom between two addresses. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgForceTransfer(
+				clientCtx.GetFromAddress().String(),
+				amount,
+				args[1],
+				args[2],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewGrantRoleCmd broadcast MsgGrantRole
+func NewGrantRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-role [denom] [role] [address] [flags]",
+		Short: fmt.Sprintf("Grant a role over a factory-created denom to an address, the role is one of %s. Must have admin authority to do so.", strings.Join(types.Roles, ", ")),
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgGrantRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				args[2],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRevokeRoleCmd broadcast MsgRevokeRole
+func NewRevokeRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-role [denom] [role] [address] [flags]",
+		Short: "Revoke a role over a factory-created denom from an address. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgRevokeRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				args[2],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetMinterAllowanceCmd broadcast MsgSetMinterAllowance
+func NewSetMinterAllowanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-minter-allowance [denom] [minter-address] [allowance] [flags]",
+		Short: "Set the remaining amount a minter can mint of a factory-created denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			allowance, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid allowance %s", args[2])
+			}
+
+			msg := types.NewMsgSetMinterAllowance(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				allowance,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewPauseDenomCmd broadcast MsgSetDenomPause to pause the transfers of a denom
+func NewPauseDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-denom [denom] [flags]",
+		Short: "Pause the transfers of a factory-created denom. Must have pauser authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgSetDenomPause(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				true,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUnpauseDenomCmd broadcast MsgSetDenomPause to unpause the transfers of a denom
+func NewUnpauseDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause-denom [denom] [flags]",
+		Short: "Unpause the transfers of a factory-created denom. Must have pauser authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgSetDenomPause(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				false,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewSetDenomMetadataCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "set-denom-metadata [metadata-file] [flags]",
@@ -26,8 +26,9 @@ func EncodeTokenFactoryMint(rawMsg json.RawMessage, sender sdk.AccAddress) ([]sd
 		return []sdk.Msg{}, types.ErrEncodeTokenFactoryMint
 	}
 	mintMsg := types.MsgMint{
-		Sender: sender.String(),
-		Amount: encodedMintMsg.Amount,
+		Sender:        sender.String(),
+		Amount:        encodedMintMsg.Amount,
+		MintToAddress: encodedMintMsg.MintToAddress,
 	}
 	return []sdk.Msg{&mintMsg}, nil
 }
@@ -38,8 +39,9 @@ func EncodeTokenFactoryBurn(rawMsg json.RawMessage, sender sdk.AccAddress) ([]sd
 		return []sdk.Msg{}, types.ErrEncodeTokenFactoryBurn
 	}
 	burnMsg := types.MsgBurn{
-		Sender: sender.String(),
-		Amount: encodedBurnMsg.Amount,
+		Sender:          sender.String(),
+		Amount:          encodedBurnMsg.Amount,
+		BurnFromAddress: encodedBurnMsg.BurnFromAddress,
 	}
 	return []sdk.Msg{&burnMsg}, nil
 }
@@ -68,3 +70,17 @@ func EncodeTokenFactorySetMetadata(rawMsg json.RawMessage, sender sdk.AccAddress
 	}
 	return []sdk.Msg{&setMetadataMsg}, nil
 }
+
+func EncodeTokenFactoryForceTransfer(rawMsg json.RawMessage, sender sdk.AccAddress) ([]sdk.Msg, error) {
+	encodedForceTransferMsg := bindings.ForceTransfer{}
+	if err := json.Unmarshal(rawMsg, &encodedForceTransferMsg); err != nil {
+		return []sdk.Msg{}, types.ErrEncodeTokenFactoryForceTransfer
+	}
+	forceTransferMsg := types.MsgForceTransfer{
+		Sender:              sender.String(),
+		Amount:              encodedForceTransferMsg.Amount,
+		TransferFromAddress: encodedForceTransferMsg.TransferFromAddress,
+		TransferToAddress:   encodedForceTransferMsg.TransferToAddress,
+	}
+	return []sdk.Msg{&forceTransferMsg}, nil
+}
@@ -47,3 +47,21 @@ func (k Keeper) setAdmin(ctx sdk.Context, denom string, admin string) error {
 
 	return k.setAuthorityMetadata(ctx, denom, metadata)
 }
+
+// spendMinterAllowance deducts the minted amount from the allowance of the
+// minter, the minters without an allowance can mint without limit
+func (k Keeper) spendMinterAllowance(ctx sdk.Context, denom string, metadata types.DenomAuthorityMetadata, minter string, amount sdk.Int) error {
+	allowance, found := metadata.GetMinterAllowance(minter)
+	if !found {
+		return nil
+	}
+
+	if allowance.LT(amount) {
+		return types.ErrMinterAllowanceExceeded.Wrapf("allowance: %s, amount: %s", allowance, amount)
+	}
+
+	if err := metadata.SetMinterAllowance(minter, allowance.Sub(amount)); err != nil {
+		return err
+	}
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
@@ -294,3 +294,146 @@ func (suite *KeeperTestSuite) TestSetDenomMetaData() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestRoles() {
+	suite.CreateDefaultDenom()
+	ctx := sdk.WrapSDKContext(suite.Ctx)
+	admin, minter, other := suite.TestAccs[0].String(), suite.TestAccs[1].String(), suite.TestAccs[2].String()
+
+	// only the admin can grant roles
+	_, err := suite.msgServer.GrantRole(ctx, types.NewMsgGrantRole(minter, suite.defaultDenom, types.RoleMinter, minter))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the minter can't mint before the role is granted
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, types.RoleMinter, minter))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, types.RoleMinter, minter))
+	suite.Require().ErrorIs(err, types.ErrRoleAlreadyGranted)
+
+	// the minter mints without limit until an allowance is set
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(10), suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom).Amount.Int64())
+
+	// the minter only holds the minter role
+	_, err = suite.msgServer.Burn(ctx, types.NewMsgBurn(minter, sdk.NewInt64Coin(suite.defaultDenom, 5)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	queryRes, err := suite.queryClient.DenomRoles(suite.Ctx.Context(), &types.QueryDenomRolesRequest{
+		Denom:   suite.defaultDenom,
+		Address: minter,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{types.RoleMinter}, queryRes.Roles)
+
+	// the admin holds every role
+	queryRes, err = suite.queryClient.DenomRoles(suite.Ctx.Context(), &types.QueryDenomRolesRequest{
+		Denom:   suite.defaultDenom,
+		Address: admin,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Roles, queryRes.Roles)
+
+	// grant the remaining roles to another account
+	for _, role := range []string{types.RoleBurner, types.RoleMetadataUpdater, types.RoleAllowListManager} {
+		_, err = suite.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, role, other))
+		suite.Require().NoError(err)
+	}
+
+	// the burner burns its own tokens, only the admin can burn from other accounts
+	_, err = suite.msgServer.Burn(ctx, types.NewMsgBurnFrom(other, sdk.NewInt64Coin(suite.defaultDenom, 5), minter))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	suite.Require().Equal(int64(10), suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom).Amount.Int64())
+
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMintTo(admin, sdk.NewInt64Coin(suite.defaultDenom, 10), other))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(ctx, types.NewMsgBurn(other, sdk.NewInt64Coin(suite.defaultDenom, 5)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(5), suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[2], suite.defaultDenom).Amount.Int64())
+
+	_, err = suite.msgServer.SetDenomMetadata(ctx, types.NewMsgSetDenomMetadata(other, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: suite.defaultDenom, Exponent: 0}},
+		Base:       suite.defaultDenom,
+		Display:    suite.defaultDenom,
+		Name:       "Role",
+		Symbol:     "ROLE",
+	}))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.UpdateDenom(ctx, types.NewMsgUpdateDenom(other, suite.defaultDenom, &banktypes.AllowList{Addresses: []string{admin}}))
+	suite.Require().NoError(err)
+
+	// revoked roles can't be used anymore
+	_, err = suite.msgServer.RevokeRole(ctx, types.NewMsgRevokeRole(admin, suite.defaultDenom, types.RoleBurner, other))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.RevokeRole(ctx, types.NewMsgRevokeRole(admin, suite.defaultDenom, types.RoleBurner, other))
+	suite.Require().ErrorIs(err, types.ErrRoleNotGranted)
+	_, err = suite.msgServer.Burn(ctx, types.NewMsgBurn(other, sdk.NewInt64Coin(suite.defaultDenom, 5)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// unknown roles are rejected
+	_, err = suite.msgServer.GrantRole(ctx, &types.MsgGrantRole{Sender: admin, Denom: suite.defaultDenom, Role: "admin", Address: other})
+	suite.Require().ErrorIs(err, types.ErrInvalidRole)
+}
+
+func (suite *KeeperTestSuite) TestMinterAllowance() {
+	suite.CreateDefaultDenom()
+	ctx := sdk.WrapSDKContext(suite.Ctx)
+	admin, minter := suite.TestAccs[0].String(), suite.TestAccs[1].String()
+
+	// the allowance can only be set on a minter
+	_, err := suite.msgServer.SetMinterAllowance(ctx, types.NewMsgSetMinterAllowance(admin, suite.defaultDenom, minter, sdk.NewInt(15)))
+	suite.Require().ErrorIs(err, types.ErrRoleNotGranted)
+	_, err = suite.queryClient.MinterAllowance(suite.Ctx.Context(), &types.QueryMinterAllowanceRequest{
+		Denom:  suite.defaultDenom,
+		Minter: minter,
+	})
+	suite.Require().Error(err)
+
+	_, err = suite.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, types.RoleMinter, minter))
+	suite.Require().NoError(err)
+
+	queryRes, err := suite.queryClient.MinterAllowance(suite.Ctx.Context(), &types.QueryMinterAllowanceRequest{
+		Denom:  suite.defaultDenom,
+		Minter: minter,
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(queryRes.Unlimited)
+
+	// only the admin can set the allowance
+	_, err = suite.msgServer.SetMinterAllowance(ctx, types.NewMsgSetMinterAllowance(minter, suite.defaultDenom, minter, sdk.NewInt(15)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.SetMinterAllowance(ctx, types.NewMsgSetMinterAllowance(admin, suite.defaultDenom, minter, sdk.NewInt(15)))
+	suite.Require().NoError(err)
+
+	// minting spends the allowance
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+
+	queryRes, err = suite.queryClient.MinterAllowance(suite.Ctx.Context(), &types.QueryMinterAllowanceRequest{
+		Denom:  suite.defaultDenom,
+		Minter: minter,
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(queryRes.Unlimited)
+	suite.Require().Equal(sdk.NewInt(5), queryRes.Allowance)
+
+	// the minter can't mint above the allowance
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 6)))
+	suite.Require().ErrorIs(err, types.ErrMinterAllowanceExceeded)
+	suite.Require().Equal(int64(10), suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom).Amount.Int64())
+
+	// the admin is not limited by the allowances
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+
+	// revoking the minter removes the allowance
+	_, err = suite.msgServer.RevokeRole(ctx, types.NewMsgRevokeRole(admin, suite.defaultDenom, types.RoleMinter, minter))
+	suite.Require().NoError(err)
+	metadata, err := suite.App.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	suite.Require().Empty(metadata.MinterAllowances)
+}
@@ -15,6 +15,15 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		return err
 	}
 
+	if k.IsPaused(ctx, amount.Denom) {
+		return types.ErrDenomPaused.Wrapf("denom: %s", amount.Denom)
+	}
+
+	err = k.validateMaxSupply(ctx, amount)
+	if err != nil {
+		return err
+	}
+
 	ctx.Logger().Info(fmt.Sprintf("Minting amount=%s for module=%s", amount.String(), types.ModuleName))
 	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
 	if err != nil {
@@ -44,11 +53,18 @@ func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) erro
 		return err
 	}
 
+	if k.bankKeeper.BlockedAddr(addr) {
+		return types.ErrBurnFromModuleAccount.Wrapf("address: %s", burnFrom)
+	}
+
+	// the admin can burn the tokens of a paused denom
 	ctx.Logger().Info(fmt.Sprintf("Sending amount=%s to module=%s from account=%s", amount.String(), types.ModuleName, addr.String()))
-	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx,
-		addr,
-		types.ModuleName,
-		sdk.NewCoins(amount))
+	err = k.withoutPause(ctx, amount.Denom, func() error {
+		return k.bankKeeper.SendCoinsFromAccountToModule(ctx,
+			addr,
+			types.ModuleName,
+			sdk.NewCoins(amount))
+	})
 	if err != nil {
 		return err
 	}
@@ -56,3 +72,31 @@ func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) erro
 	ctx.Logger().Info(fmt.Sprintf("Burning amount=%s from module=%s", amount.String(), types.ModuleName))
 	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
 }
+
+func (k Keeper) forceTransfer(ctx sdk.Context, amount sdk.Coin, fromAddr string, toAddr string) error {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	fromSdkAddr, err := sdk.AccAddressFromBech32(fromAddr)
+	if err != nil {
+		return err
+	}
+
+	if k.bankKeeper.BlockedAddr(fromSdkAddr) {
+		return types.ErrForceTransferFromModuleAccount.Wrapf("address: %s", fromAddr)
+	}
+
+	toSdkAddr, err := sdk.AccAddressFromBech32(toAddr)
+	if err != nil {
+		return err
+	}
+
+	// the admin can force transfer the tokens of a paused denom
+	ctx.Logger().Info(fmt.Sprintf("Force transferring amount=%s from account=%s to account=%s", amount.String(), fromAddr, toAddr))
+	return k.withoutPause(ctx, amount.Denom, func() error {
+		return k.bankKeeper.SendCoins(ctx, fromSdkAddr, toSdkAddr, sdk.NewCoins(amount))
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/kiichain/kiichain/x/tokenfactory/types"
 )
@@ -207,3 +209,89 @@ func (suite *KeeperTestSuite) TestBurnDenom() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMintToBurnFromAddress() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+
+	// Mint to another account
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(admin, sdk.NewInt64Coin(suite.defaultDenom, 50), suite.TestAccs[1].String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(0), suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[0], suite.defaultDenom).Amount.Int64())
+	suite.Require().Equal(int64(50), suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom).Amount.Int64())
+
+	// Only the admin can mint to another account
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(suite.TestAccs[1].String(), sdk.NewInt64Coin(suite.defaultDenom, 50), suite.TestAccs[1].String()))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// Burn from another account
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(admin, sdk.NewInt64Coin(suite.defaultDenom, 20), suite.TestAccs[1].String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(30), suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom).Amount.Int64())
+
+	// Only the admin can burn from another account
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(suite.TestAccs[1].String(), sdk.NewInt64Coin(suite.defaultDenom, 10), suite.TestAccs[1].String()))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// Burning from a module account is not allowed
+	moduleAddr := suite.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	suite.Require().True(suite.App.BankKeeper.BlockedAddr(moduleAddr))
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(admin, sdk.NewInt64Coin(suite.defaultDenom, 10), moduleAddr.String()))
+	suite.Require().ErrorIs(err, types.ErrBurnFromModuleAccount)
+
+	supply := suite.App.BankKeeper.GetSupply(suite.Ctx, suite.defaultDenom).Amount.Int64()
+	suite.Require().Equal(int64(30), supply)
+}
+
+func (suite *KeeperTestSuite) TestForceTransfer() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(admin, sdk.NewInt64Coin(suite.defaultDenom, 50), suite.TestAccs[1].String()))
+	suite.Require().NoError(err)
+
+	for _, tc := range []struct {
+		desc        string
+		msg         *types.MsgForceTransfer
+		expectedErr error
+		fromBalance int64
+		toBalance   int64
+	}{
+		{
+			desc:        "transfer is not by the admin",
+			msg:         types.NewMsgForceTransfer(suite.TestAccs[1].String(), sdk.NewInt64Coin(suite.defaultDenom, 10), suite.TestAccs[1].String(), suite.TestAccs[2].String()),
+			expectedErr: types.ErrUnauthorized,
+			fromBalance: 50,
+		},
+		{
+			desc:        "transfer from a module account",
+			msg:         types.NewMsgForceTransfer(admin, sdk.NewInt64Coin(suite.defaultDenom, 10), suite.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName).String(), suite.TestAccs[2].String()),
+			expectedErr: types.ErrForceTransferFromModuleAccount,
+			fromBalance: 50,
+		},
+		{
+			desc:        "transfer more than the balance",
+			msg:         types.NewMsgForceTransfer(admin, sdk.NewInt64Coin(suite.defaultDenom, 100), suite.TestAccs[1].String(), suite.TestAccs[2].String()),
+			expectedErr: sdkerrors.ErrInsufficientFunds,
+			fromBalance: 50,
+		},
+		{
+			desc:        "success case",
+			msg:         types.NewMsgForceTransfer(admin, sdk.NewInt64Coin(suite.defaultDenom, 10), suite.TestAccs[1].String(), suite.TestAccs[2].String()),
+			fromBalance: 40,
+			toBalance:   10,
+		},
+	} {
+		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			_, err := suite.msgServer.ForceTransfer(sdk.WrapSDKContext(suite.Ctx), tc.msg)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			suite.Require().Equal(tc.fromBalance, suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom).Amount.Int64())
+			suite.Require().Equal(tc.toBalance, suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[2], suite.defaultDenom).Amount.Int64())
+		})
+	}
+}
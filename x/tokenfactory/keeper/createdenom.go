@@ -16,6 +16,11 @@ func (k Keeper) CreateDenom(ctx sdk.Context, creatorAddr string, subdenom string
 		return "", err
 	}
 
+	err = k.chargeForCreateDenom(ctx, creatorAddr)
+	if err != nil {
+		return "", err
+	}
+
 	err = k.createDenomAfterValidation(ctx, creatorAddr, denom)
 	return denom, err
 }
@@ -70,9 +75,45 @@ func (k Keeper) validateCreateDenom(ctx sdk.Context, creatorAddr string, subdeno
 		return "", types.ErrDenomExists
 	}
 
+	maxDenoms := k.GetParams(ctx).MaxDenomsPerCreator
+	if maxDenoms > 0 && k.countDenomsFromCreator(ctx, creatorAddr) >= maxDenoms {
+		return "", types.ErrMaxDenomsPerCreator.Wrapf("creator %s has %d denoms", creatorAddr, maxDenoms)
+	}
+
 	return denom, nil
 }
 
+// chargeForCreateDenom charges the denom creation fee, which is either sent to
+// the community pool or burned, and consumes the denom creation gas
+func (k Keeper) chargeForCreateDenom(ctx sdk.Context, creatorAddr string) error {
+	params := k.GetParams(ctx)
+
+	if !params.DenomCreationFee.IsZero() {
+		creator, err := sdk.AccAddressFromBech32(creatorAddr)
+		if err != nil {
+			return err
+		}
+
+		if params.BurnDenomCreationFee {
+			err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, params.DenomCreationFee)
+			if err != nil {
+				return err
+			}
+			err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, params.DenomCreationFee)
+		} else {
+			err = k.distrKeeper.FundCommunityPool(ctx, params.DenomCreationFee, creator)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if params.DenomCreationGasConsume != 0 {
+		ctx.GasMeter().ConsumeGas(params.DenomCreationGasConsume, "consume denom creation gas")
+	}
+	return nil
+}
+
 // validateUpdateDenom this validates the update denom message
 func (k Keeper) validateUpdateDenom(ctx sdk.Context, msg *types.MsgUpdateDenom) (tokenDenom string, err error) {
 	_, _, err = types.DeconstructDenom(msg.GetDenom())
@@ -295,3 +295,76 @@ func (suite *KeeperTestSuite) TestUpdateDenom() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCreateDenomFee() {
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	creator := suite.TestAccs[0]
+	suite.FundAcc(creator, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1500)))
+
+	// the fee is sent to the community pool
+	params := types.DefaultParams()
+	params.DenomCreationFee = fee
+	suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params)
+
+	communityPool := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+	_, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(creator.String(), "bitcoin"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(500), suite.App.BankKeeper.GetBalance(suite.Ctx, creator, sdk.DefaultBondDenom).Amount.Int64())
+	suite.Require().Equal(communityPool.Add(sdk.NewDecCoinsFromCoins(fee...)...), suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx))
+
+	// the denom can't be created without the fee
+	_, err = suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(creator.String(), "litecoin"))
+	suite.Require().Error(err)
+
+	// the fee is burned
+	suite.FundAcc(creator, fee)
+	params.BurnDenomCreationFee = true
+	suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params)
+
+	supply := suite.App.BankKeeper.GetSupply(suite.Ctx, sdk.DefaultBondDenom)
+	_, err = suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(creator.String(), "litecoin"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(500), suite.App.BankKeeper.GetBalance(suite.Ctx, creator, sdk.DefaultBondDenom).Amount.Int64())
+	suite.Require().Equal(supply.Sub(fee[0]), suite.App.BankKeeper.GetSupply(suite.Ctx, sdk.DefaultBondDenom))
+}
+
+func (suite *KeeperTestSuite) TestCreateDenomGasConsume() {
+	params := types.DefaultParams()
+	params.DenomCreationGasConsume = 2_000_000
+	suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params)
+
+	ctx := suite.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter(1, 1))
+	_, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(ctx), types.NewMsgCreateDenom(suite.TestAccs[0].String(), "bitcoin"))
+	suite.Require().NoError(err)
+	suite.Require().Greater(ctx.GasMeter().GasConsumed(), params.DenomCreationGasConsume)
+
+	// the creation fails if the gas limit is below the denom creation gas
+	ctx = suite.Ctx.WithGasMeter(sdk.NewGasMeter(1_000_000, 1, 1))
+	suite.Require().Panics(func() {
+		_, _ = suite.msgServer.CreateDenom(sdk.WrapSDKContext(ctx), types.NewMsgCreateDenom(suite.TestAccs[0].String(), "litecoin"))
+	})
+}
+
+func (suite *KeeperTestSuite) TestMaxDenomsPerCreator() {
+	params := types.DefaultParams()
+	params.MaxDenomsPerCreator = 2
+	suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params)
+
+	for _, subdenom := range []string{"bitcoin", "litecoin"} {
+		_, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(suite.TestAccs[0].String(), subdenom))
+		suite.Require().NoError(err)
+	}
+
+	_, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(suite.TestAccs[0].String(), "dogecoin"))
+	suite.Require().ErrorIs(err, types.ErrMaxDenomsPerCreator)
+
+	// the limit applies to every creator separately
+	_, err = suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(suite.TestAccs[1].String(), "dogecoin"))
+	suite.Require().NoError(err)
+
+	// zero removes the limit
+	params.MaxDenomsPerCreator = 0
+	suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params)
+	_, err = suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(suite.TestAccs[0].String(), "dogecoin"))
+	suite.Require().NoError(err)
+}
@@ -9,6 +9,18 @@ func (k Keeper) addDenomFromCreator(ctx sdk.Context, creator, denom string) {
 	store.Set([]byte(denom), []byte(denom))
 }
 
+// countDenomsFromCreator returns the number of denoms created by the creator
+func (k Keeper) countDenomsFromCreator(ctx sdk.Context, creator string) uint32 {
+	iterator := k.GetCreatorPrefixStore(ctx, creator).Iterator(nil, nil)
+	defer iterator.Close()
+
+	count := uint32(0)
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
 func (k Keeper) GetAllDenomsIterator(ctx sdk.Context) sdk.Iterator {
 	return k.GetCreatorsPrefixStore(ctx).Iterator(nil, nil)
 }
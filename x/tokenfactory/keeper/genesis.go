@@ -26,6 +26,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		if !genDenom.MaxSupply.IsNil() && genDenom.MaxSupply.IsPositive() {
+			err = k.setMaxSupply(ctx, genDenom.GetDenom(), genDenom.MaxSupply)
+			if err != nil {
+				panic(err)
+			}
+		}
+		// the bank genesis holds the allow list of the paused denom
+		if genDenom.PausedAllowList != nil {
+			err = k.setPausedAllowList(ctx, genDenom.GetDenom(), *genDenom.PausedAllowList)
+			if err != nil {
+				panic(err)
+			}
+		}
 	}
 }
 
@@ -42,10 +55,18 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			panic(err)
 		}
 
-		genDenoms = append(genDenoms, types.GenesisDenom{
+		genDenom := types.GenesisDenom{
 			Denom:             denom,
 			AuthorityMetadata: authorityMetadata,
-		})
+		}
+		if maxSupply, found := k.GetMaxSupply(ctx, denom); found {
+			genDenom.MaxSupply = maxSupply
+		}
+		if allowList, found := k.getPausedAllowList(ctx, denom); found {
+			genDenom.PausedAllowList = &allowList
+		}
+
+		genDenoms = append(genDenoms, genDenom)
 	}
 
 	return &types.GenesisState{
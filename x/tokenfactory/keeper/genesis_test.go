@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
@@ -27,6 +28,8 @@ func (suite *KeeperTestSuite) TestGenesis() {
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "kii1y3pxq5dp900czh0mkudhjdqjq5m8cpmm4hvczs",
 				},
+				MaxSupply:       sdk.NewInt(1000),
+				PausedAllowList: &banktypes.AllowList{Addresses: []string{"kii1hjfwcza3e3uzeznf3qthhakdr9juetl7uajv0t"}},
 			},
 		},
 	}
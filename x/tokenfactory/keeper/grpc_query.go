@@ -97,8 +97,102 @@ func (k Keeper) DenomAllowList(c context.Context, req *types.QueryDenomAllowList
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	allowList := k.bankKeeper.GetDenomAllowList(ctx, req.Denom)
+	allowList := k.getDenomAllowList(ctx, req.Denom)
 	return &types.QueryDenomAllowListResponse{
 		AllowList: allowList,
 	}, nil
 }
+
+// DenomRoles implements Query/DenomRoles gRPC method.
+func (k Keeper) DenomRoles(c context.Context, req *types.QueryDenomRolesRequest) (*types.QueryDenomRolesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomRolesResponse{
+		Roles: authorityMetadata.GetRoles(req.Address),
+	}, nil
+}
+
+// MinterAllowance implements Query/MinterAllowance gRPC method.
+func (k Keeper) MinterAllowance(c context.Context, req *types.QueryMinterAllowanceRequest) (*types.QueryMinterAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !authorityMetadata.HasRole(types.RoleMinter, req.Minter) {
+		return nil, status.Errorf(codes.NotFound, "%s is not a minter of %s", req.Minter, req.Denom)
+	}
+
+	// the admin mints without limit
+	allowance, found := authorityMetadata.GetMinterAllowance(req.Minter)
+	if !found || req.Minter == authorityMetadata.GetAdmin() {
+		return &types.QueryMinterAllowanceResponse{Allowance: sdk.ZeroInt(), Unlimited: true}, nil
+	}
+
+	return &types.QueryMinterAllowanceResponse{
+		Allowance: allowance,
+	}, nil
+}
+
+// DenomMaxSupply implements Query/DenomMaxSupply gRPC method.
+func (k Keeper) DenomMaxSupply(c context.Context, req *types.QueryDenomMaxSupplyRequest) (*types.QueryDenomMaxSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	maxSupply, found := k.GetMaxSupply(ctx, req.Denom)
+	return &types.QueryDenomMaxSupplyResponse{
+		MaxSupply: maxSupply,
+		Unlimited: !found,
+	}, nil
+}
+
+// DenomPaused implements Query/DenomPaused gRPC method.
+func (k Keeper) DenomPaused(c context.Context, req *types.QueryDenomPausedRequest) (*types.QueryDenomPausedResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDenomPausedResponse{
+		Paused: k.IsPaused(ctx, req.Denom),
+	}, nil
+}
@@ -58,6 +58,13 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 	return nil
 }
 
+// Migrate4to5 migrates from version 4 to 5, it sets the default params after
+// adding the denom creation fee, gas and max denoms per creator params.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.keeper.SetParams(ctx, types.DefaultParams())
+	return nil
+}
+
 func (m Migrator) SetMetadata(denomMetadata *banktypes.Metadata) {
 	if len(denomMetadata.Base) == 0 {
 		panic(fmt.Errorf("no base exists for denom %v", denomMetadata))
@@ -80,7 +80,8 @@ func TestMigrate2to3(t *testing.T) {
 func TestMigrate3To4(t *testing.T) {
 	// Test migration with all metadata denom
 	metadata := banktypes.Metadata{Description: sdk.DefaultBondDenom, Base: sdk.DefaultBondDenom, Display: sdk.DefaultBondDenom, Name: sdk.DefaultBondDenom, Symbol: sdk.DefaultBondDenom}
-	keeper := NewKeeper(nil, nil, typesparams.Subspace{}, nil, nil, nil, Config{DenomAllowListMaxSize: 100})
+	// the params are not used, an empty subspace can't register the param key table
+	keeper := Keeper{config: Config{DenomAllowListMaxSize: 100}}
 	m := NewMigrator(keeper)
 	m.SetMetadata(&metadata)
 	require.Equal(t, sdk.DefaultBondDenom, metadata.Display)
@@ -94,3 +95,36 @@ func TestMigrate3To4(t *testing.T) {
 	require.Equal(t, testDenom, metadata.Name)
 	require.Equal(t, testDenom, metadata.Symbol)
 }
+
+func TestMigrate4to5(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(memStoreKey, sdk.StoreTypeMemory, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+
+	paramsSubspace := typesparams.NewSubspace(cdc,
+		codec.NewLegacyAmino(),
+		storeKey,
+		memStoreKey,
+		"TokenfactoryParams",
+	)
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+	newKeeper := NewKeeper(storeKey, cdc, paramsSubspace, nil, nil, nil, Config{DenomAllowListMaxSize: 100})
+
+	// the params of version 4 were empty
+	require.Panics(t, func() { newKeeper.GetParams(ctx) })
+
+	m := NewMigrator(newKeeper)
+	err := m.Migrate4to5(ctx)
+	require.Nil(t, err)
+	require.Equal(t, types.DefaultParams().MaxDenomsPerCreator, newKeeper.GetParams(ctx).MaxDenomsPerCreator)
+	require.True(t, newKeeper.GetParams(ctx).DenomCreationFee.IsZero())
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -45,6 +46,17 @@ func (server msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateD
 		)
 	}
 
+	// zero means no cap
+	if !msg.MaxSupply.IsNil() && msg.MaxSupply.IsPositive() {
+		err = server.setMaxSupply(ctx, denom, msg.MaxSupply)
+		if err != nil {
+			return nil, err
+		}
+		createDenomEvent = createDenomEvent.AppendAttributes(
+			sdk.NewAttribute(types.AttributeMaxSupply, msg.MaxSupply.String()),
+		)
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		createDenomEvent,
 	})
@@ -67,7 +79,7 @@ func (server msgServer) UpdateDenom(goCtx context.Context, msg *types.MsgUpdateD
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.RoleAllowListManager, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
@@ -78,7 +90,10 @@ func (server msgServer) UpdateDenom(goCtx context.Context, msg *types.MsgUpdateD
 	)
 
 	if msg.AllowList != nil {
-		server.bankKeeper.SetDenomAllowList(ctx, denom, *msg.AllowList)
+		err = server.setDenomAllowList(ctx, denom, *msg.AllowList)
+		if err != nil {
+			return nil, err
+		}
 		updateDenomEvent = updateDenomEvent.AppendAttributes(
 			sdk.NewAttribute(types.AttributeAllowList, strings.Join(msg.AllowList.Addresses, ",")),
 		)
@@ -105,11 +120,25 @@ func (server msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.RoleMinter, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
-	err = server.Keeper.mintTo(ctx, msg.Amount, msg.Sender)
+	// the admin mints without limit
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		err = server.Keeper.spendMinterAllowance(ctx, msg.Amount.GetDenom(), authorityMetadata, msg.Sender, msg.Amount.Amount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// mint to the sender unless a recipient is given
+	mintToAddress := msg.Sender
+	if msg.MintToAddress != "" {
+		mintToAddress = msg.MintToAddress
+	}
+
+	err = server.Keeper.mintTo(ctx, msg.Amount, mintToAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +146,7 @@ func (server msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.TypeMsgMint,
-			sdk.NewAttribute(types.AttributeMintToAddress, msg.Sender),
+			sdk.NewAttribute(types.AttributeMintToAddress, mintToAddress),
 			sdk.NewAttribute(types.AttributeAmount, msg.Amount.String()),
 		),
 	})
@@ -133,11 +162,26 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.RoleBurner, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	// burn from the sender unless another account is given, only the admin can
+	// burn the tokens of other accounts
+	burnFromAddress := msg.Sender
+	if msg.BurnFromAddress != "" {
+		burnFromAddress = msg.BurnFromAddress
+	}
+	if burnFromAddress != msg.Sender && msg.Sender != authorityMetadata.GetAdmin() {
 		return nil, types.ErrUnauthorized
 	}
 
-	err = server.Keeper.burnFrom(ctx, msg.Amount, msg.Sender)
+	// only the admin can burn while the denom is paused
+	if msg.Sender != authorityMetadata.GetAdmin() && server.Keeper.IsPaused(ctx, msg.Amount.Denom) {
+		return nil, types.ErrDenomPaused.Wrapf("denom: %s", msg.Amount.Denom)
+	}
+
+	err = server.Keeper.burnFrom(ctx, msg.Amount, burnFromAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -145,7 +189,7 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.TypeMsgBurn,
-			sdk.NewAttribute(types.AttributeBurnFromAddress, msg.Sender),
+			sdk.NewAttribute(types.AttributeBurnFromAddress, burnFromAddress),
 			sdk.NewAttribute(types.AttributeAmount, msg.Amount.String()),
 		),
 	})
@@ -199,7 +243,7 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.RoleMetadataUpdater, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
@@ -215,3 +259,166 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 
 	return &types.MsgSetDenomMetadataResponse{}, nil
 }
+
+func (server msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Amount.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.forceTransfer(ctx, msg.Amount, msg.TransferFromAddress, msg.TransferToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgForceTransfer,
+			sdk.NewAttribute(types.AttributeTransferFromAddress, msg.TransferFromAddress),
+			sdk.NewAttribute(types.AttributeTransferToAddress, msg.TransferToAddress),
+			sdk.NewAttribute(types.AttributeAmount, msg.Amount.String()),
+		),
+	})
+
+	return &types.MsgForceTransferResponse{}, nil
+}
+
+func (server msgServer) GrantRole(goCtx context.Context, msg *types.MsgGrantRole) (*types.MsgGrantRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = authorityMetadata.GrantRole(msg.Role, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.setAuthorityMetadata(ctx, msg.Denom, authorityMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgGrantRole,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeRole, msg.Role),
+			sdk.NewAttribute(types.AttributeAddress, msg.Address),
+		),
+	})
+
+	return &types.MsgGrantRoleResponse{}, nil
+}
+
+func (server msgServer) RevokeRole(goCtx context.Context, msg *types.MsgRevokeRole) (*types.MsgRevokeRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = authorityMetadata.RevokeRole(msg.Role, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.setAuthorityMetadata(ctx, msg.Denom, authorityMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRevokeRole,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeRole, msg.Role),
+			sdk.NewAttribute(types.AttributeAddress, msg.Address),
+		),
+	})
+
+	return &types.MsgRevokeRoleResponse{}, nil
+}
+
+func (server msgServer) SetMinterAllowance(goCtx context.Context, msg *types.MsgSetMinterAllowance) (*types.MsgSetMinterAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = authorityMetadata.SetMinterAllowance(msg.Minter, msg.Allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.setAuthorityMetadata(ctx, msg.Denom, authorityMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetMinterAllowance,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeMinter, msg.Minter),
+			sdk.NewAttribute(types.AttributeMinterAllowance, msg.Allowance.String()),
+		),
+	})
+
+	return &types.MsgSetMinterAllowanceResponse{}, nil
+}
+
+func (server msgServer) SetDenomPause(goCtx context.Context, msg *types.MsgSetDenomPause) (*types.MsgSetDenomPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !authorityMetadata.HasRole(types.RolePauser, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	if msg.Paused {
+		err = server.Keeper.pause(ctx, msg.Denom)
+	} else {
+		err = server.Keeper.unpause(ctx, msg.Denom)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetDenomPause,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributePaused, strconv.FormatBool(msg.Paused)),
+		),
+	})
+
+	return &types.MsgSetDenomPauseResponse{}, nil
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/kiichain/kiichain/x/tokenfactory/types"
+)
+
+// GetMaxSupply returns the max supply of a specific denom, false if the supply
+// of the denom is not capped
+func (k Keeper) GetMaxSupply(ctx sdk.Context, denom string) (sdk.Int, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomMaxSupplyKey))
+	if bz == nil {
+		return sdk.ZeroInt(), false
+	}
+
+	var maxSupply sdk.Int
+	if err := maxSupply.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return maxSupply, true
+}
+
+// setMaxSupply caps the supply of a specific denom
+func (k Keeper) setMaxSupply(ctx sdk.Context, denom string, maxSupply sdk.Int) error {
+	bz, err := maxSupply.Marshal()
+	if err != nil {
+		return err
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Set([]byte(types.DenomMaxSupplyKey), bz)
+	return nil
+}
+
+// validateMaxSupply returns an error if minting the amount exceeds the max
+// supply of the denom
+func (k Keeper) validateMaxSupply(ctx sdk.Context, amount sdk.Coin) error {
+	maxSupply, found := k.GetMaxSupply(ctx, amount.Denom)
+	if !found {
+		return nil
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, amount.Denom)
+	if supply.Amount.Add(amount.Amount).GT(maxSupply) {
+		return types.ErrMaxSupplyExceeded.Wrapf("supply: %s, amount: %s, max supply: %s", supply.Amount, amount.Amount, maxSupply)
+	}
+	return nil
+}
+
+// IsPaused returns true if the transfers of a specific denom are paused
+func (k Keeper) IsPaused(ctx sdk.Context, denom string) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has([]byte(types.DenomPausedAllowListKey))
+}
+
+// getPausedAllowList returns the allow list saved while the denom is paused
+func (k Keeper) getPausedAllowList(ctx sdk.Context, denom string) (banktypes.AllowList, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomPausedAllowListKey))
+	if bz == nil {
+		return banktypes.AllowList{}, false
+	}
+
+	allowList := banktypes.AllowList{}
+	if err := proto.Unmarshal(bz, &allowList); err != nil {
+		panic(err)
+	}
+	return allowList, true
+}
+
+// setPausedAllowList saves the allow list of the denom while it is paused
+func (k Keeper) setPausedAllowList(ctx sdk.Context, denom string, allowList banktypes.AllowList) error {
+	bz, err := proto.Marshal(&allowList)
+	if err != nil {
+		return err
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Set([]byte(types.DenomPausedAllowListKey), bz)
+	return nil
+}
+
+// pause blocks the bank sends of the denom. The bank keeper only lets the
+// addresses of the denom allow list send and receive the denom, the allow list
+// is replaced by the tokenfactory module address, which no account can sign
+// for, and the original allow list is saved until the denom is unpaused.
+func (k Keeper) pause(ctx sdk.Context, denom string) error {
+	if k.IsPaused(ctx, denom) {
+		return types.ErrDenomPaused.Wrapf("denom: %s", denom)
+	}
+
+	err := k.setPausedAllowList(ctx, denom, k.bankKeeper.GetDenomAllowList(ctx, denom))
+	if err != nil {
+		return err
+	}
+
+	k.bankKeeper.SetDenomAllowList(ctx, denom, banktypes.AllowList{
+		Addresses: []string{authtypes.NewModuleAddress(types.ModuleName).String()},
+	})
+	return nil
+}
+
+// unpause restores the allow list of the denom saved when it was paused
+func (k Keeper) unpause(ctx sdk.Context, denom string) error {
+	allowList, found := k.getPausedAllowList(ctx, denom)
+	if !found {
+		return types.ErrDenomNotPaused.Wrapf("denom: %s", denom)
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Delete([]byte(types.DenomPausedAllowListKey))
+	k.bankKeeper.SetDenomAllowList(ctx, denom, allowList)
+	return nil
+}
+
+// getDenomAllowList returns the allow list of the denom, the saved allow list
+// while the denom is paused
+func (k Keeper) getDenomAllowList(ctx sdk.Context, denom string) banktypes.AllowList {
+	if allowList, found := k.getPausedAllowList(ctx, denom); found {
+		return allowList
+	}
+	return k.bankKeeper.GetDenomAllowList(ctx, denom)
+}
+
+// setDenomAllowList sets the allow list of the denom, it can't be updated while
+// the denom is paused
+func (k Keeper) setDenomAllowList(ctx sdk.Context, denom string, allowList banktypes.AllowList) error {
+	if k.IsPaused(ctx, denom) {
+		return types.ErrDenomPaused.Wrapf("denom: %s", denom)
+	}
+
+	k.bankKeeper.SetDenomAllowList(ctx, denom, allowList)
+	return nil
+}
+
+// withoutPause runs an admin action on the denom as if it wasn't paused, the
+// saved allow list is applied while the action runs and the pause afterwards
+func (k Keeper) withoutPause(ctx sdk.Context, denom string, action func() error) error {
+	allowList, found := k.getPausedAllowList(ctx, denom)
+	if !found {
+		return action()
+	}
+
+	pausedAllowList := k.bankKeeper.GetDenomAllowList(ctx, denom)
+	k.bankKeeper.SetDenomAllowList(ctx, denom, allowList)
+	defer k.bankKeeper.SetDenomAllowList(ctx, denom, pausedAllowList)
+
+	return action()
+}
@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+
+	"github.com/kiichain/kiichain/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestMaxSupply() {
+	ctx := sdk.WrapSDKContext(suite.Ctx)
+	admin := suite.TestAccs[0].String()
+
+	res, err := suite.msgServer.CreateDenom(ctx, types.NewMsgCreateDenomWithMaxSupply(admin, "litecoin", sdk.NewInt(100)))
+	suite.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+
+	queryRes, err := suite.queryClient.DenomMaxSupply(suite.Ctx.Context(), &types.QueryDenomMaxSupplyRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().False(queryRes.Unlimited)
+	suite.Require().Equal(sdk.NewInt(100), queryRes.MaxSupply)
+
+	// mint up to the max supply
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 60)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 41)))
+	suite.Require().ErrorIs(err, types.ErrMaxSupplyExceeded)
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 40)))
+	suite.Require().NoError(err)
+
+	// the burned tokens can be minted again
+	_, err = suite.msgServer.Burn(ctx, types.NewMsgBurn(admin, sdk.NewInt64Coin(denom, 10)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 10)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(100), suite.App.BankKeeper.GetSupply(suite.Ctx, denom).Amount)
+
+	// the supply of a denom created without max supply is not capped
+	res, err = suite.msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(admin, "dogecoin"))
+	suite.Require().NoError(err)
+	queryRes, err = suite.queryClient.DenomMaxSupply(suite.Ctx.Context(), &types.QueryDenomMaxSupplyRequest{Denom: res.GetNewTokenDenom()})
+	suite.Require().NoError(err)
+	suite.Require().True(queryRes.Unlimited)
+}
+
+func (suite *KeeperTestSuite) TestPause() {
+	suite.CreateDefaultDenom()
+	ctx := sdk.WrapSDKContext(suite.Ctx)
+	admin, pauser, holder := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+	allowList := banktypes.AllowList{Addresses: []string{admin.String(), holder.String()}}
+
+	_, err := suite.msgServer.UpdateDenom(ctx, types.NewMsgUpdateDenom(admin.String(), suite.defaultDenom, &allowList))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoins(suite.Ctx, admin, holder, coins))
+
+	// only the pausers can pause the denom
+	_, err = suite.msgServer.SetDenomPause(ctx, types.NewMsgSetDenomPause(pauser.String(), suite.defaultDenom, true))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin.String(), suite.defaultDenom, types.RolePauser, pauser.String()))
+	suite.Require().NoError(err)
+
+	// unpausing a denom which is not paused fails
+	_, err = suite.msgServer.SetDenomPause(ctx, types.NewMsgSetDenomPause(pauser.String(), suite.defaultDenom, false))
+	suite.Require().ErrorIs(err, types.ErrDenomNotPaused)
+
+	_, err = suite.msgServer.SetDenomPause(ctx, types.NewMsgSetDenomPause(pauser.String(), suite.defaultDenom, true))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetDenomPause(ctx, types.NewMsgSetDenomPause(pauser.String(), suite.defaultDenom, true))
+	suite.Require().ErrorIs(err, types.ErrDenomPaused)
+
+	pausedRes, err := suite.queryClient.DenomPaused(suite.Ctx.Context(), &types.QueryDenomPausedRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().True(pausedRes.Paused)
+
+	// the bank sends of the denom are blocked, the other denoms can be sent
+	suite.Require().Error(suite.App.BankKeeper.SendCoins(suite.Ctx, admin, holder, coins))
+	suite.Require().Error(suite.App.BankKeeper.SendCoins(suite.Ctx, holder, admin, coins))
+	suite.FundAcc(holder, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoins(suite.Ctx, holder, admin, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))))
+
+	// the tokens can't be minted or burned by the burners
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrDenomPaused)
+	_, err = suite.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin.String(), suite.defaultDenom, types.RoleBurner, holder.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(ctx, types.NewMsgBurn(holder.String(), sdk.NewInt64Coin(suite.defaultDenom, 5)))
+	suite.Require().ErrorIs(err, types.ErrDenomPaused)
+
+	// the admin can still burn and force transfer the tokens
+	_, err = suite.msgServer.Burn(ctx, types.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 5), holder.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ForceTransfer(ctx, types.NewMsgForceTransfer(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 5), holder.String(), admin.String()))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, holder, suite.defaultDenom).IsZero())
+	suite.Require().Equal(int64(95), suite.App.BankKeeper.GetBalance(suite.Ctx, admin, suite.defaultDenom).Amount.Int64())
+
+	// the admin actions keep the original allow list and the pause
+	_, err = suite.msgServer.ForceTransfer(ctx, types.NewMsgForceTransfer(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 5), admin.String(), pauser.String()))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().Error(suite.App.BankKeeper.SendCoins(suite.Ctx, admin, holder, coins))
+
+	// the allow list can't be updated while the denom is paused
+	newAllowList := banktypes.AllowList{Addresses: []string{admin.String(), holder.String(), pauser.String()}}
+	_, err = suite.msgServer.UpdateDenom(ctx, types.NewMsgUpdateDenom(admin.String(), suite.defaultDenom, &newAllowList))
+	suite.Require().ErrorIs(err, types.ErrDenomPaused)
+	allowListRes, err := suite.queryClient.DenomAllowList(suite.Ctx.Context(), &types.QueryDenomAllowListRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(allowList, allowListRes.AllowList)
+
+	// unpausing restores the allow list
+	_, err = suite.msgServer.SetDenomPause(ctx, types.NewMsgSetDenomPause(admin.String(), suite.defaultDenom, false))
+	suite.Require().NoError(err)
+	suite.Require().Equal(allowList, suite.App.BankKeeper.GetDenomAllowList(suite.Ctx, suite.defaultDenom))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoins(suite.Ctx, admin, holder, coins))
+
+	pausedRes, err = suite.queryClient.DenomPaused(suite.Ctx.Context(), &types.QueryDenomPausedRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().False(pausedRes.Paused)
+}
+
+func (suite *KeeperTestSuite) TestPauseBankPaths() {
+	suite.CreateDefaultDenom()
+	ctx := sdk.WrapSDKContext(suite.Ctx)
+	bankServer := bankkeeper.NewMsgServerImpl(suite.App.BankKeeper)
+	admin, holder := suite.TestAccs[0], suite.TestAccs[1]
+	escrow := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "channel-0")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+
+	_, err := suite.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.App.BankKeeper.SendCoins(suite.Ctx, admin, holder, coins))
+
+	// sends holds every bank path which moves the denom, the ERC20 pointer
+	// transfers are covered by the bank precompile tests
+	sends := map[string]func() error{
+		"send": func() error {
+			_, err := bankServer.Send(ctx, banktypes.NewMsgSend(holder, admin, coins))
+			return err
+		},
+		"multi send": func() error {
+			_, err := bankServer.MultiSend(ctx, banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(holder, coins)},
+				[]banktypes.Output{banktypes.NewOutput(admin, coins)},
+			))
+			return err
+		},
+		"ibc escrow": func() error {
+			return suite.App.BankKeeper.SendCoins(suite.Ctx, holder, escrow, coins)
+		},
+		"account to module": func() error {
+			return suite.App.BankKeeper.SendCoinsFromAccountToModule(suite.Ctx, holder, ibctransfertypes.ModuleName, coins)
+		},
+		"module to account": func() error {
+			return suite.App.BankKeeper.SendCoinsFromModuleToAccount(suite.Ctx, types.ModuleName, holder, coins)
+		},
+	}
+
+	_, err = suite.msgServer.SetDenomPause(ctx, types.NewMsgSetDenomPause(admin.String(), suite.defaultDenom, true))
+	suite.Require().NoError(err)
+	for name, send := range sends {
+		suite.Require().ErrorIs(send(), sdkerrors.ErrUnauthorized, name)
+	}
+
+	// the holder can move the denom again once it is unpaused
+	_, err = suite.msgServer.SetDenomPause(ctx, types.NewMsgSetDenomPause(admin.String(), suite.defaultDenom, false))
+	suite.Require().NoError(err)
+	for _, name := range []string{"send", "multi send", "ibc escrow", "account to module"} {
+		suite.Require().NoError(suite.App.BankKeeper.SendCoins(suite.Ctx, admin, holder, coins))
+		suite.Require().NoError(sends[name](), name)
+	}
+}
@@ -1,9 +1,33 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// denom roles, the admin holds every role
+const (
+	RoleMinter           = "minter"
+	RoleBurner           = "burner"
+	RoleMetadataUpdater  = "metadata_updater"
+	RoleAllowListManager = "allow_list_manager"
+	RolePauser           = "pauser"
+)
+
+// Roles lists the roles which can be granted over a denom
+var Roles = []string{RoleMinter, RoleBurner, RoleMetadataUpdater, RoleAllowListManager, RolePauser}
+
+// ValidateRole returns an error if the role is unknown
+func ValidateRole(role string) error {
+	for _, r := range Roles {
+		if r == role {
+			return nil
+		}
+	}
+	return ErrInvalidRole.Wrapf("role: %s", role)
+}
+
 func (metadata DenomAuthorityMetadata) Validate() error {
 	if metadata.Admin != "" {
 		_, err := sdk.AccAddressFromBech32(metadata.Admin)
@@ -11,5 +35,157 @@ func (metadata DenomAuthorityMetadata) Validate() error {
 			return err
 		}
 	}
+
+	for _, role := range Roles {
+		seen := map[string]bool{}
+		for _, addr := range *metadata.roleAddresses(role) {
+			if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+				return err
+			}
+			if seen[addr] {
+				return fmt.Errorf("duplicate %s %s", role, addr)
+			}
+			seen[addr] = true
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, allowance := range metadata.MinterAllowances {
+		if !metadata.isGranted(RoleMinter, allowance.Minter) {
+			return fmt.Errorf("allowance of %s which is not a minter", allowance.Minter)
+		}
+		if seen[allowance.Minter] {
+			return fmt.Errorf("duplicate allowance of %s", allowance.Minter)
+		}
+		if allowance.Allowance.IsNil() || allowance.Allowance.IsNegative() {
+			return fmt.Errorf("invalid allowance of %s", allowance.Minter)
+		}
+		seen[allowance.Minter] = true
+	}
+	return nil
+}
+
+// HasRole returns true if the address is the admin or was granted the role
+func (metadata DenomAuthorityMetadata) HasRole(role string, address string) bool {
+	if address == "" {
+		return false
+	}
+	return metadata.Admin == address || metadata.isGranted(role, address)
+}
+
+// isGranted returns true if the role was granted to the address
+func (metadata DenomAuthorityMetadata) isGranted(role string, address string) bool {
+	addresses := metadata.roleAddresses(role)
+	if addresses == nil {
+		return false
+	}
+	for _, addr := range *addresses {
+		if addr == address {
+			return true
+		}
+	}
+	return false
+}
+
+// GetRoles returns the roles granted to the address
+func (metadata DenomAuthorityMetadata) GetRoles(address string) []string {
+	roles := []string{}
+	for _, role := range Roles {
+		if metadata.HasRole(role, address) {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// GrantRole adds the address to the role addresses
+func (metadata *DenomAuthorityMetadata) GrantRole(role string, address string) error {
+	if err := ValidateRole(role); err != nil {
+		return err
+	}
+	if metadata.HasRole(role, address) {
+		return ErrRoleAlreadyGranted.Wrapf("%s is already a %s", address, role)
+	}
+
+	addresses := metadata.roleAddresses(role)
+	*addresses = append(*addresses, address)
 	return nil
 }
+
+// RevokeRole removes the address from the role addresses, revoking the minter
+// role removes the minter allowance
+func (metadata *DenomAuthorityMetadata) RevokeRole(role string, address string) error {
+	if err := ValidateRole(role); err != nil {
+		return err
+	}
+
+	addresses := metadata.roleAddresses(role)
+	for i, addr := range *addresses {
+		if addr == address {
+			*addresses = append((*addresses)[:i], (*addresses)[i+1:]...)
+			if role == RoleMinter {
+				metadata.removeMinterAllowance(address)
+			}
+			return nil
+		}
+	}
+	return ErrRoleNotGranted.Wrapf("%s is not a %s", address, role)
+}
+
+// GetMinterAllowance returns the remaining amount the minter can mint, the
+// minter can mint without limit if it has no allowance
+func (metadata DenomAuthorityMetadata) GetMinterAllowance(minter string) (sdk.Int, bool) {
+	for _, allowance := range metadata.MinterAllowances {
+		if allowance.Minter == minter {
+			return allowance.Allowance, true
+		}
+	}
+	return sdk.ZeroInt(), false
+}
+
+// SetMinterAllowance sets the remaining amount the minter can mint, the minter
+// role must have been granted to the minter
+func (metadata *DenomAuthorityMetadata) SetMinterAllowance(minter string, allowance sdk.Int) error {
+	if !metadata.isGranted(RoleMinter, minter) {
+		return ErrRoleNotGranted.Wrapf("%s is not a %s", minter, RoleMinter)
+	}
+
+	for i := range metadata.MinterAllowances {
+		if metadata.MinterAllowances[i].Minter == minter {
+			metadata.MinterAllowances[i].Allowance = allowance
+			return nil
+		}
+	}
+	metadata.MinterAllowances = append(metadata.MinterAllowances, MinterAllowance{
+		Minter:    minter,
+		Allowance: allowance,
+	})
+	return nil
+}
+
+func (metadata *DenomAuthorityMetadata) removeMinterAllowance(minter string) {
+	for i, allowance := range metadata.MinterAllowances {
+		if allowance.Minter == minter {
+			metadata.MinterAllowances = append(metadata.MinterAllowances[:i], metadata.MinterAllowances[i+1:]...)
+			return
+		}
+	}
+}
+
+// roleAddresses returns the addresses granted the role, nil if the role is unknown
+func (metadata *DenomAuthorityMetadata) roleAddresses(role string) *[]string {
+	switch role {
+	case RoleMinter:
+		return &metadata.Minters
+	case RoleBurner:
+		return &metadata.Burners
+	case RoleMetadataUpdater:
+		return &metadata.MetadataUpdaters
+	case RoleAllowListManager:
+		return &metadata.AllowListManagers
+	case RolePauser:
+		return &metadata.Pausers
+	default:
+		return nil
+	}
+}
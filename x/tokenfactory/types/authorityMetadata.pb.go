@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -25,11 +26,24 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // DenomAuthorityMetadata specifies metadata for addresses that have specific
-// capabilities over a token factory denom. Right now there is only one Admin
-// permission, but is planned to be extended to the future.
+// capabilities over a token factory denom. The admin has every capability and
+// grants the other roles, each role allows a subset of the admin actions.
 type DenomAuthorityMetadata struct {
 	// Can be empty for no admin, or a valid Kii address
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// Addresses allowed to mint the denom
+	Minters []string `protobuf:"bytes,2,rep,name=minters,proto3" json:"minters,omitempty" yaml:"minters"`
+	// Addresses allowed to burn the denom
+	Burners []string `protobuf:"bytes,3,rep,name=burners,proto3" json:"burners,omitempty" yaml:"burners"`
+	// Addresses allowed to set the denom's bank metadata
+	MetadataUpdaters []string `protobuf:"bytes,4,rep,name=metadata_updaters,json=metadataUpdaters,proto3" json:"metadata_updaters,omitempty" yaml:"metadata_updaters"`
+	// Addresses allowed to update the denom's allow list
+	AllowListManagers []string `protobuf:"bytes,5,rep,name=allow_list_managers,json=allowListManagers,proto3" json:"allow_list_managers,omitempty" yaml:"allow_list_managers"`
+	// Addresses allowed to pause the denom
+	Pausers []string `protobuf:"bytes,6,rep,name=pausers,proto3" json:"pausers,omitempty" yaml:"pausers"`
+	// The remaining amount the minters can mint, the minters without an
+	// allowance can mint without limit
+	MinterAllowances []MinterAllowance `protobuf:"bytes,7,rep,name=minter_allowances,json=minterAllowances,proto3" json:"minter_allowances" yaml:"minter_allowances"`
 }
 
 func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
@@ -72,8 +86,97 @@ func (m *DenomAuthorityMetadata) GetAdmin() string {
 	return ""
 }
 
+func (m *DenomAuthorityMetadata) GetMinters() []string {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetBurners() []string {
+	if m != nil {
+		return m.Burners
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetMetadataUpdaters() []string {
+	if m != nil {
+		return m.MetadataUpdaters
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetAllowListManagers() []string {
+	if m != nil {
+		return m.AllowListManagers
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetPausers() []string {
+	if m != nil {
+		return m.Pausers
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetMinterAllowances() []MinterAllowance {
+	if m != nil {
+		return m.MinterAllowances
+	}
+	return nil
+}
+
+// MinterAllowance defines the remaining amount a minter can mint
+type MinterAllowance struct {
+	Minter    string                                 `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter,omitempty" yaml:"minter"`
+	Allowance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=allowance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"allowance" yaml:"allowance"`
+}
+
+func (m *MinterAllowance) Reset()         { *m = MinterAllowance{} }
+func (m *MinterAllowance) String() string { return proto.CompactTextString(m) }
+func (*MinterAllowance) ProtoMessage()    {}
+func (*MinterAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b180705dfb8b5c4, []int{1}
+}
+func (m *MinterAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinterAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinterAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinterAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinterAllowance.Merge(m, src)
+}
+func (m *MinterAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinterAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinterAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinterAllowance proto.InternalMessageInfo
+
+func (m *MinterAllowance) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*DenomAuthorityMetadata)(nil), "kiichain.kiichain3.tokenfactory.DenomAuthorityMetadata")
+	proto.RegisterType((*MinterAllowance)(nil), "kiichain.kiichain3.tokenfactory.MinterAllowance")
 }
 
 func init() {
@@ -81,22 +184,38 @@ func init() {
 }
 
 var fileDescriptor_5b180705dfb8b5c4 = []byte{
-	// 232 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0xc9, 0xcf, 0x4e,
-	0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca,
-	0x2c, 0xa9, 0xf4, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x92, 0xcf, 0xce, 0xcc, 0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x83, 0x31, 0x8c, 0xf5, 0x90,
-	0x35, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0xd5, 0xea, 0x83, 0x58, 0x10, 0x6d, 0x52, 0x72,
-	0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xfa, 0x49, 0x89, 0xc5, 0xa9, 0xfa, 0x65, 0x86, 0x49, 0xa9,
-	0x25, 0x89, 0x86, 0xfa, 0xc9, 0xf9, 0x99, 0x79, 0x10, 0x79, 0x25, 0x37, 0x2e, 0x31, 0x97, 0xd4,
-	0xbc, 0xfc, 0x5c, 0x47, 0x74, 0x6b, 0x85, 0xd4, 0xb8, 0x58, 0x13, 0x53, 0x72, 0x33, 0xf3, 0x24,
-	0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0x04, 0x3e, 0xdd, 0x93, 0xe7, 0xa9, 0x4c, 0xcc, 0xcd, 0xb1,
-	0x52, 0x02, 0x0b, 0x2b, 0x05, 0x41, 0xa4, 0xad, 0x58, 0x5e, 0x2c, 0x90, 0x67, 0x74, 0xf2, 0x39,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xa3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x98, 0xd3, 0xe1, 0x0c, 0x63, 0xfd, 0x0a, 0x7d, 0x14, 0xef,
-	0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x1d, 0x67, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff,
-	0x68, 0x1a, 0x18, 0xbb, 0x1b, 0x01, 0x00, 0x00,
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x3f, 0x8f, 0xd3, 0x30,
+	0x18, 0xc6, 0x9b, 0xeb, 0x5d, 0x4f, 0x35, 0xff, 0x5a, 0x83, 0x50, 0x54, 0xa1, 0xb8, 0xb2, 0xd0,
+	0xa9, 0x48, 0x90, 0x50, 0x6e, 0xbb, 0xed, 0x22, 0x96, 0x13, 0x94, 0x21, 0x12, 0x0b, 0x4b, 0x71,
+	0xd2, 0xd0, 0x5a, 0x6d, 0xec, 0x2a, 0x76, 0x80, 0x4e, 0x7c, 0x05, 0x3e, 0x02, 0x03, 0x1f, 0xe6,
+	0xc6, 0x1b, 0x11, 0x43, 0x84, 0xda, 0x85, 0x81, 0x29, 0x9f, 0x00, 0xd9, 0x71, 0xb8, 0x34, 0x0c,
+	0x37, 0xc5, 0x7a, 0xdf, 0xdf, 0xf3, 0x3c, 0x7e, 0x6d, 0x07, 0x3c, 0x96, 0x7c, 0x19, 0xb3, 0x0f,
+	0x24, 0x92, 0x3c, 0xdd, 0x78, 0x24, 0x93, 0x0b, 0x9e, 0x52, 0xb9, 0x99, 0xc4, 0x92, 0xcc, 0x88,
+	0x24, 0xee, 0x3a, 0xe5, 0x92, 0x43, 0xb4, 0xa4, 0x34, 0x5a, 0x10, 0xca, 0xdc, 0x6a, 0x71, 0xea,
+	0xd6, 0x85, 0x83, 0x07, 0x73, 0x3e, 0xe7, 0x9a, 0xf5, 0xd4, 0xaa, 0x94, 0x0d, 0x9c, 0x88, 0x8b,
+	0x84, 0x0b, 0x2f, 0x24, 0x22, 0xf6, 0x3e, 0x8e, 0xc3, 0x58, 0x92, 0xb1, 0x17, 0x71, 0xca, 0xca,
+	0x3e, 0xfe, 0xd3, 0x06, 0x0f, 0x5f, 0xc6, 0x8c, 0x27, 0xe7, 0xcd, 0x5c, 0x78, 0x02, 0x8e, 0xc8,
+	0x2c, 0xa1, 0xcc, 0xb6, 0x86, 0xd6, 0xa8, 0xeb, 0xf7, 0x8a, 0x1c, 0xdd, 0xde, 0x90, 0x64, 0x75,
+	0x86, 0x75, 0x19, 0x07, 0x65, 0x1b, 0x3e, 0x05, 0xc7, 0x09, 0x65, 0x32, 0x4e, 0x85, 0x7d, 0x30,
+	0x6c, 0x8f, 0xba, 0x3e, 0x2c, 0x72, 0x74, 0xb7, 0x24, 0x4d, 0x03, 0x07, 0x15, 0xa2, 0xe8, 0x30,
+	0x4b, 0x99, 0xa2, 0xdb, 0x4d, 0xda, 0x34, 0x70, 0x50, 0x21, 0xf0, 0x02, 0xf4, 0x13, 0xb3, 0x9f,
+	0x69, 0xb6, 0x9e, 0x11, 0x9d, 0x72, 0xa8, 0x75, 0x8f, 0x8a, 0x1c, 0xd9, 0x26, 0xa5, 0x89, 0xe0,
+	0xa0, 0x57, 0xd5, 0xde, 0x9a, 0x12, 0x7c, 0x03, 0xee, 0x93, 0xd5, 0x8a, 0x7f, 0x9a, 0xae, 0xa8,
+	0x90, 0xd3, 0x84, 0x30, 0x32, 0x57, 0x66, 0x47, 0xda, 0xcc, 0x29, 0x72, 0x34, 0x30, 0xc3, 0xfd,
+	0x0f, 0xe1, 0xa0, 0xaf, 0xab, 0xaf, 0xa9, 0x90, 0x13, 0x53, 0x53, 0x83, 0xac, 0x49, 0x26, 0x94,
+	0x47, 0xa7, 0x39, 0x88, 0x69, 0xe0, 0xa0, 0x42, 0xe0, 0x17, 0xd0, 0x2f, 0x4f, 0x60, 0xaa, 0x9d,
+	0x08, 0x8b, 0x62, 0x61, 0x1f, 0x0f, 0xdb, 0xa3, 0x5b, 0x2f, 0x9e, 0xbb, 0x37, 0x5c, 0xad, 0x3b,
+	0xd1, 0xca, 0xf3, 0x4a, 0xe8, 0x0f, 0x2f, 0x73, 0xd4, 0xaa, 0x8d, 0xdf, 0x34, 0x56, 0xe3, 0xef,
+	0x4b, 0xc4, 0xd9, 0xe1, 0xef, 0x6f, 0xc8, 0xc2, 0xdf, 0x2d, 0x70, 0xaf, 0xe1, 0x06, 0x9f, 0x80,
+	0x4e, 0x49, 0x9b, 0x8b, 0xee, 0x17, 0x39, 0xba, 0x53, 0x77, 0xc6, 0x81, 0x01, 0xe0, 0x7b, 0xd0,
+	0xfd, 0x97, 0x62, 0x1f, 0x68, 0xda, 0x57, 0x7b, 0xf9, 0x99, 0xa3, 0x93, 0x39, 0x95, 0x8b, 0x2c,
+	0x74, 0x23, 0x9e, 0x78, 0xe6, 0xcd, 0x95, 0x9f, 0x67, 0x62, 0xb6, 0xf4, 0xe4, 0x66, 0x1d, 0x0b,
+	0xf7, 0x82, 0xc9, 0x22, 0x47, 0xbd, 0xda, 0x39, 0x2b, 0x23, 0x1c, 0x5c, 0x9b, 0x96, 0xdb, 0xf4,
+	0x5f, 0x5d, 0x6e, 0x1d, 0xeb, 0x6a, 0xeb, 0x58, 0xbf, 0xb6, 0x8e, 0xf5, 0x75, 0xe7, 0xb4, 0xae,
+	0x76, 0x4e, 0xeb, 0xc7, 0xce, 0x69, 0xbd, 0x1b, 0xd7, 0x62, 0xaa, 0xd3, 0xba, 0x5e, 0x7c, 0xf6,
+	0xf6, 0xfe, 0x25, 0x9d, 0x1a, 0x76, 0xf4, 0x4b, 0x3f, 0xfd, 0x3b, 0x00, 0x5d, 0x45, 0xc8, 0x88,
+	0x68, 0x03, 0x00, 0x00,
 }
 
 func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
@@ -121,6 +240,81 @@ func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
 	if this.Admin != that1.Admin {
 		return false
 	}
+	if len(this.Minters) != len(that1.Minters) {
+		return false
+	}
+	for i := range this.Minters {
+		if this.Minters[i] != that1.Minters[i] {
+			return false
+		}
+	}
+	if len(this.Burners) != len(that1.Burners) {
+		return false
+	}
+	for i := range this.Burners {
+		if this.Burners[i] != that1.Burners[i] {
+			return false
+		}
+	}
+	if len(this.MetadataUpdaters) != len(that1.MetadataUpdaters) {
+		return false
+	}
+	for i := range this.MetadataUpdaters {
+		if this.MetadataUpdaters[i] != that1.MetadataUpdaters[i] {
+			return false
+		}
+	}
+	if len(this.AllowListManagers) != len(that1.AllowListManagers) {
+		return false
+	}
+	for i := range this.AllowListManagers {
+		if this.AllowListManagers[i] != that1.AllowListManagers[i] {
+			return false
+		}
+	}
+	if len(this.Pausers) != len(that1.Pausers) {
+		return false
+	}
+	for i := range this.Pausers {
+		if this.Pausers[i] != that1.Pausers[i] {
+			return false
+		}
+	}
+	if len(this.MinterAllowances) != len(that1.MinterAllowances) {
+		return false
+	}
+	for i := range this.MinterAllowances {
+		if !this.MinterAllowances[i].Equal(&that1.MinterAllowances[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MinterAllowance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MinterAllowance)
+	if !ok {
+		that2, ok := that.(MinterAllowance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Minter != that1.Minter {
+		return false
+	}
+	if !this.Allowance.Equal(that1.Allowance) {
+		return false
+	}
 	return true
 }
 func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
@@ -143,6 +337,65 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinterAllowances) > 0 {
+		for iNdEx := len(m.MinterAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Pausers) > 0 {
+		for iNdEx := len(m.Pausers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pausers[iNdEx])
+			copy(dAtA[i:], m.Pausers[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Pausers[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AllowListManagers) > 0 {
+		for iNdEx := len(m.AllowListManagers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowListManagers[iNdEx])
+			copy(dAtA[i:], m.AllowListManagers[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.AllowListManagers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MetadataUpdaters) > 0 {
+		for iNdEx := len(m.MetadataUpdaters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MetadataUpdaters[iNdEx])
+			copy(dAtA[i:], m.MetadataUpdaters[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.MetadataUpdaters[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Burners) > 0 {
+		for iNdEx := len(m.Burners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Burners[iNdEx])
+			copy(dAtA[i:], m.Burners[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Burners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Minters[iNdEx])
+			copy(dAtA[i:], m.Minters[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Minters[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -153,6 +406,46 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MinterAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinterAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinterAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Allowance.Size()
+		i -= size
+		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuthorityMetadata(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuthorityMetadata(v)
 	base := offset
@@ -174,6 +467,57 @@ func (m *DenomAuthorityMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthorityMetadata(uint64(l))
 	}
+	if len(m.Minters) > 0 {
+		for _, s := range m.Minters {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.Burners) > 0 {
+		for _, s := range m.Burners {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.MetadataUpdaters) > 0 {
+		for _, s := range m.MetadataUpdaters {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.AllowListManagers) > 0 {
+		for _, s := range m.AllowListManagers {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.Pausers) > 0 {
+		for _, s := range m.Pausers {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.MinterAllowances) > 0 {
+		for _, e := range m.MinterAllowances {
+			l = e.Size()
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MinterAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	l = m.Allowance.Size()
+	n += 1 + l + sovAuthorityMetadata(uint64(l))
 	return n
 }
 
@@ -244,6 +588,316 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burners = append(m.Burners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUpdaters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataUpdaters = append(m.MetadataUpdaters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowListManagers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowListManagers = append(m.AllowListManagers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pausers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pausers = append(m.Pausers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterAllowances = append(m.MinterAllowances, MinterAllowance{})
+			if err := m.MinterAllowances[len(m.MinterAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinterAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorityMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinterAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinterAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
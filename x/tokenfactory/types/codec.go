@@ -16,6 +16,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBurn{}, "tokenfactory/MsgBurn", nil)
 	cdc.RegisterConcrete(&MsgChangeAdmin{}, "tokenfactory/MsgChangeAdmin", nil)
 	cdc.RegisterConcrete(&MsgSetDenomMetadata{}, "tokenfactory/MsgSetDenomMetadata", nil)
+	cdc.RegisterConcrete(&MsgForceTransfer{}, "tokenfactory/MsgForceTransfer", nil)
+	cdc.RegisterConcrete(&MsgGrantRole{}, "tokenfactory/MsgGrantRole", nil)
+	cdc.RegisterConcrete(&MsgRevokeRole{}, "tokenfactory/MsgRevokeRole", nil)
+	cdc.RegisterConcrete(&MsgSetMinterAllowance{}, "tokenfactory/MsgSetMinterAllowance", nil)
+	cdc.RegisterConcrete(&MsgSetDenomPause{}, "tokenfactory/MsgSetDenomPause", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -37,6 +42,21 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSetDenomMetadata{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgForceTransfer{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgGrantRole{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRevokeRole{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetMinterAllowance{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetDenomPause{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -10,25 +10,36 @@ import (
 
 // x/tokenfactory module sentinel errors
 var (
-	ErrDenomExists                    = sdkerrors.Register(ModuleName, 2, "attempting to create a denom that already exists (has bank metadata)")
-	ErrUnauthorized                   = sdkerrors.Register(ModuleName, 3, "unauthorized account")
-	ErrInvalidDenom                   = sdkerrors.Register(ModuleName, 4, "invalid denom")
-	ErrInvalidCreator                 = sdkerrors.Register(ModuleName, 5, "invalid creator")
-	ErrInvalidAuthorityMetadata       = sdkerrors.Register(ModuleName, 6, "invalid authority metadata")
-	ErrInvalidGenesis                 = sdkerrors.Register(ModuleName, 7, "invalid genesis")
-	ErrSubdenomTooLong                = sdkerrors.Register(ModuleName, 8, fmt.Sprintf("subdenom too long, max length is %d bytes", MaxSubdenomLength))
-	ErrCreatorTooLong                 = sdkerrors.Register(ModuleName, 9, fmt.Sprintf("creator too long, max length is %d bytes", MaxCreatorLength))
-	ErrDenomDoesNotExist              = sdkerrors.Register(ModuleName, 10, "denom does not exist")
-	ErrEncodeTokenFactoryCreateDenom  = sdkerrors.Register(ModuleName, 11, "Error while encoding tokenfactory create denom msg in wasmd")
-	ErrEncodeTokenFactoryMint         = sdkerrors.Register(ModuleName, 12, "Error while encoding tokenfactory mint denom msg in wasmd")
-	ErrEncodeTokenFactoryBurn         = sdkerrors.Register(ModuleName, 13, "Error while encoding tokenfactory burn denom msg in wasmd")
-	ErrEncodeTokenFactoryChangeAdmin  = sdkerrors.Register(ModuleName, 14, "Error while encoding tokenfactory change admin msg in wasmd")
-	ErrParsingKiiTokenFactoryQuery    = sdkerrors.Register(ModuleName, 15, "Error parsing KiiTokenFactoryQuery")
-	ErrAdminAlreadyExists             = sdkerrors.Register(ModuleName, 16, "attempting to create a new admin that already exists for the denom")
-	ErrEncodeTokenFactorySetMetadata  = sdkerrors.Register(ModuleName, 17, "Error while encoding tokenfactory set metadata msg in wasmd")
-	ErrEncodingDenomAuthorityMetadata = sdkerrors.Register(ModuleName, 18, "Error encoding denom authority metadata as JSON")
-	ErrEncodingDenomsFromCreator      = sdkerrors.Register(ModuleName, 19, "Error encoding denoms from creator as JSON")
-	ErrUnknownKiiTokenFactoryQuery    = sdkerrors.Register(ModuleName, 23, "Error unknown kii token factory query")
-	ErrAllowListTooLarge              = sdkerrors.Register(ModuleName, 24, "allowlist too large")
-	ErrAllowListUndefined             = sdkerrors.Register(ModuleName, 25, "allowlist undefined")
+	ErrDenomExists                     = sdkerrors.Register(ModuleName, 2, "attempting to create a denom that already exists (has bank metadata)")
+	ErrUnauthorized                    = sdkerrors.Register(ModuleName, 3, "unauthorized account")
+	ErrInvalidDenom                    = sdkerrors.Register(ModuleName, 4, "invalid denom")
+	ErrInvalidCreator                  = sdkerrors.Register(ModuleName, 5, "invalid creator")
+	ErrInvalidAuthorityMetadata        = sdkerrors.Register(ModuleName, 6, "invalid authority metadata")
+	ErrInvalidGenesis                  = sdkerrors.Register(ModuleName, 7, "invalid genesis")
+	ErrSubdenomTooLong                 = sdkerrors.Register(ModuleName, 8, fmt.Sprintf("subdenom too long, max length is %d bytes", MaxSubdenomLength))
+	ErrCreatorTooLong                  = sdkerrors.Register(ModuleName, 9, fmt.Sprintf("creator too long, max length is %d bytes", MaxCreatorLength))
+	ErrDenomDoesNotExist               = sdkerrors.Register(ModuleName, 10, "denom does not exist")
+	ErrEncodeTokenFactoryCreateDenom   = sdkerrors.Register(ModuleName, 11, "Error while encoding tokenfactory create denom msg in wasmd")
+	ErrEncodeTokenFactoryMint          = sdkerrors.Register(ModuleName, 12, "Error while encoding tokenfactory mint denom msg in wasmd")
+	ErrEncodeTokenFactoryBurn          = sdkerrors.Register(ModuleName, 13, "Error while encoding tokenfactory burn denom msg in wasmd")
+	ErrEncodeTokenFactoryChangeAdmin   = sdkerrors.Register(ModuleName, 14, "Error while encoding tokenfactory change admin msg in wasmd")
+	ErrParsingKiiTokenFactoryQuery     = sdkerrors.Register(ModuleName, 15, "Error parsing KiiTokenFactoryQuery")
+	ErrAdminAlreadyExists              = sdkerrors.Register(ModuleName, 16, "attempting to create a new admin that already exists for the denom")
+	ErrEncodeTokenFactorySetMetadata   = sdkerrors.Register(ModuleName, 17, "Error while encoding tokenfactory set metadata msg in wasmd")
+	ErrEncodingDenomAuthorityMetadata  = sdkerrors.Register(ModuleName, 18, "Error encoding denom authority metadata as JSON")
+	ErrEncodingDenomsFromCreator       = sdkerrors.Register(ModuleName, 19, "Error encoding denoms from creator as JSON")
+	ErrUnknownKiiTokenFactoryQuery     = sdkerrors.Register(ModuleName, 23, "Error unknown kii token factory query")
+	ErrAllowListTooLarge               = sdkerrors.Register(ModuleName, 24, "allowlist too large")
+	ErrAllowListUndefined              = sdkerrors.Register(ModuleName, 25, "allowlist undefined")
+	ErrBurnFromModuleAccount           = sdkerrors.Register(ModuleName, 26, "burning from module account is not allowed")
+	ErrForceTransferFromModuleAccount  = sdkerrors.Register(ModuleName, 27, "force transfer from module account is not allowed")
+	ErrEncodeTokenFactoryForceTransfer = sdkerrors.Register(ModuleName, 28, "Error while encoding tokenfactory force transfer msg in wasmd")
+	ErrInvalidRole                     = sdkerrors.Register(ModuleName, 29, "invalid denom role")
+	ErrRoleAlreadyGranted              = sdkerrors.Register(ModuleName, 30, "role already granted")
+	ErrRoleNotGranted                  = sdkerrors.Register(ModuleName, 31, "role not granted")
+	ErrMinterAllowanceExceeded         = sdkerrors.Register(ModuleName, 32, "minter allowance exceeded")
+	ErrMaxDenomsPerCreator             = sdkerrors.Register(ModuleName, 33, "maximum number of denoms per creator reached")
+	ErrMaxSupplyExceeded               = sdkerrors.Register(ModuleName, 34, "max supply exceeded")
+	ErrDenomPaused                     = sdkerrors.Register(ModuleName, 35, "denom transfers are paused")
+	ErrDenomNotPaused                  = sdkerrors.Register(ModuleName, 36, "denom transfers are not paused")
 )
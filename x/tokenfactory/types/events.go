@@ -18,4 +18,10 @@ const (
 	AttributeNewAdmin            = "new_admin"
 	AttributeDenomMetadata       = "denom_metadata"
 	AttributeAllowList           = "denom_allow_list"
+	AttributeRole                = "role"
+	AttributeAddress             = "address"
+	AttributeMinter              = "minter"
+	AttributeMinterAllowance     = "minter_allowance"
+	AttributeMaxSupply           = "max_supply"
+	AttributePaused              = "paused"
 )
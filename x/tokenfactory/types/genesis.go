@@ -45,6 +45,23 @@ func (gs GenesisState) Validate() error {
 				return sdkerrors.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
 			}
 		}
+
+		err = denom.AuthorityMetadata.Validate()
+		if err != nil {
+			return sdkerrors.Wrapf(ErrInvalidAuthorityMetadata, "Invalid roles (%s)", err)
+		}
+
+		if !denom.MaxSupply.IsNil() && denom.MaxSupply.IsNegative() {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "invalid max supply of %s: %s", denom.GetDenom(), denom.MaxSupply)
+		}
+
+		if denom.PausedAllowList != nil {
+			for _, addr := range denom.PausedAllowList.Addresses {
+				if _, err = sdk.AccAddressFromBech32(addr); err != nil {
+					return sdkerrors.Wrapf(ErrInvalidGenesis, "invalid paused allow list address of %s: %s", denom.GetDenom(), err)
+				}
+			}
+		}
 	}
 
 	return nil
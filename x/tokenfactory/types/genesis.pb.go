@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -79,10 +81,15 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 
 // GenesisDenom defines a tokenfactory denom that is defined within genesis
 // state. The structure contains DenomAuthorityMetadata which defines the
-// denom's admin.
+// denom's admin, the max supply of the denom and the allow list of the denom
+// saved while its transfers are paused.
 type GenesisDenom struct {
-	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
-	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	Denom             string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	AuthorityMetadata DenomAuthorityMetadata                 `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	MaxSupply         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply" yaml:"max_supply"`
+	// paused_allow_list is set while the transfers of the denom are paused, it
+	// is the allow list restored when the denom is unpaused
+	PausedAllowList *types.AllowList `protobuf:"bytes,4,opt,name=paused_allow_list,json=pausedAllowList,proto3" json:"paused_allow_list,omitempty" yaml:"paused_allow_list"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -132,6 +139,13 @@ func (m *GenesisDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
 	return DenomAuthorityMetadata{}
 }
 
+func (m *GenesisDenom) GetPausedAllowList() *types.AllowList {
+	if m != nil {
+		return m.PausedAllowList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kiichain.kiichain3.tokenfactory.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "kiichain.kiichain3.tokenfactory.GenesisDenom")
@@ -140,29 +154,37 @@ func init() {
 func init() { proto.RegisterFile("tokenfactory/genesis.proto", fileDescriptor_415d5acd9b7bd461) }
 
 var fileDescriptor_415d5acd9b7bd461 = []byte{
-	// 349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2a, 0xc9, 0xcf, 0x4e,
-	0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0xcf, 0xce, 0xcc, 0x4c, 0xce, 0x48, 0xcc, 0xcc,
-	0xd3, 0x83, 0x31, 0x8c, 0xf5, 0x90, 0x95, 0x4b, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0xd5, 0xea,
-	0x83, 0x58, 0x10, 0x6d, 0x52, 0x2a, 0x28, 0x46, 0x26, 0x96, 0x96, 0x64, 0xe4, 0x17, 0x65, 0x96,
-	0x54, 0xfa, 0xa6, 0x96, 0x24, 0xa6, 0x24, 0x96, 0x24, 0x42, 0x55, 0x49, 0xa2, 0xa8, 0x2a, 0x48,
-	0x2c, 0x4a, 0xcc, 0x85, 0xda, 0xab, 0x74, 0x8a, 0x91, 0x8b, 0xc7, 0x1d, 0xe2, 0x92, 0xe0, 0x92,
-	0xc4, 0x92, 0x54, 0x21, 0x57, 0x2e, 0x36, 0x88, 0x02, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23,
-	0x75, 0x3d, 0x02, 0x2e, 0xd3, 0x0b, 0x00, 0x2b, 0x77, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08,
-	0xaa, 0x59, 0xa8, 0x98, 0x8b, 0x0f, 0x2a, 0x1f, 0x9f, 0x92, 0x9a, 0x97, 0x9f, 0x5b, 0x2c, 0xc1,
-	0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0xa4, 0x4b, 0xd0, 0x38, 0xa8, 0x6b, 0x5c, 0x40, 0xba, 0x9c, 0x64,
-	0x41, 0x86, 0x7e, 0xba, 0x27, 0x2f, 0x5a, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x84, 0x6a, 0xa4, 0x52,
-	0x10, 0x2f, 0x54, 0xc0, 0x05, 0xc2, 0x3f, 0x81, 0xf0, 0x0c, 0x58, 0x44, 0x48, 0x8d, 0x8b, 0x15,
-	0xac, 0x14, 0xec, 0x17, 0x4e, 0x27, 0x81, 0x4f, 0xf7, 0xe4, 0x79, 0x20, 0x26, 0x81, 0x85, 0x95,
-	0x82, 0x20, 0xd2, 0x42, 0x9d, 0x8c, 0x5c, 0x42, 0xf0, 0xc0, 0x8b, 0xcf, 0x85, 0x86, 0x9e, 0x04,
-	0x13, 0x38, 0x04, 0xcc, 0x09, 0x3a, 0x19, 0x6c, 0x99, 0x23, 0x7a, 0xe0, 0x3b, 0x29, 0x42, 0x1d,
-	0x2f, 0x09, 0xb1, 0x12, 0xd3, 0x02, 0xa5, 0x20, 0x41, 0x8c, 0x28, 0xb3, 0x62, 0x79, 0xb1, 0x40,
-	0x9e, 0xd1, 0xc9, 0xe7, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63,
-	0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x8c, 0xd2,
-	0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x61, 0xee, 0x81, 0x33, 0x8c, 0xf5,
-	0x2b, 0xf4, 0x51, 0x22, 0xbb, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0xd9, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x6a, 0x8d, 0xae, 0x82, 0x02, 0x00, 0x00,
+	// 480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xcd, 0x36, 0xa1, 0x52, 0xb7, 0xe5, 0x23, 0x16, 0x48, 0x6e, 0x24, 0xbc, 0xc1, 0x42, 0xa5,
+	0x97, 0xae, 0x95, 0xf6, 0x80, 0xd4, 0x5b, 0x4d, 0x11, 0x42, 0x80, 0x84, 0xdc, 0x1b, 0x17, 0x6b,
+	0x9c, 0x2c, 0x89, 0x15, 0xdb, 0x6b, 0x65, 0x37, 0x10, 0xff, 0x04, 0x6e, 0x9c, 0x39, 0xf1, 0x73,
+	0x22, 0x4e, 0x3d, 0x22, 0x0e, 0x16, 0x4a, 0x2e, 0x9c, 0xf3, 0x0b, 0x90, 0x77, 0xb7, 0x69, 0xd2,
+	0x1c, 0x72, 0xf2, 0xec, 0xcc, 0x9b, 0xf7, 0xde, 0x8c, 0x07, 0xb7, 0x24, 0x1f, 0xb2, 0xec, 0x33,
+	0x74, 0x25, 0x1f, 0x15, 0x5e, 0x9f, 0x65, 0x4c, 0xc4, 0x82, 0xe6, 0x23, 0x2e, 0xb9, 0x45, 0x86,
+	0x71, 0xdc, 0x1d, 0x40, 0x9c, 0xd1, 0x9b, 0xe0, 0x8c, 0xae, 0xc2, 0x5b, 0x8f, 0xfb, 0xbc, 0xcf,
+	0x15, 0xd6, 0xab, 0x22, 0xdd, 0xd6, 0x72, 0xba, 0x5c, 0xa4, 0x5c, 0x78, 0x11, 0x64, 0x43, 0xef,
+	0x4b, 0x27, 0x62, 0x12, 0x3a, 0xea, 0x61, 0xea, 0xcf, 0xd7, 0x24, 0x61, 0x2c, 0x07, 0x7c, 0x14,
+	0xcb, 0xe2, 0x03, 0x93, 0xd0, 0x03, 0x09, 0x06, 0x75, 0xb8, 0x86, 0xca, 0x61, 0x04, 0xa9, 0xf1,
+	0xe5, 0xfe, 0x42, 0xf8, 0xe0, 0x8d, 0x76, 0x7a, 0x25, 0x41, 0x32, 0xeb, 0x35, 0xde, 0xd5, 0x00,
+	0x1b, 0xb5, 0xd1, 0xf1, 0xfe, 0xe9, 0x0b, 0xba, 0xc5, 0x39, 0xfd, 0xa8, 0xe0, 0x7e, 0x63, 0x5a,
+	0x92, 0x5a, 0x60, 0x9a, 0x2d, 0x81, 0x1f, 0x98, 0x7a, 0xd8, 0x63, 0x19, 0x4f, 0x85, 0xbd, 0xd3,
+	0xae, 0x1f, 0xef, 0x9f, 0x9e, 0x6c, 0xa5, 0x33, 0x6e, 0x2e, 0xab, 0x2e, 0xff, 0x69, 0x45, 0xba,
+	0x28, 0xc9, 0x93, 0x02, 0xd2, 0xe4, 0xdc, 0x5d, 0xa7, 0x74, 0x83, 0xfb, 0x26, 0x71, 0xa9, 0xdf,
+	0x3f, 0xea, 0xcb, 0x61, 0x54, 0xc6, 0x3a, 0xc2, 0xf7, 0x14, 0x54, 0xcd, 0xb2, 0xe7, 0x3f, 0x5a,
+	0x94, 0xe4, 0x40, 0x33, 0xa9, 0xb4, 0x1b, 0xe8, 0xb2, 0xf5, 0x0d, 0x61, 0x6b, 0xb9, 0xbc, 0x30,
+	0x35, 0xdb, 0xb3, 0x77, 0xd4, 0x06, 0x5e, 0x6e, 0xb5, 0xac, 0xc4, 0x2e, 0xee, 0x2e, 0xdf, 0x7f,
+	0x66, 0xcc, 0x1f, 0x6a, 0xc9, 0x4d, 0x01, 0x37, 0x68, 0x6e, 0xfc, 0x32, 0x2b, 0xc2, 0x38, 0x85,
+	0x49, 0x28, 0xc6, 0x79, 0x9e, 0x14, 0x76, 0x5d, 0x19, 0x7f, 0x55, 0x31, 0xfd, 0x29, 0xc9, 0x51,
+	0x3f, 0x96, 0x83, 0x71, 0x44, 0xbb, 0x3c, 0xf5, 0xcc, 0x65, 0xe8, 0xcf, 0x89, 0xe8, 0x0d, 0x3d,
+	0x59, 0xe4, 0x4c, 0xd0, 0xb7, 0x99, 0x5c, 0x94, 0xa4, 0xa9, 0x35, 0x6f, 0x99, 0xdc, 0x60, 0x2f,
+	0x85, 0xc9, 0x95, 0x8a, 0xad, 0x04, 0x37, 0x73, 0x18, 0x0b, 0xd6, 0x0b, 0x21, 0x49, 0xf8, 0xd7,
+	0x30, 0x89, 0x85, 0xb4, 0x1b, 0x6a, 0x5a, 0x87, 0x6a, 0x46, 0xaa, 0xae, 0xcc, 0x9c, 0x1c, 0xbd,
+	0xa8, 0x60, 0xef, 0x63, 0x21, 0xfd, 0xf6, 0xb4, 0x24, 0x68, 0x51, 0x12, 0x5b, 0x0b, 0x6c, 0xd0,
+	0xb8, 0xc1, 0x43, 0x9d, 0x5b, 0xb6, 0x9c, 0x37, 0xfe, 0xfd, 0x24, 0xc8, 0x7f, 0x37, 0x9d, 0x39,
+	0xe8, 0x7a, 0xe6, 0xa0, 0xbf, 0x33, 0x07, 0x7d, 0x9f, 0x3b, 0xb5, 0xeb, 0xb9, 0x53, 0xfb, 0x3d,
+	0x77, 0x6a, 0x9f, 0x3a, 0x2b, 0x53, 0xdd, 0x6c, 0xf8, 0x36, 0x98, 0x78, 0x6b, 0xd7, 0xab, 0x86,
+	0x8c, 0x76, 0xd5, 0xf5, 0x9e, 0xfd, 0x1f, 0x00, 0x50, 0x6e, 0xd0, 0x44, 0x73, 0x03, 0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -190,6 +212,12 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if !this.AuthorityMetadata.Equal(&that1.AuthorityMetadata) {
 		return false
 	}
+	if !this.MaxSupply.Equal(that1.MaxSupply) {
+		return false
+	}
+	if !this.PausedAllowList.Equal(that1.PausedAllowList) {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -259,6 +287,28 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PausedAllowList != nil {
+		{
+			size, err := m.PausedAllowList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -319,6 +369,12 @@ func (m *GenesisDenom) Size() (n int) {
 	}
 	l = m.AuthorityMetadata.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.PausedAllowList != nil {
+		l = m.PausedAllowList.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -539,6 +595,76 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedAllowList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PausedAllowList == nil {
+				m.PausedAllowList = &types.AllowList{}
+			}
+			if err := m.PausedAllowList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/kiichain/kiichain/x/tokenfactory/types"
@@ -19,6 +21,27 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: types.DefaultGenesis(),
 			valid:    true,
 		},
+		{
+			desc: "denom creation fee and limits",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					DenomCreationFee:        sdk.NewCoins(sdk.NewInt64Coin("ukii", 1000000)),
+					BurnDenomCreationFee:    true,
+					DenomCreationGasConsume: 1000000,
+					MaxDenomsPerCreator:     10,
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid denom creation fee",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					DenomCreationFee: sdk.Coins{sdk.NewInt64Coin("ukii", 0)},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
@@ -86,6 +109,93 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "roles and minter allowance",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/kii1y3pxq5dp900czh0mkudhjdqjq5m8cpmm4hvczs/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:   "kii1y3pxq5dp900czh0mkudhjdqjq5m8cpmm4hvczs",
+							Minters: []string{"kii1hjfwcza3e3uzeznf3qthhakdr9juetl7uajv0t"},
+							Burners: []string{"kii1hjfwcza3e3uzeznf3qthhakdr9juetl7uajv0t"},
+							MinterAllowances: []types.MinterAllowance{
+								{Minter: "kii1hjfwcza3e3uzeznf3qthhakdr9juetl7uajv0t", Allowance: sdk.NewInt(100)},
+							},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "allowance of an account which is not a minter",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/kii1y3pxq5dp900czh0mkudhjdqjq5m8cpmm4hvczs/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "kii1y3pxq5dp900czh0mkudhjdqjq5m8cpmm4hvczs",
+							MinterAllowances: []types.MinterAllowance{
+								{Minter: "kii1hjfwcza3e3uzeznf3qthhakdr9juetl7uajv0t", Allowance: sdk.NewInt(100)},
+							},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate role",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/kii1y3pxq5dp900czh0mkudhjdqjq5m8cpmm4hvczs/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Pausers: []string{"kii1hjfwcza3e3uzeznf3qthhakdr9juetl7uajv0t", "kii1hjfwcza3e3uzeznf3qthhakdr9juetl7uajv0t"},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "max supply and paused denom",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:           "factory/kii1y3pxq5dp900czh0mkudhjdqjq5m8cpmm4hvczs/bitcoin",
+						MaxSupply:       sdk.NewInt(1000),
+						PausedAllowList: &banktypes.AllowList{Addresses: []string{"kii1hjfwcza3e3uzeznf3qthhakdr9juetl7uajv0t"}},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "negative max supply",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:     "factory/kii1y3pxq5dp900czh0mkudhjdqjq5m8cpmm4hvczs/bitcoin",
+						MaxSupply: sdk.NewInt(-1),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid paused allow list",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:           "factory/kii1y3pxq5dp900czh0mkudhjdqjq5m8cpmm4hvczs/bitcoin",
+						PausedAllowList: &banktypes.AllowList{Addresses: []string{"moose"}},
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "multiple denoms",
 			genState: &types.GenesisState{
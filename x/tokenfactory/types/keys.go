@@ -29,6 +29,8 @@ var (
 	DenomsPrefixKey            = "denoms"
 	CreatorPrefixKey           = "creator"
 	AdminPrefixKey             = "admin"
+	DenomMaxSupplyKey          = "maxsupply"
+	DenomPausedAllowListKey    = "pausedallowlist"
 	CreateDenomFeeWhitelistKey = "createdenomfeewhitelist"
 )
 
@@ -8,12 +8,17 @@ import (
 
 // constants
 const (
-	TypeMsgCreateDenom      = "create_denom"
-	TypeMsgUpdateDenom      = "update_denom"
-	TypeMsgMint             = "mint"
-	TypeMsgBurn             = "burn"
-	TypeMsgChangeAdmin      = "change_admin"
-	TypeMsgSetDenomMetadata = "set_denom_metadata"
+	TypeMsgCreateDenom        = "create_denom"
+	TypeMsgUpdateDenom        = "update_denom"
+	TypeMsgMint               = "mint"
+	TypeMsgBurn               = "burn"
+	TypeMsgChangeAdmin        = "change_admin"
+	TypeMsgSetDenomMetadata   = "set_denom_metadata"
+	TypeMsgForceTransfer      = "force_transfer"
+	TypeMsgGrantRole          = "grant_role"
+	TypeMsgRevokeRole         = "revoke_role"
+	TypeMsgSetMinterAllowance = "set_minter_allowance"
+	TypeMsgSetDenomPause      = "set_denom_pause"
 )
 
 var _ sdk.Msg = &MsgCreateDenom{}
@@ -26,6 +31,16 @@ func NewMsgCreateDenom(sender, subdenom string) *MsgCreateDenom {
 	}
 }
 
+// NewMsgCreateDenomWithMaxSupply creates a msg to create a new denom with a
+// capped supply
+func NewMsgCreateDenomWithMaxSupply(sender, subdenom string, maxSupply sdk.Int) *MsgCreateDenom {
+	return &MsgCreateDenom{
+		Sender:    sender,
+		Subdenom:  subdenom,
+		MaxSupply: maxSupply,
+	}
+}
+
 func (m MsgCreateDenom) Route() string { return RouterKey }
 func (m MsgCreateDenom) Type() string  { return TypeMsgCreateDenom }
 func (m MsgCreateDenom) ValidateBasic() error {
@@ -39,6 +54,10 @@ func (m MsgCreateDenom) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidDenom, err.Error())
 	}
 
+	if !m.MaxSupply.IsNil() && m.MaxSupply.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid max supply (%s)", m.MaxSupply)
+	}
+
 	return nil
 }
 
@@ -97,6 +116,15 @@ func NewMsgMint(sender string, amount sdk.Coin) *MsgMint {
 	}
 }
 
+// NewMsgMintTo creates a message to mint tokens to the given address
+func NewMsgMintTo(sender string, amount sdk.Coin, mintToAddress string) *MsgMint {
+	return &MsgMint{
+		Sender:        sender,
+		Amount:        amount,
+		MintToAddress: mintToAddress,
+	}
+}
+
 func (m MsgMint) Route() string { return RouterKey }
 func (m MsgMint) Type() string  { return TypeMsgMint }
 func (m MsgMint) ValidateBasic() error {
@@ -109,6 +137,13 @@ func (m MsgMint) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
 	}
 
+	if m.MintToAddress != "" {
+		_, err = sdk.AccAddressFromBech32(m.MintToAddress)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid mint to address (%s)", err)
+		}
+	}
+
 	return nil
 }
 
@@ -131,6 +166,15 @@ func NewMsgBurn(sender string, amount sdk.Coin) *MsgBurn {
 	}
 }
 
+// NewMsgBurnFrom creates a message to burn tokens from the given address
+func NewMsgBurnFrom(sender string, amount sdk.Coin, burnFromAddress string) *MsgBurn {
+	return &MsgBurn{
+		Sender:          sender,
+		Amount:          amount,
+		BurnFromAddress: burnFromAddress,
+	}
+}
+
 func (m MsgBurn) Route() string { return RouterKey }
 func (m MsgBurn) Type() string  { return TypeMsgBurn }
 func (m MsgBurn) ValidateBasic() error {
@@ -143,6 +187,13 @@ func (m MsgBurn) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
 	}
 
+	if m.BurnFromAddress != "" {
+		_, err = sdk.AccAddressFromBech32(m.BurnFromAddress)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid burn from address (%s)", err)
+		}
+	}
+
 	return nil
 }
 
@@ -235,3 +286,204 @@ func (m MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgForceTransfer{}
+
+// NewMsgForceTransfer creates a message to transfer tokens between two accounts
+func NewMsgForceTransfer(sender string, amount sdk.Coin, fromAddr, toAddr string) *MsgForceTransfer {
+	return &MsgForceTransfer{
+		Sender:              sender,
+		Amount:              amount,
+		TransferFromAddress: fromAddr,
+		TransferToAddress:   toAddr,
+	}
+}
+
+func (m MsgForceTransfer) Route() string { return RouterKey }
+func (m MsgForceTransfer) Type() string  { return TypeMsgForceTransfer }
+func (m MsgForceTransfer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.TransferFromAddress)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid transfer from address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.TransferToAddress)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid transfer to address (%s)", err)
+	}
+
+	if !m.Amount.IsValid() || m.Amount.Amount.Equal(sdk.ZeroInt()) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	return nil
+}
+
+func (m MsgForceTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgForceTransfer) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgGrantRole{}
+
+// NewMsgGrantRole creates a message to grant a denom role to an address
+func NewMsgGrantRole(sender, denom, role, address string) *MsgGrantRole {
+	return &MsgGrantRole{
+		Sender:  sender,
+		Denom:   denom,
+		Role:    role,
+		Address: address,
+	}
+}
+
+func (m MsgGrantRole) Route() string { return RouterKey }
+func (m MsgGrantRole) Type() string  { return TypeMsgGrantRole }
+func (m MsgGrantRole) ValidateBasic() error {
+	return validateRoleMsg(m.Sender, m.Denom, m.Role, m.Address)
+}
+
+func (m MsgGrantRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgGrantRole) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRevokeRole{}
+
+// NewMsgRevokeRole creates a message to revoke a denom role from an address
+func NewMsgRevokeRole(sender, denom, role, address string) *MsgRevokeRole {
+	return &MsgRevokeRole{
+		Sender:  sender,
+		Denom:   denom,
+		Role:    role,
+		Address: address,
+	}
+}
+
+func (m MsgRevokeRole) Route() string { return RouterKey }
+func (m MsgRevokeRole) Type() string  { return TypeMsgRevokeRole }
+func (m MsgRevokeRole) ValidateBasic() error {
+	return validateRoleMsg(m.Sender, m.Denom, m.Role, m.Address)
+}
+
+func (m MsgRevokeRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRevokeRole) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+func validateRoleMsg(sender, denom, role, address string) error {
+	_, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	return ValidateRole(role)
+}
+
+var _ sdk.Msg = &MsgSetMinterAllowance{}
+
+// NewMsgSetMinterAllowance creates a message to set the remaining amount a minter can mint
+func NewMsgSetMinterAllowance(sender, denom, minter string, allowance sdk.Int) *MsgSetMinterAllowance {
+	return &MsgSetMinterAllowance{
+		Sender:    sender,
+		Denom:     denom,
+		Minter:    minter,
+		Allowance: allowance,
+	}
+}
+
+func (m MsgSetMinterAllowance) Route() string { return RouterKey }
+func (m MsgSetMinterAllowance) Type() string  { return TypeMsgSetMinterAllowance }
+func (m MsgSetMinterAllowance) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Minter)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if m.Allowance.IsNil() || m.Allowance.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid allowance (%s)", m.Allowance)
+	}
+
+	return nil
+}
+
+func (m MsgSetMinterAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetMinterAllowance) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetDenomPause{}
+
+// NewMsgSetDenomPause creates a message to pause or unpause the transfers of a denom
+func NewMsgSetDenomPause(sender, denom string, paused bool) *MsgSetDenomPause {
+	return &MsgSetDenomPause{
+		Sender: sender,
+		Denom:  denom,
+		Paused: paused,
+	}
+}
+
+func (m MsgSetDenomPause) Route() string { return RouterKey }
+func (m MsgSetDenomPause) Type() string  { return TypeMsgSetDenomPause }
+func (m MsgSetDenomPause) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetDenomPause) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetDenomPause) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -68,6 +68,22 @@ func TestMsgCreateDenom(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "max supply",
+			msg: createMsg(func(msg types.MsgCreateDenom) types.MsgCreateDenom {
+				msg.MaxSupply = sdk.NewInt(1000)
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "negative max supply",
+			msg: createMsg(func(msg types.MsgCreateDenom) types.MsgCreateDenom {
+				msg.MaxSupply = sdk.NewInt(-1)
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -149,6 +165,7 @@ func TestMsgMint(t *testing.T) {
 	// generate a private/public key pair and get the respective address
 	pk1 := ed25519.GenPrivKey().PubKey()
 	addr1 := sdk.AccAddress(pk1.Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
 
 	// make a proper mint message
 	createMsg := func(after func(msg types.MsgMint) types.MsgMint) types.MsgMint {
@@ -206,6 +223,22 @@ func TestMsgMint(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "mint to address",
+			msg: createMsg(func(msg types.MsgMint) types.MsgMint {
+				msg.MintToAddress = addr2.String()
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid mint to address",
+			msg: createMsg(func(msg types.MsgMint) types.MsgMint {
+				msg.MintToAddress = "invalid"
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -222,6 +255,7 @@ func TestMsgBurn(t *testing.T) {
 	// generate a private/public key pair and get the respective address
 	pk1 := ed25519.GenPrivKey().PubKey()
 	addr1 := sdk.AccAddress(pk1.Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
 
 	// make a proper burn message
 	baseMsg := types.NewMsgBurn(
@@ -276,6 +310,20 @@ func TestMsgBurn(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "burn from address",
+			msg: func() *types.MsgBurn {
+				return types.NewMsgBurnFrom(addr1.String(), sdk.NewCoin("bitcoin", sdk.NewInt(500000000)), addr2.String())
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid burn from address",
+			msg: func() *types.MsgBurn {
+				return types.NewMsgBurnFrom(addr1.String(), sdk.NewCoin("bitcoin", sdk.NewInt(500000000)), "invalid")
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -360,3 +408,327 @@ func TestMsgChangeAdmin(t *testing.T) {
 		}
 	}
 }
+
+// TestMsgForceTransfer tests if valid/invalid force transfer messages are properly validated/invalidated
+func TestMsgForceTransfer(t *testing.T) {
+	// generate private/public key pairs and get the respective addresses
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr3 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// make a proper force transfer message
+	createMsg := func(after func(msg types.MsgForceTransfer) types.MsgForceTransfer) types.MsgForceTransfer {
+		properMsg := *types.NewMsgForceTransfer(
+			addr1.String(),
+			sdk.NewCoin("bitcoin", sdk.NewInt(500000000)),
+			addr2.String(),
+			addr3.String(),
+		)
+
+		return after(properMsg)
+	}
+
+	// validate force transfer message was created as intended
+	msg := createMsg(func(msg types.MsgForceTransfer) types.MsgForceTransfer {
+		return msg
+	})
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "force_transfer")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        types.MsgForceTransfer
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg types.MsgForceTransfer) types.MsgForceTransfer {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: createMsg(func(msg types.MsgForceTransfer) types.MsgForceTransfer {
+				msg.Sender = ""
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty transfer from address",
+			msg: createMsg(func(msg types.MsgForceTransfer) types.MsgForceTransfer {
+				msg.TransferFromAddress = ""
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid transfer to address",
+			msg: createMsg(func(msg types.MsgForceTransfer) types.MsgForceTransfer {
+				msg.TransferToAddress = "invalid"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: createMsg(func(msg types.MsgForceTransfer) types.MsgForceTransfer {
+				msg.Amount = sdk.NewCoin("bitcoin", sdk.ZeroInt())
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgGrantRole tests if valid/invalid grant and revoke role messages are properly validated/invalidated
+func TestMsgGrantRole(t *testing.T) {
+	// generate private/public key pairs and get the respective addresses
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	denom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper grant role message
+	createMsg := func(after func(msg types.MsgGrantRole) types.MsgGrantRole) types.MsgGrantRole {
+		properMsg := *types.NewMsgGrantRole(addr1.String(), denom, types.RoleMinter, addr2.String())
+
+		return after(properMsg)
+	}
+
+	// validate grant role message was created as intended
+	msg := createMsg(func(msg types.MsgGrantRole) types.MsgGrantRole {
+		return msg
+	})
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "grant_role")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	revokeMsg := types.NewMsgRevokeRole(addr1.String(), denom, types.RoleMinter, addr2.String())
+	require.Equal(t, revokeMsg.Type(), "revoke_role")
+	require.NoError(t, revokeMsg.ValidateBasic())
+
+	tests := []struct {
+		name       string
+		msg        types.MsgGrantRole
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg types.MsgGrantRole) types.MsgGrantRole {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "pauser role",
+			msg: createMsg(func(msg types.MsgGrantRole) types.MsgGrantRole {
+				msg.Role = types.RolePauser
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: createMsg(func(msg types.MsgGrantRole) types.MsgGrantRole {
+				msg.Sender = ""
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: createMsg(func(msg types.MsgGrantRole) types.MsgGrantRole {
+				msg.Denom = "bitcoin"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "unknown role",
+			msg: createMsg(func(msg types.MsgGrantRole) types.MsgGrantRole {
+				msg.Role = "admin"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid address",
+			msg: createMsg(func(msg types.MsgGrantRole) types.MsgGrantRole {
+				msg.Address = "invalid"
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgSetMinterAllowance tests if valid/invalid set minter allowance messages are properly validated/invalidated
+func TestMsgSetMinterAllowance(t *testing.T) {
+	// generate private/public key pairs and get the respective addresses
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	denom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper set minter allowance message
+	createMsg := func(after func(msg types.MsgSetMinterAllowance) types.MsgSetMinterAllowance) types.MsgSetMinterAllowance {
+		properMsg := *types.NewMsgSetMinterAllowance(addr1.String(), denom, addr2.String(), sdk.NewInt(1000))
+
+		return after(properMsg)
+	}
+
+	// validate set minter allowance message was created as intended
+	msg := createMsg(func(msg types.MsgSetMinterAllowance) types.MsgSetMinterAllowance {
+		return msg
+	})
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "set_minter_allowance")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSetMinterAllowance
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg types.MsgSetMinterAllowance) types.MsgSetMinterAllowance {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "zero allowance",
+			msg: createMsg(func(msg types.MsgSetMinterAllowance) types.MsgSetMinterAllowance {
+				msg.Allowance = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "negative allowance",
+			msg: createMsg(func(msg types.MsgSetMinterAllowance) types.MsgSetMinterAllowance {
+				msg.Allowance = sdk.NewInt(-1)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "nil allowance",
+			msg: createMsg(func(msg types.MsgSetMinterAllowance) types.MsgSetMinterAllowance {
+				msg.Allowance = sdk.Int{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid minter",
+			msg: createMsg(func(msg types.MsgSetMinterAllowance) types.MsgSetMinterAllowance {
+				msg.Minter = ""
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgSetDenomPause tests if valid/invalid set denom pause messages are properly validated/invalidated
+func TestMsgSetDenomPause(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	denom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper set denom pause message
+	createMsg := func(after func(msg types.MsgSetDenomPause) types.MsgSetDenomPause) types.MsgSetDenomPause {
+		properMsg := *types.NewMsgSetDenomPause(addr1.String(), denom, true)
+
+		return after(properMsg)
+	}
+
+	// validate set denom pause message was created as intended
+	msg := createMsg(func(msg types.MsgSetDenomPause) types.MsgSetDenomPause {
+		return msg
+	})
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "set_denom_pause")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSetDenomPause
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg types.MsgSetDenomPause) types.MsgSetDenomPause {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "unpause",
+			msg: createMsg(func(msg types.MsgSetDenomPause) types.MsgSetDenomPause {
+				msg.Paused = false
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: createMsg(func(msg types.MsgSetDenomPause) types.MsgSetDenomPause {
+				msg.Sender = ""
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: createMsg(func(msg types.MsgSetDenomPause) types.MsgSetDenomPause {
+				msg.Denom = "bitcoin"
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
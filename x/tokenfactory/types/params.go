@@ -1,9 +1,29 @@
 package types
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+// Parameter store keys.
+var (
+	KeyDenomCreationFee        = []byte("DenomCreationFee")
+	KeyBurnDenomCreationFee    = []byte("BurnDenomCreationFee")
+	KeyDenomCreationGasConsume = []byte("DenomCreationGasConsume")
+	KeyMaxDenomsPerCreator     = []byte("MaxDenomsPerCreator")
+)
+
+// Default parameter values, the denom creation is free and unlimited until
+// enabled by governance
+var (
+	DefaultDenomCreationFee        = sdk.Coins{}
+	DefaultBurnDenomCreationFee    = false
+	DefaultDenomCreationGasConsume = uint64(0)
+	DefaultMaxDenomsPerCreator     = uint32(0)
+)
+
 // ParamTable for tokenfactory module.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -11,15 +31,67 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 // default tokenfactory module parameters.
 func DefaultParams() Params {
-	return Params{}
+	return Params{
+		DenomCreationFee:        DefaultDenomCreationFee,
+		BurnDenomCreationFee:    DefaultBurnDenomCreationFee,
+		DenomCreationGasConsume: DefaultDenomCreationGasConsume,
+		MaxDenomsPerCreator:     DefaultMaxDenomsPerCreator,
+	}
 }
 
 // validate params.
 func (p Params) Validate() error {
-	return nil
+	if err := validateDenomCreationFee(p.DenomCreationFee); err != nil {
+		return err
+	}
+	if err := validateBool(p.BurnDenomCreationFee); err != nil {
+		return err
+	}
+	if err := validateDenomCreationGasConsume(p.DenomCreationGasConsume); err != nil {
+		return err
+	}
+	return validateMaxDenomsPerCreator(p.MaxDenomsPerCreator)
 }
 
 // Implements params.ParamSet.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDenomCreationFee, &p.DenomCreationFee, validateDenomCreationFee),
+		paramtypes.NewParamSetPair(KeyBurnDenomCreationFee, &p.BurnDenomCreationFee, validateBool),
+		paramtypes.NewParamSetPair(KeyDenomCreationGasConsume, &p.DenomCreationGasConsume, validateDenomCreationGasConsume),
+		paramtypes.NewParamSetPair(KeyMaxDenomsPerCreator, &p.MaxDenomsPerCreator, validateMaxDenomsPerCreator),
+	}
+}
+
+func validateDenomCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %w", err)
+	}
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateDenomCreationGasConsume(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxDenomsPerCreator(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
 }
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -24,6 +27,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the tokenfactory module.
 type Params struct {
+	// denom_creation_fee is the fee charged to the creator of a new denom, it is
+	// sent to the community pool unless burn_denom_creation_fee is set
+	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=denom_creation_fee,json=denomCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee" yaml:"denom_creation_fee"`
+	// burn_denom_creation_fee burns the denom creation fee instead of sending it
+	// to the community pool
+	BurnDenomCreationFee bool `protobuf:"varint,2,opt,name=burn_denom_creation_fee,json=burnDenomCreationFee,proto3" json:"burn_denom_creation_fee,omitempty" yaml:"burn_denom_creation_fee"`
+	// denom_creation_gas_consume is the gas consumed on the creation of a new
+	// denom, on top of the gas of the transaction
+	DenomCreationGasConsume uint64 `protobuf:"varint,3,opt,name=denom_creation_gas_consume,json=denomCreationGasConsume,proto3" json:"denom_creation_gas_consume,omitempty" yaml:"denom_creation_gas_consume"`
+	// max_denoms_per_creator is the maximum number of denoms an account can
+	// create, zero means no limit
+	MaxDenomsPerCreator uint32 `protobuf:"varint,4,opt,name=max_denoms_per_creator,json=maxDenomsPerCreator,proto3" json:"max_denoms_per_creator,omitempty" yaml:"max_denoms_per_creator"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -59,6 +74,34 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetDenomCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DenomCreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetBurnDenomCreationFee() bool {
+	if m != nil {
+		return m.BurnDenomCreationFee
+	}
+	return false
+}
+
+func (m *Params) GetDenomCreationGasConsume() uint64 {
+	if m != nil {
+		return m.DenomCreationGasConsume
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDenomsPerCreator() uint32 {
+	if m != nil {
+		return m.MaxDenomsPerCreator
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kiichain.kiichain3.tokenfactory.Params")
 }
@@ -66,16 +109,32 @@ func init() {
 func init() { proto.RegisterFile("tokenfactory/params.proto", fileDescriptor_0f39a375875b281a) }
 
 var fileDescriptor_0f39a375875b281a = []byte{
-	// 136 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0xc9, 0xcf, 0x4e,
-	0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0xcf, 0xce, 0xcc, 0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3,
-	0x83, 0x31, 0x8c, 0xf5, 0x90, 0x55, 0x2b, 0x71, 0x70, 0xb1, 0x05, 0x80, 0x35, 0x38, 0xf9, 0x9c,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
-	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x51, 0x7a, 0x66, 0x49, 0x46, 0x69,
-	0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xcc, 0x18, 0x38, 0xc3, 0x58, 0xbf, 0x42, 0x1f, 0xc5, 0xfe,
-	0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0xfd, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x6c, 0xba, 0xd9, 0xcd, 0x9c, 0x00, 0x00, 0x00,
+	// 393 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0xaa, 0xda, 0x40,
+	0x14, 0xc6, 0x33, 0x55, 0xa4, 0xa4, 0x14, 0x4a, 0x2a, 0xf5, 0x0f, 0x74, 0x12, 0x03, 0x85, 0x6c,
+	0x3a, 0x83, 0x75, 0xd7, 0x65, 0x52, 0xda, 0x45, 0x29, 0x48, 0x16, 0x85, 0x76, 0x13, 0x26, 0x71,
+	0x8c, 0xc1, 0xce, 0x4c, 0x98, 0x89, 0x45, 0xdf, 0xa2, 0xab, 0x3e, 0x44, 0x9f, 0xa0, 0x8f, 0xe0,
+	0xd2, 0xe5, 0x5d, 0xe5, 0x5e, 0xf4, 0x0d, 0xf2, 0x04, 0x17, 0x33, 0xca, 0x55, 0xaf, 0x77, 0x95,
+	0x43, 0xce, 0x77, 0x7e, 0xe7, 0x9b, 0xc3, 0x67, 0xf6, 0x0a, 0x31, 0xa7, 0x7c, 0x4a, 0x92, 0x42,
+	0xc8, 0x15, 0xce, 0x89, 0x24, 0x4c, 0xa1, 0x5c, 0x8a, 0x42, 0x58, 0xf6, 0x3c, 0xcb, 0x92, 0x19,
+	0xc9, 0x38, 0x3a, 0x16, 0x23, 0x74, 0xaa, 0xee, 0xb7, 0x53, 0x91, 0x8a, 0x5a, 0x8b, 0xf7, 0x95,
+	0x1e, 0xeb, 0xc3, 0x44, 0x28, 0x26, 0x14, 0x8e, 0x89, 0xa2, 0xf8, 0xf7, 0x30, 0xa6, 0x05, 0x19,
+	0xe2, 0x44, 0x64, 0x5c, 0xf7, 0xdd, 0xff, 0x0d, 0xb3, 0x35, 0xae, 0xf7, 0x58, 0x7f, 0x81, 0x69,
+	0x4d, 0x28, 0x17, 0x2c, 0x4a, 0x24, 0x25, 0x45, 0x26, 0x78, 0x34, 0xa5, 0xb4, 0x0b, 0x9c, 0x86,
+	0xf7, 0xe2, 0x43, 0x0f, 0x69, 0x10, 0xda, 0x83, 0xd0, 0x01, 0x84, 0x02, 0x91, 0x71, 0xff, 0xdb,
+	0xba, 0xb4, 0x8d, 0xaa, 0xb4, 0x7b, 0x2b, 0xc2, 0x7e, 0x7d, 0x74, 0x1f, 0x23, 0xdc, 0x7f, 0xb7,
+	0xb6, 0x97, 0x66, 0xc5, 0x6c, 0x11, 0xa3, 0x44, 0x30, 0x7c, 0xb0, 0xa4, 0x3f, 0xef, 0xd5, 0x64,
+	0x8e, 0x8b, 0x55, 0x4e, 0x55, 0x4d, 0x53, 0xe1, 0xab, 0x1a, 0x10, 0x1c, 0xe6, 0x3f, 0x53, 0x6a,
+	0xfd, 0x30, 0x3b, 0xf1, 0x42, 0xf2, 0xe8, 0x8a, 0xb9, 0x67, 0x0e, 0xf0, 0x9e, 0xfb, 0x6e, 0x55,
+	0xda, 0x50, 0x6f, 0x7f, 0x42, 0xe8, 0x86, 0xed, 0x7d, 0xe7, 0xd3, 0x25, 0x3a, 0x36, 0xfb, 0x17,
+	0xe2, 0x94, 0xa8, 0x28, 0x11, 0x5c, 0x2d, 0x18, 0xed, 0x36, 0x1c, 0xe0, 0x35, 0xfd, 0x77, 0x55,
+	0x69, 0x0f, 0xae, 0xbe, 0xed, 0x44, 0xeb, 0x86, 0x9d, 0x33, 0xdf, 0x5f, 0x88, 0x0a, 0x74, 0xc7,
+	0xfa, 0x6e, 0xbe, 0x61, 0x64, 0xa9, 0x4d, 0xa9, 0x28, 0xa7, 0x52, 0x03, 0x84, 0xec, 0x36, 0x1d,
+	0xe0, 0xbd, 0xf4, 0x07, 0x55, 0x69, 0xbf, 0xd5, 0xfc, 0xeb, 0x3a, 0x37, 0x7c, 0xcd, 0xc8, 0xb2,
+	0xf6, 0xae, 0xc6, 0x54, 0x06, 0xfa, 0xaf, 0xff, 0x75, 0xbd, 0x85, 0x60, 0xb3, 0x85, 0xe0, 0x6e,
+	0x0b, 0xc1, 0x9f, 0x1d, 0x34, 0x36, 0x3b, 0x68, 0xdc, 0xec, 0xa0, 0xf1, 0x73, 0x78, 0x72, 0xec,
+	0x63, 0x5a, 0x1e, 0x8a, 0x25, 0x3e, 0x4b, 0x59, 0x7d, 0xfb, 0xb8, 0x55, 0xc7, 0x61, 0x74, 0x3f,
+	0x00, 0x22, 0xbd, 0x64, 0x5d, 0x82, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -98,6 +157,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxDenomsPerCreator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDenomsPerCreator))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DenomCreationGasConsume != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DenomCreationGasConsume))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BurnDenomCreationFee {
+		i--
+		if m.BurnDenomCreationFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DenomCreationFee) > 0 {
+		for iNdEx := len(m.DenomCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -118,6 +211,21 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.DenomCreationFee) > 0 {
+		for _, e := range m.DenomCreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.BurnDenomCreationFee {
+		n += 2
+	}
+	if m.DenomCreationGasConsume != 0 {
+		n += 1 + sovParams(uint64(m.DenomCreationGasConsume))
+	}
+	if m.MaxDenomsPerCreator != 0 {
+		n += 1 + sovParams(uint64(m.MaxDenomsPerCreator))
+	}
 	return n
 }
 
@@ -156,6 +264,98 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCreationFee = append(m.DenomCreationFee, types.Coin{})
+			if err := m.DenomCreationFee[len(m.DenomCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnDenomCreationFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnDenomCreationFee = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationGasConsume", wireType)
+			}
+			m.DenomCreationGasConsume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DenomCreationGasConsume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDenomsPerCreator", wireType)
+			}
+			m.MaxDenomsPerCreator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDenomsPerCreator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
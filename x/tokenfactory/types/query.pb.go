@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	types "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -502,6 +503,402 @@ func (m *QueryDenomAllowListResponse) GetAllowList() types.AllowList {
 	return types.AllowList{}
 }
 
+// QueryDenomRolesRequest is the request type for the DenomRoles gRPC method
+type QueryDenomRolesRequest struct {
+	// denom is the coin denom to query the roles for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// address is the account to query the roles of.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryDenomRolesRequest) Reset()         { *m = QueryDenomRolesRequest{} }
+func (m *QueryDenomRolesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomRolesRequest) ProtoMessage()    {}
+func (*QueryDenomRolesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{10}
+}
+func (m *QueryDenomRolesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomRolesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomRolesRequest.Merge(m, src)
+}
+func (m *QueryDenomRolesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomRolesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomRolesRequest proto.InternalMessageInfo
+
+func (m *QueryDenomRolesRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryDenomRolesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryDenomRolesResponse is the response type for the DenomRoles gRPC
+// method.
+type QueryDenomRolesResponse struct {
+	// roles are the roles granted to the address, the admin holds every role.
+	Roles []string `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+}
+
+func (m *QueryDenomRolesResponse) Reset()         { *m = QueryDenomRolesResponse{} }
+func (m *QueryDenomRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomRolesResponse) ProtoMessage()    {}
+func (*QueryDenomRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{11}
+}
+func (m *QueryDenomRolesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomRolesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomRolesResponse.Merge(m, src)
+}
+func (m *QueryDenomRolesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomRolesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomRolesResponse proto.InternalMessageInfo
+
+func (m *QueryDenomRolesResponse) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+// QueryMinterAllowanceRequest is the request type for the MinterAllowance gRPC
+// method
+type QueryMinterAllowanceRequest struct {
+	// denom is the coin denom to query the allowance for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// minter is the account to query the allowance of.
+	Minter string `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
+}
+
+func (m *QueryMinterAllowanceRequest) Reset()         { *m = QueryMinterAllowanceRequest{} }
+func (m *QueryMinterAllowanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMinterAllowanceRequest) ProtoMessage()    {}
+func (*QueryMinterAllowanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{12}
+}
+func (m *QueryMinterAllowanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinterAllowanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinterAllowanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinterAllowanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinterAllowanceRequest.Merge(m, src)
+}
+func (m *QueryMinterAllowanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinterAllowanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinterAllowanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinterAllowanceRequest proto.InternalMessageInfo
+
+func (m *QueryMinterAllowanceRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryMinterAllowanceRequest) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
+}
+
+// QueryMinterAllowanceResponse is the response type for the MinterAllowance
+// gRPC method.
+type QueryMinterAllowanceResponse struct {
+	// allowance is the remaining amount the minter can mint.
+	Allowance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=allowance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"allowance"`
+	// unlimited is true if the minter has no allowance.
+	Unlimited bool `protobuf:"varint,2,opt,name=unlimited,proto3" json:"unlimited,omitempty"`
+}
+
+func (m *QueryMinterAllowanceResponse) Reset()         { *m = QueryMinterAllowanceResponse{} }
+func (m *QueryMinterAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMinterAllowanceResponse) ProtoMessage()    {}
+func (*QueryMinterAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{13}
+}
+func (m *QueryMinterAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinterAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinterAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinterAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinterAllowanceResponse.Merge(m, src)
+}
+func (m *QueryMinterAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinterAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinterAllowanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinterAllowanceResponse proto.InternalMessageInfo
+
+func (m *QueryMinterAllowanceResponse) GetUnlimited() bool {
+	if m != nil {
+		return m.Unlimited
+	}
+	return false
+}
+
+// QueryDenomMaxSupplyRequest is the request type for the DenomMaxSupply gRPC
+// method
+type QueryDenomMaxSupplyRequest struct {
+	// denom is the coin denom to query the max supply for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryDenomMaxSupplyRequest) Reset()         { *m = QueryDenomMaxSupplyRequest{} }
+func (m *QueryDenomMaxSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMaxSupplyRequest) ProtoMessage()    {}
+func (*QueryDenomMaxSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{14}
+}
+func (m *QueryDenomMaxSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMaxSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMaxSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMaxSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMaxSupplyRequest.Merge(m, src)
+}
+func (m *QueryDenomMaxSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMaxSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMaxSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMaxSupplyRequest proto.InternalMessageInfo
+
+func (m *QueryDenomMaxSupplyRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomMaxSupplyResponse is the response type for the DenomMaxSupply
+// gRPC method.
+type QueryDenomMaxSupplyResponse struct {
+	// max_supply is the maximum total supply of the denom.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
+	// unlimited is true if the supply of the denom is not capped.
+	Unlimited bool `protobuf:"varint,2,opt,name=unlimited,proto3" json:"unlimited,omitempty"`
+}
+
+func (m *QueryDenomMaxSupplyResponse) Reset()         { *m = QueryDenomMaxSupplyResponse{} }
+func (m *QueryDenomMaxSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMaxSupplyResponse) ProtoMessage()    {}
+func (*QueryDenomMaxSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{15}
+}
+func (m *QueryDenomMaxSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMaxSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMaxSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMaxSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMaxSupplyResponse.Merge(m, src)
+}
+func (m *QueryDenomMaxSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMaxSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMaxSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMaxSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryDenomMaxSupplyResponse) GetUnlimited() bool {
+	if m != nil {
+		return m.Unlimited
+	}
+	return false
+}
+
+// QueryDenomPausedRequest is the request type for the DenomPaused gRPC method
+type QueryDenomPausedRequest struct {
+	// denom is the coin denom to query the pause for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryDenomPausedRequest) Reset()         { *m = QueryDenomPausedRequest{} }
+func (m *QueryDenomPausedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomPausedRequest) ProtoMessage()    {}
+func (*QueryDenomPausedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{16}
+}
+func (m *QueryDenomPausedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomPausedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomPausedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomPausedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomPausedRequest.Merge(m, src)
+}
+func (m *QueryDenomPausedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomPausedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomPausedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomPausedRequest proto.InternalMessageInfo
+
+func (m *QueryDenomPausedRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomPausedResponse is the response type for the DenomPaused gRPC
+// method.
+type QueryDenomPausedResponse struct {
+	// paused is true if the transfers of the denom are paused.
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *QueryDenomPausedResponse) Reset()         { *m = QueryDenomPausedResponse{} }
+func (m *QueryDenomPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomPausedResponse) ProtoMessage()    {}
+func (*QueryDenomPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{17}
+}
+func (m *QueryDenomPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomPausedResponse.Merge(m, src)
+}
+func (m *QueryDenomPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomPausedResponse proto.InternalMessageInfo
+
+func (m *QueryDenomPausedResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "kiichain.kiichain3.tokenfactory.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "kiichain.kiichain3.tokenfactory.QueryParamsResponse")
@@ -513,60 +910,87 @@ func init() {
 	proto.RegisterType((*QueryDenomMetadataResponse)(nil), "kiichain.kiichain3.tokenfactory.QueryDenomMetadataResponse")
 	proto.RegisterType((*QueryDenomAllowListRequest)(nil), "kiichain.kiichain3.tokenfactory.QueryDenomAllowListRequest")
 	proto.RegisterType((*QueryDenomAllowListResponse)(nil), "kiichain.kiichain3.tokenfactory.QueryDenomAllowListResponse")
+	proto.RegisterType((*QueryDenomRolesRequest)(nil), "kiichain.kiichain3.tokenfactory.QueryDenomRolesRequest")
+	proto.RegisterType((*QueryDenomRolesResponse)(nil), "kiichain.kiichain3.tokenfactory.QueryDenomRolesResponse")
+	proto.RegisterType((*QueryMinterAllowanceRequest)(nil), "kiichain.kiichain3.tokenfactory.QueryMinterAllowanceRequest")
+	proto.RegisterType((*QueryMinterAllowanceResponse)(nil), "kiichain.kiichain3.tokenfactory.QueryMinterAllowanceResponse")
+	proto.RegisterType((*QueryDenomMaxSupplyRequest)(nil), "kiichain.kiichain3.tokenfactory.QueryDenomMaxSupplyRequest")
+	proto.RegisterType((*QueryDenomMaxSupplyResponse)(nil), "kiichain.kiichain3.tokenfactory.QueryDenomMaxSupplyResponse")
+	proto.RegisterType((*QueryDenomPausedRequest)(nil), "kiichain.kiichain3.tokenfactory.QueryDenomPausedRequest")
+	proto.RegisterType((*QueryDenomPausedResponse)(nil), "kiichain.kiichain3.tokenfactory.QueryDenomPausedResponse")
 }
 
 func init() { proto.RegisterFile("tokenfactory/query.proto", fileDescriptor_78516c77a1ba9513) }
 
 var fileDescriptor_78516c77a1ba9513 = []byte{
-	// 765 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x4f, 0x13, 0x4d,
-	0x18, 0xee, 0xf0, 0x7d, 0x94, 0x8f, 0xf9, 0x84, 0xc8, 0x48, 0x0c, 0x54, 0xd9, 0xd5, 0x51, 0xa1,
-	0x12, 0xb3, 0x2b, 0x85, 0xa8, 0x41, 0x22, 0x52, 0x10, 0x2f, 0x98, 0xe0, 0x1e, 0x8d, 0xa6, 0x99,
-	0x96, 0x65, 0xd9, 0xb4, 0xbb, 0x53, 0x76, 0xa7, 0x6a, 0x43, 0xb8, 0x78, 0xf3, 0x66, 0xa2, 0x5e,
-	0xfc, 0x17, 0xde, 0xbc, 0x78, 0xe7, 0x48, 0xf4, 0xe2, 0xc1, 0x34, 0x06, 0xf4, 0x0f, 0xf4, 0x17,
-	0x98, 0x9d, 0x99, 0x6e, 0xbb, 0xed, 0xd2, 0x5a, 0x3c, 0xb1, 0xbc, 0xef, 0xfb, 0xbc, 0xef, 0xf3,
-	0xcc, 0x3c, 0xf3, 0xa6, 0x70, 0x82, 0xd1, 0xa2, 0xe9, 0x6e, 0x93, 0x02, 0xa3, 0x5e, 0x55, 0xdf,
-	0xad, 0x98, 0x5e, 0x55, 0x2b, 0x7b, 0x94, 0x51, 0xa4, 0x16, 0x6d, 0xbb, 0xb0, 0x43, 0x6c, 0x57,
-	0x6b, 0x7c, 0xcc, 0x6b, 0xad, 0xc5, 0xa9, 0x71, 0x8b, 0x5a, 0x94, 0xd7, 0xea, 0xc1, 0x97, 0x80,
-	0xa5, 0x2e, 0x5a, 0x94, 0x5a, 0x25, 0x53, 0x27, 0x65, 0x5b, 0x27, 0xae, 0x4b, 0x19, 0x61, 0x36,
-	0x75, 0x7d, 0x99, 0x55, 0x0a, 0xd4, 0x77, 0xa8, 0xaf, 0xe7, 0x89, 0x5b, 0xd4, 0x9f, 0xcf, 0xe5,
-	0x4d, 0x46, 0xe6, 0xf8, 0x3f, 0x32, 0x3f, 0x1b, 0xe6, 0x7d, 0x53, 0xb0, 0x09, 0xab, 0xca, 0xc4,
-	0xb2, 0x5d, 0xde, 0x4c, 0xd6, 0x5e, 0x8d, 0x50, 0x27, 0x15, 0xb6, 0x43, 0x3d, 0x9b, 0x55, 0x1f,
-	0x99, 0x8c, 0x6c, 0x11, 0x46, 0x64, 0xd5, 0x64, 0xa4, 0xaa, 0x4c, 0x3c, 0xe2, 0x48, 0x32, 0x78,
-	0x1c, 0xa2, 0xc7, 0xc1, 0x88, 0x4d, 0x1e, 0x34, 0xcc, 0xdd, 0x8a, 0xe9, 0x33, 0xfc, 0x14, 0x9e,
-	0x8b, 0x44, 0xfd, 0x32, 0x75, 0x7d, 0x13, 0x3d, 0x80, 0x49, 0x01, 0x9e, 0x00, 0x97, 0x40, 0xfa,
-	0xff, 0xcc, 0x8c, 0xd6, 0xe3, 0x7c, 0x34, 0xd1, 0x20, 0xfb, 0xef, 0x41, 0x4d, 0x4d, 0x18, 0x12,
-	0x8c, 0x37, 0x20, 0xe6, 0xdd, 0xd7, 0x4c, 0x97, 0x3a, 0x2b, 0xed, 0x9c, 0x25, 0x07, 0x34, 0x0d,
-	0x07, 0xb7, 0x82, 0x02, 0x3e, 0x6b, 0x38, 0x7b, 0xb6, 0x5e, 0x53, 0xcf, 0x54, 0x89, 0x53, 0x5a,
-	0xc4, 0x3c, 0x8c, 0x0d, 0x91, 0xc6, 0x1f, 0x01, 0xbc, 0xd2, 0xb5, 0x9d, 0x24, 0xff, 0x1a, 0x40,
-	0x14, 0x1e, 0x50, 0xce, 0x91, 0x69, 0xa9, 0xe4, 0x76, 0x4f, 0x25, 0xf1, 0xdd, 0xb3, 0x97, 0x03,
-	0x65, 0xf5, 0x9a, 0x3a, 0x29, 0xa8, 0x75, 0x0e, 0xc0, 0xc6, 0x58, 0xc7, 0xb5, 0xe0, 0xf7, 0x00,
-	0x4e, 0x35, 0x39, 0xfb, 0xeb, 0x1e, 0x75, 0x56, 0x3d, 0x93, 0x30, 0xea, 0x35, 0xd4, 0xdf, 0x80,
-	0x43, 0x05, 0x11, 0x91, 0xfa, 0x51, 0xbd, 0xa6, 0x8e, 0x8a, 0x21, 0x32, 0x81, 0x8d, 0x46, 0x09,
-	0x5a, 0x87, 0xb0, 0x69, 0x8d, 0x89, 0x01, 0x2e, 0x69, 0x5a, 0x13, 0x3e, 0xd2, 0x02, 0x1f, 0x69,
-	0xc2, 0xd5, 0xd2, 0x47, 0xda, 0x26, 0xb1, 0x4c, 0x39, 0xc9, 0x68, 0x41, 0xe2, 0x77, 0x00, 0x2a,
-	0x27, 0xf1, 0x92, 0xc7, 0x78, 0x1d, 0x26, 0xf9, 0xb9, 0x07, 0x1e, 0xf8, 0x27, 0x3d, 0x9c, 0x1d,
-	0xab, 0xd7, 0xd4, 0x91, 0x96, 0x7b, 0xf1, 0xb1, 0x21, 0x0b, 0xd0, 0xc3, 0x18, 0x56, 0x33, 0x3d,
-	0x59, 0x89, 0x39, 0x11, 0x5a, 0x73, 0x70, 0xb2, 0xc9, 0xaa, 0xdd, 0x27, 0xe3, 0x11, 0x9f, 0x34,
-	0x5c, 0xf1, 0x0c, 0xa6, 0xe2, 0x20, 0x52, 0xc4, 0x32, 0xfc, 0xaf, 0xcd, 0x00, 0x53, 0x4d, 0x5e,
-	0x6e, 0x31, 0x64, 0x14, 0x5e, 0xb3, 0x30, 0x70, 0x08, 0xc2, 0x99, 0xd6, 0xf6, 0x2b, 0xa5, 0x12,
-	0x7d, 0xb1, 0x61, 0xfb, 0xac, 0x3b, 0xa5, 0x3c, 0xbc, 0x10, 0x8b, 0x91, 0x9c, 0x56, 0x21, 0x24,
-	0x41, 0x30, 0x57, 0xb2, 0x7d, 0x26, 0x59, 0x29, 0xb1, 0xac, 0x42, 0xac, 0xa4, 0x35, 0x4c, 0x1a,
-	0x81, 0xcc, 0xf7, 0x21, 0x38, 0xc8, 0x87, 0xa0, 0x0f, 0x00, 0x26, 0xc5, 0xeb, 0x43, 0xf3, 0x3d,
-	0xcd, 0xdd, 0xb9, 0x02, 0x52, 0x0b, 0xfd, 0x81, 0x84, 0x08, 0x7c, 0xed, 0xd5, 0xd7, 0x9f, 0x6f,
-	0x07, 0x54, 0x34, 0xa5, 0x37, 0x40, 0x7a, 0xcc, 0xee, 0x41, 0xbf, 0x00, 0x3c, 0x1f, 0xff, 0xa0,
-	0xd0, 0xea, 0x9f, 0xcd, 0xed, 0xba, 0x3b, 0x52, 0x6b, 0x7f, 0xd7, 0x44, 0x8a, 0xb9, 0xcf, 0xc5,
-	0x2c, 0xa2, 0x3b, 0x27, 0x88, 0x11, 0x36, 0xd7, 0xf7, 0xf8, 0xdf, 0x7d, 0xbd, 0xf3, 0xed, 0xa3,
-	0x4f, 0x00, 0x8e, 0x44, 0x1c, 0x88, 0x16, 0xfb, 0x60, 0xd6, 0xae, 0xea, 0xee, 0xa9, 0xb0, 0x52,
-	0x8c, 0xc6, 0xc5, 0xa4, 0xd1, 0x74, 0x77, 0x31, 0x21, 0xf5, 0x2f, 0x00, 0x8e, 0x75, 0x6c, 0x01,
-	0x74, 0xaf, 0x0f, 0x0a, 0x31, 0x6b, 0x2d, 0xb5, 0x7c, 0x6a, 0xbc, 0x94, 0xb1, 0xc4, 0x65, 0xdc,
-	0x42, 0x0b, 0x5d, 0x65, 0xe4, 0xb6, 0x3d, 0xea, 0xe4, 0xe4, 0x76, 0xd4, 0xf7, 0xe4, 0xc7, 0x3e,
-	0xfa, 0x0c, 0xe0, 0x68, 0xf4, 0xf9, 0xa1, 0x7e, 0x0e, 0xb5, 0xfd, 0xa1, 0xa7, 0x96, 0x4e, 0x07,
-	0x96, 0x5a, 0x6e, 0x72, 0x2d, 0xb3, 0x28, 0xdd, 0xfd, 0x4a, 0x9a, 0x5b, 0x21, 0xbb, 0x71, 0x70,
-	0xa4, 0x80, 0xc3, 0x23, 0x05, 0xfc, 0x38, 0x52, 0xc0, 0x9b, 0x63, 0x25, 0x71, 0x78, 0xac, 0x24,
-	0xbe, 0x1d, 0x2b, 0x89, 0x27, 0x19, 0xcb, 0x66, 0x3b, 0x95, 0xbc, 0x56, 0xa0, 0x4e, 0xb3, 0x5b,
-	0xc8, 0x49, 0x7f, 0x19, 0xed, 0xcc, 0xaa, 0x65, 0xd3, 0xcf, 0x27, 0xf9, 0x4f, 0x80, 0xf9, 0xdf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xa0, 0xc9, 0x0a, 0x3a, 0x00, 0x09, 0x00, 0x00,
+	// 1062 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x16, 0x92, 0xc6, 0xaf, 0xa4, 0x90, 0x21, 0x0a, 0xc9, 0x36, 0xb1, 0x61, 0xdb, 0xa6,
+	0xa1, 0x94, 0xdd, 0x26, 0xa9, 0xda, 0x12, 0x02, 0xa5, 0x4e, 0x29, 0xa0, 0x26, 0x52, 0x58, 0x6e,
+	0x08, 0x64, 0x8d, 0xed, 0xa9, 0xb3, 0xb2, 0x77, 0xc7, 0xdd, 0x1d, 0x43, 0xac, 0xaa, 0x17, 0x6e,
+	0xf4, 0x84, 0x04, 0x5c, 0xf8, 0x0d, 0x1c, 0xe0, 0xc6, 0x85, 0x2b, 0xf4, 0x58, 0xc1, 0x05, 0x71,
+	0xb0, 0x50, 0x02, 0x7f, 0x20, 0xbf, 0x00, 0xed, 0xcc, 0xf3, 0xae, 0xd7, 0xde, 0xae, 0x6b, 0xf7,
+	0x94, 0x9d, 0x37, 0xef, 0x7b, 0xef, 0xfb, 0x66, 0x9e, 0xe7, 0x53, 0x60, 0x41, 0xf0, 0x3a, 0xf3,
+	0xee, 0xd2, 0x8a, 0xe0, 0x7e, 0xdb, 0xba, 0xd7, 0x62, 0x7e, 0xdb, 0x6c, 0xfa, 0x5c, 0x70, 0x52,
+	0xa8, 0x3b, 0x4e, 0x65, 0x9f, 0x3a, 0x9e, 0xd9, 0xfd, 0xd8, 0x30, 0x7b, 0x93, 0xf5, 0xb9, 0x1a,
+	0xaf, 0x71, 0x99, 0x6b, 0x85, 0x5f, 0x0a, 0xa6, 0x2f, 0xd5, 0x38, 0xaf, 0x35, 0x98, 0x45, 0x9b,
+	0x8e, 0x45, 0x3d, 0x8f, 0x0b, 0x2a, 0x1c, 0xee, 0x05, 0xb8, 0x9b, 0xaf, 0xf0, 0xc0, 0xe5, 0x81,
+	0x55, 0xa6, 0x5e, 0xdd, 0xfa, 0x62, 0xad, 0xcc, 0x04, 0x5d, 0x93, 0x0b, 0xdc, 0xbf, 0x18, 0xed,
+	0x07, 0x4c, 0xb1, 0x89, 0xb2, 0x9a, 0xb4, 0xe6, 0x78, 0xb2, 0x18, 0xe6, 0x9e, 0x4b, 0x50, 0xa7,
+	0x2d, 0xb1, 0xcf, 0x7d, 0x47, 0xb4, 0x77, 0x99, 0xa0, 0x55, 0x2a, 0x28, 0x66, 0x2d, 0x26, 0xb2,
+	0x9a, 0xd4, 0xa7, 0x2e, 0x92, 0x31, 0xe6, 0x80, 0x7c, 0x1c, 0xb6, 0xd8, 0x93, 0x41, 0x9b, 0xdd,
+	0x6b, 0xb1, 0x40, 0x18, 0x9f, 0xc1, 0xcb, 0x89, 0x68, 0xd0, 0xe4, 0x5e, 0xc0, 0xc8, 0xfb, 0x30,
+	0xa5, 0xc0, 0x0b, 0xda, 0xab, 0xda, 0xea, 0xa9, 0xf5, 0x0b, 0xe6, 0x90, 0xf3, 0x31, 0x55, 0x81,
+	0xe2, 0xf3, 0x8f, 0x3a, 0x85, 0x09, 0x1b, 0xc1, 0xc6, 0x0e, 0x18, 0xb2, 0xfa, 0x2d, 0xe6, 0x71,
+	0xf7, 0x66, 0x3f, 0x67, 0xe4, 0x40, 0x56, 0x60, 0xb2, 0x1a, 0x26, 0xc8, 0x5e, 0xb9, 0xe2, 0x4b,
+	0xc7, 0x9d, 0xc2, 0x0b, 0x6d, 0xea, 0x36, 0x36, 0x0d, 0x19, 0x36, 0x6c, 0xb5, 0x6d, 0xfc, 0xac,
+	0xc1, 0xd9, 0xcc, 0x72, 0x48, 0xfe, 0x6b, 0x0d, 0x48, 0x74, 0x40, 0x25, 0x17, 0xb7, 0x51, 0xc9,
+	0xb5, 0xa1, 0x4a, 0xd2, 0xab, 0x17, 0x5f, 0x0b, 0x95, 0x1d, 0x77, 0x0a, 0x8b, 0x8a, 0xda, 0x60,
+	0x03, 0xc3, 0x9e, 0x1d, 0xb8, 0x16, 0xe3, 0x7b, 0x0d, 0x96, 0x63, 0xce, 0xc1, 0x6d, 0x9f, 0xbb,
+	0xdb, 0x3e, 0xa3, 0x82, 0xfb, 0x5d, 0xf5, 0x97, 0xe0, 0x64, 0x45, 0x45, 0x50, 0x3f, 0x39, 0xee,
+	0x14, 0x4e, 0xab, 0x26, 0xb8, 0x61, 0xd8, 0xdd, 0x14, 0x72, 0x1b, 0x20, 0x1e, 0x8d, 0x85, 0x13,
+	0x52, 0xd2, 0x8a, 0xa9, 0xe6, 0xc8, 0x0c, 0xe7, 0xc8, 0x54, 0x53, 0x8d, 0x73, 0x64, 0xee, 0xd1,
+	0x1a, 0xc3, 0x4e, 0x76, 0x0f, 0xd2, 0xf8, 0x4e, 0x83, 0xfc, 0x93, 0x78, 0xe1, 0x31, 0xbe, 0x0e,
+	0x53, 0xf2, 0xdc, 0xc3, 0x19, 0x78, 0x6e, 0x35, 0x57, 0x9c, 0x3d, 0xee, 0x14, 0x66, 0x7a, 0xee,
+	0x25, 0x30, 0x6c, 0x4c, 0x20, 0x1f, 0xa4, 0xb0, 0xba, 0x30, 0x94, 0x95, 0xea, 0x93, 0xa0, 0xb5,
+	0x06, 0x8b, 0x31, 0xab, 0xfe, 0x39, 0x99, 0x4b, 0xcc, 0x49, 0x77, 0x2a, 0x3e, 0x07, 0x3d, 0x0d,
+	0x82, 0x22, 0x6e, 0xc0, 0x74, 0xdf, 0x00, 0x2c, 0xc7, 0xbc, 0xbc, 0x7a, 0xc4, 0x28, 0xba, 0x66,
+	0x35, 0xc0, 0x11, 0xc8, 0x58, 0xef, 0x2d, 0x7f, 0xb3, 0xd1, 0xe0, 0x5f, 0xee, 0x38, 0x81, 0xc8,
+	0xa6, 0x54, 0x86, 0x33, 0xa9, 0x18, 0xe4, 0xb4, 0x0d, 0x40, 0xc3, 0x60, 0xa9, 0xe1, 0x04, 0x02,
+	0x59, 0xe5, 0x53, 0x59, 0x45, 0x58, 0xa4, 0x95, 0xa3, 0xdd, 0x80, 0xf1, 0x21, 0xcc, 0xc7, 0x3d,
+	0x6c, 0xde, 0x60, 0x41, 0x26, 0x27, 0xb2, 0x00, 0x27, 0x69, 0xb5, 0xea, 0xb3, 0x20, 0x90, 0xf7,
+	0x93, 0xb3, 0xbb, 0x4b, 0xc3, 0x82, 0x57, 0x06, 0x2a, 0x21, 0xd3, 0x39, 0x98, 0xf4, 0xc3, 0x80,
+	0x9a, 0x00, 0x5b, 0x2d, 0x8c, 0x3b, 0x28, 0x6f, 0xd7, 0xf1, 0x04, 0xf3, 0x25, 0x47, 0xea, 0x55,
+	0x58, 0x76, 0xff, 0x79, 0x98, 0x72, 0x65, 0x3e, 0xb6, 0xc7, 0x95, 0xf1, 0x50, 0x83, 0xa5, 0xf4,
+	0x6a, 0xc8, 0x61, 0x07, 0x72, 0xb4, 0x1b, 0xc4, 0x5f, 0x88, 0x19, 0x1e, 0xc6, 0xdf, 0x9d, 0xc2,
+	0x4a, 0xcd, 0x11, 0xfb, 0xad, 0xb2, 0x59, 0xe1, 0xae, 0x85, 0x4f, 0xa9, 0xfa, 0xf3, 0x66, 0x50,
+	0xad, 0x5b, 0xa2, 0xdd, 0x64, 0x81, 0xf9, 0x91, 0x27, 0xec, 0xb8, 0x00, 0x59, 0x82, 0x5c, 0xcb,
+	0x6b, 0x38, 0xae, 0x23, 0x58, 0x55, 0x32, 0x99, 0xb6, 0xe3, 0x40, 0xf2, 0xb2, 0x77, 0xe9, 0xc1,
+	0x27, 0xad, 0x66, 0xb3, 0xd1, 0xce, 0xbe, 0xec, 0x87, 0x1a, 0x9c, 0x49, 0x05, 0x21, 0xff, 0x5d,
+	0x00, 0x97, 0x1e, 0x94, 0x02, 0x19, 0x1d, 0x57, 0x80, 0xdb, 0x2d, 0x3b, 0x44, 0x40, 0xe2, 0x2e,
+	0xf7, 0x68, 0x2b, 0x60, 0xd5, 0x6c, 0xf6, 0xeb, 0xb0, 0x30, 0x08, 0x40, 0xe6, 0xf3, 0xa1, 0x09,
+	0x84, 0x11, 0x09, 0x99, 0xb6, 0x71, 0xb5, 0xfe, 0xfb, 0x0c, 0x4c, 0x4a, 0x10, 0xf9, 0x41, 0x83,
+	0x29, 0xf5, 0xf0, 0x93, 0x8d, 0xa1, 0xef, 0xea, 0xa0, 0xfb, 0xe8, 0x57, 0x46, 0x03, 0x29, 0x5e,
+	0xc6, 0xf9, 0xaf, 0xfe, 0xfc, 0xf7, 0xdb, 0x13, 0x05, 0xb2, 0x6c, 0x75, 0x41, 0x56, 0x8a, 0xed,
+	0x91, 0xff, 0x34, 0x98, 0x4f, 0x7f, 0xcb, 0xc9, 0xf6, 0xd3, 0xf5, 0xcd, 0xb4, 0x2d, 0xfd, 0xd6,
+	0xb3, 0x15, 0x41, 0x31, 0xef, 0x49, 0x31, 0x9b, 0xe4, 0xfa, 0x13, 0xc4, 0xa8, 0x17, 0xd6, 0xba,
+	0x2f, 0xff, 0x3e, 0xb0, 0x06, 0x6d, 0x87, 0xfc, 0xa2, 0xc1, 0x4c, 0xe2, 0xf1, 0x23, 0x9b, 0x23,
+	0x30, 0xeb, 0x57, 0xf5, 0xf6, 0x58, 0x58, 0x14, 0x63, 0x4a, 0x31, 0xab, 0x64, 0x25, 0x5b, 0x4c,
+	0x44, 0xfd, 0x0f, 0x0d, 0x66, 0x07, 0x0c, 0x88, 0xbc, 0x3b, 0x02, 0x85, 0x14, 0x47, 0xd5, 0x6f,
+	0x8c, 0x8d, 0x47, 0x19, 0x5b, 0x52, 0xc6, 0x55, 0x72, 0x25, 0x53, 0x46, 0xe9, 0xae, 0xcf, 0xdd,
+	0x12, 0x1a, 0xb3, 0x75, 0x1f, 0x3f, 0x1e, 0x90, 0x5f, 0x35, 0x38, 0x9d, 0x7c, 0xf9, 0xc9, 0x28,
+	0x87, 0xda, 0xef, 0x31, 0xfa, 0xd6, 0x78, 0x60, 0xd4, 0x72, 0x59, 0x6a, 0xb9, 0x48, 0x56, 0xb3,
+	0xaf, 0x24, 0x36, 0x24, 0xf2, 0xa3, 0x06, 0x10, 0x7b, 0x01, 0xb9, 0x36, 0x42, 0xfb, 0x5e, 0x1f,
+	0xd2, 0xaf, 0x8f, 0x0e, 0x44, 0xce, 0x6f, 0x48, 0xce, 0xe7, 0xc9, 0xd9, 0x6c, 0xce, 0xd2, 0x8d,
+	0xc8, 0x6f, 0x1a, 0xbc, 0xd8, 0xe7, 0x1d, 0xe4, 0x29, 0x8f, 0x2c, 0xdd, 0xc0, 0xf4, 0x77, 0xc6,
+	0x44, 0x23, 0xfb, 0xab, 0x92, 0xfd, 0x65, 0x62, 0x0e, 0xf9, 0x11, 0x48, 0x78, 0x29, 0xb6, 0xa6,
+	0x68, 0x6e, 0x22, 0x0f, 0x19, 0x69, 0x6e, 0xfa, 0xed, 0x4a, 0xdf, 0x1a, 0x0f, 0x3c, 0xda, 0xdc,
+	0xc4, 0xd6, 0x46, 0x7e, 0xd2, 0xe0, 0x54, 0x8f, 0x8d, 0x90, 0x51, 0xee, 0x3f, 0x61, 0x55, 0xfa,
+	0x5b, 0x63, 0x20, 0x91, 0xf6, 0x25, 0x49, 0x7b, 0x85, 0x9c, 0xcb, 0xa6, 0xad, 0x9c, 0xac, 0x78,
+	0xe7, 0xd1, 0x61, 0x5e, 0x7b, 0x7c, 0x98, 0xd7, 0xfe, 0x39, 0xcc, 0x6b, 0xdf, 0x1c, 0xe5, 0x27,
+	0x1e, 0x1f, 0xe5, 0x27, 0xfe, 0x3a, 0xca, 0x4f, 0x7c, 0xba, 0xd6, 0xe3, 0xcc, 0x51, 0xa5, 0xe8,
+	0xe3, 0x20, 0x59, 0x54, 0x1a, 0x75, 0x79, 0x4a, 0xfe, 0x9f, 0xb5, 0xf1, 0xff, 0x00, 0x30, 0x00,
+	0x13, 0xb1, 0x65, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -588,14 +1012,25 @@ type QueryClient interface {
 	// DenomAuthorityMetadata for a particular denom.
 	DenomAuthorityMetadata(ctx context.Context, in *QueryDenomAuthorityMetadataRequest, opts ...grpc.CallOption) (*QueryDenomAuthorityMetadataResponse, error)
 	// DenomsMetadata defines a gRPC query method for fetching
-	//
-	//	DenomMetadata for a particular denom.
+	//  DenomMetadata for a particular denom.
 	DenomMetadata(ctx context.Context, in *QueryDenomMetadataRequest, opts ...grpc.CallOption) (*QueryDenomMetadataResponse, error)
 	// DenomsFromCreator defines a gRPC query method for fetching all
 	// denominations created by a specific admin/creator.
 	DenomsFromCreator(ctx context.Context, in *QueryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryDenomsFromCreatorResponse, error)
 	// DenomAllowList defines a gRPC query method for fetching the denom allow list
 	DenomAllowList(ctx context.Context, in *QueryDenomAllowListRequest, opts ...grpc.CallOption) (*QueryDenomAllowListResponse, error)
+	// DenomRoles defines a gRPC query method for fetching the roles an address
+	// was granted over a denom
+	DenomRoles(ctx context.Context, in *QueryDenomRolesRequest, opts ...grpc.CallOption) (*QueryDenomRolesResponse, error)
+	// MinterAllowance defines a gRPC query method for fetching the remaining
+	// amount a minter can mint
+	MinterAllowance(ctx context.Context, in *QueryMinterAllowanceRequest, opts ...grpc.CallOption) (*QueryMinterAllowanceResponse, error)
+	// DenomMaxSupply defines a gRPC query method for fetching the max supply of
+	// a denom
+	DenomMaxSupply(ctx context.Context, in *QueryDenomMaxSupplyRequest, opts ...grpc.CallOption) (*QueryDenomMaxSupplyResponse, error)
+	// DenomPaused defines a gRPC query method for fetching whether the transfers
+	// of a denom are paused
+	DenomPaused(ctx context.Context, in *QueryDenomPausedRequest, opts ...grpc.CallOption) (*QueryDenomPausedResponse, error)
 }
 
 type queryClient struct {
@@ -651,6 +1086,42 @@ func (c *queryClient) DenomAllowList(ctx context.Context, in *QueryDenomAllowLis
 	return out, nil
 }
 
+func (c *queryClient) DenomRoles(ctx context.Context, in *QueryDenomRolesRequest, opts ...grpc.CallOption) (*QueryDenomRolesResponse, error) {
+	out := new(QueryDenomRolesResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.tokenfactory.Query/DenomRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinterAllowance(ctx context.Context, in *QueryMinterAllowanceRequest, opts ...grpc.CallOption) (*QueryMinterAllowanceResponse, error) {
+	out := new(QueryMinterAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.tokenfactory.Query/MinterAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomMaxSupply(ctx context.Context, in *QueryDenomMaxSupplyRequest, opts ...grpc.CallOption) (*QueryDenomMaxSupplyResponse, error) {
+	out := new(QueryDenomMaxSupplyResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.tokenfactory.Query/DenomMaxSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomPaused(ctx context.Context, in *QueryDenomPausedRequest, opts ...grpc.CallOption) (*QueryDenomPausedResponse, error) {
+	out := new(QueryDenomPausedResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.tokenfactory.Query/DenomPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the tokenfactory module's
@@ -660,14 +1131,25 @@ type QueryServer interface {
 	// DenomAuthorityMetadata for a particular denom.
 	DenomAuthorityMetadata(context.Context, *QueryDenomAuthorityMetadataRequest) (*QueryDenomAuthorityMetadataResponse, error)
 	// DenomsMetadata defines a gRPC query method for fetching
-	//
-	//	DenomMetadata for a particular denom.
+	//  DenomMetadata for a particular denom.
 	DenomMetadata(context.Context, *QueryDenomMetadataRequest) (*QueryDenomMetadataResponse, error)
 	// DenomsFromCreator defines a gRPC query method for fetching all
 	// denominations created by a specific admin/creator.
 	DenomsFromCreator(context.Context, *QueryDenomsFromCreatorRequest) (*QueryDenomsFromCreatorResponse, error)
 	// DenomAllowList defines a gRPC query method for fetching the denom allow list
 	DenomAllowList(context.Context, *QueryDenomAllowListRequest) (*QueryDenomAllowListResponse, error)
+	// DenomRoles defines a gRPC query method for fetching the roles an address
+	// was granted over a denom
+	DenomRoles(context.Context, *QueryDenomRolesRequest) (*QueryDenomRolesResponse, error)
+	// MinterAllowance defines a gRPC query method for fetching the remaining
+	// amount a minter can mint
+	MinterAllowance(context.Context, *QueryMinterAllowanceRequest) (*QueryMinterAllowanceResponse, error)
+	// DenomMaxSupply defines a gRPC query method for fetching the max supply of
+	// a denom
+	DenomMaxSupply(context.Context, *QueryDenomMaxSupplyRequest) (*QueryDenomMaxSupplyResponse, error)
+	// DenomPaused defines a gRPC query method for fetching whether the transfers
+	// of a denom are paused
+	DenomPaused(context.Context, *QueryDenomPausedRequest) (*QueryDenomPausedResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -689,6 +1171,18 @@ func (*UnimplementedQueryServer) DenomsFromCreator(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) DenomAllowList(ctx context.Context, req *QueryDenomAllowListRequest) (*QueryDenomAllowListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomAllowList not implemented")
 }
+func (*UnimplementedQueryServer) DenomRoles(ctx context.Context, req *QueryDenomRolesRequest) (*QueryDenomRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomRoles not implemented")
+}
+func (*UnimplementedQueryServer) MinterAllowance(ctx context.Context, req *QueryMinterAllowanceRequest) (*QueryMinterAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinterAllowance not implemented")
+}
+func (*UnimplementedQueryServer) DenomMaxSupply(ctx context.Context, req *QueryDenomMaxSupplyRequest) (*QueryDenomMaxSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomMaxSupply not implemented")
+}
+func (*UnimplementedQueryServer) DenomPaused(ctx context.Context, req *QueryDenomPausedRequest) (*QueryDenomPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomPaused not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -784,9 +1278,81 @@ func _Query_DenomAllowList_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kiichain.kiichain3.tokenfactory.Query",
-	HandlerType: (*QueryServer)(nil),
+func _Query_DenomRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.tokenfactory.Query/DenomRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomRoles(ctx, req.(*QueryDenomRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinterAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinterAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinterAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.tokenfactory.Query/MinterAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinterAllowance(ctx, req.(*QueryMinterAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomMaxSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomMaxSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomMaxSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.tokenfactory.Query/DenomMaxSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomMaxSupply(ctx, req.(*QueryDenomMaxSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.tokenfactory.Query/DenomPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomPaused(ctx, req.(*QueryDenomPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kiichain.kiichain3.tokenfactory.Query",
+	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Params",
@@ -808,6 +1374,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DenomAllowList",
 			Handler:    _Query_DenomAllowList_Handler,
 		},
+		{
+			MethodName: "DenomRoles",
+			Handler:    _Query_DenomRoles_Handler,
+		},
+		{
+			MethodName: "MinterAllowance",
+			Handler:    _Query_MinterAllowance_Handler,
+		},
+		{
+			MethodName: "DenomMaxSupply",
+			Handler:    _Query_DenomMaxSupply_Handler,
+		},
+		{
+			MethodName: "DenomPaused",
+			Handler:    _Query_DenomPaused_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tokenfactory/query.proto",
@@ -1144,152 +1726,1251 @@ func (m *QueryDenomAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryDenomRolesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryDenomRolesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDenomRolesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomAuthorityMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDenomRolesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomRolesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomAuthorityMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMinterAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AuthorityMetadata.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDenomsFromCreatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMinterAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinterAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomsFromCreatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMinterAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinterAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinterAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Denoms) > 0 {
-		for _, s := range m.Denoms {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Unlimited {
+		i--
+		if m.Unlimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Allowance.Size()
+		i -= size
+		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDenomMaxSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMaxSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMaxSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDenomMaxSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Metadata.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDenomAllowListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *QueryDenomMaxSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMaxSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unlimited {
+		i--
+		if m.Unlimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomPausedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomPausedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomPausedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomAuthorityMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomAuthorityMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AuthorityMetadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomsFromCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomsFromCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomAllowListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomAllowListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AllowList.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomRolesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMinterAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMinterAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Allowance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Unlimited {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDenomMaxSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMaxSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Unlimited {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDenomPausedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryDenomAllowListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.AllowList.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomAllowListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1312,12 +2993,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomAllowListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomAllowListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1339,7 +3052,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomAllowListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1362,15 +3075,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomAllowListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1397,7 +3110,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AllowList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1422,7 +3135,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomRolesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1445,10 +3158,10 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomRolesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomRolesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1483,6 +3196,38 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1504,7 +3249,7 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomRolesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1527,17 +3272,17 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomRolesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1547,24 +3292,23 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1587,7 +3331,7 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMinterAllowanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1610,15 +3354,15 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMinterAllowanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMinterAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1646,13 +3390,13 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1662,27 +3406,23 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1705,7 +3445,7 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMinterAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1728,15 +3468,15 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMinterAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMinterAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1764,13 +3504,15 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlimited", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1780,28 +3522,12 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Unlimited = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1823,7 +3549,7 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMaxSupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1846,10 +3572,10 @@ func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMaxSupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMaxSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1905,7 +3631,7 @@ func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMaxSupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1928,17 +3654,17 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMaxSupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMaxSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1948,25 +3674,46 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlimited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unlimited = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1988,7 +3735,7 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomAllowListRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomPausedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2011,10 +3758,10 @@ func (m *QueryDenomAllowListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomAllowListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomPausedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomAllowListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2070,7 +3817,7 @@ func (m *QueryDenomAllowListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomAllowListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomPausedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2093,17 +3840,17 @@ func (m *QueryDenomAllowListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomAllowListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomPausedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowList", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2113,25 +3860,12 @@ func (m *QueryDenomAllowListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.AllowList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])